@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
@@ -16,17 +17,21 @@ import (
 type FacadeHandler interface {
 	GetBalance(address string) (*big.Int, error)
 	GetValueForKey(address string, key string) (string, error)
+	GetProof(address string) (*state.ProofApiResponse, error)
+	GetProofDataTrie(address string, key string) (*state.DataTrieProofApiResponse, error)
 	GetAccount(address string) (state.UserAccountHandler, error)
+	GetAccountAtBlockNonce(address string, blockNonce uint64) (state.UserAccountHandler, error)
 	IsInterfaceNil() bool
 }
 
 type accountResponse struct {
-	Address  string `json:"address"`
-	Nonce    uint64 `json:"nonce"`
-	Balance  string `json:"balance"`
-	Code     string `json:"code"`
-	CodeHash []byte `json:"codeHash"`
-	RootHash []byte `json:"rootHash"`
+	Address     string `json:"address"`
+	Nonce       uint64 `json:"nonce"`
+	Balance     string `json:"balance"`
+	Code        string `json:"code"`
+	CodeHash    []byte `json:"codeHash"`
+	RootHash    []byte `json:"rootHash"`
+	StoredBytes uint64 `json:"storedBytes"`
 }
 
 // Routes defines address related routes
@@ -34,10 +39,13 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, "/:address", GetAccount)
 	router.RegisterHandler(http.MethodGet, "/:address/balance", GetBalance)
 	router.RegisterHandler(http.MethodGet, "/:address/key/:key", GetValueForKey)
+	router.RegisterHandler(http.MethodGet, "/:address/proof", GetProof)
+	router.RegisterHandler(http.MethodGet, "/:address/key/:key/proof", GetProofDataTrie)
 }
 
 // GetAccount returns an accountResponse containing information
-//  about the account correlated with provided address
+//  about the account correlated with provided address. If the blockNonce query parameter
+//  is provided, the account is returned as it was after the block with that nonce was committed
 func GetAccount(c *gin.Context) {
 	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
 	if !ok {
@@ -46,7 +54,20 @@ func GetAccount(c *gin.Context) {
 	}
 
 	addr := c.Param("address")
-	acc, err := ef.GetAccount(addr)
+	var acc state.UserAccountHandler
+	var err error
+	blockNonceParam, hasBlockNonce := c.GetQuery("blockNonce")
+	if hasBlockNonce {
+		blockNonce, errParse := strconv.ParseUint(blockNonceParam, 10, 64)
+		if errParse != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), errors.ErrInvalidBlockNonce.Error())})
+			return
+		}
+
+		acc, err = ef.GetAccountAtBlockNonce(addr, blockNonce)
+	} else {
+		acc, err = ef.GetAccount(addr)
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error())})
 		return
@@ -106,13 +127,66 @@ func GetValueForKey(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"value": value})
 }
 
+// GetProof returns the Merkle proof of the given address, anchored to the current block's root hash
+func GetProof(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error())})
+		return
+	}
+
+	proof, err := ef.GetProof(addr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"proof": proof})
+}
+
+// GetProofDataTrie returns the Merkle proofs of the given key from the address' data trie and of the address itself
+func GetProofDataTrie(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error())})
+		return
+	}
+
+	key := c.Param("key")
+	if key == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyKey.Error())})
+		return
+	}
+
+	proof, err := ef.GetProofDataTrie(addr, key)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"proof": proof})
+}
+
 func accountResponseFromBaseAccount(address string, account state.UserAccountHandler) accountResponse {
 	return accountResponse{
-		Address:  address,
-		Nonce:    account.GetNonce(),
-		Balance:  account.GetBalance().String(),
-		Code:     hex.EncodeToString(account.GetCode()),
-		CodeHash: account.GetCodeHash(),
-		RootHash: account.GetRootHash(),
+		Address:     address,
+		Nonce:       account.GetNonce(),
+		Balance:     account.GetBalance().String(),
+		Code:        hex.EncodeToString(account.GetCode()),
+		CodeHash:    account.GetCodeHash(),
+		RootHash:    account.GetRootHash(),
+		StoredBytes: account.GetStoredBytes(),
 	}
 }
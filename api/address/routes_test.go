@@ -50,15 +50,26 @@ func init() {
 	gin.SetMode(gin.TestMode)
 }
 
+type proofResponse struct {
+	GeneralResponse
+	Proof *state.ProofApiResponse `json:"proof"`
+}
+
+type dataTrieProofResponse struct {
+	GeneralResponse
+	Proof *state.DataTrieProofApiResponse `json:"proof"`
+}
+
 type AccountResponse struct {
 	GeneralResponse
 	Account struct {
-		Address  string `json:"address"`
-		Nonce    uint64 `json:"nonce"`
-		Balance  string `json:"balance"`
-		Code     string `json:"code"`
-		CodeHash []byte `json:"codeHash"`
-		RootHash []byte `json:"rootHash"`
+		Address     string `json:"address"`
+		Nonce       uint64 `json:"nonce"`
+		Balance     string `json:"balance"`
+		Code        string `json:"code"`
+		CodeHash    []byte `json:"codeHash"`
+		RootHash    []byte `json:"rootHash"`
+		StoredBytes uint64 `json:"storedBytes"`
 	} `json:"account"`
 }
 
@@ -212,6 +223,91 @@ func TestGetValueForKey_ShouldWork(t *testing.T) {
 	assert.Equal(t, testValue, valueForKeyResponseObj.Value)
 }
 
+func TestGetProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedProof := &state.ProofApiResponse{
+		BlockNonce: 37,
+		RootHash:   "aabb",
+		Key:        "ccdd",
+		Value:      "eeff",
+		Proof:      []string{"0102", "0304"},
+	}
+	facade := mock.Facade{
+		GetProofCalled: func(address string) (*state.ProofApiResponse, error) {
+			assert.Equal(t, testAddress, address)
+			return expectedProof, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/proof", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, expectedProof, response.Proof)
+}
+
+func TestGetProof_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetProofCalled: func(_ string) (*state.ProofApiResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Nil(t, response.Proof)
+	assert.Equal(t, fmt.Sprintf("%s: %s", errors2.ErrGetProof.Error(), expectedErr.Error()), response.Error)
+}
+
+func TestGetProofDataTrie_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	testKey := "0a0b"
+	expectedProof := &state.DataTrieProofApiResponse{
+		AccountProof:  &state.ProofApiResponse{RootHash: "aabb", Proof: []string{"01"}},
+		DataTrieProof: &state.ProofApiResponse{RootHash: "ccdd", Proof: []string{"02"}},
+		Value:         "eeff",
+	}
+	facade := mock.Facade{
+		GetProofDataTrieCalled: func(address string, key string) (*state.DataTrieProofApiResponse, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, testKey, key)
+			return expectedProof, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/key/%s/proof", testAddress, testKey), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := dataTrieProofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, expectedProof, response.Proof)
+}
+
 func TestGetAccount_FailsWithWrongFacadeTypeConversion(t *testing.T) {
 	t.Parallel()
 
@@ -255,6 +351,7 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 			acc, _ := state.NewUserAccount([]byte("1234"))
 			_ = acc.AddToBalance(big.NewInt(100))
 			acc.IncreaseNonce(1)
+			acc.StoredBytes = 37
 
 			return acc, nil
 		},
@@ -272,9 +369,53 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, accountResponse.Account.Address, reqAddress)
 	assert.Equal(t, accountResponse.Account.Nonce, uint64(1))
 	assert.Equal(t, accountResponse.Account.Balance, "100")
+	assert.Equal(t, accountResponse.Account.StoredBytes, uint64(37))
+	assert.Empty(t, accountResponse.Error)
+}
+
+func TestGetAccount_WithBlockNonceShouldReturnHistoricalAccount(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+			assert.Fail(t, "the current account should not be requested")
+			return nil, nil
+		},
+		GetAccountAtBlockNonceCalled: func(address string, blockNonce uint64) (state.UserAccountHandler, error) {
+			assert.Equal(t, uint64(37), blockNonce)
+			acc, _ := state.NewUserAccount([]byte("1234"))
+			_ = acc.AddToBalance(big.NewInt(50))
+
+			return acc, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test?blockNonce=37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "50", accountResponse.Account.Balance)
 	assert.Empty(t, accountResponse.Error)
 }
 
+func TestGetAccount_WithInvalidBlockNonceShouldErr(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test?blockNonce=latest", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(accountResponse.Error, errors2.ErrInvalidBlockNonce.Error()))
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -319,6 +460,8 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address", Open: true},
 					{Name: "/:address/balance", Open: true},
 					{Name: "/:address/key/:key", Open: true},
+					{Name: "/:address/proof", Open: true},
+					{Name: "/:address/key/:key/proof", Open: true},
 				},
 			},
 		},
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/network"
 	"github.com/ElrondNetwork/elrond-go/api/node"
+	"github.com/ElrondNetwork/elrond-go/api/state"
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
 	valStats "github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
@@ -144,6 +145,13 @@ func registerRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		hardfork.Routes(wrappedHardforkRouter)
 	}
 
+	stateRoutes := ws.Group("/state")
+	stateRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+	wrappedStateRouter, err := wrapper.NewRouterWrapper("state", stateRoutes, routesConfig)
+	if err == nil {
+		state.Routes(wrappedStateRouter)
+	}
+
 	apiHandler, ok := elrondFacade.(MainApiHandler)
 	if ok && apiHandler.PprofEnabled() {
 		pprof.Register(ws)
@@ -19,6 +19,30 @@ var ErrGetBalance = errors.New("get balance error")
 // ErrGetValueForKey signals an error in getting the value of a key for an account
 var ErrGetValueForKey = errors.New("get value for key error")
 
+// ErrInvalidBlockNonce signals that an invalid block nonce has been provided
+var ErrInvalidBlockNonce = errors.New("invalid block nonce")
+
+// ErrGetProof signals an error in getting the Merkle proof of an account or of a data trie key
+var ErrGetProof = errors.New("get proof error")
+
+// ErrGetStateDiff signals an error in getting the diff between two states
+var ErrGetStateDiff = errors.New("get state diff error")
+
+// ErrGetAccountsChangeSet signals an error in getting the accounts changed by a block
+var ErrGetAccountsChangeSet = errors.New("get accounts change set error")
+
+// ErrGetPeerAccounts signals an error in getting the peer accounts at a validator statistics root hash
+var ErrGetPeerAccounts = errors.New("get peer accounts error")
+
+// ErrComparePeerAccounts signals an error in comparing the peer accounts at two validator statistics root hashes
+var ErrComparePeerAccounts = errors.New("compare peer accounts error")
+
+// ErrInvalidEpoch signals an invalid epoch was provided
+var ErrInvalidEpoch = errors.New("invalid epoch")
+
+// ErrEmptyRootHash signals an empty root hash was provided
+var ErrEmptyRootHash = errors.New("root hash is empty")
+
 // ErrEmptyAddress signals an empty address was provided
 var ErrEmptyAddress = errors.New("address is empty")
 
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"encoding/hex"
 	"math/big"
 
@@ -23,19 +24,27 @@ type Facade struct {
 	GetHeartbeatsHandler              func() ([]data.PubKeyHeartbeat, error)
 	BalanceHandler                    func(string) (*big.Int, error)
 	GetAccountHandler                 func(address string) (state.UserAccountHandler, error)
+	GetAccountAtBlockNonceCalled      func(address string, blockNonce uint64) (state.UserAccountHandler, error)
+	GetStateDiffCalled                func(fromRootHash string, toRootHash string) (*state.StateDiffApiResponse, error)
+	GetAccountsChangeSetCalled        func(blockNonce uint64) (*state.AccountsChangeSetApiResponse, error)
 	GenerateTransactionHandler        func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler             func(hash string) (*transaction.ApiTransactionResult, error)
-	CreateTransactionHandler          func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error)
+	CreateTransactionHandler          func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, version uint32, options uint32, signaturesHex []string, notBeforeRound uint64, notBeforeEpoch uint32) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler        func(tx *transaction.Transaction) error
 	SendBulkTransactionsHandler       func(txs []*transaction.Transaction) (uint64, error)
 	ExecuteSCQueryHandler             func(query *process.SCQuery) (*vmcommon.VMOutput, error)
 	StatusMetricsHandler              func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler        func() (map[string]*state.ValidatorApiResponse, error)
+	GetPeerAccountsCalled             func(ctx context.Context, rootHash string) (*state.PeerAccountsApiResponse, error)
+	ComparePeerAccountsCalled         func(ctx context.Context, fromRootHash string, toRootHash string) (*state.PeerAccountsComparisonApiResponse, error)
+	GetEpochStartRootHashCalled       func(epoch uint32) (string, error)
 	ComputeTransactionGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
 	NodeConfigCalled                  func() map[string]interface{}
 	GetQueryHandlerCalled             func(name string) (debug.QueryHandler, error)
 	GetTransactionStatusCalled        func(hash string) (string, error)
 	GetValueForKeyCalled              func(address string, key string) (string, error)
+	GetProofCalled                    func(address string) (*state.ProofApiResponse, error)
+	GetProofDataTrieCalled            func(address string, key string) (*state.DataTrieProofApiResponse, error)
 	GetPeerInfoCalled                 func(pid string) ([]core.QueryP2PPeerInfo, error)
 }
 
@@ -86,11 +95,56 @@ func (f *Facade) GetValueForKey(address string, key string) (string, error) {
 	return "", nil
 }
 
+// GetProof is the mock implementation of a handler's GetProof method
+func (f *Facade) GetProof(address string) (*state.ProofApiResponse, error) {
+	if f.GetProofCalled != nil {
+		return f.GetProofCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetProofDataTrie is the mock implementation of a handler's GetProofDataTrie method
+func (f *Facade) GetProofDataTrie(address string, key string) (*state.DataTrieProofApiResponse, error) {
+	if f.GetProofDataTrieCalled != nil {
+		return f.GetProofDataTrieCalled(address, key)
+	}
+
+	return nil, nil
+}
+
 // GetAccount is the mock implementation of a handler's GetAccount method
 func (f *Facade) GetAccount(address string) (state.UserAccountHandler, error) {
 	return f.GetAccountHandler(address)
 }
 
+// GetAccountAtBlockNonce is the mock implementation of a handler's GetAccountAtBlockNonce method
+func (f *Facade) GetAccountAtBlockNonce(address string, blockNonce uint64) (state.UserAccountHandler, error) {
+	if f.GetAccountAtBlockNonceCalled != nil {
+		return f.GetAccountAtBlockNonceCalled(address, blockNonce)
+	}
+
+	return nil, nil
+}
+
+// GetStateDiff is the mock implementation of a handler's GetStateDiff method
+func (f *Facade) GetStateDiff(fromRootHash string, toRootHash string) (*state.StateDiffApiResponse, error) {
+	if f.GetStateDiffCalled != nil {
+		return f.GetStateDiffCalled(fromRootHash, toRootHash)
+	}
+
+	return nil, nil
+}
+
+// GetAccountsChangeSet is the mock implementation of a handler's GetAccountsChangeSet method
+func (f *Facade) GetAccountsChangeSet(blockNonce uint64) (*state.AccountsChangeSetApiResponse, error) {
+	if f.GetAccountsChangeSetCalled != nil {
+		return f.GetAccountsChangeSetCalled(blockNonce)
+	}
+
+	return nil, nil
+}
+
 // CreateTransaction is  mock implementation of a handler's CreateTransaction method
 func (f *Facade) CreateTransaction(
 	nonce uint64,
@@ -101,8 +155,13 @@ func (f *Facade) CreateTransaction(
 	gasLimit uint64,
 	data string,
 	signatureHex string,
+	version uint32,
+	options uint32,
+	signaturesHex []string,
+	notBeforeRound uint64,
+	notBeforeEpoch uint32,
 ) (*transaction.Transaction, []byte, error) {
-	return f.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex)
+	return f.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, version, options, signaturesHex, notBeforeRound, notBeforeEpoch)
 }
 
 // GetTransaction is the mock implementation of a handler's GetTransaction method
@@ -125,6 +184,33 @@ func (f *Facade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiRespons
 	return f.ValidatorStatisticsHandler()
 }
 
+// GetPeerAccounts is the mock implementation of a handler's GetPeerAccounts method
+func (f *Facade) GetPeerAccounts(ctx context.Context, rootHash string) (*state.PeerAccountsApiResponse, error) {
+	if f.GetPeerAccountsCalled != nil {
+		return f.GetPeerAccountsCalled(ctx, rootHash)
+	}
+
+	return nil, nil
+}
+
+// ComparePeerAccounts is the mock implementation of a handler's ComparePeerAccounts method
+func (f *Facade) ComparePeerAccounts(ctx context.Context, fromRootHash string, toRootHash string) (*state.PeerAccountsComparisonApiResponse, error) {
+	if f.ComparePeerAccountsCalled != nil {
+		return f.ComparePeerAccountsCalled(ctx, fromRootHash, toRootHash)
+	}
+
+	return nil, nil
+}
+
+// GetEpochStartValidatorStatsRootHash is the mock implementation of a handler's GetEpochStartValidatorStatsRootHash method
+func (f *Facade) GetEpochStartValidatorStatsRootHash(epoch uint32) (string, error) {
+	if f.GetEpochStartRootHashCalled != nil {
+		return f.GetEpochStartRootHashCalled(epoch)
+	}
+
+	return "", nil
+}
+
 // ExecuteSCQuery is a mock implementation.
 func (f *Facade) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
 	return f.ExecuteSCQueryHandler(query)
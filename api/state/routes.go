@@ -0,0 +1,73 @@
+package state
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/gin-gonic/gin"
+)
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	GetStateDiff(fromRootHash string, toRootHash string) (*state.StateDiffApiResponse, error)
+	GetAccountsChangeSet(blockNonce uint64) (*state.AccountsChangeSetApiResponse, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines state related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, "/diff", GetStateDiff)
+	router.RegisterHandler(http.MethodGet, "/changes/:nonce", GetAccountsChangeSet)
+}
+
+// GetStateDiff returns the leaves that differ between the tries having the hex encoded root hashes provided
+// in the from and to query parameters
+func GetStateDiff(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	fromRootHash := c.Query("from")
+	toRootHash := c.Query("to")
+	if fromRootHash == "" || toRootHash == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetStateDiff.Error(), errors.ErrEmptyRootHash.Error())})
+		return
+	}
+
+	diff, err := ef.GetStateDiff(fromRootHash, toRootHash)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetStateDiff.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"diff": diff})
+}
+
+// GetAccountsChangeSet returns the accounts changed by the block having the nonce provided in the request path
+func GetAccountsChangeSet(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	blockNonce, err := strconv.ParseUint(c.Param("nonce"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetAccountsChangeSet.Error(), errors.ErrInvalidBlockNonce.Error())})
+		return
+	}
+
+	changeSet, err := ef.GetAccountsChangeSet(blockNonce)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetAccountsChangeSet.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"changeSet": changeSet})
+}
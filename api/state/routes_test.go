@@ -0,0 +1,233 @@
+package state_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	stateApi "github.com/ElrondNetwork/elrond-go/api/state"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var log = logger.GetOrCreate("api/state_test")
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+type stateDiffResponse struct {
+	Diff  *state.StateDiffApiResponse `json:"diff"`
+	Error string                      `json:"error"`
+}
+
+type accountsChangeSetResponse struct {
+	ChangeSet *state.AccountsChangeSetApiResponse `json:"changeSet"`
+	Error     string                              `json:"error"`
+}
+
+func startNodeServer(handler stateApi.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ginStateRoute := ws.Group("/state")
+	if handler != nil {
+		ginStateRoute.Use(middleware.WithElrondFacade(handler))
+	}
+	stateRoute, _ := wrapper.NewRouterWrapper("state", ginStateRoute, getRoutesConfig())
+	stateApi.Routes(stateRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	ginStateRoute := ws.Group("/state")
+	stateRoute, _ := wrapper.NewRouterWrapper("state", ginStateRoute, getRoutesConfig())
+	stateApi.Routes(stateRoute)
+	return ws
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	log.LogIfError(err)
+}
+
+func TestGetStateDiff_WithWrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/state/diff?from=aa&to=bb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := stateDiffResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestGetStateDiff_MissingRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{}
+	ws := startNodeServer(facade)
+
+	for _, url := range []string{"/state/diff", "/state/diff?from=aa", "/state/diff?to=bb"} {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := stateDiffResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrEmptyRootHash.Error())
+	}
+}
+
+func TestGetStateDiff_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		GetStateDiffCalled: func(fromRootHash string, toRootHash string) (*state.StateDiffApiResponse, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/state/diff?from=aa&to=bb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := stateDiffResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrGetStateDiff.Error())
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestGetStateDiff_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedDiff := &state.StateDiffApiResponse{
+		FromRootHash: "aa",
+		ToRootHash:   "bb",
+		Diffs: []state.LeafDiffApiResponse{
+			{Type: "added", Key: "01", NewValue: "02"},
+		},
+	}
+	facade := &mock.Facade{
+		GetStateDiffCalled: func(fromRootHash string, toRootHash string) (*state.StateDiffApiResponse, error) {
+			assert.Equal(t, "aa", fromRootHash)
+			assert.Equal(t, "bb", toRootHash)
+			return expectedDiff, nil
+		},
+	}
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/state/diff?from=aa&to=bb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := stateDiffResponse{}
+	loadResponse(resp.Body, &response)
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedDiff, response.Diff)
+}
+
+func TestGetAccountsChangeSet_InvalidNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{}
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/state/changes/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountsChangeSetResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrInvalidBlockNonce.Error())
+}
+
+func TestGetAccountsChangeSet_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		GetAccountsChangeSetCalled: func(blockNonce uint64) (*state.AccountsChangeSetApiResponse, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/state/changes/5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountsChangeSetResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrGetAccountsChangeSet.Error())
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestGetAccountsChangeSet_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedChangeSet := &state.AccountsChangeSetApiResponse{
+		BlockNonce: 5,
+		BlockHash:  "aa",
+		RootHash:   "bb",
+		Changes: []state.AccountChangeApiResponse{
+			{Address: "01", IsNew: true, Nonce: 1, Balance: "10", BalanceDelta: "10", DataTrieKeys: []string{}},
+		},
+	}
+	facade := &mock.Facade{
+		GetAccountsChangeSetCalled: func(blockNonce uint64) (*state.AccountsChangeSetApiResponse, error) {
+			assert.Equal(t, uint64(5), blockNonce)
+			return expectedChangeSet, nil
+		},
+	}
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/state/changes/5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountsChangeSetResponse{}
+	loadResponse(resp.Body, &response)
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedChangeSet, response.ChangeSet)
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"state": {
+				Routes: []config.RouteConfig{
+					{Name: "/diff", Open: true},
+					{Name: "/changes/:nonce", Open: true},
+				},
+			},
+		},
+	}
+}
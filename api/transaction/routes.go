@@ -15,7 +15,7 @@ import (
 // TxService interface defines methods that can be used from `elrondFacade` context variable
 type TxService interface {
 	CreateTransaction(nonce uint64, value string, receiver string, sender string, gasPrice uint64,
-		gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error)
+		gasLimit uint64, data string, signatureHex string, version uint32, options uint32, signaturesHex []string, notBeforeRound uint64, notBeforeEpoch uint32) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	GetTransaction(hash string) (*transaction.ApiTransactionResult, error)
@@ -42,14 +42,19 @@ type MultipleTxRequest struct {
 
 // SendTxRequest represents the structure that maps and validates user input for publishing a new transaction
 type SendTxRequest struct {
-	Sender    string `form:"sender" json:"sender"`
-	Receiver  string `form:"receiver" json:"receiver"`
-	Value     string `form:"value" json:"value"`
-	Data      string `form:"data" json:"data"`
-	Nonce     uint64 `form:"nonce" json:"nonce"`
-	GasPrice  uint64 `form:"gasPrice" json:"gasPrice"`
-	GasLimit  uint64 `form:"gasLimit" json:"gasLimit"`
-	Signature string `form:"signature" json:"signature"`
+	Sender         string   `form:"sender" json:"sender"`
+	Receiver       string   `form:"receiver" json:"receiver"`
+	Value          string   `form:"value" json:"value"`
+	Data           string   `form:"data" json:"data"`
+	Nonce          uint64   `form:"nonce" json:"nonce"`
+	GasPrice       uint64   `form:"gasPrice" json:"gasPrice"`
+	GasLimit       uint64   `form:"gasLimit" json:"gasLimit"`
+	Signature      string   `form:"signature" json:"signature"`
+	Version        uint32   `form:"version" json:"version,omitempty"`
+	Options        uint32   `form:"options" json:"options,omitempty"`
+	Signatures     []string `form:"signatures" json:"signatures,omitempty"`
+	NotBeforeRound uint64   `form:"notBeforeRound" json:"notBeforeRound,omitempty"`
+	NotBeforeEpoch uint32   `form:"notBeforeEpoch" json:"notBeforeEpoch,omitempty"`
 }
 
 //TxResponse represents the structure on which the response will be validated against
@@ -95,6 +100,11 @@ func SendTransaction(c *gin.Context) {
 		gtx.GasLimit,
 		gtx.Data,
 		gtx.Signature,
+		gtx.Version,
+		gtx.Options,
+		gtx.Signatures,
+		gtx.NotBeforeRound,
+		gtx.NotBeforeEpoch,
 	)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error())})
@@ -147,6 +157,11 @@ func SendMultipleTransactions(c *gin.Context) {
 			receivedTx.GasLimit,
 			receivedTx.Data,
 			receivedTx.Signature,
+			receivedTx.Version,
+			receivedTx.Options,
+			receivedTx.Signatures,
+			receivedTx.NotBeforeRound,
+			receivedTx.NotBeforeEpoch,
 		)
 		if err != nil {
 			continue
@@ -245,6 +260,11 @@ func ComputeTransactionGasLimit(c *gin.Context) {
 		gtx.GasLimit,
 		gtx.Data,
 		gtx.Signature,
+		gtx.Version,
+		gtx.Options,
+		gtx.Signatures,
+		gtx.NotBeforeRound,
+		gtx.NotBeforeEpoch,
 	)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
@@ -222,7 +222,7 @@ func TestSendTransaction_ErrorWhenFacadeSendTransactionError(t *testing.T) {
 	errorString := "send transaction error"
 
 	facade := mock.Facade{
-		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string) (t *tr.Transaction, i []byte, err error) {
+		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, version uint32, options uint32, signaturesHex []string, notBeforeRound uint64, notBeforeEpoch uint32) (t *tr.Transaction, i []byte, err error) {
 			return nil, nil, nil
 		},
 		SendBulkTransactionsHandler: func(txs []*tr.Transaction) (u uint64, err error) {
@@ -267,7 +267,7 @@ func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 
 	facade := mock.Facade{
 		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-			gasLimit uint64, data string, signatureHex string) (t *tr.Transaction, i []byte, err error) {
+			gasLimit uint64, data string, signatureHex string, version uint32, options uint32, signaturesHex []string, notBeforeRound uint64, notBeforeEpoch uint32) (t *tr.Transaction, i []byte, err error) {
 			txHash, _ := hex.DecodeString(hexTxHash)
 			return nil, txHash, nil
 		},
@@ -346,7 +346,7 @@ func TestSendMultipleTransactions_OkPayloadShouldWork(t *testing.T) {
 
 	facade := mock.Facade{
 		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-			gasLimit uint64, data string, signatureHex string) (*tr.Transaction, []byte, error) {
+			gasLimit uint64, data string, signatureHex string, version uint32, options uint32, signaturesHex []string, notBeforeRound uint64, notBeforeEpoch uint32) (*tr.Transaction, []byte, error) {
 			createTxWasCalled = true
 			return &tr.Transaction{}, make([]byte, 0), nil
 		},
@@ -395,7 +395,7 @@ func TestComputeTransactionGasLimit(t *testing.T) {
 	expectedGasLimit := uint64(37)
 
 	facade := mock.Facade{
-		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ string, _ uint64, _ uint64, _ string, _ string) (*tr.Transaction, []byte, error) {
+		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ string, _ uint64, _ uint64, _ string, _ string, _ uint32, _ uint32, _ []string, _ uint64, _ uint32) (*tr.Transaction, []byte, error) {
 			return &tr.Transaction{}, nil, nil
 		},
 		ComputeTransactionGasLimitHandler: func(tx *tr.Transaction) (uint64, error) {
@@ -1,7 +1,10 @@
 package validator
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
@@ -12,12 +15,17 @@ import (
 // ValidatorsStatisticsApiHandler interface defines methods that can be used from `elrondFacade` context variable
 type ValidatorsStatisticsApiHandler interface {
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetPeerAccounts(ctx context.Context, rootHash string) (*state.PeerAccountsApiResponse, error)
+	ComparePeerAccounts(ctx context.Context, fromRootHash string, toRootHash string) (*state.PeerAccountsComparisonApiResponse, error)
+	GetEpochStartValidatorStatsRootHash(epoch uint32) (string, error)
 	IsInterfaceNil() bool
 }
 
 // Routes defines validators' related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, "/statistics", Statistics)
+	router.RegisterHandler(http.MethodGet, "/peer-accounts", PeerAccounts)
+	router.RegisterHandler(http.MethodGet, "/peer-accounts/compare", ComparePeerAccounts)
 }
 
 // Statistics will return the validation statistics for all validators
@@ -36,3 +44,73 @@ func Statistics(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"statistics": valStats})
 }
+
+// PeerAccounts will return the full data of the peer accounts read at the validator statistics root hash provided
+// in the rootHash query parameter or at the start of the epoch provided in the epoch query parameter
+func PeerAccounts(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(ValidatorsStatisticsApiHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	rootHash, err := getValidatorStatsRootHash(ef, c.Query("rootHash"), c.Query("epoch"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetPeerAccounts.Error(), err.Error())})
+		return
+	}
+
+	peerAccounts, err := ef.GetPeerAccounts(c.Request.Context(), rootHash)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetPeerAccounts.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"peerAccounts": peerAccounts})
+}
+
+// ComparePeerAccounts will return the peer accounts that differ between two validator statistics root hashes,
+// provided either in the fromRootHash and toRootHash query parameters or as the start blocks of the epochs
+// provided in the fromEpoch and toEpoch query parameters
+func ComparePeerAccounts(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(ValidatorsStatisticsApiHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	fromRootHash, err := getValidatorStatsRootHash(ef, c.Query("fromRootHash"), c.Query("fromEpoch"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrComparePeerAccounts.Error(), err.Error())})
+		return
+	}
+	toRootHash, err := getValidatorStatsRootHash(ef, c.Query("toRootHash"), c.Query("toEpoch"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrComparePeerAccounts.Error(), err.Error())})
+		return
+	}
+
+	comparison, err := ef.ComparePeerAccounts(c.Request.Context(), fromRootHash, toRootHash)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrComparePeerAccounts.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"comparison": comparison})
+}
+
+func getValidatorStatsRootHash(ef ValidatorsStatisticsApiHandler, rootHash string, epoch string) (string, error) {
+	if rootHash != "" {
+		return rootHash, nil
+	}
+	if epoch == "" {
+		return "", errors.ErrEmptyRootHash
+	}
+
+	epochValue, err := strconv.ParseUint(epoch, 10, 32)
+	if err != nil {
+		return "", errors.ErrInvalidEpoch
+	}
+
+	return ef.GetEpochStartValidatorStatsRootHash(uint32(epochValue))
+}
@@ -1,6 +1,7 @@
 package validator_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/validator"
@@ -25,6 +27,16 @@ type ValidatorStatisticsResponse struct {
 	Error  string                                 `json:"error"`
 }
 
+type PeerAccountsResponse struct {
+	Result *state.PeerAccountsApiResponse `json:"peerAccounts"`
+	Error  string                         `json:"error"`
+}
+
+type PeerAccountsComparisonResponse struct {
+	Result *state.PeerAccountsComparisonApiResponse `json:"comparison"`
+	Error  string                                   `json:"error"`
+}
+
 func TestValidatorStatistics_ErrorWithWrongFacade(t *testing.T) {
 	t.Parallel()
 
@@ -91,6 +103,185 @@ func TestValidatorStatistics_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, response.Result, mapToReturn)
 }
 
+func TestPeerAccounts_ErrorWithWrongFacade(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/validator/peer-accounts?rootHash=aa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
+
+func TestPeerAccounts_MissingRootHashAndEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	req, _ := http.NewRequest("GET", "/validator/peer-accounts", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := PeerAccountsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrEmptyRootHash.Error())
+}
+
+func TestPeerAccounts_InvalidEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	req, _ := http.NewRequest("GET", "/validator/peer-accounts?epoch=abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := PeerAccountsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrInvalidEpoch.Error())
+}
+
+func TestPeerAccounts_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errStr := "error in facade"
+	facade := mock.Facade{
+		GetPeerAccountsCalled: func(_ context.Context, rootHash string) (*state.PeerAccountsApiResponse, error) {
+			return nil, errors.New(errStr)
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/validator/peer-accounts?rootHash=aa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := PeerAccountsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errStr)
+}
+
+func TestPeerAccounts_WithRootHashShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &state.PeerAccountsApiResponse{
+		RootHash: "aa",
+		PeerAccounts: map[string]*state.PeerAccountApiResponse{
+			"bls": {BLSPublicKey: "bls", Rating: 50, AccumulatedFees: "10"},
+		},
+	}
+	facade := mock.Facade{
+		GetPeerAccountsCalled: func(_ context.Context, rootHash string) (*state.PeerAccountsApiResponse, error) {
+			assert.Equal(t, "aa", rootHash)
+			return expectedResponse, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/validator/peer-accounts?rootHash=aa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := PeerAccountsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedResponse, response.Result)
+}
+
+func TestPeerAccounts_WithEpochShouldReadTheEpochStartRootHash(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetEpochStartRootHashCalled: func(epoch uint32) (string, error) {
+			assert.Equal(t, uint32(4), epoch)
+			return "bb", nil
+		},
+		GetPeerAccountsCalled: func(_ context.Context, rootHash string) (*state.PeerAccountsApiResponse, error) {
+			return &state.PeerAccountsApiResponse{RootHash: rootHash}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/validator/peer-accounts?epoch=4", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := PeerAccountsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "bb", response.Result.RootHash)
+}
+
+func TestComparePeerAccounts_MissingArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	for _, query := range []string{"", "?fromRootHash=aa", "?toEpoch=2", "?fromEpoch=x&toEpoch=2"} {
+		req, _ := http.NewRequest("GET", "/validator/peer-accounts/compare"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := PeerAccountsComparisonResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrComparePeerAccounts.Error())
+	}
+}
+
+func TestComparePeerAccounts_EpochStartRootHashErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errStr := "epoch start block not found"
+	facade := mock.Facade{
+		GetEpochStartRootHashCalled: func(epoch uint32) (string, error) {
+			return "", errors.New(errStr)
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/validator/peer-accounts/compare?fromEpoch=1&toEpoch=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := PeerAccountsComparisonResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, errStr)
+}
+
+func TestComparePeerAccounts_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &state.PeerAccountsComparisonApiResponse{
+		FromRootHash: "aa",
+		ToRootHash:   "02",
+		Changes: map[string]*state.PeerAccountChangeApiResponse{
+			"bls": {
+				From:        &state.PeerAccountApiResponse{BLSPublicKey: "bls", Rating: 50},
+				To:          &state.PeerAccountApiResponse{BLSPublicKey: "bls", Rating: 40},
+				RatingDelta: -10,
+			},
+		},
+	}
+	facade := mock.Facade{
+		GetEpochStartRootHashCalled: func(epoch uint32) (string, error) {
+			return fmt.Sprintf("%02d", epoch), nil
+		},
+		ComparePeerAccountsCalled: func(_ context.Context, fromRootHash string, toRootHash string) (*state.PeerAccountsComparisonApiResponse, error) {
+			assert.Equal(t, "aa", fromRootHash)
+			assert.Equal(t, "02", toRootHash)
+			return expectedResponse, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/validator/peer-accounts/compare?fromRootHash=aa&toEpoch=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := PeerAccountsComparisonResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedResponse, response.Result)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -135,6 +326,8 @@ func getRoutesConfig() config.ApiRoutesConfig {
 			"validator": {
 				[]config.RouteConfig{
 					{Name: "/statistics", Open: true},
+					{Name: "/peer-accounts", Open: true},
+					{Name: "/peer-accounts/compare", Open: true},
 				},
 			},
 		},
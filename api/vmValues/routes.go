@@ -19,11 +19,13 @@ type FacadeHandler interface {
 	IsInterfaceNil() bool
 }
 
-// VMValueRequest represents the structure on which user input for generating a new transaction will validate against
+// VMValueRequest represents the structure on which user input for generating a new transaction will validate against.
+// The optional block nonce selects the state on which the query is executed, by default the current state is used
 type VMValueRequest struct {
-	ScAddress string   `form:"scAddress" json:"scAddress"`
-	FuncName  string   `form:"funcName" json:"funcName"`
-	Args      []string `form:"args"  json:"args"`
+	ScAddress  string   `form:"scAddress" json:"scAddress"`
+	FuncName   string   `form:"funcName" json:"funcName"`
+	Args       []string `form:"args"  json:"args"`
+	BlockNonce *uint64  `form:"blockNonce" json:"blockNonce"`
 }
 
 // Routes defines address related routes
@@ -120,9 +122,10 @@ func createSCQuery(fh FacadeHandler, request *VMValueRequest) (*process.SCQuery,
 	}
 
 	return &process.SCQuery{
-		ScAddress: decodedAddress,
-		FuncName:  request.FuncName,
-		Arguments: arguments,
+		ScAddress:  decodedAddress,
+		FuncName:   request.FuncName,
+		Arguments:  arguments,
+		BlockNonce: request.BlockNonce,
 	}, nil
 }
 
@@ -0,0 +1,98 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/economics"
+	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
+	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var _ process.QueryVMContainerCreator = (*queryVMContainerCreator)(nil)
+
+// ArgsQueryVMContainerCreator holds the arguments needed to create the virtual machines used by the queries. The
+// accounts set in ArgBlockChainHook are replaced by the ones provided on each creation
+type ArgsQueryVMContainerCreator struct {
+	ArgBlockChainHook    hooks.ArgBlockChainHook
+	VirtualMachineConfig config.VirtualMachineConfig
+	GasSchedule          map[string]map[string]uint64
+	Economics            *economics.EconomicsData
+	MessageSigVerifier   vm.MessageSignVerifier
+	NodesSetup           sharding.GenesisNodesSetupHandler
+	Hasher               hashing.Hasher
+	Marshalizer          marshal.Marshalizer
+	SystemSCConfig       *config.SystemSmartContractsConfig
+	ValidatorAccounts    state.AccountsAdapter
+}
+
+type queryVMContainerCreator struct {
+	args ArgsQueryVMContainerCreator
+}
+
+// NewQueryVMContainerCreator creates a component able to build the virtual machines, each with its own
+// blockchain hook, working on a given accounts adapter
+func NewQueryVMContainerCreator(args ArgsQueryVMContainerCreator) (*queryVMContainerCreator, error) {
+	if check.IfNil(args.ArgBlockChainHook.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if args.Economics == nil {
+		return nil, process.ErrNilEconomicsData
+	}
+
+	return &queryVMContainerCreator{
+		args: args,
+	}, nil
+}
+
+// CreateVMContainer creates the virtual machines and their blockchain hook over the provided accounts. On metachain,
+// the system smart contracts read the validators from the peer accounts provided on construction
+func (qvcc *queryVMContainerCreator) CreateVMContainer(accounts state.AccountsAdapter) (process.VirtualMachinesContainer, error) {
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+
+	argsHook := qvcc.args.ArgBlockChainHook
+	argsHook.Accounts = accounts
+	shardCoordinator := argsHook.ShardCoordinator
+
+	var vmFactory process.VirtualMachinesContainerFactory
+	var err error
+	if shardCoordinator.SelfId() == core.MetachainShardId {
+		vmFactory, err = metachain.NewVMContainerFactory(
+			argsHook,
+			qvcc.args.Economics,
+			qvcc.args.MessageSigVerifier,
+			qvcc.args.GasSchedule,
+			qvcc.args.NodesSetup,
+			qvcc.args.Hasher,
+			qvcc.args.Marshalizer,
+			qvcc.args.SystemSCConfig,
+			qvcc.args.ValidatorAccounts,
+		)
+	} else {
+		vmFactory, err = shard.NewVMContainerFactory(
+			qvcc.args.VirtualMachineConfig,
+			qvcc.args.Economics.MaxGasLimitPerBlock(shardCoordinator.SelfId()),
+			qvcc.args.GasSchedule,
+			argsHook,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return vmFactory.Create()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (qvcc *queryVMContainerCreator) IsInterfaceNil() bool {
+	return qvcc == nil
+}
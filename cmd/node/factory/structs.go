@@ -98,6 +98,7 @@ type Process struct {
 	HeaderIntegrityVerifier  HeaderIntegrityVerifierHandler
 	ValidatorsStatistics     process.ValidatorStatisticsProcessor
 	ValidatorsProvider       process.ValidatorsProvider
+	PeerAccountsExplorer     process.PeerAccountsExplorer
 	BlockTracker             process.BlockTracker
 	PendingMiniBlocksHandler process.PendingMiniBlocksHandler
 	RequestHandler           process.RequestHandler
@@ -295,6 +296,18 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
+	argPeerAccountsExplorer := peer.ArgPeerAccountsExplorer{
+		PeerAdapter:              args.state.PeerAccounts,
+		Marshalizer:              args.coreData.InternalMarshalizer,
+		Store:                    args.data.Store,
+		ValidatorPubKeyConverter: args.validatorPubkeyConverter,
+		AddressPubKeyConverter:   args.state.AddressPubkeyConverter,
+	}
+	peerAccountsExplorer, err := peer.NewPeerAccountsExplorer(argPeerAccountsExplorer)
+	if err != nil {
+		return nil, err
+	}
+
 	epochStartTrigger, err := newEpochStartTrigger(args, requestHandler)
 	if err != nil {
 		return nil, err
@@ -408,6 +421,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		epochStartTrigger,
 		args.whiteListHandler,
 		args.whiteListerVerifiedTxs,
+		args.mainConfig.GeneralSettings.TxVersionEnableEpoch,
 	)
 	if err != nil {
 		return nil, err
@@ -482,6 +496,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		HeaderIntegrityVerifier:  headerIntegrityVerifier,
 		ValidatorsStatistics:     validatorStatisticsProcessor,
 		ValidatorsProvider:       validatorsProvider,
+		PeerAccountsExplorer:     peerAccountsExplorer,
 		BlockTracker:             blockTracker,
 		PendingMiniBlocksHandler: pendingMiniBlocksHandler,
 		RequestHandler:           requestHandler,
@@ -638,6 +653,7 @@ func newInterceptorContainerFactory(
 	epochStartTrigger process.EpochStartTriggerHandler,
 	whiteListHandler process.WhiteListHandler,
 	whiteListerVerifiedTxs process.WhiteListHandler,
+	txVersionEnableEpoch uint32,
 ) (process.InterceptorsContainerFactory, process.BlackListHandler, error) {
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
 		return newShardInterceptorContainerFactory(
@@ -656,6 +672,7 @@ func newInterceptorContainerFactory(
 			epochStartTrigger,
 			whiteListHandler,
 			whiteListerVerifiedTxs,
+			txVersionEnableEpoch,
 		)
 	}
 	if shardCoordinator.SelfId() == core.MetachainShardId {
@@ -675,6 +692,7 @@ func newInterceptorContainerFactory(
 			epochStartTrigger,
 			whiteListHandler,
 			whiteListerVerifiedTxs,
+			txVersionEnableEpoch,
 		)
 	}
 
@@ -733,10 +751,12 @@ func newShardInterceptorContainerFactory(
 	epochStartTrigger process.EpochStartTriggerHandler,
 	whiteListHandler process.WhiteListHandler,
 	whiteListerVerifiedTxs process.WhiteListHandler,
+	txVersionEnableEpoch uint32,
 ) (process.InterceptorsContainerFactory, process.BlackListHandler, error) {
 	headerBlackList := timecache.NewTimeCache(timeSpanForBadHeaders)
 	shardInterceptorsContainerFactoryArgs := interceptorscontainer.ShardInterceptorsContainerFactoryArgs{
 		Accounts:                state.AccountsAdapter,
+		BlockChain:              data.Blkc,
 		ShardCoordinator:        shardCoordinator,
 		NodesCoordinator:        nodesCoordinator,
 		Messenger:               network.NetMessenger,
@@ -763,6 +783,7 @@ func newShardInterceptorContainerFactory(
 		WhiteListerVerifiedTxs:  whiteListerVerifiedTxs,
 		AntifloodHandler:        network.InputAntifloodHandler,
 		NonceConverter:          dataCore.Uint64ByteSliceConverter,
+		TxVersionEnableEpoch:    txVersionEnableEpoch,
 	}
 	interceptorContainerFactory, err := interceptorscontainer.NewShardInterceptorsContainerFactory(shardInterceptorsContainerFactoryArgs)
 	if err != nil {
@@ -788,6 +809,7 @@ func newMetaInterceptorContainerFactory(
 	epochStartTrigger process.EpochStartTriggerHandler,
 	whiteListHandler process.WhiteListHandler,
 	whiteListerVerifiedTxs process.WhiteListHandler,
+	txVersionEnableEpoch uint32,
 ) (process.InterceptorsContainerFactory, process.BlackListHandler, error) {
 	headerBlackList := timecache.NewTimeCache(timeSpanForBadHeaders)
 	metaInterceptorsContainerFactoryArgs := interceptorscontainer.MetaInterceptorsContainerFactoryArgs{
@@ -801,6 +823,7 @@ func newMetaInterceptorContainerFactory(
 		MultiSigner:             crypto.MultiSigner,
 		DataPool:                data.Datapool,
 		Accounts:                state.AccountsAdapter,
+		BlockChain:              data.Blkc,
 		AddressPubkeyConverter:  state.AddressPubkeyConverter,
 		SingleSigner:            crypto.TxSingleSigner,
 		BlockSingleSigner:       crypto.SingleSigner,
@@ -818,6 +841,7 @@ func newMetaInterceptorContainerFactory(
 		WhiteListerVerifiedTxs:  whiteListerVerifiedTxs,
 		AntifloodHandler:        network.InputAntifloodHandler,
 		NonceConverter:          dataCore.Uint64ByteSliceConverter,
+		TxVersionEnableEpoch:    txVersionEnableEpoch,
 	}
 	interceptorContainerFactory, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(metaInterceptorsContainerFactoryArgs)
 	if err != nil {
@@ -1101,9 +1125,11 @@ func newShardBlockProcessor(
 	argsParser := vmcommon.NewAtArgumentParser()
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:          gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     core.InternalMarshalizer,
+		GasMap:                      gasSchedule,
+		MapDNSAddresses:             make(map[string]struct{}),
+		Marshalizer:                 core.InternalMarshalizer,
+		EpochNotifier:               core.EpochNotifier,
+		MultisigAccountsEnableEpoch: config.GeneralSettings.MultisigAccountsEnableEpoch,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -1169,8 +1195,9 @@ func newShardBlockProcessor(
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  stateComponents.AddressPubkeyConverter,
 		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
+		BuiltInFunctions: builtInFuncs,
 		ArgumentParser:   vmcommon.NewAtArgumentParser(),
+		EpochNotifier:    core.EpochNotifier,
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	if err != nil {
@@ -1230,6 +1257,9 @@ func newShardBlockProcessor(
 		economics,
 		receiptTxInterim,
 		badTxInterim,
+		scForwarder,
+		core.EpochNotifier,
+		config.GeneralSettings.NonceLanesEnableEpoch,
 	)
 	if err != nil {
 		return nil, errors.New("could not create transaction statisticsProcessor: " + err.Error())
@@ -1268,6 +1298,7 @@ func newShardBlockProcessor(
 		blockTracker,
 		blockSizeComputationHandler,
 		balanceComputationHandler,
+		vmFactory.BlockChainHookImpl(),
 	)
 	if err != nil {
 		return nil, err
@@ -1312,6 +1343,7 @@ func newShardBlockProcessor(
 		RequestHandler:         requestHandler,
 		Core:                   coreServiceContainer,
 		BlockChainHook:         vmFactory.BlockChainHookImpl(),
+		EpochNotifier:          core.EpochNotifier,
 		TxCoordinator:          txCoordinator,
 		Rounder:                rounder,
 		EpochStartTrigger:      epochStartTrigger,
@@ -1428,8 +1460,9 @@ func newMetaBlockProcessor(
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  stateComponents.AddressPubkeyConverter,
 		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
+		BuiltInFunctions: builtInFuncs,
 		ArgumentParser:   vmcommon.NewAtArgumentParser(),
+		EpochNotifier:    core.EpochNotifier,
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	if err != nil {
@@ -1513,6 +1546,7 @@ func newMetaBlockProcessor(
 		stateComponents.AddressPubkeyConverter,
 		blockSizeComputationHandler,
 		balanceComputationHandler,
+		vmFactory.BlockChainHookImpl(),
 	)
 	if err != nil {
 		return nil, err
@@ -1541,7 +1575,10 @@ func newMetaBlockProcessor(
 		return nil, err
 	}
 
-	scDataGetter, err := smartContract.NewSCQueryService(vmContainer, economicsData)
+	scDataGetter, err := smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{
+		VmContainer:  vmContainer,
+		EconomicsFee: economicsData,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1638,6 +1675,7 @@ func newMetaBlockProcessor(
 		RequestHandler:         requestHandler,
 		Core:                   coreServiceContainer,
 		BlockChainHook:         vmFactory.BlockChainHookImpl(),
+		EpochNotifier:          core.EpochNotifier,
 		TxCoordinator:          txCoordinator,
 		EpochStartTrigger:      epochStartTrigger,
 		Rounder:                rounder,
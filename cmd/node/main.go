@@ -31,6 +31,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/random"
 	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/statistics/machine"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
@@ -38,8 +39,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	trieFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/debug/consistency"
+	storerDebug "github.com/ElrondNetwork/elrond-go/debug/storer"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
@@ -55,8 +60,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
-	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
-	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	processFactory "github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	"github.com/ElrondNetwork/elrond-go/process/rating"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
@@ -65,6 +69,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/adaptivecache"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
@@ -671,7 +676,18 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		core.PathIdentifierPlaceholder)
 
 	var pathManager *pathmanager.PathManager
-	pathManager, err = pathmanager.NewPathManager(pathTemplateForPruningStorer, pathTemplateForStaticStorer)
+	pathManager, err = createPathManager(
+		pathTemplateForPruningStorer,
+		pathTemplateForStaticStorer,
+		generalConfig.StoragePruning.ColdStoragePath,
+		genesisNodesConfig.ChainID,
+	)
+	if err != nil {
+		return err
+	}
+
+	memoryBudget := adaptivecache.NewMemoryBudget()
+	err = startAdaptiveCachesMonitoring(memoryBudget, generalConfig.AdaptiveCache)
 	if err != nil {
 		return err
 	}
@@ -853,6 +869,11 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	storersDebugger, err := storerDebug.NewStorersDebugger(generalConfig.Debug.Storer)
+	if err != nil {
+		return err
+	}
+
 	epochStartBootstrapArgs := bootstrap.ArgsEpochStartBootstrap{
 		PublicKey:                  cryptoParams.PublicKey,
 		Marshalizer:                coreComponents.InternalMarshalizer,
@@ -881,6 +902,8 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		AddressPubkeyConverter:     addressPubkeyConverter,
 		LatestStorageDataProvider:  latestStorageDataProvider,
 		StatusHandler:              coreComponents.StatusHandler,
+		StorerWrapper:              storersDebugger,
+		MemoryBudget:               memoryBudget,
 	}
 	bootstrapper, err := bootstrap.NewEpochStartBootstrap(epochStartBootstrapArgs)
 	if err != nil {
@@ -926,6 +949,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	log.Trace("creating data components")
 	epochStartNotifier := notifier.NewEpochStartSubscriptionHandler()
+	registerTrieStorageManagersForEpochChange(triesComponents.TrieStorageManagers, coreComponents.EpochNotifier)
 
 	dataArgs := mainFactory.DataComponentsFactoryArgs{
 		Config:             *generalConfig,
@@ -935,6 +959,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		PathManager:        pathManager,
 		EpochStartNotifier: epochStartNotifier,
 		CurrentEpoch:       storerEpoch,
+		MemoryBudget:       memoryBudget,
 	}
 	dataComponentsFactory, err := mainFactory.NewDataComponentsFactory(dataArgs)
 	if err != nil {
@@ -944,6 +969,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	if err != nil {
 		return err
 	}
+	wrapChainStorers(dataComponents.Store, storersDebugger)
 
 	log.Trace("initializing metrics")
 	err = metrics.InitMetrics(
@@ -1131,6 +1157,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	if err != nil {
 		return err
 	}
+	registerTxPoolRemovalHandler(dataComponents.Datapool.Transactions(), whiteListRequest, whiteListerVerifiedTxs)
 
 	log.Trace("creating process components")
 	processArgs := factory.NewProcessComponentsFactoryArgs(
@@ -1201,6 +1228,21 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		elasticIndexer.SetTxLogsProcessor(processComponents.TxLogsProcessor)
 		processComponents.TxLogsProcessor.EnableLogToBeSavedInCache()
 	}
+	log.Trace("creating historical accounts provider")
+	historicalAccountsProvider, err := stateFactory.NewHistoricalAccountsProvider(stateFactory.ArgsHistoricalAccountsProvider{
+		TrieStorage:          triesComponents.TrieStorageManagers[trieFactory.UserAccountTrie],
+		Marshalizer:          coreComponents.InternalMarshalizer,
+		Hasher:               coreComponents.Hasher,
+		AccountFactory:       stateFactory.NewAccountCreator(),
+		MaxTrieLevelInMemory: generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+		StorageService:       dataComponents.Store,
+		Uint64Converter:      coreComponents.Uint64ByteSliceConverter,
+		ShardId:              shardCoordinator.SelfId(),
+	})
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating node structure")
 	currentNode, err := createNode(
 		generalConfig,
@@ -1233,6 +1275,16 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	err = nodeDebugFactory.AddStorersDebugHandler(currentNode, storersDebugger)
+	if err != nil {
+		return err
+	}
+
+	err = currentNode.ApplyOptions(node.WithHistoricalAccountsProvider(historicalAccountsProvider))
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating software checker structure")
 	softwareVersionChecker, err := factory.CreateSoftwareVersionChecker(coreComponents.StatusHandler, generalConfig.SoftwareVersionConfig)
 	if err != nil {
@@ -1264,6 +1316,8 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		cryptoComponents.MessageSignVerifier,
 		genesisNodesConfig,
 		systemSCConfig,
+		historicalAccountsProvider,
+		coreComponents.EpochNotifier,
 	)
 	if err != nil {
 		return err
@@ -1282,6 +1336,75 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	storersHolder, ok := dataComponents.Store.(metrics.StorersHolder)
+	if ok {
+		err = metrics.StartStorageStatisticsPolling(coreComponents.StatusHandler, statusPollingInterval, storersHolder)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = metrics.StartStorersDebugPolling(coreComponents.StatusHandler, statusPollingInterval, storersDebugger)
+	if err != nil {
+		return err
+	}
+
+	err = metrics.StartCachesStatisticsPolling(coreComponents.StatusHandler, statusPollingInterval, memoryBudget)
+	if err != nil {
+		return err
+	}
+
+	err = metrics.StartDecodedTrieNodesCachesPolling(
+		coreComponents.StatusHandler,
+		statusPollingInterval,
+		getDecodedTrieNodesCaches(triesComponents),
+	)
+	if err != nil {
+		return err
+	}
+
+	var triesChecker io.Closer
+	if generalConfig.TrieConsistencyChecker.Enabled {
+		log.Trace("creating tries consistency checker")
+		checkInterval := time.Duration(generalConfig.TrieConsistencyChecker.CheckIntervalInMinutes) * time.Minute
+		checker, errCreate := consistency.NewTriesChecker(checkInterval)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		trieCheckers, errCreate := createTriesConsistencyCheckers(
+			generalConfig.TrieConsistencyChecker,
+			triesComponents,
+			dataComponents,
+			coreComponents,
+			processComponents.RequestHandler,
+			shardCoordinator,
+		)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		for _, trieChecker := range trieCheckers {
+			err = checker.AddChecker(trieChecker)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = nodeDebugFactory.AddTriesConsistencyDebugHandler(currentNode, checker)
+		if err != nil {
+			return err
+		}
+
+		err = metrics.StartTriesConsistencyPolling(coreComponents.StatusHandler, statusPollingInterval, checker)
+		if err != nil {
+			return err
+		}
+
+		checker.StartChecking()
+		triesChecker = checker
+	}
+
 	updateMachineStatisticsDuration := time.Second
 	err = metrics.StartMachineStatisticsPolling(coreComponents.StatusHandler, updateMachineStatisticsDuration)
 	if err != nil {
@@ -1333,7 +1456,11 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	}
 
 	go func() {
+		if triesChecker != nil {
+			log.LogIfError(triesChecker.Close())
+		}
 		closeAllComponents(log, dataComponents, triesComponents, networkComponents)
+		log.LogIfError(memoryBudget.Close())
 	}()
 	time.Sleep(maxTimeToClose)
 	handleAppClose(log, sig)
@@ -1351,6 +1478,15 @@ func closeAllComponents(
 	err := dataComponents.Store.CloseAll()
 	log.LogIfError(err)
 
+	txPool, ok := dataComponents.Datapool.Transactions().(io.Closer)
+	if ok {
+		err = txPool.Close()
+		log.LogIfError(err)
+	}
+
+	err = dataComponents.TxPoolJournal.Close()
+	log.LogIfError(err)
+
 	dataTries := triesComponents.TriesContainer.GetAll()
 	for _, trie := range dataTries {
 		err = trie.ClosePersister()
@@ -1907,6 +2043,7 @@ func createHardForkTrigger(
 		Uint64Converter:          coreData.Uint64ByteSliceConverter,
 		DataPool:                 data.Datapool,
 		StorageService:           data.Store,
+		BlockChain:               data.Blkc,
 		RequestHandler:           process.RequestHandler,
 		ShardCoordinator:         shardCoordinator,
 		Messenger:                network.NetMessenger,
@@ -1931,6 +2068,7 @@ func createHardForkTrigger(
 		InputAntifloodHandler:    network.InputAntifloodHandler,
 		OutputAntifloodHandler:   network.OutputAntifloodHandler,
 		ValidityAttester:         process.BlockTracker,
+		TxVersionEnableEpoch:     config.GeneralSettings.TxVersionEnableEpoch,
 	}
 	hardForkExportFactory, err := exportFactory.NewExportHandlerFactory(argsExporter)
 	if err != nil {
@@ -2066,6 +2204,8 @@ func createNode(
 		node.WithHeaderIntegrityVerifier(process.HeaderIntegrityVerifier),
 		node.WithValidatorStatistics(process.ValidatorsStatistics),
 		node.WithValidatorsProvider(process.ValidatorsProvider),
+		node.WithPeerAccountsExplorer(process.PeerAccountsExplorer),
+		node.WithTxPoolJournal(data.TxPoolJournal),
 		node.WithChainID(coreData.ChainID),
 		node.WithBlockTracker(process.BlockTracker),
 		node.WithRequestHandler(process.RequestHandler),
@@ -2078,6 +2218,8 @@ func createNode(
 		node.WithPublicKeySize(config.ValidatorPubkeyConverter.Length),
 		node.WithNodeStopChannel(chanStopNodeProcess),
 		node.WithApiTransactionByHashThrottler(apiTxsByHashThrottler),
+		node.WithFullArchive(config.StoragePruning.FullArchive),
+		node.WithTxVersionEnableEpoch(config.GeneralSettings.TxVersionEnableEpoch),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
@@ -2214,14 +2356,15 @@ func createApiResolver(
 	messageSigVerifier vm.MessageSignVerifier,
 	nodesSetup sharding.GenesisNodesSetupHandler,
 	systemSCConfig *config.SystemSmartContractsConfig,
+	historicalAccountsProvider state.HistoricalAccountsProvider,
+	epochNotifier process.EpochNotifier,
 ) (facade.ApiResolver, error) {
-	var vmFactory process.VirtualMachinesContainerFactory
-	var err error
-
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:          gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     marshalizer,
+		GasMap:                      gasSchedule,
+		MapDNSAddresses:             make(map[string]struct{}),
+		Marshalizer:                 marshalizer,
+		EpochNotifier:               epochNotifier,
+		MultisigAccountsEnableEpoch: config.GeneralSettings.MultisigAccountsEnableEpoch,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -2239,54 +2382,54 @@ func createApiResolver(
 		BuiltInFunctions: builtInFuncs,
 	}
 
-	if shardCoordinator.SelfId() == core.MetachainShardId {
-		vmFactory, err = metachain.NewVMContainerFactory(
-			argsHook,
-			economics,
-			messageSigVerifier,
-			gasSchedule,
-			nodesSetup,
-			hasher,
-			marshalizer,
-			systemSCConfig,
-			validatorAccounts,
-		)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		vmFactory, err = shard.NewVMContainerFactory(
-			config.VirtualMachineConfig,
-			economics.MaxGasLimitPerBlock(shardCoordinator.SelfId()),
-			gasSchedule,
-			argsHook)
-		if err != nil {
-			return nil, err
-		}
+	argsQueryVMContainerCreator := factory.ArgsQueryVMContainerCreator{
+		ArgBlockChainHook:    argsHook,
+		VirtualMachineConfig: config.VirtualMachineConfig,
+		GasSchedule:          gasSchedule,
+		Economics:            economics,
+		MessageSigVerifier:   messageSigVerifier,
+		NodesSetup:           nodesSetup,
+		Hasher:               hasher,
+		Marshalizer:          marshalizer,
+		SystemSCConfig:       systemSCConfig,
+		ValidatorAccounts:    validatorAccounts,
+	}
+	queryVMContainerCreator, err := factory.NewQueryVMContainerCreator(argsQueryVMContainerCreator)
+	if err != nil {
+		return nil, err
 	}
 
-	vmContainer, err := vmFactory.Create()
+	vmContainer, err := queryVMContainerCreator.CreateVMContainer(accnts)
 	if err != nil {
 		return nil, err
 	}
 
-	scQueryService, err := smartContract.NewSCQueryService(vmContainer, economics)
+	scQueryService, err := smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{
+		VmContainer:                vmContainer,
+		EconomicsFee:               economics,
+		QueryVMContainerCreator:        queryVMContainerCreator,
+		HistoricalAccountsProvider:     historicalAccountsProvider,
+		IsFullArchive:                  config.StoragePruning.FullArchive,
+		MaxConcurrentHistoricalQueries: config.VirtualMachineConfig.MaxConcurrentHistoricalQueries,
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  pubkeyConv,
-		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   vmcommon.NewAtArgumentParser(),
+		PubkeyConverter:      pubkeyConv,
+		ShardCoordinator:     shardCoordinator,
+		BuiltInFunctions:     builtInFuncs,
+		ArgumentParser:       vmcommon.NewAtArgumentParser(),
+		EpochNotifier:        epochNotifier,
+		RelayedTxEnableEpoch: config.GeneralSettings.RelayedTxEnableEpoch,
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	if err != nil {
 		return nil, err
 	}
 
-	txCostHandler, err := transaction.NewTransactionCostEstimator(txTypeHandler, economics, scQueryService, gasSchedule)
+	txCostHandler, err := transaction.NewTransactionCostEstimator(txTypeHandler, economics, scQueryService, marshalizer, gasSchedule)
 	if err != nil {
 		return nil, err
 	}
@@ -2294,6 +2437,21 @@ func createApiResolver(
 	return external.NewNodeApiResolver(scQueryService, statusMetrics, txCostHandler)
 }
 
+// registerTxPoolRemovalHandler removes from the white lists the transactions evicted, replaced or expired inside the
+// transactions pool, so that they are intercepted and verified again if received afterwards
+func registerTxPoolRemovalHandler(txPool dataRetriever.ShardedDataCacherNotifier, whiteLists ...process.WhiteListHandler) {
+	removalNotifier, ok := txPool.(dataRetriever.TxPoolRemovalNotifier)
+	if !ok {
+		return
+	}
+
+	removalNotifier.RegisterRemovalHandler(func(txHashes [][]byte) {
+		for _, whiteList := range whiteLists {
+			whiteList.Remove(txHashes)
+		}
+	})
+}
+
 func createWhiteListerVerifiedTxs(generalConfig *config.Config) (process.WhiteListHandler, error) {
 	whiteListCacheVerified, err := storageUnit.NewCache(
 		storageUnit.CacheType(generalConfig.WhiteListerVerifiedTxs.Type),
@@ -2306,3 +2464,137 @@ func createWhiteListerVerifiedTxs(generalConfig *config.Config) (process.WhiteLi
 	}
 	return interceptors.NewWhiteListDataVerifier(whiteListCacheVerified)
 }
+
+func createPathManager(
+	pathTemplateForPruningStorer string,
+	pathTemplateForStaticStorer string,
+	coldStoragePath string,
+	chainID string,
+) (*pathmanager.PathManager, error) {
+	if len(coldStoragePath) == 0 {
+		return pathmanager.NewPathManager(pathTemplateForPruningStorer, pathTemplateForStaticStorer)
+	}
+
+	pathTemplateForColdStorage := filepath.Join(
+		coldStoragePath,
+		chainID,
+		fmt.Sprintf("%s_%s", defaultEpochString, core.PathEpochPlaceholder),
+		fmt.Sprintf("%s_%s", defaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	return pathmanager.NewPathManagerWithColdStorage(
+		pathTemplateForPruningStorer,
+		pathTemplateForStaticStorer,
+		pathTemplateForColdStorage,
+	)
+}
+
+// getDecodedTrieNodesCaches returns the decoded nodes caches placed in front of the tries' databases, if enabled
+func getDecodedTrieNodesCaches(triesComponents *mainFactory.TriesComponents) map[string]metrics.DecodedTrieNodesCacheStatisticsHandler {
+	caches := make(map[string]metrics.DecodedTrieNodesCacheStatisticsHandler)
+	for name, trieStorageManager := range triesComponents.TrieStorageManagers {
+		cache, ok := trieStorageManager.Database().(metrics.DecodedTrieNodesCacheStatisticsHandler)
+		if ok {
+			caches[name] = cache
+		}
+	}
+
+	return caches
+}
+
+func wrapChainStorers(store dataRetriever.StorageService, storerWrapper storage.StorerWrapper) {
+	chainStorer, ok := store.(*dataRetriever.ChainStorer)
+	if !ok {
+		return
+	}
+
+	for unitType, storer := range chainStorer.GetAllStorers() {
+		chainStorer.AddStorer(unitType, storerWrapper.Wrap(unitType.String(), storer))
+	}
+}
+
+func createTriesConsistencyCheckers(
+	checkerConfig config.TrieConsistencyCheckerConfig,
+	triesComponents *mainFactory.TriesComponents,
+	dataComponents *mainFactory.DataComponents,
+	coreComponents *mainFactory.CoreComponents,
+	requestHandler process.RequestHandler,
+	shardCoordinator sharding.Coordinator,
+) ([]consistency.TrieConsistencyChecker, error) {
+	accountsRootHashProvider, err := consistency.NewAccountsRootHashProvider(dataComponents.Blkc)
+	if err != nil {
+		return nil, err
+	}
+
+	dataTrieRootHashExtractor, err := state.NewDataTrieRootHashExtractor(coreComponents.InternalMarshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	accountsChecker, err := trie.NewTrieConsistencyChecker(trie.ArgTrieConsistencyChecker{
+		Name:                     "accounts",
+		TrieStorage:              triesComponents.TrieStorageManagers[trieFactory.UserAccountTrie],
+		Marshalizer:              coreComponents.InternalMarshalizer,
+		Hasher:                   coreComponents.Hasher,
+		RootHashProvider:         accountsRootHashProvider,
+		LeafRootHashExtractor:    dataTrieRootHashExtractor,
+		RequestHandler:           requestHandler,
+		InterceptedNodes:         dataComponents.Datapool.TrieNodes(),
+		ShardId:                  shardCoordinator.SelfId(),
+		Topic:                    processFactory.AccountTrieNodesTopic,
+		RequestMissingNodes:      checkerConfig.RequestMissingNodes,
+		MaxCheckedNodesPerSecond: checkerConfig.MaxCheckedNodesPerSecond,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if shardCoordinator.SelfId() != core.MetachainShardId {
+		return []consistency.TrieConsistencyChecker{accountsChecker}, nil
+	}
+
+	validatorStatisticsRootHashProvider, err := consistency.NewValidatorStatisticsRootHashProvider(dataComponents.Blkc)
+	if err != nil {
+		return nil, err
+	}
+
+	peerChecker, err := trie.NewTrieConsistencyChecker(trie.ArgTrieConsistencyChecker{
+		Name:                     "peer",
+		TrieStorage:              triesComponents.TrieStorageManagers[trieFactory.PeerAccountTrie],
+		Marshalizer:              coreComponents.InternalMarshalizer,
+		Hasher:                   coreComponents.Hasher,
+		RootHashProvider:         validatorStatisticsRootHashProvider,
+		RequestHandler:           requestHandler,
+		InterceptedNodes:         dataComponents.Datapool.TrieNodes(),
+		ShardId:                  core.MetachainShardId,
+		Topic:                    processFactory.ValidatorTrieNodesTopic,
+		RequestMissingNodes:      checkerConfig.RequestMissingNodes,
+		MaxCheckedNodesPerSecond: checkerConfig.MaxCheckedNodesPerSecond,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []consistency.TrieConsistencyChecker{accountsChecker, peerChecker}, nil
+}
+
+func startAdaptiveCachesMonitoring(budget *adaptivecache.MemoryBudget, adaptiveCacheConfig config.AdaptiveCacheConfig) error {
+	budget.SetTotalSizeInBytes(adaptiveCacheConfig.TotalSizeInBytes)
+
+	return budget.StartMonitoring(adaptivecache.ArgsMemoryMonitoring{
+		MemoryStatistics:       &machine.MemStatistics{},
+		HighMemoryUsagePercent: adaptiveCacheConfig.HighMemoryUsagePercent,
+		CheckInterval:          time.Duration(adaptiveCacheConfig.CheckIntervalInSeconds) * time.Second,
+	})
+}
+
+// registerTrieStorageManagersForEpochChange keeps the trie storage managers updated with the epoch of the block being
+// processed or created, which decides the encoding version of the trie nodes hashed and written for that block
+func registerTrieStorageManagersForEpochChange(
+	trieStorageManagers map[string]data.StorageManager,
+	epochNotifier process.EpochNotifier,
+) {
+	for _, trieStorageManager := range trieStorageManagers {
+		epochNotifier.RegisterNotifyHandler(trieStorageManager)
+	}
+}
@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/appStatusPolling"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage/adaptivecache"
+)
+
+// CachesStatisticsHandler defines a component able to provide the statistics of the adaptive caches
+type CachesStatisticsHandler interface {
+	CachesStatistics() []adaptivecache.CacheStatistics
+	UsedSizeInBytes() uint64
+	ScalePercent() float64
+}
+
+// StartCachesStatisticsPolling will start saving information in status handler about the adaptive caches
+func StartCachesStatisticsPolling(
+	ash core.AppStatusHandler,
+	pollingInterval time.Duration,
+	statisticsHandler CachesStatisticsHandler,
+) error {
+	if check.IfNil(ash) {
+		return errors.New("nil AppStatusHandler")
+	}
+	if statisticsHandler == nil {
+		return errors.New("nil caches statistics handler")
+	}
+
+	appStatusPollingHandler, err := appStatusPolling.NewAppStatusPolling(ash, pollingInterval)
+	if err != nil {
+		return errors.New("cannot init AppStatusPolling")
+	}
+
+	computeCachesStatistics := func(appStatusHandler core.AppStatusHandler) {
+		appStatusHandler.SetUInt64Value(core.MetricAdaptiveCachesSizeInBytes, statisticsHandler.UsedSizeInBytes())
+		appStatusHandler.SetStringValue(core.MetricAdaptiveCachesScalePercent, fmt.Sprintf("%.2f", statisticsHandler.ScalePercent()))
+
+		// caches with the same name are reported together
+		statisticsByName := make(map[string]adaptivecache.CacheStatistics)
+		for _, statistics := range statisticsHandler.CachesStatistics() {
+			summed := statisticsByName[statistics.Name]
+			summed.NumHits += statistics.NumHits
+			summed.NumMisses += statistics.NumMisses
+			statisticsByName[statistics.Name] = summed
+		}
+
+		for name, statistics := range statisticsByName {
+			metricKey := core.MetricCachePrefix + name + "_hit_ratio"
+			appStatusHandler.SetStringValue(metricKey, fmt.Sprintf("%.4f", statistics.HitRatio()))
+		}
+	}
+
+	err = appStatusPollingHandler.RegisterPollingFunc(computeCachesStatistics)
+	if err != nil {
+		return fmt.Errorf("%w, cannot register handler func for caches statistics", err)
+	}
+
+	appStatusPollingHandler.Poll()
+
+	return nil
+}
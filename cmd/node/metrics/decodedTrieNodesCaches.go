@@ -0,0 +1,76 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/appStatusPolling"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+// DecodedTrieNodesCacheStatisticsHandler defines a component able to provide the usage of a decoded trie nodes cache
+type DecodedTrieNodesCacheStatisticsHandler interface {
+	NumHits() uint64
+	NumMisses() uint64
+	IsInterfaceNil() bool
+}
+
+// StartDecodedTrieNodesCachesPolling will start saving the decoded trie nodes caches' statistics in status handler.
+// The caches are provided by the name of the trie using them
+func StartDecodedTrieNodesCachesPolling(
+	ash core.AppStatusHandler,
+	pollingInterval time.Duration,
+	caches map[string]DecodedTrieNodesCacheStatisticsHandler,
+) error {
+	if check.IfNil(ash) {
+		return errors.New("nil AppStatusHandler")
+	}
+	for name, cache := range caches {
+		if check.IfNil(cache) {
+			return fmt.Errorf("nil decoded trie nodes cache statistics handler for %s", name)
+		}
+	}
+
+	appStatusPollingHandler, err := appStatusPolling.NewAppStatusPolling(ash, pollingInterval)
+	if err != nil {
+		return errors.New("cannot init AppStatusPolling")
+	}
+
+	computeDecodedTrieNodesCaches := func(appStatusHandler core.AppStatusHandler) {
+		for name, cache := range caches {
+			setDecodedTrieNodesCacheMetrics(appStatusHandler, name, cache)
+		}
+	}
+
+	err = appStatusPollingHandler.RegisterPollingFunc(computeDecodedTrieNodesCaches)
+	if err != nil {
+		return fmt.Errorf("%w, cannot register handler func for decoded trie nodes caches", err)
+	}
+
+	appStatusPollingHandler.Poll()
+
+	return nil
+}
+
+func setDecodedTrieNodesCacheMetrics(
+	appStatusHandler core.AppStatusHandler,
+	name string,
+	cache DecodedTrieNodesCacheStatisticsHandler,
+) {
+	metricKey := func(statisticName string) string {
+		return core.MetricDecodedTrieNodesCachePrefix + name + "_" + statisticName
+	}
+
+	numHits := cache.NumHits()
+	numMisses := cache.NumMisses()
+	hitRatio := float64(0)
+	if numHits+numMisses > 0 {
+		hitRatio = float64(numHits) / float64(numHits+numMisses)
+	}
+
+	appStatusHandler.SetUInt64Value(metricKey("num_hits"), numHits)
+	appStatusHandler.SetUInt64Value(metricKey("num_misses"), numMisses)
+	appStatusHandler.SetStringValue(metricKey("hit_ratio"), fmt.Sprintf("%.4f", hitRatio))
+}
@@ -0,0 +1,140 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/appStatusPolling"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/debug/storer"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// StorersHolder defines a component able to return all the storers used by the node
+type StorersHolder interface {
+	GetAllStorers() map[dataRetriever.UnitType]storage.Storer
+}
+
+// StorersStatisticsHandler defines a component able to provide the usage statistics of the storers
+type StorersStatisticsHandler interface {
+	Statistics() map[string]storer.UnitStatistics
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
+type bloomFilterStatisticsHandler interface {
+	BloomFilterStatistics() storage.BloomFilterStatistics
+}
+
+// StartStorageStatisticsPolling will start saving information in status handler about the storers
+func StartStorageStatisticsPolling(
+	ash core.AppStatusHandler,
+	pollingInterval time.Duration,
+	storersHolder StorersHolder,
+) error {
+	if check.IfNil(ash) {
+		return errors.New("nil AppStatusHandler")
+	}
+	if storersHolder == nil {
+		return errors.New("nil storers holder")
+	}
+
+	appStatusPollingHandler, err := appStatusPolling.NewAppStatusPolling(ash, pollingInterval)
+	if err != nil {
+		return errors.New("cannot init AppStatusPolling")
+	}
+
+	err = registerBloomFilterStatistics(appStatusPollingHandler, storersHolder)
+	if err != nil {
+		return err
+	}
+
+	appStatusPollingHandler.Poll()
+
+	return nil
+}
+
+func registerBloomFilterStatistics(
+	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
+	storersHolder StorersHolder,
+) error {
+
+	computeBloomFilterStatistics := func(appStatusHandler core.AppStatusHandler) {
+		statistics := storage.BloomFilterStatistics{}
+		for _, s := range storersHolder.GetAllStorers() {
+			statisticsHandler, ok := s.(bloomFilterStatisticsHandler)
+			if !ok {
+				continue
+			}
+
+			statistics = statistics.Add(statisticsHandler.BloomFilterStatistics())
+		}
+
+		appStatusHandler.SetStringValue(core.MetricBloomFilterFalsePositiveRate, fmt.Sprintf("%.4f", statistics.FalsePositiveRate()))
+	}
+
+	err := appStatusPollingHandler.RegisterPollingFunc(computeBloomFilterStatistics)
+	if err != nil {
+		return fmt.Errorf("%w, cannot register handler func for bloom filter statistics", err)
+	}
+
+	return nil
+}
+
+// StartStorersDebugPolling will start saving the storers' usage statistics in status handler
+func StartStorersDebugPolling(
+	ash core.AppStatusHandler,
+	pollingInterval time.Duration,
+	statisticsHandler StorersStatisticsHandler,
+) error {
+	if check.IfNil(ash) {
+		return errors.New("nil AppStatusHandler")
+	}
+	if check.IfNil(statisticsHandler) {
+		return errors.New("nil storers statistics handler")
+	}
+
+	appStatusPollingHandler, err := appStatusPolling.NewAppStatusPolling(ash, pollingInterval)
+	if err != nil {
+		return errors.New("cannot init AppStatusPolling")
+	}
+
+	computeStorersStatistics := func(appStatusHandler core.AppStatusHandler) {
+		if !statisticsHandler.IsEnabled() {
+			return
+		}
+
+		for identifier, statistics := range statisticsHandler.Statistics() {
+			setStorerMetrics(appStatusHandler, identifier, statistics)
+		}
+	}
+
+	err = appStatusPollingHandler.RegisterPollingFunc(computeStorersStatistics)
+	if err != nil {
+		return fmt.Errorf("%w, cannot register handler func for storers statistics", err)
+	}
+
+	appStatusPollingHandler.Poll()
+
+	return nil
+}
+
+func setStorerMetrics(appStatusHandler core.AppStatusHandler, identifier string, statistics storer.UnitStatistics) {
+	metricKey := func(name string) string {
+		return core.MetricStorerPrefix + identifier + "_" + name
+	}
+
+	appStatusHandler.SetUInt64Value(metricKey("num_puts"), statistics.NumPuts)
+	appStatusHandler.SetUInt64Value(metricKey("num_gets"), statistics.NumGets)
+	appStatusHandler.SetUInt64Value(metricKey("num_get_misses"), statistics.NumGetMisses)
+	appStatusHandler.SetUInt64Value(metricKey("num_has"), statistics.NumHas)
+	appStatusHandler.SetUInt64Value(metricKey("num_removes"), statistics.NumRemoves)
+	appStatusHandler.SetUInt64Value(metricKey("num_cache_hits"), statistics.NumCacheHits)
+	appStatusHandler.SetUInt64Value(metricKey("bytes_written"), statistics.BytesWritten)
+	appStatusHandler.SetUInt64Value(metricKey("bytes_read"), statistics.BytesRead)
+	appStatusHandler.SetStringValue(metricKey("put_latency"), statistics.PutLatency.String())
+	appStatusHandler.SetStringValue(metricKey("get_latency"), statistics.GetLatency.String())
+}
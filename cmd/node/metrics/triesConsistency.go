@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/appStatusPolling"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+)
+
+// TriesConsistencyStatisticsHandler defines a component able to provide the tries consistency check results
+type TriesConsistencyStatisticsHandler interface {
+	Statistics() []trie.ConsistencyCheckStatistics
+	IsInterfaceNil() bool
+}
+
+// StartTriesConsistencyPolling will start saving the tries consistency check results in status handler
+func StartTriesConsistencyPolling(
+	ash core.AppStatusHandler,
+	pollingInterval time.Duration,
+	statisticsHandler TriesConsistencyStatisticsHandler,
+) error {
+	if check.IfNil(ash) {
+		return errors.New("nil AppStatusHandler")
+	}
+	if check.IfNil(statisticsHandler) {
+		return errors.New("nil tries consistency statistics handler")
+	}
+
+	appStatusPollingHandler, err := appStatusPolling.NewAppStatusPolling(ash, pollingInterval)
+	if err != nil {
+		return errors.New("cannot init AppStatusPolling")
+	}
+
+	computeTriesConsistency := func(appStatusHandler core.AppStatusHandler) {
+		for _, statistics := range statisticsHandler.Statistics() {
+			setTrieConsistencyMetrics(appStatusHandler, statistics)
+		}
+	}
+
+	err = appStatusPollingHandler.RegisterPollingFunc(computeTriesConsistency)
+	if err != nil {
+		return fmt.Errorf("%w, cannot register handler func for tries consistency", err)
+	}
+
+	appStatusPollingHandler.Poll()
+
+	return nil
+}
+
+func setTrieConsistencyMetrics(appStatusHandler core.AppStatusHandler, statistics trie.ConsistencyCheckStatistics) {
+	metricKey := func(name string) string {
+		return core.MetricTrieConsistencyPrefix + statistics.Name + "_" + name
+	}
+
+	appStatusHandler.SetUInt64Value(metricKey("num_checks"), statistics.NumChecks)
+	appStatusHandler.SetUInt64Value(metricKey("checked_nodes"), statistics.NumCheckedNodes)
+	appStatusHandler.SetUInt64Value(metricKey("missing_nodes"), statistics.NumMissingNodes)
+	appStatusHandler.SetUInt64Value(metricKey("corrupted_nodes"), statistics.NumCorruptedNodes)
+	appStatusHandler.SetUInt64Value(metricKey("pending_nodes"), statistics.NumPendingNodes)
+	appStatusHandler.SetUInt64Value(metricKey("healed_nodes"), statistics.NumHealedNodes)
+	appStatusHandler.SetUInt64Value(metricKey("last_check_duration_in_ms"), uint64(statistics.LastCheckDuration.Milliseconds()))
+}
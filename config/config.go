@@ -2,12 +2,15 @@ package config
 
 // CacheConfig will map the json cache configuration
 type CacheConfig struct {
-	Type                 string
-	Capacity             uint32
-	SizePerSender        uint32
-	SizeInBytes          uint64
-	SizeInBytesPerSender uint32
-	Shards               uint32
+	Type                                 string
+	Capacity                             uint32
+	SizePerSender                        uint32
+	SizeInBytes                          uint64
+	SizeInBytesPerSender                 uint32
+	Shards                               uint32
+	ReplacementMinGasPriceBumpPercentage uint32
+	MaxTxAgeInSeconds                    uint32
+	SelectionStrategy                    string
 }
 
 //HeadersPoolConfig will map the headers cache configuration
@@ -114,12 +117,15 @@ type Config struct {
 	TrieSnapshotDB           DBConfig
 	EvictionWaitingList      EvictionWaitingListConfig
 	StateTriesConfig         StateTriesConfig
+	DecodedTrieNodesCache    CacheConfig
+	TrieConsistencyChecker   TrieConsistencyCheckerConfig
 	TrieStorageManagerConfig TrieStorageManagerConfig
 	BadBlocksCache           CacheConfig
 
 	TxBlockBodyDataPool         CacheConfig
 	PeerBlockBodyDataPool       CacheConfig
 	TxDataPool                  CacheConfig
+	TxPoolJournal               TxPoolJournalConfig
 	UnsignedTransactionDataPool CacheConfig
 	RewardTransactionDataPool   CacheConfig
 	TrieNodesDataPool           CacheConfig
@@ -146,8 +152,12 @@ type Config struct {
 	GeneralSettings     GeneralSettingsConfig
 	Consensus           TypeConfig
 	StoragePruning      StoragePruningConfig
+	AdaptiveCache       AdaptiveCacheConfig
 	TxLogsStorage       StorageConfig
 
+	AccountsChangeSetStorage StorageConfig
+	ReceiptsStorage          StorageConfig
+
 	NTPConfig               NTPConfig
 	HeadersPoolConfig       HeadersPoolConfig
 	BlockSizeThrottleConfig BlockSizeThrottleConfig
@@ -165,6 +175,14 @@ type StoragePruningConfig struct {
 	FullArchive         bool
 	NumEpochsToKeep     uint64
 	NumActivePersisters uint64
+	ColdStoragePath     string
+}
+
+// AdaptiveCacheConfig will hold the settings of the memory budget shared by the adaptive caches
+type AdaptiveCacheConfig struct {
+	TotalSizeInBytes       uint64
+	HighMemoryUsagePercent uint64
+	CheckIntervalInSeconds int
 }
 
 // ResourceStatsConfig will hold all resource stats settings
@@ -190,9 +208,14 @@ type ValidatorStatisticsConfig struct {
 
 // GeneralSettingsConfig will hold the general settings for a node
 type GeneralSettingsConfig struct {
-	StatusPollingIntervalSec int
-	MaxComputableRounds      uint64
-	StartInEpochEnabled      bool
+	StatusPollingIntervalSec    int
+	MaxComputableRounds         uint64
+	StartInEpochEnabled         bool
+	TxVersionEnableEpoch        uint32
+	NonceLanesEnableEpoch       uint32
+	MultisigAccountsEnableEpoch uint32
+	RelayedTxEnableEpoch        uint32
+	StoredBytesEnableEpoch      uint32
 }
 
 // FacadeConfig will hold different configuration option that will be passed to the main ElrondFacade
@@ -210,11 +233,30 @@ type StateTriesConfig struct {
 	MaxPeerTrieLevelInMemory    uint
 }
 
+// TrieConsistencyCheckerConfig will hold the settings of the background checker that verifies the state tries
+// against the database
+type TrieConsistencyCheckerConfig struct {
+	Enabled                  bool
+	CheckIntervalInMinutes   int
+	MaxCheckedNodesPerSecond int
+	RequestMissingNodes      bool
+}
+
+// TxPoolJournalConfig will hold the settings of the journal which persists the transactions pool across restarts
+type TxPoolJournalConfig struct {
+	Enabled         bool
+	MaxNumTxs       uint32
+	MaxAgeInSeconds uint32
+	DB              DBConfig
+}
+
 // TrieStorageManagerConfig will hold config information about trie storage manager
 type TrieStorageManagerConfig struct {
-	PruningBufferLen   uint32
-	SnapshotsBufferLen uint32
-	MaxSnapshots       uint8
+	PruningBufferLen                uint32
+	SnapshotsBufferLen              uint32
+	MaxSnapshots                    uint8
+	NodesEncodingVersion            uint8
+	NodesEncodingVersionEnableEpoch uint32
 }
 
 // WebServerAntifloodConfig will hold the anti-lflooding parameters for the web server
@@ -288,8 +330,9 @@ type IncreaseFactorConfig struct {
 
 // VirtualMachineConfig holds configuration for the Virtual Machine(s)
 type VirtualMachineConfig struct {
-	OutOfProcessEnabled bool
-	OutOfProcessConfig  VirtualMachineOutOfProcessConfig
+	OutOfProcessEnabled            bool
+	OutOfProcessConfig             VirtualMachineOutOfProcessConfig
+	MaxConcurrentHistoricalQueries uint32
 }
 
 // VirtualMachineOutOfProcessConfig holds configuration for out-of-process virtual machine(s)
@@ -320,6 +363,7 @@ type HardforkConfig struct {
 type DebugConfig struct {
 	InterceptorResolver InterceptorResolverDebugConfig
 	Antiflood           AntifloodDebugConfig
+	Storer              StorerDebugConfig
 }
 
 // InterceptorResolverDebugConfig will hold the interceptor-resolver debug configuration
@@ -333,6 +377,12 @@ type InterceptorResolverDebugConfig struct {
 	DebugLineExpiration        int
 }
 
+// StorerDebugConfig will hold the storers' instrumentation configuration
+type StorerDebugConfig struct {
+	Enabled                    bool
+	SlowOperationThresholdInMs int
+}
+
 // AntifloodDebugConfig will hold the antiflood debug configuration
 type AntifloodDebugConfig struct {
 	Enabled                    bool
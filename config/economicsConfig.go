@@ -24,6 +24,7 @@ type FeeSettings struct {
 	DataLimitForBaseCalc    string
 	MinGasPrice             string
 	MinGasLimit             string
+	StorageRentPerByte      string
 }
 
 // ValidatorSettings will hold the validator settings
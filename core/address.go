@@ -2,6 +2,8 @@ package core
 
 import (
 	"bytes"
+	"encoding/binary"
+	"strconv"
 )
 
 // NumInitCharactersForScAddress numbers of characters for smart contract address identifier
@@ -16,6 +18,7 @@ const ShardIdentiferLen = 2
 
 const metaChainShardIdentifier uint8 = 255
 const numInitCharactersForOnMetachainSC = 15
+const nonceLaneKeyIdentifier = "nonceLane"
 
 // IsSmartContractAddress verifies if a set address is of type smart contract
 func IsSmartContractAddress(rcvAddress []byte) bool {
@@ -72,3 +75,23 @@ func IsSmartContractOnMetachain(identifier []byte, rcvAddress []byte) bool {
 		make([]byte, numInitCharactersForOnMetachainSC))
 	return isOnMetaChainSCAddress
 }
+
+// ComputeNonceLaneSenderKey returns the key identifying the transactions sequence sent by an address on a nonce lane.
+// The default lane is identified by the address alone, the secondary lanes by the address suffixed with the lane
+func ComputeNonceLaneSenderKey(address []byte, lane uint32) []byte {
+	if lane == 0 {
+		return address
+	}
+
+	key := make([]byte, len(address)+4)
+	copy(key, address)
+	binary.BigEndian.PutUint32(key[len(address):], lane)
+
+	return key
+}
+
+// ComputeNonceLaneStorageKey returns the protected key under which the nonce of a secondary lane is saved in the
+// data trie of the account
+func ComputeNonceLaneStorageKey(lane uint32) []byte {
+	return []byte(ElrondProtectedKeyPrefix + nonceLaneKeyIdentifier + strconv.FormatUint(uint64(lane), 10))
+}
@@ -75,3 +75,19 @@ func TestIsSmartContractOnMetachain_ShouldReturnTrue(t *testing.T) {
 	scAddress, _ := hex.DecodeString("000000000000000000000000000000000000000000000000000000b51e0eb3a1")
 	assert.True(t, IsSmartContractOnMetachain(identifier, scAddress))
 }
+
+func TestAddress_ComputeNonceLaneSenderKey(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	assert.Equal(t, address, ComputeNonceLaneSenderKey(address, 0))
+	assert.Equal(t, append([]byte("address"), 0, 0, 1, 2), ComputeNonceLaneSenderKey(address, 258))
+	assert.Equal(t, []byte("address"), address)
+	assert.NotEqual(t, ComputeNonceLaneSenderKey(address, 1), ComputeNonceLaneSenderKey(address, 2))
+}
+
+func TestAddress_ComputeNonceLaneStorageKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []byte(ElrondProtectedKeyPrefix+"nonceLane3"), ComputeNonceLaneStorageKey(3))
+}
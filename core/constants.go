@@ -308,6 +308,32 @@ const MetricLastBlockTxCount = "erd_last_block_tx_count"
 // MetricAverageBlockTxCount holds the average count of transactions in a block
 const MetricAverageBlockTxCount = "erd_average_block_tx_count"
 
+// MetricBloomFilterFalsePositiveRate holds the false positive rate of all the storers' bloom filters
+const MetricBloomFilterFalsePositiveRate = "erd_bloom_filter_false_positive_rate"
+
+// MetricStorerPrefix is the prefix of the metrics holding the storers' usage statistics. The metric key is composed
+// of this prefix, the storer identifier and the statistic name, e.g. erd_storer_TransactionUnit_num_gets
+const MetricStorerPrefix = "erd_storer_"
+
+// MetricAdaptiveCachesSizeInBytes is the metric holding the memory used by all the adaptive caches
+const MetricAdaptiveCachesSizeInBytes = "erd_adaptive_caches_size_in_bytes"
+
+// MetricAdaptiveCachesScalePercent is the metric holding the scale applied on the adaptive caches' capacities
+const MetricAdaptiveCachesScalePercent = "erd_adaptive_caches_scale_percent"
+
+// MetricCachePrefix is the prefix of the metrics holding the hit ratio of each adaptive cache, e.g.
+// erd_cache_Transactions_hit_ratio
+const MetricCachePrefix = "erd_cache_"
+
+// MetricDecodedTrieNodesCachePrefix is the prefix of the metrics holding the decoded trie nodes caches' statistics.
+// The metric key is composed of this prefix, the trie name and the statistic name,
+// e.g. erd_decoded_trie_nodes_cache_userAccount_hit_ratio
+const MetricDecodedTrieNodesCachePrefix = "erd_decoded_trie_nodes_cache_"
+
+// MetricTrieConsistencyPrefix is the prefix of the metrics holding the tries consistency check results. The metric key
+// is composed of this prefix, the trie name and the statistic name, e.g. erd_trie_consistency_accounts_missing_nodes
+const MetricTrieConsistencyPrefix = "erd_trie_consistency_"
+
 // LastNonceKeyMetricsStorage holds the key used for storing the last nonce for stored metrics
 const LastNonceKeyMetricsStorage = "lastNonce"
 
@@ -337,10 +363,26 @@ const (
 	TxStatusReceived TransactionStatus = "received"
 	// TxStatusExecuted represents the status of a transaction which was received and executed
 	TxStatusExecuted TransactionStatus = "executed"
+	// TxStatusExpiredFromPool represents the status of a transaction which was received, but was removed from the
+	// transactions pool before being executed, because it was too old
+	TxStatusExpiredFromPool TransactionStatus = "expiredFromPool"
 	// TxStatusUnknown represents the status returned for a missing transaction
 	TxStatusUnknown TransactionStatus = "unknown"
 )
 
+// ReceiptStatus is the type used to represent the processing status of a transaction, as recorded in its receipt
+type ReceiptStatus string
+
+const (
+	// ReceiptStatusSuccess represents the status of a transaction which was successfully executed in the current shard
+	ReceiptStatusSuccess ReceiptStatus = "success"
+	// ReceiptStatusFailed represents the status of a transaction whose execution failed, the fee being consumed anyway
+	ReceiptStatusFailed ReceiptStatus = "failed"
+	// ReceiptStatusPendingInDestination represents the status of a cross shard transaction which was executed in the
+	// sender shard and still has to be executed in the destination shard
+	ReceiptStatusPendingInDestination ReceiptStatus = "pendingInDestinationShard"
+)
+
 const (
 	// StorerOrder defines the order of storers to be notified of a start of epoch event
 	StorerOrder = iota
@@ -387,6 +429,15 @@ const MetricP2PUnknownPeers = "erd_p2p_unknown_shard_peers"
 // MetricP2PNumConnectedPeersClassification is the metric for monitoring the number of connected peers split on the connection type
 const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_classification"
 
+// MetricTrieSyncNumProcessedNodes is the metric that outputs the number of trie nodes synced while bootstrapping
+const MetricTrieSyncNumProcessedNodes = "erd_trie_sync_num_nodes_processed"
+
+// MetricTrieSyncNumReceivedBytes is the metric that outputs the number of bytes received while syncing the tries
+const MetricTrieSyncNumReceivedBytes = "erd_trie_sync_num_bytes_received"
+
+// MetricTrieSyncBytesPerSecond is the metric that outputs the average trie sync download speed
+const MetricTrieSyncBytesPerSecond = "erd_trie_sync_bytes_per_second"
+
 // HighestRoundFromBootStorage is the key for the highest round that is saved in storage
 const HighestRoundFromBootStorage = "highestRoundFromBootStorage"
 
@@ -414,6 +465,15 @@ const BuiltInFunctionSaveKeyValue = "SaveKeyValue"
 // BuiltInFunctionESDTTransfer is the key for the elrond standard digital token transfer built-in function
 const BuiltInFunctionESDTTransfer = "ESDTTransfer"
 
+// BuiltInFunctionSetMultisigAccount is the key for the built-in function which turns an account into a multisig account
+const BuiltInFunctionSetMultisigAccount = "SetMultisigAccount"
+
+// BuiltInFunctionChangeMultisigSigners is the key for the built-in function which changes the signers of a multisig account
+const BuiltInFunctionChangeMultisigSigners = "ChangeMultisigSigners"
+
+// RelayedTransaction is the key for a relayed transaction, which carries a user transaction in its data field
+const RelayedTransaction = "relayedTx"
+
 // SCDeployInitFunctionName is the key for the function which is called at smart contract deploy time
 const SCDeployInitFunctionName = "_init"
 
@@ -429,6 +489,10 @@ const SleepTimeBetweenCreateDBRetries = 5 * time.Second
 // ElrondProtectedKeyPrefix is the key prefix which is protected from writing in the trie - only for special builtin functions
 const ElrondProtectedKeyPrefix = "ELROND"
 
+// MaxNonceLanes is the maximum number of independent nonce sequences an account can use, the default lane (0) being
+// the one ordered by the account nonce
+const MaxNonceLanes = 16
+
 // MaxSoftwareVersionLengthInBytes represents the maximum length for the software version to be saved in block header
 const MaxSoftwareVersionLengthInBytes = 10
 
@@ -0,0 +1,74 @@
+package forking
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// epochNotifier keeps the epoch of the header being created or processed. The components which activate features
+// starting with a given epoch should rely on it instead of the epoch start trigger, since the latter changes its
+// epoch independently of the block in progress
+type epochNotifier struct {
+	mutEpoch     sync.RWMutex
+	currentEpoch uint32
+	handlers     []core.EpochSubscriberHandler
+}
+
+// NewEpochNotifier creates a new epoch notifier starting from epoch 0
+func NewEpochNotifier() *epochNotifier {
+	return &epochNotifier{
+		handlers: make([]core.EpochSubscriberHandler, 0),
+	}
+}
+
+// RegisterNotifyHandler registers a new handler and notifies it with the current epoch
+func (en *epochNotifier) RegisterNotifyHandler(handler core.EpochSubscriberHandler) {
+	if check.IfNil(handler) {
+		return
+	}
+
+	en.mutEpoch.Lock()
+	en.handlers = append(en.handlers, handler)
+	currentEpoch := en.currentEpoch
+	en.mutEpoch.Unlock()
+
+	handler.EpochConfirmed(currentEpoch)
+}
+
+// CheckEpoch records the epoch of the provided header and notifies the registered handlers if the epoch changed
+func (en *epochNotifier) CheckEpoch(header data.HeaderHandler) {
+	if check.IfNil(header) {
+		return
+	}
+
+	en.mutEpoch.Lock()
+	if en.currentEpoch == header.GetEpoch() {
+		en.mutEpoch.Unlock()
+		return
+	}
+
+	en.currentEpoch = header.GetEpoch()
+	handlers := make([]core.EpochSubscriberHandler, len(en.handlers))
+	copy(handlers, en.handlers)
+	en.mutEpoch.Unlock()
+
+	for _, handler := range handlers {
+		handler.EpochConfirmed(header.GetEpoch())
+	}
+}
+
+// CurrentEpoch returns the epoch of the last header created or processed
+func (en *epochNotifier) CurrentEpoch() uint32 {
+	en.mutEpoch.RLock()
+	defer en.mutEpoch.RUnlock()
+
+	return en.currentEpoch
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (en *epochNotifier) IsInterfaceNil() bool {
+	return en == nil
+}
@@ -0,0 +1,51 @@
+package forking_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/forking"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/assert"
+)
+
+type epochSubscriberStub struct {
+	confirmedEpochs []uint32
+}
+
+func (ess *epochSubscriberStub) EpochConfirmed(epoch uint32) {
+	ess.confirmedEpochs = append(ess.confirmedEpochs, epoch)
+}
+
+func (ess *epochSubscriberStub) IsInterfaceNil() bool {
+	return ess == nil
+}
+
+func TestEpochNotifier_CheckEpochShouldNotifyOnlyWhenTheEpochChanges(t *testing.T) {
+	t.Parallel()
+
+	en := forking.NewEpochNotifier()
+	subscriber := &epochSubscriberStub{}
+	en.RegisterNotifyHandler(subscriber)
+	assert.Equal(t, []uint32{0}, subscriber.confirmedEpochs)
+
+	en.CheckEpoch(&block.Header{Epoch: 0})
+	en.CheckEpoch(&block.Header{Epoch: 2})
+	en.CheckEpoch(&block.Header{Epoch: 2})
+	en.CheckEpoch(nil)
+	en.CheckEpoch(&block.Header{Epoch: 1})
+
+	assert.Equal(t, []uint32{0, 2, 1}, subscriber.confirmedEpochs)
+	assert.Equal(t, uint32(1), en.CurrentEpoch())
+}
+
+func TestEpochNotifier_RegisterNotifyHandlerShouldNotifyTheCurrentEpoch(t *testing.T) {
+	t.Parallel()
+
+	en := forking.NewEpochNotifier()
+	en.CheckEpoch(&block.Header{Epoch: 3})
+	en.RegisterNotifyHandler(nil)
+
+	subscriber := &epochSubscriberStub{}
+	en.RegisterNotifyHandler(subscriber)
+	assert.Equal(t, []uint32{3}, subscriber.confirmedEpochs)
+}
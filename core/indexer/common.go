@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 )
@@ -186,6 +187,54 @@ func (cm *commonProcessor) buildRewardTransaction(
 	}
 }
 
+func (cm *commonProcessor) buildAccountsChanges(
+	header data.HeaderHandler,
+	headerHash []byte,
+	changeSet *state.AccountsChangeSet,
+) []*AccountChange {
+	blockHash := hex.EncodeToString(headerHash)
+	accountsChanges := make([]*AccountChange, 0, len(changeSet.Changes))
+	for _, change := range changeSet.Changes {
+		address := cm.addressPubkeyConverter.Encode(change.Address)
+		dataTrieKeys := make([]string, 0, len(change.DataTrieKeys))
+		for _, key := range change.DataTrieKeys {
+			dataTrieKeys = append(dataTrieKeys, hex.EncodeToString(key))
+		}
+
+		ownerAddress := ""
+		if len(change.OwnerAddress) > 0 {
+			ownerAddress = cm.addressPubkeyConverter.Encode(change.OwnerAddress)
+		}
+
+		accountsChanges = append(accountsChanges, &AccountChange{
+			ID:           fmt.Sprintf("%s_%s", blockHash, address),
+			Address:      address,
+			BlockHash:    blockHash,
+			BlockNonce:   header.GetNonce(),
+			ShardID:      header.GetShardID(),
+			IsNew:        change.IsNew,
+			IsRemoved:    change.IsRemoved,
+			Nonce:        change.Nonce,
+			Balance:      bigIntToString(change.Balance),
+			BalanceDelta: bigIntToString(change.BalanceDelta),
+			CodeHash:     hex.EncodeToString(change.CodeHash),
+			OwnerAddress: ownerAddress,
+			DataTrieKeys: dataTrieKeys,
+			Timestamp:    time.Duration(header.GetTimeStamp()),
+		})
+	}
+
+	return accountsChanges
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
 func (cm *commonProcessor) convertScResultInDatabaseScr(sc *smartContractResult.SmartContractResult) ScResult {
 	decodedData := decodeScResultData(sc.Data)
 	return ScResult{
@@ -365,6 +414,47 @@ func serializeTransactions(
 	return buffSlice
 }
 
+func serializeAccountsChanges(accountsChanges []*AccountChange) []bytes.Buffer {
+	var buff bytes.Buffer
+	buffSlice := make([]bytes.Buffer, 0)
+	for _, accountChange := range accountsChanges {
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s", "_type" : "%s" } }%s`, accountChange.ID, "_doc", "\n"))
+		serializedData, err := json.Marshal(accountChange)
+		if err != nil {
+			log.Debug("indexer: marshal",
+				"error", "could not serialize account change, will skip indexing",
+				"address", accountChange.Address)
+			continue
+		}
+
+		// append a newline for each element
+		serializedData = append(serializedData, "\n"...)
+
+		buffLenWithCurrentChange := buff.Len() + len(meta) + len(serializedData)
+		if buffLenWithCurrentChange > txsBulkSizeThreshold {
+			buffSlice = append(buffSlice, buff)
+			buff = bytes.Buffer{}
+		}
+
+		buff.Grow(len(meta) + len(serializedData))
+		_, err = buff.Write(meta)
+		if err != nil {
+			log.Warn("elastic search: serialize bulk account changes, write meta", "error", err.Error())
+		}
+		_, err = buff.Write(serializedData)
+		if err != nil {
+			log.Warn("elastic search: serialize bulk account changes, write serialized account change", "error", err.Error())
+		}
+	}
+
+	// check if the last buffer contains data
+	if buff.Len() != 0 {
+		buffSlice = append(buffSlice, buff)
+	}
+
+	return buffSlice
+}
+
 func buildBulksOfHashes(hashes []string) [][]string {
 	bulks := make([][]string, (len(hashes)/maxNumberOfDocumentsGet)+1)
 	for i := 0; i < len(bulks); i++ {
@@ -391,69 +481,98 @@ func prepareSerializedDataForATransaction(
 	existsInDb bool,
 ) (meta []byte, serializedData []byte) {
 	var err error
+	if isCrossShardTx(tx) && tx.Status != txStatusInvalid {
+		// the sender and the destination shards index the transaction in any order, so each of them merges in
+		// the document only the fields it knows, creating the document if it does not exist yet
+		return prepareCrossShardTxUpsert(tx, selfShardID)
+	}
 	if existsInDb {
-		if !isCrossShardDstMe(tx, selfShardID) || tx.Status == txStatusInvalid {
-			return
-		}
-		// update transaction
-		meta, serializedData = prepareTxUpdate(tx)
-	} else {
-		// insert transaction in database
-		meta = []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s", "_type" : "%s" } }%s`, tx.Hash, "_doc", "\n"))
-		serializedData, err = json.Marshal(tx)
-		if err != nil {
-			log.Debug("indexer: marshal",
-				"error", "could not serialize transaction, will skip indexing",
-				"tx hash", tx.Hash)
-			return
-		}
+		return
 	}
-	return
-}
-
-func prepareTxUpdate(tx *Transaction) ([]byte, []byte) {
-	var meta, serializedData []byte
 
-	meta = []byte(fmt.Sprintf(`{ "update" : { "_id" : "%s", "_type" : "%s"  } }%s`, tx.Hash, "_doc", "\n"))
-
-	marshalizedLog, err := json.Marshal(tx.Log)
+	// insert transaction in database
+	meta = []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s", "_type" : "%s" } }%s`, tx.Hash, "_doc", "\n"))
+	serializedData, err = json.Marshal(tx)
 	if err != nil {
 		log.Debug("indexer: marshal",
-			"error", "could not serialize transaction log, will skip indexing",
+			"error", "could not serialize transaction, will skip indexing",
 			"tx hash", tx.Hash)
-		return nil, nil
+		return
 	}
-	scResults, err := json.Marshal(tx.SmartContractResults)
-	if err != nil {
-		log.Debug("indexer: marshal",
-			"error", "could not serialize smart contract results, will skip indexing",
-			"tx hash", tx.Hash)
-		return nil, nil
+	return
+}
+
+func prepareCrossShardTxUpsert(tx *Transaction, selfShardID uint32) ([]byte, []byte) {
+	fields := prepareSrcTxFields(tx)
+	if tx.ReceiverShard == selfShardID {
+		fields = prepareDstTxFields(tx)
 	}
 
-	marshalizedTimestamp, err := json.Marshal(tx.Timestamp)
+	meta := []byte(fmt.Sprintf(`{ "update" : { "_id" : "%s", "_type" : "%s", "retry_on_conflict" : %d } }%s`,
+		tx.Hash, "_doc", crossShardTxUpdateRetries, "\n"))
+	serializedData, err := json.Marshal(&txUpsert{
+		Doc:    fields,
+		Upsert: tx,
+	})
 	if err != nil {
 		log.Debug("indexer: marshal",
-			"error", "could not serialize timestamp, will skip indexing",
+			"error", "could not serialize transaction, will skip indexing",
 			"tx hash", tx.Hash)
 		return nil, nil
 	}
 
-	if tx.GasUsed == tx.GasLimit {
-		// do not update gasUsed because it is the same with gasUsed when transaction was saved first time in database
-		serializedData = []byte(fmt.Sprintf(`{ "doc" : { "log" : %s, "scResults" : %s, "status": "%s", "timestamp": %s } }`,
-			string(marshalizedLog), string(scResults), tx.Status, string(marshalizedTimestamp)))
-	} else {
-		// update gasUsed because was changed (is a smart contract operation)
-		serializedData = []byte(fmt.Sprintf(`{ "doc" : { "log" : %s, "scResults" : %s, "status": "%s", "timestamp": %s, "gasUsed" : %s } }`,
-			string(marshalizedLog), string(scResults), tx.Status, string(marshalizedTimestamp), fmt.Sprintf("%d", tx.GasUsed)))
+	return meta, serializedData
+}
+
+// prepareSrcTxFields returns the fields known by the sender shard: the fee charged there and the status of the
+// receipt if the transaction failed, as a pending status must not overwrite the one set by the destination shard
+func prepareSrcTxFields(tx *Transaction) map[string]interface{} {
+	fields := make(map[string]interface{})
+	addFeeFields(fields, tx)
+	if tx.ReceiptStatus != "" && tx.ReceiptStatus != string(core.ReceiptStatusPendingInDestination) {
+		fields["receiptStatus"] = tx.ReceiptStatus
 	}
 
-	return meta, serializedData
+	return fields
+}
+
+// prepareDstTxFields returns the fields known by the destination shard: the execution results, the final status
+// of the receipt and, for the smart contract calls, the whole fee
+func prepareDstTxFields(tx *Transaction) map[string]interface{} {
+	fields := map[string]interface{}{
+		"log":       tx.Log,
+		"scResults": tx.SmartContractResults,
+		"status":    tx.Status,
+		"timestamp": tx.Timestamp,
+	}
+	if tx.GasUsed != tx.GasLimit {
+		// the gas used was changed by a smart contract operation
+		fields["gasUsed"] = tx.GasUsed
+	}
+	addFeeFields(fields, tx)
+	if tx.ReceiptStatus != "" {
+		fields["receiptStatus"] = tx.ReceiptStatus
+	}
+
+	return fields
+}
+
+func addFeeFields(fields map[string]interface{}, tx *Transaction) {
+	if tx.Fee == "" {
+		// the fee was not charged in this shard
+		return
+	}
+
+	fields["gasUsed"] = tx.GasUsed
+	fields["gasRefunded"] = tx.GasRefunded
+	fields["fee"] = tx.Fee
+	if tx.DeveloperFee != "" {
+		fields["developerFee"] = tx.DeveloperFee
+	}
 }
 
-func isCrossShardDstMe(tx *Transaction, selfShardID uint32) bool {
-	return tx.SenderShard != tx.ReceiverShard && tx.ReceiverShard == selfShardID
+func isCrossShardTx(tx *Transaction) bool {
+	return tx.SenderShard != tx.ReceiverShard
 }
 
 func computeSizeOfTxs(marshalizer marshal.Marshalizer, txs map[string]data.TransactionHandler) int {
@@ -3,6 +3,7 @@ package indexer
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -178,3 +179,71 @@ func TestInterpretAsString(t *testing.T) {
 	decodedData = decodeScResultData(data2)
 	require.Equal(t, expectedData2, decodedData)
 }
+
+func TestPrepareSerializedDataForATransaction_CrossShardFromSenderShard(t *testing.T) {
+	t.Parallel()
+
+	tx := &Transaction{
+		Hash:          "txHash",
+		SenderShard:   0,
+		ReceiverShard: 1,
+		GasLimit:      100,
+		GasUsed:       70,
+		GasRefunded:   30,
+		Fee:           "700",
+		Status:        txStatusSuccess,
+		ReceiptStatus: string(core.ReceiptStatusPendingInDestination),
+	}
+
+	meta, serializedData := prepareSerializedDataForATransaction(tx, 0, true)
+	require.True(t, bytes.Contains(meta, []byte(`"update"`)))
+
+	upsert := &txUpsert{}
+	err := json.Unmarshal(serializedData, upsert)
+	require.Nil(t, err)
+	require.Equal(t, map[string]interface{}{
+		"gasUsed":     float64(70),
+		"gasRefunded": float64(30),
+		"fee":         "700",
+	}, upsert.Doc)
+	require.Equal(t, string(core.ReceiptStatusPendingInDestination), upsert.Upsert.ReceiptStatus)
+}
+
+func TestPrepareSerializedDataForATransaction_CrossShardInDestinationShard(t *testing.T) {
+	t.Parallel()
+
+	tx := &Transaction{
+		Hash:          "txHash",
+		SenderShard:   0,
+		ReceiverShard: 1,
+		GasLimit:      100,
+		GasUsed:       100,
+		Status:        txStatusSuccess,
+		ReceiptStatus: string(core.ReceiptStatusSuccess),
+	}
+
+	meta, serializedData := prepareSerializedDataForATransaction(tx, 1, false)
+	require.True(t, bytes.Contains(meta, []byte(`"update"`)))
+
+	upsert := &txUpsert{}
+	err := json.Unmarshal(serializedData, upsert)
+	require.Nil(t, err)
+	require.Equal(t, string(core.ReceiptStatusSuccess), upsert.Doc["receiptStatus"])
+	_, hasFee := upsert.Doc["fee"]
+	require.False(t, hasFee)
+	_, hasGasUsed := upsert.Doc["gasUsed"]
+	require.False(t, hasGasUsed)
+}
+
+func TestPrepareSerializedDataForATransaction_IntraShardAlreadyIndexedShouldSkip(t *testing.T) {
+	t.Parallel()
+
+	tx := &Transaction{Hash: "txHash", Status: txStatusSuccess}
+
+	meta, serializedData := prepareSerializedDataForATransaction(tx, 0, true)
+	require.Nil(t, meta)
+	require.Nil(t, serializedData)
+
+	meta, _ = prepareSerializedDataForATransaction(tx, 0, false)
+	require.True(t, bytes.Contains(meta, []byte(`"index"`)))
+}
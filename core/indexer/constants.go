@@ -3,6 +3,7 @@ package indexer
 const txsBulkSizeThreshold = 900000 // 0.9MB
 
 const maxNumberOfDocumentsGet = 1000
+const crossShardTxUpdateRetries = 3
 const txIndex = "transactions"
 const blockIndex = "blocks"
 const miniblocksIndex = "miniblocks"
@@ -10,6 +11,7 @@ const tpsIndex = "tps"
 const validatorsIndex = "validators"
 const roundIndex = "rounds"
 const ratingIndex = "rating"
+const accountsChangesIndex = "accountschanges"
 
 const metachainTpsDocID = "meta"
 const shardTpsDocIDPrefix = "shard"
@@ -22,14 +22,25 @@ type Transaction struct {
 	GasPrice             uint64        `json:"gasPrice"`
 	GasLimit             uint64        `json:"gasLimit"`
 	GasUsed              uint64        `json:"gasUsed"`
+	GasRefunded          uint64        `json:"gasRefunded,omitempty"`
+	Fee                  string        `json:"fee,omitempty"`
+	DeveloperFee         string        `json:"developerFee,omitempty"`
 	Data                 string        `json:"data"`
 	Signature            string        `json:"signature"`
 	Timestamp            time.Duration `json:"timestamp"`
 	Status               string        `json:"status"`
+	ReceiptStatus        string        `json:"receiptStatus,omitempty"`
 	SmartContractResults []ScResult    `json:"scResults"`
 	Log                  TxLog         `json:"-"`
 }
 
+// txUpsert holds the fields of a transaction which are merged in its document, or the whole transaction if the
+// document does not exist yet
+type txUpsert struct {
+	Doc    map[string]interface{} `json:"doc"`
+	Upsert *Transaction           `json:"upsert"`
+}
+
 // TxLog holds all the data needed for a log structure
 type TxLog struct {
 	Address string  `json:"scAddress"`
@@ -134,3 +145,22 @@ type TPS struct {
 	LastBlockTxCount      uint32   `json:"lastBlockTxCount"`
 	ShardID               uint32   `json:"shardID"`
 }
+
+// AccountChange is a structure containing the state of an account touched by a block, together with the
+//  balance delta and the data trie keys changed by the block
+type AccountChange struct {
+	ID           string        `json:"-"`
+	Address      string        `json:"address"`
+	BlockHash    string        `json:"blockHash"`
+	BlockNonce   uint64        `json:"blockNonce"`
+	ShardID      uint32        `json:"shardId"`
+	IsNew        bool          `json:"isNew"`
+	IsRemoved    bool          `json:"isRemoved"`
+	Nonce        uint64        `json:"nonce"`
+	Balance      string        `json:"balance"`
+	BalanceDelta string        `json:"balanceDelta"`
+	CodeHash     string        `json:"codeHash"`
+	OwnerAddress string        `json:"ownerAddress"`
+	DataTrieKeys []string      `json:"dataTrieKeys"`
+	Timestamp    time.Duration `json:"timestamp"`
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -165,6 +166,15 @@ func (ei *elasticIndexer) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
 	ei.database.SaveShardStatistics(tpsBenchmark)
 }
 
+// SaveAccountsChangeSet will save on elasticsearch the accounts changed by the block having the given hash
+func (ei *elasticIndexer) SaveAccountsChangeSet(header data.HeaderHandler, headerHash []byte, changeSet *state.AccountsChangeSet) {
+	if check.IfNil(header) || changeSet == nil {
+		return
+	}
+
+	ei.database.SaveAccountsChangeSet(header, headerHash, changeSet)
+}
+
 // SetTxLogsProcessor will set tx logs processor
 func (ei *elasticIndexer) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
 	ei.database.SetTxLogsProcessor(txLogsProc)
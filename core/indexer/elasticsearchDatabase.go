@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -105,6 +106,11 @@ func (esd *elasticSearchDatabase) createIndexes() error {
 		return err
 	}
 
+	err = esd.dbClient.CheckAndCreateIndex(accountsChangesIndex, timestampMapping())
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -222,6 +228,25 @@ func (esd *elasticSearchDatabase) SaveTransactions(
 	}
 }
 
+// SaveAccountsChangeSet will prepare and save information about the accounts changed by a block in elasticsearch server
+func (esd *elasticSearchDatabase) SaveAccountsChangeSet(
+	header data.HeaderHandler,
+	headerHash []byte,
+	changeSet *state.AccountsChangeSet,
+) {
+	accountsChanges := esd.buildAccountsChanges(header, headerHash, changeSet)
+	buffSlice := serializeAccountsChanges(accountsChanges)
+
+	for idx := range buffSlice {
+		err := esd.dbClient.DoBulkRequest(&buffSlice[idx], accountsChangesIndex)
+		if err != nil {
+			log.Warn("indexer indexing bulk of accounts changes",
+				"error", err.Error())
+			continue
+		}
+	}
+}
+
 // SetTxLogsProcessor will set tx logs processor
 func (esd *elasticSearchDatabase) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
 	esd.txLogsProcessor = txLogsProc
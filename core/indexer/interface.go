@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
 )
@@ -20,6 +21,7 @@ type Indexer interface {
 	UpdateTPS(tpsBenchmark statistics.TPSBenchmark)
 	SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32)
 	SaveValidatorsRating(indexID string, infoRating []ValidatorRatingInfo)
+	SaveAccountsChangeSet(header data.HeaderHandler, headerHash []byte, changeSet *state.AccountsChangeSet)
 	IsInterfaceNil() bool
 	IsNilIndexer() bool
 }
@@ -34,6 +36,7 @@ type databaseHandler interface {
 	SaveShardValidatorsPubKeys(shardID, epoch uint32, shardValidatorsPubKeys [][]byte)
 	SaveValidatorsRating(Index string, validatorsRatingInfo []ValidatorRatingInfo)
 	SaveShardStatistics(tpsBenchmark statistics.TPSBenchmark)
+	SaveAccountsChangeSet(header data.HeaderHandler, headerHash []byte, changeSet *state.AccountsChangeSet)
 }
 
 // databaseClientHandler is an interface that do requests to elasticsearch server
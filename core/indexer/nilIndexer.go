@@ -3,6 +3,7 @@ package indexer
 import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
@@ -35,6 +36,10 @@ func (ni *NilIndexer) UpdateTPS(_ statistics.TPSBenchmark) {
 func (ni *NilIndexer) SaveValidatorsRating(_ string, _ []ValidatorRatingInfo) {
 }
 
+// SaveAccountsChangeSet will do nothing
+func (ni *NilIndexer) SaveAccountsChangeSet(_ data.HeaderHandler, _ []byte, _ *state.AccountsChangeSet) {
+}
+
 // SaveValidatorsPubKeys will do nothing
 func (ni *NilIndexer) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) {
 }
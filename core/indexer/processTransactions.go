@@ -63,6 +63,10 @@ func (tdp *txDatabaseProcessor) prepareTransactionsForDatabase(
 	scResults := groupSmartContractResults(txPool)
 
 	for _, rec := range receipts {
+		if isFeeReceipt(rec) {
+			continue
+		}
+
 		tx, ok := transactions[string(rec.TxHash)]
 		if !ok {
 			continue
@@ -102,6 +106,19 @@ func (tdp *txDatabaseProcessor) prepareTransactionsForDatabase(
 		}
 	}
 
+	for _, rec := range receipts {
+		if !isFeeReceipt(rec) {
+			continue
+		}
+
+		tx, ok := transactions[string(rec.TxHash)]
+		if !ok {
+			continue
+		}
+
+		addFeeReceiptInfoInTx(rec, tx)
+	}
+
 	// TODO for the moment do not save logs in database
 	// uncomment this when transaction logs need to be saved in database
 	//for hash, tx := range transactions {
@@ -234,6 +251,29 @@ func groupSmartContractResults(txPool map[string]data.TransactionHandler) []*sma
 	return scResults
 }
 
+// isFeeReceipt returns true if the receipt holds the fee breakdown of a processed transaction
+func isFeeReceipt(rec *receipt.Receipt) bool {
+	return rec.Fee != nil
+}
+
+// addFeeReceiptInfoInTx sets the receipt status and, if the fee was charged in the shard which generated the
+// receipt, the gas and fee breakdown
+func addFeeReceiptInfoInTx(rec *receipt.Receipt, tx *Transaction) {
+	tx.ReceiptStatus = rec.Status
+
+	isFeeChargedInShard := rec.GasUsed > 0 || rec.GasRefunded > 0 || rec.Fee.Sign() > 0
+	if !isFeeChargedInShard {
+		return
+	}
+
+	tx.GasUsed = rec.GasUsed
+	tx.GasRefunded = rec.GasRefunded
+	tx.Fee = rec.Fee.String()
+	if rec.DeveloperFee != nil {
+		tx.DeveloperFee = rec.DeveloperFee.String()
+	}
+}
+
 func groupReceipts(txPool map[string]data.TransactionHandler) []*receipt.Receipt {
 	receipts := make([]*receipt.Receipt, 0)
 	for hash, tx := range txPool {
@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -125,6 +126,79 @@ func TestPrepareTransactionsForDatabase(t *testing.T) {
 
 }
 
+func TestPrepareTransactionsForDatabase_FeeReceiptsShouldSetTheFeeBreakdown(t *testing.T) {
+	t.Parallel()
+
+	txHash1 := []byte("txHash1")
+	tx1 := &transaction.Transaction{
+		GasLimit: 100,
+		GasPrice: 10,
+	}
+	txHash2 := []byte("txHash2")
+	tx2 := &transaction.Transaction{
+		GasLimit: 100,
+		GasPrice: 10,
+	}
+
+	recHash1 := []byte("recHash1")
+	rec1 := &receipt.Receipt{
+		Value:        big.NewInt(300),
+		TxHash:       txHash1,
+		GasUsed:      70,
+		GasRefunded:  30,
+		Fee:          big.NewInt(700),
+		DeveloperFee: big.NewInt(21),
+		Status:       string(core.ReceiptStatusPendingInDestination),
+	}
+	recHash2 := []byte("recHash2")
+	rec2 := &receipt.Receipt{
+		Value:        big.NewInt(0),
+		TxHash:       txHash2,
+		Fee:          big.NewInt(0),
+		DeveloperFee: big.NewInt(0),
+		Status:       string(core.ReceiptStatusSuccess),
+	}
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes: [][]byte{txHash1, txHash2},
+				Type:     block.TxBlock,
+			},
+		},
+	}
+	txPool := map[string]data.TransactionHandler{
+		string(txHash1):  tx1,
+		string(txHash2):  tx2,
+		string(recHash1): rec1,
+		string(recHash2): rec2,
+	}
+
+	txDbProc := newTxDatabaseProcessor(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.PubkeyConverterMock{},
+		&mock.PubkeyConverterMock{},
+	)
+
+	transactions := txDbProc.prepareTransactionsForDatabase(body, &block.Header{}, txPool, 0)
+	assert.Equal(t, 2, len(transactions))
+	for _, tx := range transactions {
+		if tx.Hash == hex.EncodeToString(txHash1) {
+			assert.Equal(t, uint64(70), tx.GasUsed)
+			assert.Equal(t, uint64(30), tx.GasRefunded)
+			assert.Equal(t, "700", tx.Fee)
+			assert.Equal(t, "21", tx.DeveloperFee)
+			assert.Equal(t, string(core.ReceiptStatusPendingInDestination), tx.ReceiptStatus)
+			continue
+		}
+
+		assert.Equal(t, tx2.GasLimit, tx.GasUsed)
+		assert.Equal(t, "", tx.Fee)
+		assert.Equal(t, string(core.ReceiptStatusSuccess), tx.ReceiptStatus)
+	}
+}
+
 func TestPrepareTxLog(t *testing.T) {
 	t.Parallel()
 
@@ -24,3 +24,9 @@ type PubkeyConverter interface {
 	Encode(pkBytes []byte) string
 	IsInterfaceNil() bool
 }
+
+// EpochSubscriberHandler defines the components notified when the epoch of the block in progress changes
+type EpochSubscriberHandler interface {
+	EpochConfirmed(epoch uint32)
+	IsInterfaceNil() bool
+}
@@ -161,6 +161,8 @@ type Trie interface {
 	Database() DBWriteCacher
 	GetSerializedNodes([]byte, uint64) ([][]byte, uint64, error)
 	GetAllLeaves() (map[string][]byte, error)
+	IterateAllLeaves(ctx context.Context, handler func(key []byte, value []byte) error) error
+	GetProof(key []byte) ([][]byte, error)
 	IsPruningEnabled() bool
 	EnterSnapshotMode()
 	ExitSnapshotMode()
@@ -192,6 +194,20 @@ type TrieSyncer interface {
 	IsInterfaceNil() bool
 }
 
+// SyncStatisticsHandler defines the methods for a component able to store the progress of the trie syncers
+type SyncStatisticsHandler interface {
+	AddNumReceived(value int)
+	AddNumFoundInStorage(value int)
+	AddNumBytesReceived(value uint64)
+	NumReceived() int
+	NumFoundInStorage() int
+	NumSynced() int
+	NumBytesReceived() uint64
+	BytesPerSecond() uint64
+	Reset()
+	IsInterfaceNil() bool
+}
+
 // StorageManager manages all trie storage operations
 type StorageManager interface {
 	Database() DBWriteCacher
@@ -204,6 +220,8 @@ type StorageManager interface {
 	IsPruningEnabled() bool
 	EnterSnapshotMode()
 	ExitSnapshotMode()
+	EpochConfirmed(epoch uint32)
+	GetNodesEncodingVersion() byte
 	IsInterfaceNil() bool
 }
 
@@ -0,0 +1,20 @@
+package mock
+
+// LeafRootHashExtractorStub -
+type LeafRootHashExtractorStub struct {
+	ExtractRootHashCalled func(leafValue []byte) ([]byte, bool)
+}
+
+// ExtractRootHash -
+func (lrhes *LeafRootHashExtractorStub) ExtractRootHash(leafValue []byte) ([]byte, bool) {
+	if lrhes.ExtractRootHashCalled != nil {
+		return lrhes.ExtractRootHashCalled(leafValue)
+	}
+
+	return nil, false
+}
+
+// IsInterfaceNil -
+func (lrhes *LeafRootHashExtractorStub) IsInterfaceNil() bool {
+	return lrhes == nil
+}
@@ -6,8 +6,9 @@ import (
 
 // PathManagerStub -
 type PathManagerStub struct {
-	PathForEpochCalled  func(shardId string, epoch uint32, identifier string) string
-	PathForStaticCalled func(shardId string, identifier string) string
+	PathForEpochCalled              func(shardId string, epoch uint32, identifier string) string
+	PathForEpochInColdStorageCalled func(shardId string, epoch uint32, identifier string) string
+	PathForStaticCalled             func(shardId string, identifier string) string
 }
 
 // PathForEpoch -
@@ -19,6 +20,15 @@ func (p *PathManagerStub) PathForEpoch(shardId string, epoch uint32, identifier
 	return fmt.Sprintf("Epoch_%d/Shard_%s/%s", epoch, shardId, identifier)
 }
 
+// PathForEpochInColdStorage -
+func (p *PathManagerStub) PathForEpochInColdStorage(shardId string, epoch uint32, identifier string) string {
+	if p.PathForEpochInColdStorageCalled != nil {
+		return p.PathForEpochInColdStorageCalled(shardId, epoch, identifier)
+	}
+
+	return ""
+}
+
 // PathForStatic -
 func (p *PathManagerStub) PathForStatic(shardId string, identifier string) string {
 	if p.PathForEpochCalled != nil {
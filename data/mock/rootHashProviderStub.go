@@ -0,0 +1,20 @@
+package mock
+
+// RootHashProviderStub -
+type RootHashProviderStub struct {
+	RootHashCalled func() []byte
+}
+
+// RootHash -
+func (rhps *RootHashProviderStub) RootHash() []byte {
+	if rhps.RootHashCalled != nil {
+		return rhps.RootHashCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (rhps *RootHashProviderStub) IsInterfaceNil() bool {
+	return rhps == nil
+}
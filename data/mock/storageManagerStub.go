@@ -15,6 +15,8 @@ type StorageManagerStub struct {
 	IsPruningEnabledCalled            func() bool
 	EnterSnapshotModeCalled           func()
 	ExitSnapshotModeCalled            func()
+	EpochConfirmedCalled              func(epoch uint32)
+	GetNodesEncodingVersionCalled     func() byte
 	IsInterfaceNilCalled              func() bool
 }
 
@@ -85,6 +87,22 @@ func (sms *StorageManagerStub) ExitSnapshotMode() {
 	}
 }
 
+// EpochConfirmed --
+func (sms *StorageManagerStub) EpochConfirmed(epoch uint32) {
+	if sms.EpochConfirmedCalled != nil {
+		sms.EpochConfirmedCalled(epoch)
+	}
+}
+
+// GetNodesEncodingVersion --
+func (sms *StorageManagerStub) GetNodesEncodingVersion() byte {
+	if sms.GetNodesEncodingVersionCalled != nil {
+		return sms.GetNodesEncodingVersionCalled()
+	}
+
+	return 0
+}
+
 // IsInterfaceNil --
 func (sms *StorageManagerStub) IsInterfaceNil() bool {
 	return sms == nil
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"errors"
 
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -25,6 +26,8 @@ type TrieStub struct {
 	GetSerializedNodesCalled func([]byte, uint64) ([][]byte, uint64, error)
 	DatabaseCalled           func() data.DBWriteCacher
 	GetAllLeavesCalled       func() (map[string][]byte, error)
+	IterateAllLeavesCalled   func(ctx context.Context, handler func(key []byte, value []byte) error) error
+	GetProofCalled           func(key []byte) ([][]byte, error)
 	IsPruningEnabledCalled   func() bool
 	ClosePersisterCalled     func() error
 }
@@ -114,6 +117,24 @@ func (ts *TrieStub) GetAllLeaves() (map[string][]byte, error) {
 	return nil, errNotImplemented
 }
 
+// IterateAllLeaves -
+func (ts *TrieStub) IterateAllLeaves(ctx context.Context, handler func(key []byte, value []byte) error) error {
+	if ts.IterateAllLeavesCalled != nil {
+		return ts.IterateAllLeavesCalled(ctx, handler)
+	}
+
+	return errNotImplemented
+}
+
+// GetProof -
+func (ts *TrieStub) GetProof(key []byte) ([][]byte, error) {
+	if ts.GetProofCalled != nil {
+		return ts.GetProofCalled(key)
+	}
+
+	return nil, errNotImplemented
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ts *TrieStub) IsInterfaceNil() bool {
 	return ts == nil
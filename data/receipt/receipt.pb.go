@@ -30,10 +30,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Receipt holds all the data needed for a transaction receipt
 type Receipt struct {
-	Value   *math_big.Int `protobuf:"bytes,1,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"value"`
-	SndAddr []byte        `protobuf:"bytes,2,opt,name=SndAddr,proto3" json:"sender"`
-	Data    []byte        `protobuf:"bytes,3,opt,name=Data,proto3" json:"data,omitempty"`
-	TxHash  []byte        `protobuf:"bytes,4,opt,name=TxHash,proto3" json:"txHash"`
+	Value        *math_big.Int `protobuf:"bytes,1,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"value"`
+	SndAddr      []byte        `protobuf:"bytes,2,opt,name=SndAddr,proto3" json:"sender"`
+	Data         []byte        `protobuf:"bytes,3,opt,name=Data,proto3" json:"data,omitempty"`
+	TxHash       []byte        `protobuf:"bytes,4,opt,name=TxHash,proto3" json:"txHash"`
+	GasUsed      uint64        `protobuf:"varint,5,opt,name=GasUsed,proto3" json:"gasUsed,omitempty"`
+	GasRefunded  uint64        `protobuf:"varint,6,opt,name=GasRefunded,proto3" json:"gasRefunded,omitempty"`
+	Fee          *math_big.Int `protobuf:"bytes,7,opt,name=Fee,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"fee,omitempty"`
+	DeveloperFee *math_big.Int `protobuf:"bytes,8,opt,name=DeveloperFee,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"developerFee,omitempty"`
+	Status       string        `protobuf:"bytes,9,opt,name=Status,proto3" json:"status,omitempty"`
 }
 
 func (m *Receipt) Reset()      { *m = Receipt{} }
@@ -92,6 +97,41 @@ func (m *Receipt) GetTxHash() []byte {
 	return nil
 }
 
+func (m *Receipt) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *Receipt) GetGasRefunded() uint64 {
+	if m != nil {
+		return m.GasRefunded
+	}
+	return 0
+}
+
+func (m *Receipt) GetFee() *math_big.Int {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *Receipt) GetDeveloperFee() *math_big.Int {
+	if m != nil {
+		return m.DeveloperFee
+	}
+	return nil
+}
+
+func (m *Receipt) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Receipt)(nil), "proto.Receipt")
 }
@@ -99,27 +139,35 @@ func init() {
 func init() { proto.RegisterFile("receipt.proto", fileDescriptor_ace1d6eb38fad2c8) }
 
 var fileDescriptor_ace1d6eb38fad2c8 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbd, 0x4e, 0xf3, 0x30,
-	0x18, 0x85, 0xed, 0xef, 0xeb, 0x8f, 0x64, 0x01, 0x83, 0xa7, 0x88, 0xe1, 0x0d, 0xaa, 0x10, 0x62,
-	0xa0, 0xc9, 0xc0, 0xc8, 0xd4, 0x02, 0x12, 0x5d, 0x3a, 0x04, 0xc4, 0xc0, 0xe6, 0xd4, 0xc6, 0x8d,
-	0x68, 0xe2, 0xca, 0x7d, 0xcb, 0xcf, 0xc6, 0x25, 0x70, 0x19, 0x88, 0x2b, 0x61, 0xec, 0xd8, 0xa9,
-	0x10, 0x77, 0x41, 0x9d, 0x7a, 0x09, 0xa8, 0x6e, 0x11, 0x4c, 0xf6, 0x39, 0xc7, 0x7e, 0x8e, 0x74,
-	0xd8, 0xb6, 0x55, 0x3d, 0x95, 0x0d, 0x31, 0x1a, 0x5a, 0x83, 0x86, 0x57, 0xfd, 0xb1, 0xdb, 0xd4,
-	0x19, 0xf6, 0xc7, 0x69, 0xd4, 0x33, 0x79, 0xac, 0x8d, 0x36, 0xb1, 0xb7, 0xd3, 0xf1, 0xad, 0x57,
-	0x5e, 0xf8, 0xdb, 0xfa, 0x57, 0xa3, 0xa4, 0xac, 0x9e, 0xac, 0x39, 0x5c, 0xb2, 0xea, 0xb5, 0x18,
-	0x8c, 0x55, 0x40, 0xf7, 0xe8, 0xe1, 0x56, 0xbb, 0xbb, 0x98, 0x85, 0xd5, 0xfb, 0x95, 0xf1, 0xf6,
-	0x11, 0xb6, 0x72, 0x81, 0xfd, 0x38, 0xcd, 0x74, 0xd4, 0x29, 0xf0, 0xe4, 0x4f, 0xc7, 0xf9, 0xc0,
-	0x9a, 0x42, 0x76, 0x15, 0x3e, 0x18, 0x7b, 0x17, 0x2b, 0xaf, 0x9a, 0xda, 0xc4, 0x52, 0xa0, 0x88,
-	0xda, 0x99, 0xee, 0x14, 0x78, 0x2a, 0x46, 0xa8, 0x6c, 0xb2, 0x86, 0xf3, 0x7d, 0x56, 0xbf, 0x2c,
-	0x64, 0x4b, 0x4a, 0x1b, 0xfc, 0xf3, 0x3d, 0x6c, 0x31, 0x0b, 0x6b, 0x23, 0x55, 0x48, 0x65, 0x93,
-	0x9f, 0x88, 0x1f, 0xb0, 0xca, 0x99, 0x40, 0x11, 0xfc, 0xf7, 0x4f, 0xf8, 0x62, 0x16, 0xee, 0xac,
-	0x88, 0x47, 0x26, 0xcf, 0x50, 0xe5, 0x43, 0x7c, 0x4a, 0x7c, 0xce, 0x1b, 0xac, 0x76, 0xf5, 0x78,
-	0x21, 0x46, 0xfd, 0xa0, 0xf2, 0x0b, 0x43, 0xef, 0x24, 0x9b, 0xa4, 0xdd, 0x9a, 0x94, 0x40, 0xa6,
-	0x25, 0x90, 0x65, 0x09, 0xf4, 0xd9, 0x01, 0x7d, 0x75, 0x40, 0xdf, 0x1d, 0xd0, 0x89, 0x03, 0x3a,
-	0x75, 0x40, 0x3f, 0x1d, 0xd0, 0x2f, 0x07, 0x64, 0xe9, 0x80, 0xbe, 0xcc, 0x81, 0x4c, 0xe6, 0x40,
-	0xa6, 0x73, 0x20, 0x37, 0xf5, 0xcd, 0xc4, 0x69, 0xcd, 0xaf, 0x75, 0xfc, 0x1d, 0x00, 0x00, 0xff,
-	0xff, 0x92, 0x61, 0x03, 0x72, 0x74, 0x01, 0x00, 0x00,
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x34, 0xb1, 0xe9, 0xd1, 0x22, 0x38, 0x51, 0x64, 0x18, 0xee, 0xa2, 0x0a, 0xa1,
+	0x0c, 0x6d, 0x3c, 0x30, 0x76, 0x8a, 0x29, 0x94, 0x2e, 0x1d, 0x5c, 0xe8, 0xc0, 0x82, 0x2e, 0xbd,
+	0x17, 0xc7, 0x22, 0xf1, 0x45, 0xf6, 0xb9, 0xc0, 0x86, 0xc4, 0x17, 0xe0, 0x63, 0x20, 0x3e, 0x09,
+	0x63, 0xc6, 0x4c, 0x07, 0xb9, 0x2c, 0xe8, 0x24, 0xa4, 0x7e, 0x04, 0x94, 0x4b, 0xaa, 0xb8, 0x7b,
+	0x26, 0xfb, 0xfd, 0xdf, 0xff, 0xfd, 0x7f, 0xa7, 0xa7, 0x87, 0x77, 0x0b, 0xb8, 0x84, 0x6c, 0xac,
+	0x3a, 0xe3, 0x42, 0x2a, 0x49, 0x9a, 0xee, 0xf3, 0xf4, 0x30, 0xcd, 0xd4, 0xa0, 0xea, 0x75, 0x2e,
+	0xe5, 0x28, 0x4a, 0x65, 0x2a, 0x23, 0x27, 0xf7, 0xaa, 0xbe, 0xab, 0x5c, 0xe1, 0xfe, 0x96, 0x53,
+	0xfb, 0xff, 0x1a, 0x38, 0x48, 0x96, 0x39, 0x44, 0xe0, 0xe6, 0x05, 0x1f, 0x56, 0x10, 0xa2, 0x16,
+	0x6a, 0xef, 0xc4, 0x67, 0x56, 0xb3, 0xe6, 0xd5, 0x42, 0xf8, 0xf9, 0x9b, 0x75, 0x47, 0x5c, 0x0d,
+	0xa2, 0x5e, 0x96, 0x76, 0x4e, 0x73, 0x75, 0x54, 0x63, 0xbc, 0x1a, 0x16, 0x32, 0x17, 0x67, 0xa0,
+	0x3e, 0xc9, 0xe2, 0x63, 0x04, 0xae, 0x3a, 0x4c, 0x65, 0x24, 0xb8, 0xe2, 0x9d, 0x38, 0x4b, 0x4f,
+	0x73, 0xf5, 0x92, 0x97, 0x0a, 0x8a, 0x64, 0x19, 0x4e, 0x9e, 0xe1, 0xe0, 0x3c, 0x17, 0x5d, 0x21,
+	0x8a, 0xf0, 0x8e, 0xe3, 0x60, 0xab, 0x99, 0x5f, 0x42, 0x2e, 0xa0, 0x48, 0x6e, 0x5a, 0xe4, 0x39,
+	0x6e, 0x1c, 0x73, 0xc5, 0xc3, 0x2d, 0x67, 0x21, 0x56, 0xb3, 0xfb, 0x8b, 0xc4, 0x03, 0x39, 0xca,
+	0x14, 0x8c, 0xc6, 0xea, 0x4b, 0xe2, 0xfa, 0x64, 0x1f, 0xfb, 0x6f, 0x3f, 0xbf, 0xe1, 0xe5, 0x20,
+	0x6c, 0xac, 0xc3, 0x94, 0x53, 0x92, 0x55, 0x87, 0x44, 0x38, 0x38, 0xe1, 0xe5, 0xbb, 0x12, 0x44,
+	0xd8, 0x6c, 0xa1, 0x76, 0x23, 0xde, 0xb3, 0x9a, 0x3d, 0x4c, 0x97, 0x52, 0x2d, 0xf1, 0xc6, 0x45,
+	0x8e, 0xf0, 0xbd, 0x13, 0x5e, 0x26, 0xd0, 0xaf, 0x72, 0x01, 0x22, 0xf4, 0xdd, 0xd0, 0x13, 0xab,
+	0xd9, 0x5e, 0xba, 0x96, 0x6b, 0x83, 0x75, 0x37, 0x19, 0xe0, 0xad, 0xd7, 0x00, 0x61, 0xe0, 0x9e,
+	0x73, 0x61, 0x35, 0xdb, 0xed, 0x03, 0xac, 0xcd, 0x9b, 0xd9, 0xe5, 0x02, 0x41, 0xbe, 0x21, 0xbc,
+	0x73, 0x0c, 0x57, 0x30, 0x94, 0x63, 0x28, 0x16, 0xcc, 0xbb, 0x8e, 0xf9, 0xc1, 0x6a, 0xf6, 0x58,
+	0xd4, 0xf4, 0x4d, 0xc3, 0x6f, 0x41, 0xc9, 0x01, 0xf6, 0xcf, 0x15, 0x57, 0x55, 0x19, 0x6e, 0xb7,
+	0x50, 0x7b, 0x3b, 0x7e, 0x64, 0x35, 0x7b, 0x50, 0x3a, 0xa5, 0xb6, 0xa2, 0x95, 0x27, 0xee, 0x4e,
+	0x66, 0xd4, 0x9b, 0xce, 0xa8, 0x77, 0x3d, 0xa3, 0xe8, 0xab, 0xa1, 0xe8, 0x87, 0xa1, 0xe8, 0x97,
+	0xa1, 0x68, 0x62, 0x28, 0x9a, 0x1a, 0x8a, 0xfe, 0x18, 0x8a, 0xfe, 0x1a, 0xea, 0x5d, 0x1b, 0x8a,
+	0xbe, 0xcf, 0xa9, 0x37, 0x99, 0x53, 0x6f, 0x3a, 0xa7, 0xde, 0xfb, 0x60, 0x75, 0xee, 0x3d, 0xdf,
+	0x5d, 0xee, 0x8b, 0xff, 0x03, 0x00, 0x84, 0x8a, 0x73, 0x30, 0x00, 0x03, 0x00, 0x00,
 }
 
 func (this *Receipt) Equal(that interface{}) bool {
@@ -156,18 +204,44 @@ func (this *Receipt) Equal(that interface{}) bool {
 	if !bytes.Equal(this.TxHash, that1.TxHash) {
 		return false
 	}
+	if this.GasUsed != that1.GasUsed {
+		return false
+	}
+	if this.GasRefunded != that1.GasRefunded {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.Fee, that1.Fee) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.DeveloperFee, that1.DeveloperFee) {
+			return false
+		}
+	}
+	if this.Status != that1.Status {
+		return false
+	}
 	return true
 }
 func (this *Receipt) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 13)
 	s = append(s, "&receipt.Receipt{")
 	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
 	s = append(s, "SndAddr: "+fmt.Sprintf("%#v", this.SndAddr)+",\n")
 	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
 	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "GasUsed: "+fmt.Sprintf("%#v", this.GasUsed)+",\n")
+	s = append(s, "GasRefunded: "+fmt.Sprintf("%#v", this.GasRefunded)+",\n")
+	s = append(s, "Fee: "+fmt.Sprintf("%#v", this.Fee)+",\n")
+	s = append(s, "DeveloperFee: "+fmt.Sprintf("%#v", this.DeveloperFee)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -199,6 +273,45 @@ func (m *Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.DeveloperFee)
+		i -= size
+		if _, err := __caster.MarshalTo(m.DeveloperFee, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReceipt(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.Fee)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Fee, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReceipt(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.GasRefunded != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.GasRefunded))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.TxHash) > 0 {
 		i -= len(m.TxHash)
 		copy(dAtA[i:], m.TxHash)
@@ -268,6 +381,26 @@ func (m *Receipt) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovReceipt(uint64(l))
 	}
+	if m.GasUsed != 0 {
+		n += 1 + sovReceipt(uint64(m.GasUsed))
+	}
+	if m.GasRefunded != 0 {
+		n += 1 + sovReceipt(uint64(m.GasRefunded))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.Fee)
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.DeveloperFee)
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
 	return n
 }
 
@@ -286,6 +419,11 @@ func (this *Receipt) String() string {
 		`SndAddr:` + fmt.Sprintf("%v", this.SndAddr) + `,`,
 		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
 		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`GasUsed:` + fmt.Sprintf("%v", this.GasUsed) + `,`,
+		`GasRefunded:` + fmt.Sprintf("%v", this.GasRefunded) + `,`,
+		`Fee:` + fmt.Sprintf("%v", this.Fee) + `,`,
+		`DeveloperFee:` + fmt.Sprintf("%v", this.DeveloperFee) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -467,6 +605,152 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 				m.TxHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRefunded", wireType)
+			}
+			m.GasRefunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasRefunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Fee = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.DeveloperFee = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReceipt(dAtA[iNdEx:])
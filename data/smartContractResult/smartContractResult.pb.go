@@ -43,6 +43,7 @@ type SmartContractResult struct {
 	CallType       github_com_ElrondNetwork_elrond_vm_common.CallType `protobuf:"varint,11,opt,name=CallType,proto3,casttype=github.com/ElrondNetwork/elrond-vm-common.CallType" json:"callType"`
 	CodeMetadata   []byte                                             `protobuf:"bytes,12,opt,name=CodeMetadata,proto3" json:"codeMetadata,omitempty"`
 	ReturnMessage  []byte                                             `protobuf:"bytes,13,opt,name=ReturnMessage,proto3" json:"returnMessage,omitempty"`
+	RelayerAddr    []byte                                             `protobuf:"bytes,14,opt,name=RelayerAddr,proto3" json:"relayer,omitempty"`
 }
 
 func (m *SmartContractResult) Reset()      { *m = SmartContractResult{} }
@@ -164,6 +165,13 @@ func (m *SmartContractResult) GetReturnMessage() []byte {
 	return nil
 }
 
+func (m *SmartContractResult) GetRelayerAddr() []byte {
+	if m != nil {
+		return m.RelayerAddr
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SmartContractResult)(nil), "proto.SmartContractResult")
 }
@@ -171,41 +179,43 @@ func init() {
 func init() { proto.RegisterFile("smartContractResult.proto", fileDescriptor_edc1605de0d3d805) }
 
 var fileDescriptor_edc1605de0d3d805 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
+	// 567 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x86, 0x63, 0xb6, 0xb4, 0x9d, 0xe9, 0x7a, 0xf0, 0x24, 0x08, 0x43, 0x72, 0x2a, 0x84, 0xa6,
-	0x1e, 0x68, 0x2a, 0xc1, 0x0d, 0x24, 0xa4, 0xa6, 0x20, 0x98, 0x44, 0xcb, 0xe4, 0x4d, 0x1c, 0x38,
-	0x20, 0xb9, 0x89, 0x49, 0x23, 0x12, 0xbb, 0x72, 0xdc, 0xc2, 0x6e, 0xfc, 0x04, 0x7e, 0x06, 0xe2,
-	0x97, 0x70, 0xec, 0xb1, 0x17, 0x02, 0x4d, 0x2f, 0x28, 0xa7, 0x9d, 0x39, 0xa1, 0xb8, 0x5d, 0x97,
-	0x02, 0x12, 0xa7, 0xe4, 0x7b, 0xdf, 0xe7, 0x7b, 0x6d, 0x7f, 0x96, 0xe1, 0xad, 0x24, 0xa6, 0x52,
-	0xf5, 0x04, 0x57, 0x92, 0x7a, 0x8a, 0xb0, 0x64, 0x12, 0x29, 0x67, 0x2c, 0x85, 0x12, 0xc8, 0xd4,
-	0x9f, 0xc3, 0x76, 0x10, 0xaa, 0xd1, 0x64, 0xe8, 0x78, 0x22, 0xee, 0x04, 0x22, 0x10, 0x1d, 0x2d,
-	0x0f, 0x27, 0x6f, 0x75, 0xa5, 0x0b, 0xfd, 0xb7, 0xea, 0xba, 0xf3, 0xcd, 0x84, 0x07, 0xa7, 0x7f,
-	0x67, 0x22, 0x1b, 0x9a, 0x03, 0xc1, 0x3d, 0x66, 0x81, 0x26, 0x68, 0xed, 0xba, 0x7b, 0x79, 0x6a,
-	0x9b, 0xbc, 0x10, 0xc8, 0x4a, 0x47, 0x3e, 0x34, 0x5f, 0xd1, 0x68, 0xc2, 0xac, 0x6b, 0x4d, 0xd0,
-	0xaa, 0xbb, 0x83, 0x02, 0x98, 0x16, 0xc2, 0x97, 0xef, 0x76, 0x37, 0xa6, 0x6a, 0xd4, 0x19, 0x86,
-	0x81, 0x73, 0xcc, 0xd5, 0xa3, 0xd2, 0x86, 0x9e, 0x46, 0x52, 0x70, 0x7f, 0xc0, 0xd4, 0x7b, 0x21,
-	0xdf, 0x75, 0x98, 0xae, 0xda, 0x81, 0xe8, 0xf8, 0x54, 0x51, 0xc7, 0x0d, 0x83, 0x63, 0xae, 0x7a,
-	0x34, 0x51, 0x4c, 0x92, 0x55, 0x38, 0x3a, 0x82, 0x55, 0xe2, 0x4d, 0xbb, 0xbe, 0x2f, 0xad, 0x1d,
-	0xbd, 0x4e, 0x3d, 0x4f, 0xed, 0x9a, 0x64, 0x1e, 0x0b, 0xa7, 0x4c, 0x92, 0x4b, 0x13, 0xdd, 0x85,
-	0xd5, 0x53, 0xee, 0x6b, 0x6e, 0x57, 0x73, 0x30, 0x4f, 0xed, 0x4a, 0xc2, 0xb8, 0x5f, 0x50, 0x6b,
-	0x0b, 0x1d, 0xc1, 0xdd, 0x9e, 0xf0, 0x99, 0x65, 0x6a, 0x04, 0xe5, 0xa9, 0xdd, 0xf0, 0x84, 0xcf,
-	0xee, 0x89, 0x38, 0x54, 0x2c, 0x1e, 0xab, 0x73, 0xa2, 0xfd, 0x82, 0x7b, 0x42, 0x15, 0xb5, 0x2a,
-	0x57, 0x5c, 0xb1, 0xc3, 0x32, 0x57, 0xf8, 0xc8, 0x81, 0xf0, 0x44, 0xb2, 0xe9, 0xd9, 0x87, 0xe7,
-	0x34, 0x19, 0x59, 0x55, 0x4d, 0x37, 0xf2, 0xd4, 0x86, 0xe3, 0x8d, 0x4a, 0x4a, 0x04, 0x7a, 0x08,
-	0x1b, 0x2f, 0x65, 0x18, 0x84, 0x9c, 0x46, 0xeb, 0x9e, 0xda, 0xd5, 0x0a, 0x62, 0xcb, 0x21, 0x7f,
-	0x90, 0xa8, 0x05, 0x6b, 0xcf, 0x68, 0xf2, 0x22, 0x8c, 0x43, 0x65, 0xed, 0xe9, 0x3b, 0xd1, 0xa3,
-	0x08, 0xd6, 0x1a, 0xd9, 0xb8, 0x6b, 0xf2, 0x44, 0x86, 0x1e, 0xb3, 0xe0, 0x16, 0xa9, 0x35, 0xb2,
-	0x71, 0xd1, 0x1b, 0x58, 0xeb, 0xd1, 0x28, 0x3a, 0x3b, 0x1f, 0x33, 0xeb, 0x7a, 0x13, 0xb4, 0x76,
-	0x5c, 0xb7, 0x20, 0xbd, 0xb5, 0xf6, 0x2b, 0xb5, 0xef, 0xff, 0xef, 0xf2, 0xa6, 0x71, 0xdb, 0x13,
-	0x71, 0x2c, 0xb8, 0x73, 0x99, 0x44, 0x36, 0x99, 0xe8, 0x31, 0xac, 0x17, 0xf3, 0xec, 0x33, 0x45,
-	0x8b, 0xf9, 0x59, 0x75, 0x7d, 0xda, 0xc3, 0x3c, 0xb5, 0x6f, 0x78, 0x25, 0xbd, 0x34, 0xd7, 0x2d,
-	0x1e, 0x75, 0xe1, 0x3e, 0x61, 0x6a, 0x22, 0x79, 0x9f, 0x25, 0x09, 0x0d, 0x98, 0xb5, 0xaf, 0x03,
-	0x6e, 0xe7, 0xa9, 0x7d, 0x53, 0x96, 0x8d, 0x52, 0xc2, 0x76, 0x87, 0xdb, 0x9f, 0x2d, 0xb0, 0x31,
-	0x5f, 0x60, 0xe3, 0x62, 0x81, 0xc1, 0xc7, 0x0c, 0x83, 0xcf, 0x19, 0x06, 0x5f, 0x33, 0x0c, 0x66,
-	0x19, 0x06, 0xf3, 0x0c, 0x83, 0x1f, 0x19, 0x06, 0x3f, 0x33, 0x6c, 0x5c, 0x64, 0x18, 0x7c, 0x5a,
-	0x62, 0x63, 0xb6, 0xc4, 0xc6, 0x7c, 0x89, 0x8d, 0xd7, 0x07, 0xff, 0x78, 0x6a, 0xc3, 0x8a, 0x7e,
-	0x35, 0x0f, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0xf9, 0xa5, 0x24, 0x7d, 0x88, 0x03, 0x00, 0x00,
+	0x18, 0x86, 0x63, 0xb6, 0x76, 0x9d, 0xd7, 0x55, 0xc2, 0x13, 0x10, 0x86, 0xe4, 0x54, 0x08, 0x4d,
+	0x3d, 0xd0, 0x54, 0x82, 0x03, 0x12, 0x48, 0x48, 0x4d, 0x41, 0x30, 0x89, 0x96, 0xc9, 0x9b, 0x38,
+	0x70, 0x40, 0x72, 0x13, 0x93, 0x46, 0x24, 0x76, 0xe5, 0xb8, 0x85, 0xde, 0xf8, 0x09, 0x9c, 0xf8,
+	0x0d, 0x88, 0x5f, 0xc2, 0xb1, 0xc7, 0x9e, 0x02, 0x4d, 0x2f, 0x28, 0xa7, 0x9d, 0x39, 0xa1, 0xb8,
+	0x5d, 0x97, 0x02, 0x12, 0xa7, 0xe4, 0x7b, 0xdf, 0xe7, 0x7b, 0xed, 0x7c, 0x8e, 0xe1, 0xcd, 0x38,
+	0xa2, 0x52, 0x75, 0x04, 0x57, 0x92, 0xba, 0x8a, 0xb0, 0x78, 0x14, 0x2a, 0x7b, 0x28, 0x85, 0x12,
+	0xa8, 0xa4, 0x1f, 0x87, 0x4d, 0x3f, 0x50, 0x83, 0x51, 0xdf, 0x76, 0x45, 0xd4, 0xf2, 0x85, 0x2f,
+	0x5a, 0x5a, 0xee, 0x8f, 0xde, 0xea, 0x4a, 0x17, 0xfa, 0x6d, 0xd9, 0x75, 0xfb, 0x73, 0x19, 0x1e,
+	0x9c, 0xfe, 0x9d, 0x89, 0x2c, 0x58, 0xea, 0x09, 0xee, 0x32, 0x13, 0xd4, 0x41, 0x63, 0xdb, 0xd9,
+	0xcd, 0x12, 0xab, 0xc4, 0x73, 0x81, 0x2c, 0x75, 0xe4, 0xc1, 0xd2, 0x2b, 0x1a, 0x8e, 0x98, 0x79,
+	0xa5, 0x0e, 0x1a, 0x55, 0xa7, 0x97, 0x03, 0xe3, 0x5c, 0xf8, 0xfa, 0xdd, 0x6a, 0x47, 0x54, 0x0d,
+	0x5a, 0xfd, 0xc0, 0xb7, 0x8f, 0xb9, 0x7a, 0x54, 0xd8, 0xd0, 0xd3, 0x50, 0x0a, 0xee, 0xf5, 0x98,
+	0x7a, 0x2f, 0xe4, 0xbb, 0x16, 0xd3, 0x55, 0xd3, 0x17, 0x2d, 0x8f, 0x2a, 0x6a, 0x3b, 0x81, 0x7f,
+	0xcc, 0x55, 0x87, 0xc6, 0x8a, 0x49, 0xb2, 0x0c, 0x47, 0x47, 0x70, 0x87, 0xb8, 0xe3, 0xb6, 0xe7,
+	0x49, 0x73, 0x4b, 0xaf, 0x53, 0xcd, 0x12, 0xab, 0x22, 0x99, 0xcb, 0x82, 0x31, 0x93, 0xe4, 0xc2,
+	0x44, 0x77, 0xe0, 0xce, 0x29, 0xf7, 0x34, 0xb7, 0xad, 0x39, 0x98, 0x25, 0x56, 0x39, 0x66, 0xdc,
+	0xcb, 0xa9, 0x95, 0x85, 0x8e, 0xe0, 0x76, 0x47, 0x78, 0xcc, 0x2c, 0x69, 0x04, 0x65, 0x89, 0x55,
+	0x73, 0x85, 0xc7, 0xee, 0x8a, 0x28, 0x50, 0x2c, 0x1a, 0xaa, 0x09, 0xd1, 0x7e, 0xce, 0x3d, 0xa1,
+	0x8a, 0x9a, 0xe5, 0x4b, 0x2e, 0xdf, 0x61, 0x91, 0xcb, 0x7d, 0x64, 0x43, 0x78, 0x22, 0xd9, 0xf8,
+	0xec, 0xc3, 0x73, 0x1a, 0x0f, 0xcc, 0x1d, 0x4d, 0xd7, 0xb2, 0xc4, 0x82, 0xc3, 0xb5, 0x4a, 0x0a,
+	0x04, 0x7a, 0x08, 0x6b, 0x2f, 0x65, 0xe0, 0x07, 0x9c, 0x86, 0xab, 0x9e, 0xca, 0xe5, 0x0a, 0x62,
+	0xc3, 0x21, 0x7f, 0x90, 0xa8, 0x01, 0x2b, 0xcf, 0x68, 0xfc, 0x22, 0x88, 0x02, 0x65, 0xee, 0xea,
+	0x33, 0xd1, 0xa3, 0xf0, 0x57, 0x1a, 0x59, 0xbb, 0x2b, 0xf2, 0x44, 0x06, 0x2e, 0x33, 0xe1, 0x06,
+	0xa9, 0x35, 0xb2, 0x76, 0xd1, 0x1b, 0x58, 0xe9, 0xd0, 0x30, 0x3c, 0x9b, 0x0c, 0x99, 0xb9, 0x57,
+	0x07, 0x8d, 0x2d, 0xc7, 0xc9, 0x49, 0x77, 0xa5, 0xfd, 0x4a, 0xac, 0x7b, 0xff, 0x3b, 0xbc, 0x71,
+	0xd4, 0x74, 0x45, 0x14, 0x09, 0x6e, 0x5f, 0x24, 0x91, 0x75, 0x26, 0x7a, 0x0c, 0xab, 0xf9, 0x3c,
+	0xbb, 0x4c, 0xd1, 0x7c, 0x7e, 0x66, 0x55, 0x7f, 0xed, 0x61, 0x96, 0x58, 0xd7, 0xdd, 0x82, 0x5e,
+	0x98, 0xeb, 0x06, 0x8f, 0xda, 0x70, 0x9f, 0x30, 0x35, 0x92, 0xbc, 0xcb, 0xe2, 0x98, 0xfa, 0xcc,
+	0xdc, 0xd7, 0x01, 0xb7, 0xb2, 0xc4, 0xba, 0x21, 0x8b, 0x46, 0x21, 0x61, 0xb3, 0x03, 0x3d, 0x80,
+	0x7b, 0x84, 0x85, 0x74, 0xc2, 0xa4, 0xfe, 0x39, 0x6a, 0x3a, 0xe0, 0x5a, 0x96, 0x58, 0x57, 0xe5,
+	0x52, 0x2e, 0xb4, 0x16, 0x49, 0xa7, 0x3b, 0x9d, 0x63, 0x63, 0x36, 0xc7, 0xc6, 0xf9, 0x1c, 0x83,
+	0x8f, 0x29, 0x06, 0x5f, 0x52, 0x0c, 0xbe, 0xa5, 0x18, 0x4c, 0x53, 0x0c, 0x66, 0x29, 0x06, 0x3f,
+	0x52, 0x0c, 0x7e, 0xa6, 0xd8, 0x38, 0x4f, 0x31, 0xf8, 0xb4, 0xc0, 0xc6, 0x74, 0x81, 0x8d, 0xd9,
+	0x02, 0x1b, 0xaf, 0x0f, 0xfe, 0x71, 0x47, 0xfb, 0x65, 0x7d, 0xdd, 0xee, 0xff, 0x1e, 0x00, 0x2c,
+	0xf0, 0x13, 0xea, 0xc1, 0x03, 0x00, 0x00,
 }
 
 func (this *SmartContractResult) Equal(that interface{}) bool {
@@ -269,13 +279,16 @@ func (this *SmartContractResult) Equal(that interface{}) bool {
 	if !bytes.Equal(this.ReturnMessage, that1.ReturnMessage) {
 		return false
 	}
+	if !bytes.Equal(this.RelayerAddr, that1.RelayerAddr) {
+		return false
+	}
 	return true
 }
 func (this *SmartContractResult) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 18)
 	s = append(s, "&smartContractResult.SmartContractResult{")
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
@@ -290,6 +303,7 @@ func (this *SmartContractResult) GoString() string {
 	s = append(s, "CallType: "+fmt.Sprintf("%#v", this.CallType)+",\n")
 	s = append(s, "CodeMetadata: "+fmt.Sprintf("%#v", this.CodeMetadata)+",\n")
 	s = append(s, "ReturnMessage: "+fmt.Sprintf("%#v", this.ReturnMessage)+",\n")
+	s = append(s, "RelayerAddr: "+fmt.Sprintf("%#v", this.RelayerAddr)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -321,6 +335,13 @@ func (m *SmartContractResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RelayerAddr) > 0 {
+		i -= len(m.RelayerAddr)
+		copy(dAtA[i:], m.RelayerAddr)
+		i = encodeVarintSmartContractResult(dAtA, i, uint64(len(m.RelayerAddr)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.ReturnMessage) > 0 {
 		i -= len(m.ReturnMessage)
 		copy(dAtA[i:], m.ReturnMessage)
@@ -477,6 +498,10 @@ func (m *SmartContractResult) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSmartContractResult(uint64(l))
 	}
+	l = len(m.RelayerAddr)
+	if l > 0 {
+		n += 1 + l + sovSmartContractResult(uint64(l))
+	}
 	return n
 }
 
@@ -504,6 +529,7 @@ func (this *SmartContractResult) String() string {
 		`CallType:` + fmt.Sprintf("%v", this.CallType) + `,`,
 		`CodeMetadata:` + fmt.Sprintf("%v", this.CodeMetadata) + `,`,
 		`ReturnMessage:` + fmt.Sprintf("%v", this.ReturnMessage) + `,`,
+		`RelayerAddr:` + fmt.Sprintf("%v", this.RelayerAddr) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -931,6 +957,40 @@ func (m *SmartContractResult) Unmarshal(dAtA []byte) error {
 				m.ReturnMessage = []byte{}
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartContractResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartContractResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerAddr = append(m.RelayerAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.RelayerAddr == nil {
+				m.RelayerAddr = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSmartContractResult(dAtA[iNdEx:])
@@ -0,0 +1,1144 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: accountsChangeSet.proto
+
+package state
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_ElrondNetwork_elrond_go_data "github.com/ElrondNetwork/elrond-go/data"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccountChange holds the state of an account touched by a committed block, together with its balance delta
+type AccountChange struct {
+	Address      []byte        `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	IsNew        bool          `protobuf:"varint,2,opt,name=IsNew,proto3" json:"IsNew,omitempty"`
+	IsRemoved    bool          `protobuf:"varint,3,opt,name=IsRemoved,proto3" json:"IsRemoved,omitempty"`
+	Nonce        uint64        `protobuf:"varint,4,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Balance      *math_big.Int `protobuf:"bytes,5,opt,name=Balance,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"Balance,omitempty"`
+	BalanceDelta *math_big.Int `protobuf:"bytes,6,opt,name=BalanceDelta,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"BalanceDelta,omitempty"`
+	CodeHash     []byte        `protobuf:"bytes,7,opt,name=CodeHash,proto3" json:"CodeHash,omitempty"`
+	OwnerAddress []byte        `protobuf:"bytes,8,opt,name=OwnerAddress,proto3" json:"OwnerAddress,omitempty"`
+	DataTrieKeys [][]byte      `protobuf:"bytes,9,rep,name=DataTrieKeys,proto3" json:"DataTrieKeys,omitempty"`
+}
+
+func (m *AccountChange) Reset()      { *m = AccountChange{} }
+func (*AccountChange) ProtoMessage() {}
+func (*AccountChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12dc48aa2ebb0ef6, []int{0}
+}
+func (m *AccountChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountChange.Merge(m, src)
+}
+func (m *AccountChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountChange proto.InternalMessageInfo
+
+func (m *AccountChange) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *AccountChange) GetIsNew() bool {
+	if m != nil {
+		return m.IsNew
+	}
+	return false
+}
+
+func (m *AccountChange) GetIsRemoved() bool {
+	if m != nil {
+		return m.IsRemoved
+	}
+	return false
+}
+
+func (m *AccountChange) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *AccountChange) GetBalance() *math_big.Int {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *AccountChange) GetBalanceDelta() *math_big.Int {
+	if m != nil {
+		return m.BalanceDelta
+	}
+	return nil
+}
+
+func (m *AccountChange) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+func (m *AccountChange) GetOwnerAddress() []byte {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return nil
+}
+
+func (m *AccountChange) GetDataTrieKeys() [][]byte {
+	if m != nil {
+		return m.DataTrieKeys
+	}
+	return nil
+}
+
+// AccountsChangeSet holds the accounts changed by a committed block, in the order they were first touched
+type AccountsChangeSet struct {
+	RootHash []byte           `protobuf:"bytes,1,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+	Changes  []*AccountChange `protobuf:"bytes,2,rep,name=Changes,proto3" json:"Changes,omitempty"`
+}
+
+func (m *AccountsChangeSet) Reset()      { *m = AccountsChangeSet{} }
+func (*AccountsChangeSet) ProtoMessage() {}
+func (*AccountsChangeSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12dc48aa2ebb0ef6, []int{1}
+}
+func (m *AccountsChangeSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountsChangeSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountsChangeSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountsChangeSet.Merge(m, src)
+}
+func (m *AccountsChangeSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountsChangeSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountsChangeSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountsChangeSet proto.InternalMessageInfo
+
+func (m *AccountsChangeSet) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *AccountsChangeSet) GetChanges() []*AccountChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AccountChange)(nil), "proto.AccountChange")
+	proto.RegisterType((*AccountsChangeSet)(nil), "proto.AccountsChangeSet")
+}
+
+func init() { proto.RegisterFile("accountsChangeSet.proto", fileDescriptor_12dc48aa2ebb0ef6) }
+
+var fileDescriptor_12dc48aa2ebb0ef6 = []byte{
+	// 412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0xb1, 0x8e, 0xd3, 0x30,
+	0x1c, 0xc6, 0xe3, 0xeb, 0xf5, 0xd2, 0x33, 0x65, 0xc0, 0x3a, 0x09, 0xeb, 0x84, 0x4c, 0xd4, 0x29,
+	0xcb, 0x25, 0x12, 0x8c, 0x0c, 0x28, 0xe9, 0x9d, 0x44, 0x84, 0x54, 0xa4, 0xc0, 0xc4, 0x72, 0x72,
+	0x92, 0x3f, 0x49, 0x44, 0x1b, 0xa3, 0xd8, 0xa5, 0x62, 0xe3, 0x11, 0x78, 0x07, 0x16, 0xc4, 0x93,
+	0x30, 0x76, 0xec, 0x06, 0x75, 0x17, 0xc6, 0x7b, 0x04, 0x14, 0x9b, 0x1c, 0x2d, 0xf3, 0x4d, 0xf1,
+	0xef, 0xf3, 0xdf, 0xdf, 0xa7, 0xfc, 0x3f, 0xfc, 0x90, 0xe7, 0xb9, 0x58, 0x36, 0x4a, 0x4e, 0x2b,
+	0xde, 0x94, 0xf0, 0x1a, 0x54, 0xf0, 0xa1, 0x15, 0x4a, 0x90, 0xa1, 0xf9, 0x9c, 0x5f, 0x94, 0xb5,
+	0xaa, 0x96, 0x59, 0x90, 0x8b, 0x45, 0x58, 0x8a, 0x52, 0x84, 0x46, 0xce, 0x96, 0xef, 0x0c, 0x19,
+	0x30, 0x27, 0xfb, 0x6a, 0xf2, 0x75, 0x80, 0xef, 0x47, 0xd6, 0xd1, 0x1a, 0x12, 0x8a, 0xdd, 0xa8,
+	0x28, 0x5a, 0x90, 0x92, 0x22, 0x0f, 0xf9, 0xe3, 0xb4, 0x47, 0x72, 0x86, 0x87, 0x89, 0x9c, 0xc1,
+	0x8a, 0x1e, 0x79, 0xc8, 0x1f, 0xa5, 0x16, 0xc8, 0x23, 0x7c, 0x9a, 0xc8, 0x14, 0x16, 0xe2, 0x23,
+	0x14, 0x74, 0x60, 0x6e, 0xfe, 0x09, 0xdd, 0x9b, 0x99, 0x68, 0x72, 0xa0, 0xc7, 0x1e, 0xf2, 0x8f,
+	0x53, 0x0b, 0xe4, 0x1a, 0xbb, 0x31, 0x9f, 0xf3, 0x4e, 0x1f, 0x76, 0x19, 0xf1, 0xd5, 0xf7, 0x9f,
+	0x8f, 0xa3, 0x05, 0x57, 0x55, 0x98, 0xd5, 0x65, 0x90, 0x34, 0xea, 0xd9, 0xde, 0x6f, 0x5c, 0xcd,
+	0x5b, 0xd1, 0x14, 0x33, 0x50, 0x2b, 0xd1, 0xbe, 0x0f, 0xc1, 0xd0, 0x45, 0x29, 0xc2, 0x82, 0x2b,
+	0x1e, 0xc4, 0x75, 0x99, 0x34, 0x6a, 0xca, 0xa5, 0x82, 0x36, 0xed, 0x5d, 0x49, 0x8d, 0xc7, 0x7f,
+	0x8f, 0x97, 0x30, 0x57, 0x9c, 0x9e, 0xdc, 0x65, 0xca, 0x81, 0x35, 0x39, 0xc7, 0xa3, 0xa9, 0x28,
+	0xe0, 0x05, 0x97, 0x15, 0x75, 0xcd, 0xc2, 0x6e, 0x99, 0x4c, 0xf0, 0xf8, 0xd5, 0xaa, 0x81, 0xb6,
+	0x5f, 0xe8, 0xc8, 0xdc, 0x1f, 0x68, 0xdd, 0xcc, 0x25, 0x57, 0xfc, 0x4d, 0x5b, 0xc3, 0x4b, 0xf8,
+	0x24, 0xe9, 0xa9, 0x37, 0xe8, 0x66, 0xf6, 0xb5, 0xc9, 0x35, 0x7e, 0x10, 0xfd, 0x5f, 0x7b, 0x17,
+	0x9c, 0x0a, 0xa1, 0x4c, 0xb0, 0x6d, 0xea, 0x96, 0x49, 0x80, 0x5d, 0x3b, 0x28, 0xe9, 0x91, 0x37,
+	0xf0, 0xef, 0x3d, 0x39, 0xb3, 0x7d, 0x07, 0x07, 0x5d, 0xa7, 0xfd, 0x50, 0xfc, 0x7c, 0xbd, 0x65,
+	0xce, 0x66, 0xcb, 0x9c, 0x9b, 0x2d, 0x43, 0x9f, 0x35, 0x43, 0xdf, 0x34, 0x43, 0x3f, 0x34, 0x43,
+	0x6b, 0xcd, 0xd0, 0x46, 0x33, 0xf4, 0x4b, 0x33, 0xf4, 0x5b, 0x33, 0xe7, 0x46, 0x33, 0xf4, 0x65,
+	0xc7, 0x9c, 0xf5, 0x8e, 0x39, 0x9b, 0x1d, 0x73, 0xde, 0x0e, 0xa5, 0xe2, 0x0a, 0xb2, 0x13, 0x63,
+	0xff, 0xf4, 0xcf, 0x00, 0xe7, 0xbd, 0xd5, 0x37, 0x9f, 0x02, 0x00, 0x00,
+}
+
+func (this *AccountChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountChange)
+	if !ok {
+		that2, ok := that.(AccountChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if this.IsNew != that1.IsNew {
+		return false
+	}
+	if this.IsRemoved != that1.IsRemoved {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.Balance, that1.Balance) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.BalanceDelta, that1.BalanceDelta) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+		return false
+	}
+	if !bytes.Equal(this.OwnerAddress, that1.OwnerAddress) {
+		return false
+	}
+	if len(this.DataTrieKeys) != len(that1.DataTrieKeys) {
+		return false
+	}
+	for i := range this.DataTrieKeys {
+		if !bytes.Equal(this.DataTrieKeys[i], that1.DataTrieKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AccountsChangeSet) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountsChangeSet)
+	if !ok {
+		that2, ok := that.(AccountsChangeSet)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.RootHash, that1.RootHash) {
+		return false
+	}
+	if len(this.Changes) != len(that1.Changes) {
+		return false
+	}
+	for i := range this.Changes {
+		if !this.Changes[i].Equal(that1.Changes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AccountChange) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&state.AccountChange{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "IsNew: "+fmt.Sprintf("%#v", this.IsNew)+",\n")
+	s = append(s, "IsRemoved: "+fmt.Sprintf("%#v", this.IsRemoved)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "BalanceDelta: "+fmt.Sprintf("%#v", this.BalanceDelta)+",\n")
+	s = append(s, "CodeHash: "+fmt.Sprintf("%#v", this.CodeHash)+",\n")
+	s = append(s, "OwnerAddress: "+fmt.Sprintf("%#v", this.OwnerAddress)+",\n")
+	s = append(s, "DataTrieKeys: "+fmt.Sprintf("%#v", this.DataTrieKeys)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountsChangeSet) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&state.AccountsChangeSet{")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	if this.Changes != nil {
+		s = append(s, "Changes: "+fmt.Sprintf("%#v", this.Changes)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAccountsChangeSet(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AccountChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataTrieKeys) > 0 {
+		for iNdEx := len(m.DataTrieKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataTrieKeys[iNdEx])
+			copy(dAtA[i:], m.DataTrieKeys[iNdEx])
+			i = encodeVarintAccountsChangeSet(dAtA, i, uint64(len(m.DataTrieKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintAccountsChangeSet(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintAccountsChangeSet(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.BalanceDelta)
+		i -= size
+		if _, err := __caster.MarshalTo(m.BalanceDelta, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccountsChangeSet(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.Balance)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Balance, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccountsChangeSet(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Nonce != 0 {
+		i = encodeVarintAccountsChangeSet(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsRemoved {
+		i--
+		if m.IsRemoved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsNew {
+		i--
+		if m.IsNew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAccountsChangeSet(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountsChangeSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountsChangeSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountsChangeSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccountsChangeSet(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintAccountsChangeSet(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccountsChangeSet(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccountsChangeSet(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAccountsChangeSet(uint64(l))
+	}
+	if m.IsNew {
+		n += 2
+	}
+	if m.IsRemoved {
+		n += 2
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovAccountsChangeSet(uint64(m.Nonce))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.Balance)
+		n += 1 + l + sovAccountsChangeSet(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.BalanceDelta)
+		n += 1 + l + sovAccountsChangeSet(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovAccountsChangeSet(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovAccountsChangeSet(uint64(l))
+	}
+	if len(m.DataTrieKeys) > 0 {
+		for _, b := range m.DataTrieKeys {
+			l = len(b)
+			n += 1 + l + sovAccountsChangeSet(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountsChangeSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovAccountsChangeSet(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovAccountsChangeSet(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAccountsChangeSet(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccountsChangeSet(x uint64) (n int) {
+	return sovAccountsChangeSet(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AccountChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountChange{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`IsNew:` + fmt.Sprintf("%v", this.IsNew) + `,`,
+		`IsRemoved:` + fmt.Sprintf("%v", this.IsRemoved) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`BalanceDelta:` + fmt.Sprintf("%v", this.BalanceDelta) + `,`,
+		`CodeHash:` + fmt.Sprintf("%v", this.CodeHash) + `,`,
+		`OwnerAddress:` + fmt.Sprintf("%v", this.OwnerAddress) + `,`,
+		`DataTrieKeys:` + fmt.Sprintf("%v", this.DataTrieKeys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountsChangeSet) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChanges := "[]*AccountChange{"
+	for _, f := range this.Changes {
+		repeatedStringForChanges += strings.Replace(f.String(), "AccountChange", "AccountChange", 1) + ","
+	}
+	repeatedStringForChanges += "}"
+	s := strings.Join([]string{`&AccountsChangeSet{`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`Changes:` + repeatedStringForChanges + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAccountsChangeSet(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AccountChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountsChangeSet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsChangeSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsNew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsChangeSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsNew = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRemoved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsChangeSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRemoved = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsChangeSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsChangeSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Balance = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceDelta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsChangeSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.BalanceDelta = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsChangeSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsChangeSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = append(m.OwnerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OwnerAddress == nil {
+				m.OwnerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTrieKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsChangeSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataTrieKeys = append(m.DataTrieKeys, make([]byte, postIndex-iNdEx))
+			copy(m.DataTrieKeys[len(m.DataTrieKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountsChangeSet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountsChangeSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountsChangeSet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountsChangeSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountsChangeSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsChangeSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsChangeSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &AccountChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountsChangeSet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountsChangeSet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccountsChangeSet(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccountsChangeSet
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountsChangeSet
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountsChangeSet
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccountsChangeSet
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccountsChangeSet
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccountsChangeSet
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccountsChangeSet        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccountsChangeSet          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccountsChangeSet = fmt.Errorf("proto: unexpected end of group")
+)
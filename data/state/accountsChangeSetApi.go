@@ -0,0 +1,23 @@
+package state
+
+// AccountChangeApiResponse holds the state of an account touched by a block. The addresses are encoded with the
+// address pubkey converter, while the code hash and the data trie keys are hex encoded
+type AccountChangeApiResponse struct {
+	Address      string   `json:"address"`
+	IsNew        bool     `json:"isNew"`
+	IsRemoved    bool     `json:"isRemoved"`
+	Nonce        uint64   `json:"nonce"`
+	Balance      string   `json:"balance"`
+	BalanceDelta string   `json:"balanceDelta"`
+	CodeHash     string   `json:"codeHash"`
+	OwnerAddress string   `json:"ownerAddress"`
+	DataTrieKeys []string `json:"dataTrieKeys"`
+}
+
+// AccountsChangeSetApiResponse holds the accounts changed by a block, in the order they were first touched
+type AccountsChangeSetApiResponse struct {
+	BlockNonce uint64                     `json:"blockNonce"`
+	BlockHash  string                     `json:"blockHash"`
+	RootHash   string                     `json:"rootHash"`
+	Changes    []AccountChangeApiResponse `json:"changes"`
+}
@@ -2,11 +2,13 @@ package state
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"sync"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -20,10 +22,14 @@ type AccountsDB struct {
 	marshalizer    marshal.Marshalizer
 	accountFactory AccountFactory
 
-	lastRootHash []byte
-	dataTries    TriesHolder
-	entries      []JournalEntry
-	mutOp        sync.RWMutex
+	lastRootHash           []byte
+	dataTries              TriesHolder
+	entries                []JournalEntry
+	collectChangeSets      bool
+	lastChangeSet          *AccountsChangeSet
+	storedBytesEnableEpoch atomic.Uint32
+	flagStoredBytes        atomic.Flag
+	mutOp                  sync.RWMutex
 }
 
 var log = logger.GetOrCreate("state")
@@ -199,6 +205,17 @@ func (adb *AccountsDB) saveDataTrie(accountHandler baseAccountHandler) error {
 	dataTrie := trackableDataTrie.DataTrie()
 	oldValues := make(map[string][]byte)
 
+	storedBytesAccount, shouldCountStoredBytes := accountHandler.(storedBytesHandler)
+	shouldCountStoredBytes = shouldCountStoredBytes && adb.flagStoredBytes.IsSet()
+	if shouldCountStoredBytes {
+		err := adb.backfillStoredBytes(accountHandler, storedBytesAccount)
+		if err != nil {
+			return err
+		}
+	}
+
+	addressLength := len(accountHandler.AddressBytes())
+	storedBytesDelta := int64(0)
 	for k, v := range trackableDataTrie.DirtyData() {
 		//TODO use trackableDataTrie.originalData() instead of getting from the trie
 		val, err := dataTrie.Get([]byte(k))
@@ -207,6 +224,7 @@ func (adb *AccountsDB) saveDataTrie(accountHandler baseAccountHandler) error {
 		}
 
 		oldValues[k] = val
+		storedBytesDelta += int64(storedBytesOfValue(v, addressLength)) - int64(storedBytesOfValue(val, addressLength))
 
 		err = dataTrie.Update([]byte(k), v)
 		if err != nil {
@@ -220,6 +238,10 @@ func (adb *AccountsDB) saveDataTrie(accountHandler baseAccountHandler) error {
 	}
 	adb.journalize(entry)
 
+	if shouldCountStoredBytes {
+		storedBytesAccount.setStoredBytes(addStoredBytesDelta(storedBytesAccount.GetStoredBytes(), storedBytesDelta))
+	}
+
 	rootHash, err := trackableDataTrie.DataTrie().Root()
 	if err != nil {
 		return err
@@ -236,6 +258,46 @@ func (adb *AccountsDB) saveDataTrie(accountHandler baseAccountHandler) error {
 	return nil
 }
 
+// backfillStoredBytes counts the bytes already stored by an account which saved its data trie before the stored
+// bytes were tracked. A data trie holding at least one key always stores a non zero number of bytes
+func (adb *AccountsDB) backfillStoredBytes(accountHandler baseAccountHandler, storedBytesAccount storedBytesHandler) error {
+	if storedBytesAccount.GetStoredBytes() > 0 || len(accountHandler.GetRootHash()) == 0 {
+		return nil
+	}
+
+	addressLength := len(accountHandler.AddressBytes())
+	storedBytes := uint64(0)
+	err := accountHandler.DataTrie().IterateAllLeaves(context.Background(), func(_ []byte, value []byte) error {
+		storedBytes += storedBytesOfValue(value, addressLength)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	storedBytesAccount.setStoredBytes(storedBytes)
+
+	return nil
+}
+
+// storedBytesOfValue returns the number of key and value bytes held by a data trie value, which is saved followed by
+// its key and by the address of the account
+func storedBytesOfValue(value []byte, addressLength int) uint64 {
+	if len(value) <= addressLength {
+		return 0
+	}
+
+	return uint64(len(value) - addressLength)
+}
+
+func addStoredBytesDelta(storedBytes uint64, delta int64) uint64 {
+	if delta < 0 && uint64(-delta) > storedBytes {
+		return 0
+	}
+
+	return uint64(int64(storedBytes) + delta)
+}
+
 func (adb *AccountsDB) saveAccountToTrie(accountHandler AccountHandler) error {
 	log.Trace("accountsDB.saveAccountToTrie",
 		"address", hex.EncodeToString(accountHandler.AddressBytes()),
@@ -453,6 +515,7 @@ func (adb *AccountsDB) Commit() ([]byte, error) {
 	defer adb.mutOp.Unlock()
 
 	log.Trace("accountsDB.Commit started")
+	changeSet := adb.buildChangeSet()
 	adb.entries = make([]JournalEntry, 0)
 
 	oldHashes := make([][]byte, 0)
@@ -500,12 +563,61 @@ func (adb *AccountsDB) Commit() ([]byte, error) {
 		return nil, err
 	}
 	adb.lastRootHash = root
+	if changeSet != nil {
+		changeSet.RootHash = root
+	}
+	adb.lastChangeSet = changeSet
 
 	log.Trace("accountsDB.Commit ended", "root hash", root)
 
 	return root, nil
 }
 
+func (adb *AccountsDB) buildChangeSet() *AccountsChangeSet {
+	if !adb.collectChangeSets {
+		return nil
+	}
+
+	builder := newChangeSetBuilder()
+	builder.addJournalEntries(adb.entries)
+	changeSet, err := builder.build(adb.getAccount)
+	if err != nil {
+		log.Warn("accountsDB: could not build the change set", "error", err.Error())
+		return nil
+	}
+
+	return changeSet
+}
+
+// SetStoredBytesEnableEpoch sets the epoch starting with which the user accounts count the bytes stored in their
+// data tries. The count is part of the saved accounts, so it is only kept by the accounts adapter registered for the
+// epoch changes of the processed blocks
+func (adb *AccountsDB) SetStoredBytesEnableEpoch(epoch uint32) {
+	adb.storedBytesEnableEpoch.Set(epoch)
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (adb *AccountsDB) EpochConfirmed(epoch uint32) {
+	adb.flagStoredBytes.Toggle(epoch >= adb.storedBytesEnableEpoch.Get())
+	log.Debug("accountsDB: stored bytes tracking", "enabled", adb.flagStoredBytes.IsSet())
+}
+
+// EnableChangeSetsCollection makes the following commits build the change set of the committed accounts. The
+// collection is disabled by default as only the accounts processed by the node need it
+func (adb *AccountsDB) EnableChangeSetsCollection() {
+	adb.mutOp.Lock()
+	adb.collectChangeSets = true
+	adb.mutOp.Unlock()
+}
+
+// LastCommittedChangeSet returns the accounts changed by the last commit, or nil if the change set is not available
+func (adb *AccountsDB) LastCommittedChangeSet() *AccountsChangeSet {
+	adb.mutOp.RLock()
+	defer adb.mutOp.RUnlock()
+
+	return adb.lastChangeSet
+}
+
 // RootHash returns the main trie's root hash
 func (adb *AccountsDB) RootHash() ([]byte, error) {
 	adb.mutOp.Lock()
@@ -561,30 +673,31 @@ func (adb *AccountsDB) RecreateAllTries(rootHash []byte) (map[string]data.Trie,
 		return nil, err
 	}
 
-	leafs, err := recreatedTrie.GetAllLeaves()
-	if err != nil {
-		return nil, err
-	}
-
 	allTries := make(map[string]data.Trie)
 	allTries[string(rootHash)] = recreatedTrie
 
-	for _, leaf := range leafs {
+	err = recreatedTrie.IterateAllLeaves(context.Background(), func(_ []byte, value []byte) error {
 		account := &userAccount{}
-		err = adb.marshalizer.Unmarshal(account, leaf)
-		if err != nil {
-			log.Trace("this must be a leaf with code", "err", err)
-			continue
+		errUnmarshal := adb.marshalizer.Unmarshal(account, value)
+		if errUnmarshal != nil {
+			log.Trace("this must be a leaf with code", "err", errUnmarshal)
+			return nil
 		}
 
-		if len(account.RootHash) > 0 {
-			dataTrie, err := adb.mainTrie.Recreate(account.RootHash)
-			if err != nil {
-				return nil, err
-			}
+		if len(account.RootHash) == 0 {
+			return nil
+		}
 
-			allTries[string(account.RootHash)] = dataTrie
+		dataTrie, errRecreate := adb.mainTrie.Recreate(account.RootHash)
+		if errRecreate != nil {
+			return errRecreate
 		}
+
+		allTries[string(account.RootHash)] = dataTrie
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allTries, nil
@@ -636,23 +749,22 @@ func (adb *AccountsDB) SnapshotState(rootHash []byte) {
 }
 
 func (adb *AccountsDB) snapshotUserAccountDataTrie(rootHash []byte) {
-	leafs, err := adb.GetAllLeaves(rootHash)
-	if err != nil {
-		log.Error("incomplete snapshot as getAllLeaves error", "error", err)
-		return
-	}
-
-	for _, leaf := range leafs {
+	err := adb.IterateAllLeaves(context.Background(), rootHash, func(_ []byte, value []byte) error {
 		account := &userAccount{}
-		err = adb.marshalizer.Unmarshal(account, leaf)
-		if err != nil {
-			log.Trace("this must be a leaf with code", "err", err)
-			continue
+		errUnmarshal := adb.marshalizer.Unmarshal(account, value)
+		if errUnmarshal != nil {
+			log.Trace("this must be a leaf with code", "err", errUnmarshal)
+			return nil
 		}
 
 		if len(account.RootHash) > 0 {
 			adb.mainTrie.SetCheckpoint(account.RootHash)
 		}
+
+		return nil
+	})
+	if err != nil {
+		log.Error("incomplete snapshot as iterateAllLeaves error", "error", err)
 	}
 }
 
@@ -676,8 +788,30 @@ func (adb *AccountsDB) IsPruningEnabled() bool {
 	return adb.mainTrie.IsPruningEnabled()
 }
 
-// GetAllLeaves returns all the leaves from a given rootHash
-func (adb *AccountsDB) GetAllLeaves(rootHash []byte) (map[string][]byte, error) {
+// IterateAllLeaves calls the handler for every leaf of the trie having the given root hash, in the order of the
+// leaves' keys. Only the trie recreation is done under the accounts lock, so the iteration does not block the
+// other operations. The iteration stops at the first handler error or when the context is done
+func (adb *AccountsDB) IterateAllLeaves(
+	ctx context.Context,
+	rootHash []byte,
+	handler func(key []byte, value []byte) error,
+) error {
+	adb.mutOp.Lock()
+	newTrie, err := adb.mainTrie.Recreate(rootHash)
+	adb.mutOp.Unlock()
+	if err != nil {
+		return err
+	}
+	if check.IfNil(newTrie) {
+		return ErrNilTrie
+	}
+
+	return newTrie.IterateAllLeaves(ctx, handler)
+}
+
+// GetProof returns the Merkle proof for the given key, computed on the trie having the given root hash.
+// As the data tries share the storage with the main trie, the root hash can also be a data trie root hash
+func (adb *AccountsDB) GetProof(rootHash []byte, key []byte) ([][]byte, error) {
 	adb.mutOp.Lock()
 	defer adb.mutOp.Unlock()
 
@@ -685,16 +819,28 @@ func (adb *AccountsDB) GetAllLeaves(rootHash []byte) (map[string][]byte, error)
 	if err != nil {
 		return nil, err
 	}
-	if newTrie == nil {
+	if check.IfNil(newTrie) {
 		return nil, ErrNilTrie
 	}
 
-	allAccounts, err := newTrie.GetAllLeaves()
+	return newTrie.GetProof(key)
+}
+
+// GetTrie returns the committed trie having the given root hash. As the data tries share the storage
+// with the main trie, the root hash can also be a data trie root hash
+func (adb *AccountsDB) GetTrie(rootHash []byte) (data.Trie, error) {
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	newTrie, err := adb.mainTrie.Recreate(rootHash)
 	if err != nil {
 		return nil, err
 	}
+	if check.IfNil(newTrie) {
+		return nil, ErrNilTrie
+	}
 
-	return allAccounts, nil
+	return newTrie, nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
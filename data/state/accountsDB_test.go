@@ -2,8 +2,10 @@ package state_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 	"testing"
 	"time"
@@ -17,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state/factory"
 	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func generateAccountDBFromTrie(trie data.Trie) *state.AccountsDB {
@@ -902,7 +905,7 @@ func TestAccountsDB_RootHash(t *testing.T) {
 	assert.Equal(t, rootHash, res)
 }
 
-func TestAccountsDB_GetAllLeavesWrongRootHash(t *testing.T) {
+func TestAccountsDB_IterateAllLeavesWrongRootHash(t *testing.T) {
 	t.Parallel()
 
 	trieStub := &mock.TrieStub{
@@ -912,31 +915,294 @@ func TestAccountsDB_GetAllLeavesWrongRootHash(t *testing.T) {
 	}
 
 	adb := generateAccountDBFromTrie(trieStub)
-	res, err := adb.GetAllLeaves([]byte("root hash"))
+	err := adb.IterateAllLeaves(context.Background(), []byte("root hash"), func(_ []byte, _ []byte) error {
+		return nil
+	})
 	assert.Equal(t, state.ErrNilTrie, err)
-	assert.Nil(t, res)
 }
 
-func TestAccountsDB_GetAllLeaves(t *testing.T) {
+func TestAccountsDB_IterateAllLeaves(t *testing.T) {
 	t.Parallel()
 
 	recreateCalled := false
-	getAllLeavesCalled := false
+	iterateAllLeavesCalled := false
 	trieStub := &mock.TrieStub{
 		RecreateCalled: func(root []byte) (d data.Trie, err error) {
 			recreateCalled = true
 			return &mock.TrieStub{
-				GetAllLeavesCalled: func() (m map[string][]byte, err error) {
-					getAllLeavesCalled = true
-					return nil, nil
+				IterateAllLeavesCalled: func(_ context.Context, _ func(key []byte, value []byte) error) error {
+					iterateAllLeavesCalled = true
+					return nil
 				},
 			}, nil
 		},
 	}
 
 	adb := generateAccountDBFromTrie(trieStub)
-	_, err := adb.GetAllLeaves([]byte("root hash"))
+	err := adb.IterateAllLeaves(context.Background(), []byte("root hash"), func(_ []byte, _ []byte) error {
+		return nil
+	})
 	assert.Nil(t, err)
 	assert.True(t, recreateCalled)
-	assert.True(t, getAllLeavesCalled)
+	assert.True(t, iterateAllLeavesCalled)
+}
+
+func TestAccountsDB_IterateAllLeavesShouldReturnAllAccounts(t *testing.T) {
+	t.Parallel()
+
+	marsh := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	tr, _ := trie.NewTrie(storageManager, marsh, hsh, 5)
+	adb, _ := state.NewAccountsDB(tr, hsh, marsh, factory.NewAccountCreator())
+
+	numAccounts := 20
+	for i := 0; i < numAccounts; i++ {
+		account, _ := adb.LoadAccount([]byte(fmt.Sprintf("address%d", i)))
+		_ = adb.SaveAccount(account)
+	}
+	rootHash, err := adb.Commit()
+	assert.Nil(t, err)
+
+	addresses := make(map[string]struct{})
+	err = adb.IterateAllLeaves(context.Background(), rootHash, func(key []byte, _ []byte) error {
+		addresses[string(key)] = struct{}{}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, numAccounts, len(addresses))
+	assert.Contains(t, addresses, "address7")
+}
+
+func TestAccountsDB_GetTrieNilTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	trieStub := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (d data.Trie, err error) {
+			return nil, nil
+		},
+	}
+
+	adb := generateAccountDBFromTrie(trieStub)
+	tr, err := adb.GetTrie([]byte("root hash"))
+	assert.Equal(t, state.ErrNilTrie, err)
+	assert.Nil(t, tr)
+}
+
+func TestAccountsDB_GetTrie(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	recreatedTrie := &mock.TrieStub{}
+	trieStub := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (d data.Trie, err error) {
+			assert.Equal(t, rootHash, root)
+			return recreatedTrie, nil
+		},
+	}
+
+	adb := generateAccountDBFromTrie(trieStub)
+	tr, err := adb.GetTrie(rootHash)
+	assert.Nil(t, err)
+	assert.True(t, tr == recreatedTrie)
+}
+
+func createAccountsDBWithMemoryTrie() *state.AccountsDB {
+	marsh := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	tr, _ := trie.NewTrie(storageManager, marsh, hsh, 5)
+	adb, _ := state.NewAccountsDB(tr, hsh, marsh, factory.NewAccountCreator())
+
+	return adb
+}
+
+func TestAccountsDB_CommitShouldBuildTheChangeSet(t *testing.T) {
+	t.Parallel()
+
+	adb := createAccountsDBWithMemoryTrie()
+	adb.EnableChangeSetsCollection()
+	assert.Nil(t, adb.LastCommittedChangeSet())
+
+	existingAddress := []byte("existing address")
+	removedAddress := []byte("removed address")
+	newAddress := []byte("new address")
+	for _, address := range [][]byte{existingAddress, removedAddress} {
+		acc, _ := adb.LoadAccount(address)
+		_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(10))
+		_ = adb.SaveAccount(acc)
+	}
+	_, _ = adb.Commit()
+
+	acc, _ := adb.LoadAccount(existingAddress)
+	userAcc := acc.(state.UserAccountHandler)
+	_ = userAcc.SubFromBalance(big.NewInt(3))
+	userAcc.IncreaseNonce(1)
+	userAcc.SetOwnerAddress([]byte("owner"))
+	userAcc.DataTrieTracker().SaveKeyValue([]byte("key2"), []byte("value2"))
+	userAcc.DataTrieTracker().SaveKeyValue([]byte("key1"), []byte("value1"))
+	_ = adb.SaveAccount(userAcc)
+
+	acc, _ = adb.LoadAccount(newAddress)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(5))
+	_ = adb.SaveAccount(acc)
+
+	_ = adb.RemoveAccount(removedAddress)
+
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	changeSet := adb.LastCommittedChangeSet()
+	require.NotNil(t, changeSet)
+	assert.Equal(t, rootHash, changeSet.RootHash)
+	require.Equal(t, 3, len(changeSet.Changes))
+
+	existingChange := changeSet.Changes[0]
+	assert.Equal(t, existingAddress, existingChange.Address)
+	assert.False(t, existingChange.IsNew)
+	assert.False(t, existingChange.IsRemoved)
+	assert.Equal(t, uint64(1), existingChange.Nonce)
+	assert.Equal(t, big.NewInt(7), existingChange.Balance)
+	assert.Equal(t, big.NewInt(-3), existingChange.BalanceDelta)
+	assert.Equal(t, []byte("owner"), existingChange.OwnerAddress)
+	assert.Equal(t, [][]byte{[]byte("key1"), []byte("key2")}, existingChange.DataTrieKeys)
+
+	newChange := changeSet.Changes[1]
+	assert.Equal(t, newAddress, newChange.Address)
+	assert.True(t, newChange.IsNew)
+	assert.Equal(t, big.NewInt(5), newChange.Balance)
+	assert.Equal(t, big.NewInt(5), newChange.BalanceDelta)
+
+	removedChange := changeSet.Changes[2]
+	assert.Equal(t, removedAddress, removedChange.Address)
+	assert.True(t, removedChange.IsRemoved)
+	assert.Equal(t, big.NewInt(0), removedChange.Balance)
+	assert.Equal(t, big.NewInt(-10), removedChange.BalanceDelta)
+}
+
+func TestAccountsDB_CommitWithoutChangeSetsCollectionShouldNotBuildTheChangeSet(t *testing.T) {
+	t.Parallel()
+
+	adb := createAccountsDBWithMemoryTrie()
+
+	acc, _ := adb.LoadAccount([]byte("address"))
+	_ = adb.SaveAccount(acc)
+	_, err := adb.Commit()
+
+	assert.Nil(t, err)
+	assert.Nil(t, adb.LastCommittedChangeSet())
+}
+
+func TestAccountsDB_CommitShouldNotIncludeRevertedChangesInTheChangeSet(t *testing.T) {
+	t.Parallel()
+
+	adb := createAccountsDBWithMemoryTrie()
+	adb.EnableChangeSetsCollection()
+
+	acc, _ := adb.LoadAccount([]byte("kept address"))
+	_ = adb.SaveAccount(acc)
+	snapshot := adb.JournalLen()
+
+	acc, _ = adb.LoadAccount([]byte("reverted address"))
+	_ = adb.SaveAccount(acc)
+	err := adb.RevertToSnapshot(snapshot)
+	require.Nil(t, err)
+
+	_, err = adb.Commit()
+	require.Nil(t, err)
+
+	changeSet := adb.LastCommittedChangeSet()
+	require.Equal(t, 1, len(changeSet.Changes))
+	assert.Equal(t, []byte("kept address"), changeSet.Changes[0].Address)
+
+	_, err = adb.Commit()
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(adb.LastCommittedChangeSet().Changes))
+}
+
+func saveKeyValueInAccount(t *testing.T, adb *state.AccountsDB, address []byte, key []byte, value []byte) state.UserAccountHandler {
+	acc, err := adb.LoadAccount(address)
+	require.Nil(t, err)
+
+	userAcc := acc.(state.UserAccountHandler)
+	userAcc.DataTrieTracker().SaveKeyValue(key, value)
+	err = adb.SaveAccount(userAcc)
+	require.Nil(t, err)
+
+	return userAcc
+}
+
+func TestAccountsDB_SaveAccountBeforeStoredBytesEnableEpochShouldNotCountTheStoredBytes(t *testing.T) {
+	t.Parallel()
+
+	adb := createAccountsDBWithMemoryTrie()
+	adb.SetStoredBytesEnableEpoch(2)
+	adb.EpochConfirmed(1)
+
+	acc := saveKeyValueInAccount(t, adb, []byte("address"), []byte("key1"), []byte("value1"))
+
+	assert.Equal(t, uint64(0), acc.GetStoredBytes())
+}
+
+func TestAccountsDB_SaveAccountShouldTrackTheStoredBytes(t *testing.T) {
+	t.Parallel()
+
+	adb := createAccountsDBWithMemoryTrie()
+	adb.SetStoredBytesEnableEpoch(2)
+	adb.EpochConfirmed(2)
+
+	address := []byte("address")
+	acc := saveKeyValueInAccount(t, adb, address, []byte("key1"), []byte("value1"))
+	assert.Equal(t, uint64(10), acc.GetStoredBytes())
+
+	acc = saveKeyValueInAccount(t, adb, address, []byte("key1"), []byte("val"))
+	assert.Equal(t, uint64(7), acc.GetStoredBytes())
+
+	acc = saveKeyValueInAccount(t, adb, address, []byte("key2"), []byte("value2"))
+	assert.Equal(t, uint64(17), acc.GetStoredBytes())
+
+	acc = saveKeyValueInAccount(t, adb, address, []byte("key1"), nil)
+	assert.Equal(t, uint64(10), acc.GetStoredBytes())
+
+	loadedAcc, err := adb.LoadAccount(address)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(10), loadedAcc.(state.UserAccountHandler).GetStoredBytes())
+}
+
+func TestAccountsDB_SaveAccountAfterStoredBytesEnableEpochShouldCountTheAlreadyStoredBytes(t *testing.T) {
+	t.Parallel()
+
+	adb := createAccountsDBWithMemoryTrie()
+	adb.SetStoredBytesEnableEpoch(2)
+	adb.EpochConfirmed(1)
+
+	address := []byte("address")
+	_ = saveKeyValueInAccount(t, adb, address, []byte("key1"), []byte("value1"))
+	_, err := adb.Commit()
+	require.Nil(t, err)
+
+	adb.EpochConfirmed(2)
+	acc := saveKeyValueInAccount(t, adb, address, []byte("key2"), []byte("value2"))
+
+	assert.Equal(t, uint64(20), acc.GetStoredBytes())
+}
+
+func TestAccountsDB_RevertToSnapshotShouldRestoreTheStoredBytes(t *testing.T) {
+	t.Parallel()
+
+	adb := createAccountsDBWithMemoryTrie()
+	adb.SetStoredBytesEnableEpoch(0)
+	adb.EpochConfirmed(0)
+
+	address := []byte("address")
+	_ = saveKeyValueInAccount(t, adb, address, []byte("key1"), []byte("value1"))
+	snapshot := adb.JournalLen()
+
+	_ = saveKeyValueInAccount(t, adb, address, []byte("key2"), []byte("value2"))
+	err := adb.RevertToSnapshot(snapshot)
+	require.Nil(t, err)
+
+	acc, err := adb.LoadAccount(address)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(10), acc.(state.UserAccountHandler).GetStoredBytes())
 }
@@ -0,0 +1,129 @@
+package state
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+// touchedAccount gathers what the journal recorded about an account since the last commit
+type touchedAccount struct {
+	address      []byte
+	oldAccount   AccountHandler
+	dataTrieKeys map[string]struct{}
+}
+
+// changeSetBuilder builds the change set of a commit out of the journal entries. The first entry journalized for an
+// address holds the account as it was before the changes, while the account as it is after the changes is read from
+// the main trie, which is why the change set has to be built before the journal is cleared
+type changeSetBuilder struct {
+	touchedAccounts map[string]*touchedAccount
+	orderedAccounts []*touchedAccount
+}
+
+func newChangeSetBuilder() *changeSetBuilder {
+	return &changeSetBuilder{
+		touchedAccounts: make(map[string]*touchedAccount),
+		orderedAccounts: make([]*touchedAccount, 0),
+	}
+}
+
+func (csb *changeSetBuilder) addJournalEntries(entries []JournalEntry) {
+	for _, entry := range entries {
+		switch journalEntry := entry.(type) {
+		case *journalEntryAccountCreation:
+			csb.getOrCreateTouchedAccount(journalEntry.address)
+		case *journalEntryAccount:
+			touched, isFirstEntry := csb.getOrCreateTouchedAccount(journalEntry.account.AddressBytes())
+			if isFirstEntry {
+				touched.oldAccount = journalEntry.account
+			}
+		case *journalEntryDataTrieUpdates:
+			touched, _ := csb.getOrCreateTouchedAccount(journalEntry.account.AddressBytes())
+			for key := range journalEntry.trieUpdates {
+				touched.dataTrieKeys[key] = struct{}{}
+			}
+		}
+	}
+}
+
+func (csb *changeSetBuilder) getOrCreateTouchedAccount(address []byte) (*touchedAccount, bool) {
+	touched, ok := csb.touchedAccounts[string(address)]
+	if ok {
+		return touched, false
+	}
+
+	touched = &touchedAccount{
+		address:      address,
+		dataTrieKeys: make(map[string]struct{}),
+	}
+	csb.touchedAccounts[string(address)] = touched
+	csb.orderedAccounts = append(csb.orderedAccounts, touched)
+
+	return touched, true
+}
+
+func (csb *changeSetBuilder) build(getAccount func(address []byte) (AccountHandler, error)) (*AccountsChangeSet, error) {
+	changeSet := &AccountsChangeSet{
+		Changes: make([]*AccountChange, 0, len(csb.orderedAccounts)),
+	}
+
+	for _, touched := range csb.orderedAccounts {
+		newAccount, err := getAccount(touched.address)
+		if err != nil {
+			return nil, err
+		}
+
+		changeSet.Changes = append(changeSet.Changes, createAccountChange(touched, newAccount))
+	}
+
+	return changeSet, nil
+}
+
+func createAccountChange(touched *touchedAccount, newAccount AccountHandler) *AccountChange {
+	change := &AccountChange{
+		Address:      touched.address,
+		IsNew:        check.IfNil(touched.oldAccount),
+		IsRemoved:    check.IfNil(newAccount),
+		Balance:      big.NewInt(0),
+		DataTrieKeys: sortedKeys(touched.dataTrieKeys),
+	}
+
+	oldBalance := big.NewInt(0)
+	oldUserAccount, ok := touched.oldAccount.(UserAccountHandler)
+	if ok && oldUserAccount.GetBalance() != nil {
+		oldBalance = oldUserAccount.GetBalance()
+	}
+
+	if !change.IsRemoved {
+		change.Nonce = newAccount.GetNonce()
+	}
+	newUserAccount, ok := newAccount.(UserAccountHandler)
+	if ok {
+		if newUserAccount.GetBalance() != nil {
+			change.Balance = newUserAccount.GetBalance()
+		}
+		change.CodeHash = newUserAccount.GetCodeHash()
+		change.OwnerAddress = newUserAccount.GetOwnerAddress()
+	}
+
+	change.BalanceDelta = big.NewInt(0).Sub(change.Balance, oldBalance)
+
+	return change
+}
+
+func sortedKeys(keys map[string]struct{}) [][]byte {
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	result := make([][]byte, 0, len(sorted))
+	for _, key := range sorted {
+		result = append(result, []byte(key))
+	}
+
+	return result
+}
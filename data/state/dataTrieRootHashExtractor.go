@@ -0,0 +1,39 @@
+package state
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+type dataTrieRootHashExtractor struct {
+	marshalizer marshal.Marshalizer
+}
+
+// NewDataTrieRootHashExtractor creates a component able to extract the data trie root hash of a user account
+// from the account's serialized form, as it is stored in the accounts trie leaves
+func NewDataTrieRootHashExtractor(marshalizer marshal.Marshalizer) (*dataTrieRootHashExtractor, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	return &dataTrieRootHashExtractor{
+		marshalizer: marshalizer,
+	}, nil
+}
+
+// ExtractRootHash returns the data trie root hash of the serialized user account. Leaves that do not hold
+// user accounts (such as the ones holding code) are ignored
+func (extractor *dataTrieRootHashExtractor) ExtractRootHash(leafValue []byte) ([]byte, bool) {
+	account := NewEmptyUserAccount()
+	err := extractor.marshalizer.Unmarshal(account, leafValue)
+	if err != nil {
+		return nil, false
+	}
+
+	return account.RootHash, len(account.RootHash) > 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (extractor *dataTrieRootHashExtractor) IsInterfaceNil() bool {
+	return extractor == nil
+}
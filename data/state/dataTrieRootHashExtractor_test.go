@@ -0,0 +1,40 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDataTrieRootHashExtractor_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	extractor, err := state.NewDataTrieRootHashExtractor(nil)
+	assert.Nil(t, extractor)
+	assert.Equal(t, state.ErrNilMarshalizer, err)
+}
+
+func TestDataTrieRootHashExtractor_ExtractRootHash(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	extractor, _ := state.NewDataTrieRootHashExtractor(marshalizer)
+
+	account, _ := state.NewUserAccount([]byte("address"))
+	buff, _ := marshalizer.Marshal(account)
+	rootHash, ok := extractor.ExtractRootHash(buff)
+	assert.False(t, ok)
+	assert.Nil(t, rootHash)
+
+	account.SetRootHash([]byte("root hash"))
+	buff, _ = marshalizer.Marshal(account)
+	rootHash, ok = extractor.ExtractRootHash(buff)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("root hash"), rootHash)
+
+	rootHash, ok = extractor.ExtractRootHash([]byte("code"))
+	assert.False(t, ok)
+	assert.Nil(t, rootHash)
+}
@@ -115,3 +115,15 @@ var ErrInvalidPubkeyConverterType = errors.New("invalid pubkey converter type")
 
 // ErrInvalidHash signals that the given hash is invalid
 var ErrInvalidHash = errors.New("invalid hash provided")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilUint64Converter signals that a nil uint64 converter has been provided
+var ErrNilUint64Converter = errors.New("nil uint64 converter")
+
+// ErrNilTrieSyncStatistics signals that a nil trie sync statistics handler has been provided
+var ErrNilTrieSyncStatistics = errors.New("nil trie sync statistics handler")
+
+// ErrInvalidMaxInFlightNodes signals that an invalid maximum number of in flight trie nodes has been provided
+var ErrInvalidMaxInFlightNodes = errors.New("invalid maximum number of in flight trie nodes")
@@ -0,0 +1,118 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// ArgsHistoricalAccountsProvider holds the arguments needed to create a historical accounts provider
+type ArgsHistoricalAccountsProvider struct {
+	TrieStorage          data.StorageManager
+	Marshalizer          marshal.Marshalizer
+	Hasher               hashing.Hasher
+	AccountFactory       state.AccountFactory
+	MaxTrieLevelInMemory uint
+	StorageService       dataRetriever.StorageService
+	Uint64Converter      typeConverters.Uint64ByteSliceConverter
+	ShardId              uint32
+}
+
+type historicalAccountsProvider struct {
+	trieStorage          data.StorageManager
+	marshalizer          marshal.Marshalizer
+	hasher               hashing.Hasher
+	accountFactory       state.AccountFactory
+	maxTrieLevelInMemory uint
+	storageService       dataRetriever.StorageService
+	uint64Converter      typeConverters.Uint64ByteSliceConverter
+	shardId              uint32
+}
+
+// NewHistoricalAccountsProvider creates a component able to recreate read only accounts adapters over the past
+// states of the accounts trie. The trie nodes are read from the live trie database or from its snapshots,
+// without altering the live accounts adapter
+func NewHistoricalAccountsProvider(args ArgsHistoricalAccountsProvider) (*historicalAccountsProvider, error) {
+	if check.IfNil(args.TrieStorage) {
+		return nil, state.ErrNilStorageManager
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, state.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, state.ErrNilHasher
+	}
+	if check.IfNil(args.AccountFactory) {
+		return nil, state.ErrNilAccountFactory
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, state.ErrNilStorageService
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return nil, state.ErrNilUint64Converter
+	}
+
+	return &historicalAccountsProvider{
+		trieStorage:          args.TrieStorage,
+		marshalizer:          args.Marshalizer,
+		hasher:               args.Hasher,
+		accountFactory:       args.AccountFactory,
+		maxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+		storageService:       args.StorageService,
+		uint64Converter:      args.Uint64Converter,
+		shardId:              args.ShardId,
+	}, nil
+}
+
+// GetAccountsAdapterAtRootHash returns a read only accounts adapter over the state having the given root hash
+func (hap *historicalAccountsProvider) GetAccountsAdapterAtRootHash(rootHash []byte) (state.AccountsAdapter, error) {
+	readOnlyTrieStorage, err := trie.NewReadOnlyTrieStorage(hap.trieStorage)
+	if err != nil {
+		return nil, err
+	}
+
+	emptyTrie, err := trie.NewTrie(readOnlyTrieStorage, hap.marshalizer, hap.hasher, hap.maxTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	recreatedTrie, err := emptyTrie.Recreate(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewAccountsDB(recreatedTrie, hap.hasher, hap.marshalizer, hap.accountFactory)
+}
+
+// GetAccountsAdapterAtBlockNonce returns a read only accounts adapter over the state committed by the block
+// having the given nonce, together with the block's header
+func (hap *historicalAccountsProvider) GetAccountsAdapterAtBlockNonce(blockNonce uint64) (state.AccountsAdapter, data.HeaderHandler, error) {
+	header, _, err := process.GetHeaderFromStorageWithNonce(
+		blockNonce,
+		hap.shardId,
+		hap.storageService,
+		hap.uint64Converter,
+		hap.marshalizer,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accounts, err := hap.GetAccountsAdapterAtRootHash(header.GetRootHash())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accounts, header, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hap *historicalAccountsProvider) IsInterfaceNil() bool {
+	return hap == nil
+}
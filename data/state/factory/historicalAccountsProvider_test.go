@@ -0,0 +1,146 @@
+package factory_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createHistoricalAccountsProviderArgs() factory.ArgsHistoricalAccountsProvider {
+	trieStorage, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+
+	return factory.ArgsHistoricalAccountsProvider{
+		TrieStorage:          trieStorage,
+		Marshalizer:          &mock.ProtobufMarshalizerMock{},
+		Hasher:               &mock.KeccakMock{},
+		AccountFactory:       factory.NewAccountCreator(),
+		MaxTrieLevelInMemory: 5,
+		StorageService:       dataRetriever.NewChainStorer(),
+		Uint64Converter:      uint64ByteSlice.NewBigEndianConverter(),
+		ShardId:              0,
+	}
+}
+
+func createLiveAccountsWithBalance(t *testing.T, args factory.ArgsHistoricalAccountsProvider, address []byte, balance int64) (state.AccountsAdapter, []byte) {
+	tr, _ := trie.NewTrie(args.TrieStorage, args.Marshalizer, args.Hasher, args.MaxTrieLevelInMemory)
+	accounts, err := state.NewAccountsDB(tr, args.Hasher, args.Marshalizer, args.AccountFactory)
+	require.Nil(t, err)
+
+	return accounts, setBalance(t, accounts, address, balance)
+}
+
+func setBalance(t *testing.T, accounts state.AccountsAdapter, address []byte, balance int64) []byte {
+	account, _ := accounts.LoadAccount(address)
+	userAccount := account.(state.UserAccountHandler)
+	_ = userAccount.AddToBalance(big.NewInt(balance).Sub(big.NewInt(balance), userAccount.GetBalance()))
+	require.Nil(t, accounts.SaveAccount(userAccount))
+	rootHash, err := accounts.Commit()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+func TestNewHistoricalAccountsProvider_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHistoricalAccountsProviderArgs()
+	args.TrieStorage = nil
+	hap, err := factory.NewHistoricalAccountsProvider(args)
+	assert.Nil(t, hap)
+	assert.Equal(t, state.ErrNilStorageManager, err)
+
+	args = createHistoricalAccountsProviderArgs()
+	args.AccountFactory = nil
+	hap, err = factory.NewHistoricalAccountsProvider(args)
+	assert.Nil(t, hap)
+	assert.Equal(t, state.ErrNilAccountFactory, err)
+
+	args = createHistoricalAccountsProviderArgs()
+	args.StorageService = nil
+	hap, err = factory.NewHistoricalAccountsProvider(args)
+	assert.Nil(t, hap)
+	assert.Equal(t, state.ErrNilStorageService, err)
+
+	args = createHistoricalAccountsProviderArgs()
+	args.Uint64Converter = nil
+	hap, err = factory.NewHistoricalAccountsProvider(args)
+	assert.Nil(t, hap)
+	assert.Equal(t, state.ErrNilUint64Converter, err)
+}
+
+func TestHistoricalAccountsProvider_GetAccountsAdapterAtRootHashShouldNotDisturbLiveState(t *testing.T) {
+	t.Parallel()
+
+	args := createHistoricalAccountsProviderArgs()
+	address := make([]byte, 32)
+	liveAccounts, oldRootHash := createLiveAccountsWithBalance(t, args, address, 100)
+	newRootHash := setBalance(t, liveAccounts, address, 200)
+
+	hap, _ := factory.NewHistoricalAccountsProvider(args)
+	oldAccounts, err := hap.GetAccountsAdapterAtRootHash(oldRootHash)
+	require.Nil(t, err)
+
+	account, err := oldAccounts.GetExistingAccount(address)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), account.(state.UserAccountHandler).GetBalance())
+
+	liveRootHash, _ := liveAccounts.RootHash()
+	assert.Equal(t, newRootHash, liveRootHash)
+	account, _ = liveAccounts.GetExistingAccount(address)
+	assert.Equal(t, big.NewInt(200), account.(state.UserAccountHandler).GetBalance())
+}
+
+func TestHistoricalAccountsProvider_GetAccountsAdapterAtBlockNonce(t *testing.T) {
+	t.Parallel()
+
+	args := createHistoricalAccountsProviderArgs()
+	address := make([]byte, 32)
+	liveAccounts, oldRootHash := createLiveAccountsWithBalance(t, args, address, 100)
+	_ = setBalance(t, liveAccounts, address, 200)
+
+	blockNonce := uint64(37)
+	header := &block.Header{Nonce: blockNonce, RootHash: oldRootHash}
+	headerHash := []byte("header hash")
+	buffHeader, _ := args.Marshalizer.Marshal(header)
+
+	headersUnit := createMemoryStorageUnit()
+	_ = headersUnit.Put(headerHash, buffHeader)
+	nonceToHashUnit := createMemoryStorageUnit()
+	_ = nonceToHashUnit.Put(args.Uint64Converter.ToByteSlice(blockNonce), headerHash)
+
+	chainStorer := dataRetriever.NewChainStorer()
+	chainStorer.AddStorer(dataRetriever.BlockHeaderUnit, headersUnit)
+	chainStorer.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit, nonceToHashUnit)
+	args.StorageService = chainStorer
+
+	hap, _ := factory.NewHistoricalAccountsProvider(args)
+	oldAccounts, recoveredHeader, err := hap.GetAccountsAdapterAtBlockNonce(blockNonce)
+	require.Nil(t, err)
+	assert.Equal(t, blockNonce, recoveredHeader.GetNonce())
+
+	account, err := oldAccounts.GetExistingAccount(address)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), account.(state.UserAccountHandler).GetBalance())
+
+	_, _, err = hap.GetAccountsAdapterAtBlockNonce(blockNonce + 1)
+	assert.NotNil(t, err)
+}
+
+func createMemoryStorageUnit() *storageUnit.Unit {
+	cache, _ := lrucache.NewCache(10)
+	unit, _ := storageUnit.NewStorageUnit(cache, memorydb.New())
+
+	return unit
+}
@@ -1,6 +1,7 @@
 package state
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -25,6 +26,13 @@ type AccountFactory interface {
 	IsInterfaceNil() bool
 }
 
+// HistoricalAccountsProvider creates read only accounts adapters over past states of the accounts trie
+type HistoricalAccountsProvider interface {
+	GetAccountsAdapterAtRootHash(rootHash []byte) (AccountsAdapter, error)
+	GetAccountsAdapterAtBlockNonce(blockNonce uint64) (AccountsAdapter, data.HeaderHandler, error)
+	IsInterfaceNil() bool
+}
+
 // Updater set a new value for a key, implemented by trie
 type Updater interface {
 	Update(key, value []byte) error
@@ -100,6 +108,7 @@ type UserAccountHandler interface {
 	GetOwnerAddress() []byte
 	SetUserName(userName []byte)
 	GetUserName() []byte
+	GetStoredBytes() uint64
 	AccountHandler
 }
 
@@ -133,7 +142,9 @@ type AccountsAdapter interface {
 	SnapshotState(rootHash []byte)
 	SetStateCheckpoint(rootHash []byte)
 	IsPruningEnabled() bool
-	GetAllLeaves(rootHash []byte) (map[string][]byte, error)
+	IterateAllLeaves(ctx context.Context, rootHash []byte, handler func(key []byte, value []byte) error) error
+	GetProof(rootHash []byte, key []byte) ([][]byte, error)
+	GetTrie(rootHash []byte) (data.Trie, error)
 	RecreateAllTries(rootHash []byte) (map[string]data.Trie, error)
 	IsInterfaceNil() bool
 }
@@ -171,3 +182,8 @@ type baseAccountHandler interface {
 	DataTrieTracker() DataTrieTracker
 	IsInterfaceNil() bool
 }
+
+type storedBytesHandler interface {
+	GetStoredBytes() uint64
+	setStoredBytes(storedBytes uint64)
+}
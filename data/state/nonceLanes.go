@@ -0,0 +1,50 @@
+package state
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+// GetNonceForLane returns the current nonce of the provided lane of the account. The default lane is ordered by the
+// account nonce, the secondary lanes keep their nonces in the data trie of the account
+func GetNonceForLane(account UserAccountHandler, lane uint32) (uint64, error) {
+	if check.IfNil(account) {
+		return 0, ErrNilAccountHandler
+	}
+	if lane == 0 {
+		return account.GetNonce(), nil
+	}
+
+	val, err := account.DataTrieTracker().RetrieveValue(core.ComputeNonceLaneStorageKey(lane))
+	if err == ErrNilTrie {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return big.NewInt(0).SetBytes(val).Uint64(), nil
+}
+
+// IncreaseNonceForLane increments the nonce of the provided lane of the account
+func IncreaseNonceForLane(account UserAccountHandler, lane uint32) error {
+	if check.IfNil(account) {
+		return ErrNilAccountHandler
+	}
+	if lane == 0 {
+		account.IncreaseNonce(1)
+		return nil
+	}
+
+	nonce, err := GetNonceForLane(account, lane)
+	if err != nil {
+		return err
+	}
+
+	newNonce := big.NewInt(0).SetUint64(nonce + 1)
+	account.DataTrieTracker().SaveKeyValue(core.ComputeNonceLaneStorageKey(lane), newNonce.Bytes())
+
+	return nil
+}
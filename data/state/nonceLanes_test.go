@@ -0,0 +1,53 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNonceForLane_NilAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nonce, err := state.GetNonceForLane(nil, 1)
+	assert.Equal(t, uint64(0), nonce)
+	assert.Equal(t, state.ErrNilAccountHandler, err)
+}
+
+func TestGetNonceForLane_DefaultLaneShouldReturnTheAccountNonce(t *testing.T) {
+	t.Parallel()
+
+	acc, _ := state.NewUserAccount([]byte("address"))
+	acc.IncreaseNonce(7)
+
+	nonce, err := state.GetNonceForLane(acc, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(7), nonce)
+}
+
+func TestIncreaseNonceForLane_ShouldIncreaseOnlyTheProvidedLane(t *testing.T) {
+	t.Parallel()
+
+	acc, _ := state.NewUserAccount([]byte("address"))
+
+	nonce, err := state.GetNonceForLane(acc, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), nonce)
+
+	assert.Nil(t, state.IncreaseNonceForLane(acc, 3))
+	assert.Nil(t, state.IncreaseNonceForLane(acc, 3))
+	assert.Nil(t, state.IncreaseNonceForLane(acc, 0))
+
+	nonce, _ = state.GetNonceForLane(acc, 3)
+	assert.Equal(t, uint64(2), nonce)
+	nonce, _ = state.GetNonceForLane(acc, 2)
+	assert.Equal(t, uint64(0), nonce)
+	assert.Equal(t, uint64(1), acc.GetNonce())
+}
+
+func TestIncreaseNonceForLane_NilAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, state.ErrNilAccountHandler, state.IncreaseNonceForLane(nil, 1))
+}
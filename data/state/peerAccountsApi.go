@@ -0,0 +1,55 @@
+package state
+
+// SignRateApiResponse holds the number of successful and failed signings of a peer
+type SignRateApiResponse struct {
+	NumSuccess uint32 `json:"numSuccess"`
+	NumFailure uint32 `json:"numFailure"`
+}
+
+// PeerAccountApiResponse holds the full data of a peer account. The BLS public key is encoded with the validator
+// pubkey converter and the reward address with the address pubkey converter
+type PeerAccountApiResponse struct {
+	BLSPublicKey               string              `json:"blsPublicKey"`
+	RewardAddress              string              `json:"rewardAddress"`
+	ShardId                    uint32              `json:"shardId"`
+	List                       string              `json:"list"`
+	IndexInList                uint32              `json:"indexInList"`
+	Rating                     uint32              `json:"rating"`
+	TempRating                 uint32              `json:"tempRating"`
+	ValidatorSuccessRate       SignRateApiResponse `json:"validatorSuccessRate"`
+	LeaderSuccessRate          SignRateApiResponse `json:"leaderSuccessRate"`
+	TotalValidatorSuccessRate  SignRateApiResponse `json:"totalValidatorSuccessRate"`
+	TotalLeaderSuccessRate     SignRateApiResponse `json:"totalLeaderSuccessRate"`
+	AccumulatedFees            string              `json:"accumulatedFees"`
+	NumSelectedInSuccessBlocks uint32              `json:"numSelectedInSuccessBlocks"`
+	ConsecutiveProposerMisses  uint32              `json:"consecutiveProposerMisses"`
+	Nonce                      uint64              `json:"nonce"`
+	UnStakedEpoch              uint32              `json:"unStakedEpoch"`
+}
+
+// PeerAccountsApiResponse holds the peer accounts of the peer accounts trie having the hex encoded root hash,
+// by their encoded BLS public key. Truncated is set when the number of peer accounts exceeded the response limit
+type PeerAccountsApiResponse struct {
+	RootHash     string                             `json:"rootHash"`
+	PeerAccounts map[string]*PeerAccountApiResponse `json:"peerAccounts"`
+	Truncated    bool                               `json:"truncated"`
+}
+
+// PeerAccountChangeApiResponse holds the data of a peer account in two peer accounts tries. From is nil for an account
+// missing from the first trie, while To is nil for an account missing from the second one
+type PeerAccountChangeApiResponse struct {
+	From            *PeerAccountApiResponse `json:"from"`
+	To              *PeerAccountApiResponse `json:"to"`
+	RatingDelta     int64                   `json:"ratingDelta"`
+	TempRatingDelta int64                   `json:"tempRatingDelta"`
+}
+
+// PeerAccountsComparisonApiResponse holds the peer accounts that differ between the peer accounts tries having the
+// hex encoded root hashes, by their encoded BLS public key. Truncated is set when the number of changes exceeded the
+// response limit
+type PeerAccountsComparisonApiResponse struct {
+	FromRootHash string                                   `json:"fromRootHash"`
+	ToRootHash   string                                   `json:"toRootHash"`
+	Changes      map[string]*PeerAccountChangeApiResponse `json:"changes"`
+	Truncated    bool                                     `json:"truncated"`
+}
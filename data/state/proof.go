@@ -0,0 +1,20 @@
+package state
+
+// ProofApiResponse holds the Merkle proof of a key, anchored to a trie root hash. All the byte slices
+// are hex encoded. An empty value means that the proof shows that the key is absent
+type ProofApiResponse struct {
+	BlockNonce uint64   `json:"blockNonce"`
+	RootHash   string   `json:"rootHash"`
+	Key        string   `json:"key"`
+	Value      string   `json:"value"`
+	Proof      []string `json:"proof"`
+}
+
+// DataTrieProofApiResponse holds the Merkle proof of a data trie value, together with the proof of the account
+// that holds the data trie. The account proof is anchored to the block's state root hash while the data trie
+// proof is anchored to the data trie root hash found in the proven account
+type DataTrieProofApiResponse struct {
+	AccountProof  *ProofApiResponse `json:"accountProof"`
+	DataTrieProof *ProofApiResponse `json:"dataTrieProof"`
+	Value         string            `json:"value"`
+}
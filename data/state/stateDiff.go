@@ -0,0 +1,19 @@
+package state
+
+// LeafDiffApiResponse holds a leaf that differs between two states. The key and the values are hex encoded.
+// The old value is empty for added leaves and the new value is empty for removed leaves
+type LeafDiffApiResponse struct {
+	Type     string `json:"type"`
+	Key      string `json:"key"`
+	OldValue string `json:"oldValue"`
+	NewValue string `json:"newValue"`
+}
+
+// StateDiffApiResponse holds the leaves that differ between the tries having the given root hashes. Truncated
+// is set when the number of differences exceeded the maximum number of entries that can be returned
+type StateDiffApiResponse struct {
+	FromRootHash string                `json:"fromRootHash"`
+	ToRootHash   string                `json:"toRootHash"`
+	Diffs        []LeafDiffApiResponse `json:"diffs"`
+	Truncated    bool                  `json:"truncated"`
+}
@@ -140,6 +140,11 @@ func (a *userAccount) SetCodeMetadata(codeMetadata []byte) {
 	a.CodeMetadata = codeMetadata
 }
 
+// setStoredBytes sets the number of key and value bytes stored in the account's data trie
+func (a *userAccount) setStoredBytes(storedBytes uint64) {
+	a.StoredBytes = storedBytes
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (a *userAccount) IsInterfaceNil() bool {
 	return a == nil
@@ -38,6 +38,7 @@ type UserAccountData struct {
 	OwnerAddress    []byte        `protobuf:"bytes,7,opt,name=OwnerAddress,proto3" json:"OwnerAddress,omitempty"`
 	UserName        []byte        `protobuf:"bytes,8,opt,name=UserName,proto3" json:"UserName,omitempty"`
 	CodeMetadata    []byte        `protobuf:"bytes,9,opt,name=CodeMetadata,proto3" json:"CodeMetadata,omitempty"`
+	StoredBytes     uint64        `protobuf:"varint,10,opt,name=StoredBytes,proto3" json:"StoredBytes,omitempty"`
 }
 
 func (m *UserAccountData) Reset()      { *m = UserAccountData{} }
@@ -131,6 +132,13 @@ func (m *UserAccountData) GetCodeMetadata() []byte {
 	return nil
 }
 
+func (m *UserAccountData) GetStoredBytes() uint64 {
+	if m != nil {
+		return m.StoredBytes
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*UserAccountData)(nil), "proto.UserAccountData")
 }
@@ -138,30 +146,31 @@ func init() {
 func init() { proto.RegisterFile("userAccountData.proto", fileDescriptor_275d64df7d722770) }
 
 var fileDescriptor_275d64df7d722770 = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0xb1, 0x6e, 0xe2, 0x40,
-	0x10, 0x86, 0xbd, 0x07, 0x06, 0x6e, 0x85, 0x84, 0x64, 0xdd, 0x49, 0x16, 0xc5, 0x1e, 0xa2, 0xa2,
-	0x01, 0x17, 0x57, 0x5e, 0x71, 0xc2, 0x80, 0x74, 0x14, 0xc7, 0x49, 0x96, 0xae, 0x49, 0x13, 0xad,
-	0xed, 0x89, 0x41, 0x01, 0x0f, 0x5a, 0xaf, 0x43, 0x9b, 0x47, 0xc8, 0x63, 0x44, 0x69, 0xf2, 0x1a,
-	0x29, 0x29, 0xe9, 0x12, 0x96, 0x26, 0x25, 0x8f, 0x10, 0xed, 0x5a, 0x8e, 0x20, 0x75, 0xaa, 0xdd,
-	0xef, 0xff, 0x35, 0x33, 0xff, 0xec, 0xd2, 0xef, 0x79, 0x06, 0x62, 0x18, 0x45, 0x98, 0xa7, 0x72,
-	0xcc, 0x25, 0x1f, 0xac, 0x05, 0x4a, 0x74, 0x6c, 0x73, 0xb4, 0xfb, 0xc9, 0x42, 0xce, 0xf3, 0x70,
-	0x10, 0xe1, 0xca, 0x4b, 0x30, 0x41, 0xcf, 0xc8, 0x61, 0x7e, 0x65, 0xc8, 0x80, 0xb9, 0x15, 0x55,
-	0xdd, 0xc7, 0x0a, 0x6d, 0xfd, 0x3f, 0xef, 0xe7, 0x7c, 0xa3, 0xf6, 0x0c, 0xd3, 0x08, 0x5c, 0xd2,
-	0x21, 0xbd, 0x6a, 0x50, 0x80, 0x73, 0x49, 0xeb, 0x3e, 0x5f, 0x72, 0xad, 0x7f, 0xe9, 0x90, 0x5e,
-	0xd3, 0x9f, 0x3c, 0x3c, 0xff, 0x18, 0xae, 0xb8, 0x9c, 0x7b, 0xe1, 0x22, 0x19, 0x4c, 0x53, 0xf9,
-	0xeb, 0x64, 0xf4, 0x64, 0x29, 0x30, 0x8d, 0x67, 0x20, 0x37, 0x28, 0xae, 0x3d, 0x30, 0xd4, 0x4f,
-	0xd0, 0x8b, 0x75, 0x60, 0x7f, 0x91, 0x4c, 0x53, 0x39, 0xe2, 0x99, 0x04, 0x11, 0x94, 0x5d, 0x9d,
-	0x36, 0x6d, 0x8c, 0x30, 0x86, 0x3f, 0x3c, 0x9b, 0xbb, 0x15, 0x3d, 0x21, 0x78, 0x67, 0xed, 0x05,
-	0x88, 0xd2, 0x78, 0xd5, 0xc2, 0x2b, 0xd9, 0x71, 0x69, 0x7d, 0x18, 0xc7, 0x02, 0xb2, 0xcc, 0xb5,
-	0x8d, 0x55, 0xa2, 0x83, 0xb4, 0x35, 0x86, 0x1b, 0x58, 0xe2, 0x1a, 0x44, 0x00, 0x1b, 0x2e, 0x62,
-	0xb7, 0xf6, 0x99, 0xd1, 0x3f, 0x76, 0x77, 0xba, 0xb4, 0xf9, 0x6f, 0x93, 0x82, 0x28, 0xf3, 0xd4,
-	0x4d, 0x9e, 0x33, 0x4d, 0xaf, 0xa2, 0x1f, 0x7c, 0xc6, 0x57, 0xe0, 0x36, 0x8a, 0x55, 0x4a, 0xd6,
-	0xf5, 0x7a, 0xe5, 0xbf, 0x20, 0xb9, 0x9e, 0xe6, 0x7e, 0x2d, 0xea, 0x4f, 0x35, 0xff, 0xf7, 0x76,
-	0xcf, 0xac, 0xdd, 0x9e, 0x59, 0xc7, 0x3d, 0x23, 0xb7, 0x8a, 0x91, 0x7b, 0xc5, 0xc8, 0x93, 0x62,
-	0x64, 0xab, 0x18, 0xd9, 0x29, 0x46, 0x5e, 0x14, 0x23, 0xaf, 0x8a, 0x59, 0x47, 0xc5, 0xc8, 0xdd,
-	0x81, 0x59, 0xdb, 0x03, 0xb3, 0x76, 0x07, 0x66, 0x5d, 0xd8, 0x99, 0xe4, 0x12, 0xc2, 0x9a, 0xf9,
-	0xf9, 0x9f, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x95, 0xf9, 0xe1, 0x48, 0x02, 0x00, 0x00,
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x31, 0xef, 0xd2, 0x40,
+	0x18, 0xc6, 0x7b, 0xfe, 0x29, 0xe0, 0x49, 0x42, 0x72, 0xd1, 0xe4, 0xc2, 0x70, 0x12, 0x26, 0x16,
+	0xe8, 0xe0, 0xe8, 0x60, 0x28, 0x90, 0xc8, 0x20, 0x26, 0x35, 0x2e, 0x2e, 0xe6, 0xda, 0xbe, 0x16,
+	0x22, 0xf4, 0x25, 0xd7, 0xab, 0xc4, 0xcd, 0x8f, 0xe0, 0xc7, 0x30, 0x7e, 0x12, 0x47, 0xdc, 0xd8,
+	0x94, 0x63, 0x71, 0xe4, 0x23, 0x98, 0xbb, 0xa6, 0x06, 0x9c, 0xff, 0x53, 0xfb, 0x7b, 0x9e, 0xbc,
+	0xef, 0xfb, 0xbc, 0x77, 0x47, 0x9f, 0x94, 0x05, 0xa8, 0x49, 0x92, 0x60, 0x99, 0xeb, 0x99, 0xd4,
+	0x72, 0xbc, 0x53, 0xa8, 0x91, 0xf9, 0xee, 0xd3, 0x1b, 0x65, 0x6b, 0xbd, 0x2a, 0xe3, 0x71, 0x82,
+	0xdb, 0x20, 0xc3, 0x0c, 0x03, 0x27, 0xc7, 0xe5, 0x07, 0x47, 0x0e, 0xdc, 0x5f, 0x55, 0x35, 0xf8,
+	0x79, 0x47, 0xbb, 0x6f, 0x6f, 0xfb, 0xb1, 0xc7, 0xd4, 0x5f, 0x62, 0x9e, 0x00, 0x27, 0x7d, 0x32,
+	0x6c, 0x44, 0x15, 0xb0, 0xf7, 0xb4, 0x15, 0xca, 0x8d, 0xb4, 0xfa, 0x83, 0x3e, 0x19, 0x76, 0xc2,
+	0xf9, 0xf7, 0x5f, 0x4f, 0x27, 0x5b, 0xa9, 0x57, 0x41, 0xbc, 0xce, 0xc6, 0x8b, 0x5c, 0x3f, 0xbf,
+	0x1a, 0x3d, 0xdf, 0x28, 0xcc, 0xd3, 0x25, 0xe8, 0x3d, 0xaa, 0x8f, 0x01, 0x38, 0x1a, 0x65, 0x18,
+	0xa4, 0x36, 0x70, 0xb8, 0xce, 0x16, 0xb9, 0x9e, 0xca, 0x42, 0x83, 0x8a, 0xea, 0xae, 0xac, 0x47,
+	0xdb, 0x53, 0x4c, 0xe1, 0xa5, 0x2c, 0x56, 0xfc, 0xce, 0x4e, 0x88, 0xfe, 0xb1, 0xf5, 0x22, 0x44,
+	0xed, 0xbc, 0x46, 0xe5, 0xd5, 0xcc, 0x38, 0x6d, 0x4d, 0xd2, 0x54, 0x41, 0x51, 0x70, 0xdf, 0x59,
+	0x35, 0x32, 0xa4, 0xdd, 0x19, 0x7c, 0x82, 0x0d, 0xee, 0x40, 0x45, 0xb0, 0x97, 0x2a, 0xe5, 0xcd,
+	0xfb, 0x8c, 0xfe, 0x7f, 0x77, 0x36, 0xa0, 0x9d, 0xd7, 0xfb, 0x1c, 0x54, 0x9d, 0xa7, 0xe5, 0xf2,
+	0xdc, 0x68, 0x76, 0x15, 0x7b, 0xe0, 0x4b, 0xb9, 0x05, 0xde, 0xae, 0x56, 0xa9, 0xd9, 0xd6, 0xdb,
+	0x95, 0x5f, 0x81, 0x96, 0x76, 0x1a, 0x7f, 0x58, 0xd5, 0x5f, 0x6b, 0xac, 0x4f, 0x1f, 0xbd, 0xd1,
+	0xa8, 0x20, 0x0d, 0x3f, 0x6b, 0x28, 0x38, 0x75, 0x77, 0x74, 0x2d, 0x85, 0x2f, 0x0e, 0x27, 0xe1,
+	0x1d, 0x4f, 0xc2, 0xbb, 0x9c, 0x04, 0xf9, 0x62, 0x04, 0xf9, 0x66, 0x04, 0xf9, 0x61, 0x04, 0x39,
+	0x18, 0x41, 0x8e, 0x46, 0x90, 0xdf, 0x46, 0x90, 0x3f, 0x46, 0x78, 0x17, 0x23, 0xc8, 0xd7, 0xb3,
+	0xf0, 0x0e, 0x67, 0xe1, 0x1d, 0xcf, 0xc2, 0x7b, 0xe7, 0x17, 0x5a, 0x6a, 0x88, 0x9b, 0xee, 0x6d,
+	0x3c, 0xfb, 0x3b, 0x00, 0xd9, 0x37, 0x1b, 0x37, 0x6a, 0x02, 0x00, 0x00,
 }
 
 func (this *UserAccountData) Equal(that interface{}) bool {
@@ -216,13 +225,16 @@ func (this *UserAccountData) Equal(that interface{}) bool {
 	if !bytes.Equal(this.CodeMetadata, that1.CodeMetadata) {
 		return false
 	}
+	if this.StoredBytes != that1.StoredBytes {
+		return false
+	}
 	return true
 }
 func (this *UserAccountData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 13)
+	s := make([]string, 0, 14)
 	s = append(s, "&state.UserAccountData{")
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
@@ -233,6 +245,7 @@ func (this *UserAccountData) GoString() string {
 	s = append(s, "OwnerAddress: "+fmt.Sprintf("%#v", this.OwnerAddress)+",\n")
 	s = append(s, "UserName: "+fmt.Sprintf("%#v", this.UserName)+",\n")
 	s = append(s, "CodeMetadata: "+fmt.Sprintf("%#v", this.CodeMetadata)+",\n")
+	s = append(s, "StoredBytes: "+fmt.Sprintf("%#v", this.StoredBytes)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -264,6 +277,11 @@ func (m *UserAccountData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StoredBytes != 0 {
+		i = encodeVarintUserAccountData(dAtA, i, uint64(m.StoredBytes))
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.CodeMetadata) > 0 {
 		i -= len(m.CodeMetadata)
 		copy(dAtA[i:], m.CodeMetadata)
@@ -390,6 +408,9 @@ func (m *UserAccountData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovUserAccountData(uint64(l))
 	}
+	if m.StoredBytes != 0 {
+		n += 1 + sovUserAccountData(uint64(m.StoredBytes))
+	}
 	return n
 }
 
@@ -413,6 +434,7 @@ func (this *UserAccountData) String() string {
 		`OwnerAddress:` + fmt.Sprintf("%v", this.OwnerAddress) + `,`,
 		`UserName:` + fmt.Sprintf("%v", this.UserName) + `,`,
 		`CodeMetadata:` + fmt.Sprintf("%v", this.CodeMetadata) + `,`,
+		`StoredBytes:` + fmt.Sprintf("%v", this.StoredBytes) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -753,6 +775,25 @@ func (m *UserAccountData) Unmarshal(dAtA []byte) error {
 				m.CodeMetadata = []byte{}
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredBytes", wireType)
+			}
+			m.StoredBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserAccountData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoredBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUserAccountData(dAtA[iNdEx:])
@@ -28,6 +28,8 @@ type baseAccountsSyncer struct {
 	cacher               storage.Cacher
 	rootHash             []byte
 	maxTrieLevelInMemory uint
+	syncStatistics       data.SyncStatisticsHandler
+	maxInFlightNodes     int
 }
 
 const minWaitTime = time.Second
@@ -41,6 +43,8 @@ type ArgsNewBaseAccountsSyncer struct {
 	WaitTime             time.Duration
 	Cacher               storage.Cacher
 	MaxTrieLevelInMemory uint
+	TrieSyncStatistics   data.SyncStatisticsHandler
+	MaxInFlightNodes     int
 }
 
 func checkArgs(args ArgsNewBaseAccountsSyncer) error {
@@ -62,6 +66,12 @@ func checkArgs(args ArgsNewBaseAccountsSyncer) error {
 	if check.IfNil(args.Cacher) {
 		return state.ErrNilCacher
 	}
+	if check.IfNil(args.TrieSyncStatistics) {
+		return state.ErrNilTrieSyncStatistics
+	}
+	if args.MaxInFlightNodes < 1 {
+		return fmt.Errorf("%w, provided %d", state.ErrInvalidMaxInFlightNodes, args.MaxInFlightNodes)
+	}
 
 	return nil
 }
@@ -75,7 +85,7 @@ func (b *baseAccountsSyncer) syncMainTrie(rootHash []byte, trieTopic string, ctx
 	}
 
 	b.dataTries[string(rootHash)] = dataTrie
-	trieSyncer, err := trie.NewTrieSyncer(b.requestHandler, b.cacher, dataTrie, b.shardId, trieTopic)
+	trieSyncer, err := b.createTrieSyncer(dataTrie, trieTopic)
 	if err != nil {
 		return err
 	}
@@ -89,6 +99,20 @@ func (b *baseAccountsSyncer) syncMainTrie(rootHash []byte, trieTopic string, ctx
 	return nil
 }
 
+func (b *baseAccountsSyncer) createTrieSyncer(tr data.Trie, trieTopic string) (data.TrieSyncer, error) {
+	arg := trie.ArgTrieSyncer{
+		RequestHandler:     b.requestHandler,
+		InterceptedNodes:   b.cacher,
+		Trie:               tr,
+		ShardId:            b.shardId,
+		Topic:              trieTopic,
+		TrieSyncStatistics: b.syncStatistics,
+		MaxInFlightNodes:   b.maxInFlightNodes,
+	}
+
+	return trie.NewTrieSyncer(arg)
+}
+
 // GetSyncedTries returns the synced map of data trie
 func (b *baseAccountsSyncer) GetSyncedTries() map[string]data.Trie {
 	b.mutex.Lock()
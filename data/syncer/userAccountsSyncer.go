@@ -56,6 +56,8 @@ func NewUserAccountsSyncer(args ArgsNewUserAccountsSyncer) (*userAccountsSyncer,
 		cacher:               args.Cacher,
 		rootHash:             nil,
 		maxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+		syncStatistics:       args.TrieSyncStatistics,
+		maxInFlightNodes:     args.MaxInFlightNodes,
 	}
 
 	u := &userAccountsSyncer{
@@ -80,7 +82,7 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte) error {
 	}
 
 	mainTrie := u.dataTries[string(rootHash)]
-	rootHashes, err := u.findAllAccountRootHashes(mainTrie)
+	rootHashes, err := u.findAllAccountRootHashes(mainTrie, ctx)
 	if err != nil {
 		return err
 	}
@@ -114,7 +116,10 @@ func (u *userAccountsSyncer) syncAccountDataTries(rootHashes [][]byte, ctx conte
 			}
 		}
 
+		u.throttler.StartProcessing()
 		go func(trieRootHash []byte) {
+			defer u.throttler.EndProcessing()
+
 			newErr := u.syncDataTrie(trieRootHash, ctx)
 			if newErr != nil {
 				errMutex.Lock()
@@ -134,8 +139,6 @@ func (u *userAccountsSyncer) syncAccountDataTries(rootHashes [][]byte, ctx conte
 }
 
 func (u *userAccountsSyncer) syncDataTrie(rootHash []byte, ctx context.Context) error {
-	u.throttler.StartProcessing()
-
 	u.syncerMutex.Lock()
 	dataTrie, err := trie.NewTrie(u.trieStorageManager, u.marshalizer, u.hasher, u.maxTrieLevelInMemory)
 	if err != nil {
@@ -144,7 +147,7 @@ func (u *userAccountsSyncer) syncDataTrie(rootHash []byte, ctx context.Context)
 	}
 
 	u.dataTries[string(rootHash)] = dataTrie
-	trieSyncer, err := trie.NewTrieSyncer(u.requestHandler, u.cacher, dataTrie, u.shardId, factory.AccountTrieNodesTopic)
+	trieSyncer, err := u.createTrieSyncer(dataTrie, factory.AccountTrieNodesTopic)
 	if err != nil {
 		u.syncerMutex.Unlock()
 		return err
@@ -152,34 +155,36 @@ func (u *userAccountsSyncer) syncDataTrie(rootHash []byte, ctx context.Context)
 	u.trieSyncers[string(rootHash)] = trieSyncer
 	u.syncerMutex.Unlock()
 
-	err = trieSyncer.StartSyncing(rootHash, ctx)
-	if err != nil {
-		return err
-	}
-
-	u.throttler.EndProcessing()
-
-	return nil
+	return trieSyncer.StartSyncing(rootHash, ctx)
 }
 
-func (u *userAccountsSyncer) findAllAccountRootHashes(mainTrie data.Trie) ([][]byte, error) {
-	leafs, err := mainTrie.GetAllLeaves()
-	if err != nil {
-		return nil, err
-	}
-
+func (u *userAccountsSyncer) findAllAccountRootHashes(mainTrie data.Trie, ctx context.Context) ([][]byte, error) {
 	rootHashes := make([][]byte, 0)
-	for _, leaf := range leafs {
+	uniqueRootHashes := make(map[string]struct{})
+	err := mainTrie.IterateAllLeaves(ctx, func(_ []byte, value []byte) error {
 		account := state.NewEmptyUserAccount()
-		err = u.marshalizer.Unmarshal(account, leaf)
-		if err != nil {
-			log.Trace("this must be a leaf with code", "err", err)
-			continue
+		errUnmarshal := u.marshalizer.Unmarshal(account, value)
+		if errUnmarshal != nil {
+			log.Trace("this must be a leaf with code", "err", errUnmarshal)
+			return nil
+		}
+
+		if len(account.RootHash) == 0 {
+			return nil
 		}
 
-		if len(account.RootHash) > 0 {
-			rootHashes = append(rootHashes, account.RootHash)
+		_, isDuplicated := uniqueRootHashes[string(account.RootHash)]
+		if isDuplicated {
+			return nil
 		}
+
+		uniqueRootHashes[string(account.RootHash)] = struct{}{}
+		rootHashes = append(rootHashes, account.RootHash)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return rootHashes, nil
@@ -39,6 +39,8 @@ func NewValidatorAccountsSyncer(args ArgsNewValidatorAccountsSyncer) (*validator
 		cacher:               args.Cacher,
 		rootHash:             nil,
 		maxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+		syncStatistics:       args.TrieSyncStatistics,
+		maxInFlightNodes:     args.MaxInFlightNodes,
 	}
 
 	u := &validatorAccountsSyncer{
@@ -2,16 +2,26 @@ package transaction
 
 // ApiTransactionResult is the data transfer object which will be returned on the get transaction by hash endpoint
 type ApiTransactionResult struct {
-	Type      string `json:"type"`
-	Nonce     uint64 `json:"nonce,omitempty"`
-	Round     uint64 `json:"round,omitempty"`
-	Epoch     uint32 `json:"epoch,omitempty"`
-	Value     string `json:"value,omitempty"`
-	Receiver  string `json:"receiver,omitempty"`
-	Sender    string `json:"sender,omitempty"`
-	GasPrice  uint64 `json:"gasPrice,omitempty"`
-	GasLimit  uint64 `json:"gasLimit,omitempty"`
-	Data      string `json:"data,omitempty"`
-	Code      string `json:"code,omitempty"`
-	Signature string `json:"signature,omitempty"`
+	Type      string      `json:"type"`
+	Nonce     uint64      `json:"nonce,omitempty"`
+	Round     uint64      `json:"round,omitempty"`
+	Epoch     uint32      `json:"epoch,omitempty"`
+	Value     string      `json:"value,omitempty"`
+	Receiver  string      `json:"receiver,omitempty"`
+	Sender    string      `json:"sender,omitempty"`
+	GasPrice  uint64      `json:"gasPrice,omitempty"`
+	GasLimit  uint64      `json:"gasLimit,omitempty"`
+	Data      string      `json:"data,omitempty"`
+	Code      string      `json:"code,omitempty"`
+	Signature string      `json:"signature,omitempty"`
+	Receipt   *ApiReceipt `json:"receipt,omitempty"`
+}
+
+// ApiReceipt is the data transfer object holding the receipt of an executed transaction
+type ApiReceipt struct {
+	GasUsed      uint64 `json:"gasUsed"`
+	GasRefunded  uint64 `json:"gasRefunded"`
+	Fee          string `json:"fee"`
+	DeveloperFee string `json:"developerFee"`
+	Status       string `json:"status"`
 }
@@ -10,6 +10,21 @@ import (
 
 var _ = data.TransactionHandler(&Transaction{})
 
+// InitialVersion is the version of the transactions created before the versioning was introduced. Transactions having
+// the version field unset (zero) are treated the same way
+const InitialVersion = uint32(1)
+
+// VersionWithOptions is the first transaction version which is allowed to set the options field
+const VersionWithOptions = uint32(2)
+
+// MaskSignedWithHash is the option bit which tells that the signature is computed over the hash of the serialized
+// transaction, instead of the serialized transaction itself. It is useful for the devices which are not able to sign
+// large data fields, like the hardware wallets
+const MaskSignedWithHash = uint32(1)
+
+// MaskAllOptions holds all the option bits known by the protocol
+const MaskAllOptions = MaskSignedWithHash
+
 // IsInterfaceNil verifies if underlying object is nil
 func (tx *Transaction) IsInterfaceNil() bool {
 	return tx == nil
@@ -35,6 +50,28 @@ func (tx *Transaction) SetSndAddr(addr []byte) {
 	tx.SndAddr = addr
 }
 
+// HasOptionSignedWithHash returns true if the signature of the transaction is computed over the hash of the
+// serialized transaction
+func (tx *Transaction) HasOptionSignedWithHash() bool {
+	return tx.Version >= VersionWithOptions && tx.Options&MaskSignedWithHash > 0
+}
+
+// IsTimeLocked returns true if the transaction can not be executed before a given round or epoch
+func (tx *Transaction) IsTimeLocked() bool {
+	return tx.NotBeforeRound > 0 || tx.NotBeforeEpoch > 0
+}
+
+// CanBeExecutedAt returns true if the time lock of the transaction, if any, is satisfied by the provided round and epoch
+func (tx *Transaction) CanBeExecutedAt(round uint64, epoch uint32) bool {
+	return round >= tx.NotBeforeRound && epoch >= tx.NotBeforeEpoch
+}
+
+// UsesNonceLane returns true if the transaction is ordered on one of the secondary nonce lanes of its sender instead
+// of the account nonce
+func (tx *Transaction) UsesNonceLane() bool {
+	return tx.NonceLane > 0
+}
+
 // TrimSlicePtr creates a copy of the provided slice without the excess capacity
 func TrimSlicePtr(in []*Transaction) []*Transaction {
 	if len(in) == 0 {
@@ -67,9 +104,16 @@ type frontendTransaction struct {
 	GasLimit         uint64 `json:"gasLimit"`
 	Data             string `json:"data,omitempty"`
 	Signature        string `json:"signature,omitempty"`
+	Version          uint32 `json:"version,omitempty"`
+	Options          uint32 `json:"options,omitempty"`
+	NotBeforeRound   uint64 `json:"notBeforeRound,omitempty"`
+	NotBeforeEpoch   uint32 `json:"notBeforeEpoch,omitempty"`
+	NonceLane        uint32 `json:"nonceLane,omitempty"`
 }
 
-// GetDataForSigning returns the serialized transaction having an empty signature field
+// GetDataForSigning returns the serialized transaction having an empty signature field. The version, the options, the
+// time lock and the nonce lane are omitted when not set, so the transactions created before the versioning was
+// introduced keep their signing data
 func (tx *Transaction) GetDataForSigning(encoder Encoder, marshalizer Marshalizer) ([]byte, error) {
 	if check.IfNil(encoder) {
 		return nil, ErrNilEncoder
@@ -88,6 +132,11 @@ func (tx *Transaction) GetDataForSigning(encoder Encoder, marshalizer Marshalize
 		SenderUsername:   tx.SndUserName,
 		ReceiverUsername: tx.RcvUserName,
 		Data:             string(tx.Data),
+		Version:          tx.Version,
+		Options:          tx.Options,
+		NotBeforeRound:   tx.NotBeforeRound,
+		NotBeforeEpoch:   tx.NotBeforeEpoch,
+		NonceLane:        tx.NonceLane,
 	}
 
 	return marshalizer.Marshal(ftx)
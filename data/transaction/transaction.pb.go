@@ -30,16 +30,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Transaction holds all the data needed for a value transfer or SC call
 type Transaction struct {
-	Nonce       uint64        `protobuf:"varint,1,opt,name=Nonce,proto3" json:"nonce"`
-	Value       *math_big.Int `protobuf:"bytes,2,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"value"`
-	RcvAddr     []byte        `protobuf:"bytes,3,opt,name=RcvAddr,proto3" json:"receiver"`
-	RcvUserName []byte        `protobuf:"bytes,4,opt,name=RcvUserName,proto3" json:"rcvUserName,omitempty"`
-	SndAddr     []byte        `protobuf:"bytes,5,opt,name=SndAddr,proto3" json:"sender"`
-	SndUserName []byte        `protobuf:"bytes,6,opt,name=SndUserName,proto3" json:"sndUserName,omitempty"`
-	GasPrice    uint64        `protobuf:"varint,7,opt,name=GasPrice,proto3" json:"gasPrice,omitempty"`
-	GasLimit    uint64        `protobuf:"varint,8,opt,name=GasLimit,proto3" json:"gasLimit,omitempty"`
-	Data        []byte        `protobuf:"bytes,9,opt,name=Data,proto3" json:"data,omitempty"`
-	Signature   []byte        `protobuf:"bytes,10,opt,name=Signature,proto3" json:"signature,omitempty"`
+	Nonce          uint64        `protobuf:"varint,1,opt,name=Nonce,proto3" json:"nonce"`
+	Value          *math_big.Int `protobuf:"bytes,2,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"value"`
+	RcvAddr        []byte        `protobuf:"bytes,3,opt,name=RcvAddr,proto3" json:"receiver"`
+	RcvUserName    []byte        `protobuf:"bytes,4,opt,name=RcvUserName,proto3" json:"rcvUserName,omitempty"`
+	SndAddr        []byte        `protobuf:"bytes,5,opt,name=SndAddr,proto3" json:"sender"`
+	SndUserName    []byte        `protobuf:"bytes,6,opt,name=SndUserName,proto3" json:"sndUserName,omitempty"`
+	GasPrice       uint64        `protobuf:"varint,7,opt,name=GasPrice,proto3" json:"gasPrice,omitempty"`
+	GasLimit       uint64        `protobuf:"varint,8,opt,name=GasLimit,proto3" json:"gasLimit,omitempty"`
+	Data           []byte        `protobuf:"bytes,9,opt,name=Data,proto3" json:"data,omitempty"`
+	Signature      []byte        `protobuf:"bytes,10,opt,name=Signature,proto3" json:"signature,omitempty"`
+	Version        uint32        `protobuf:"varint,11,opt,name=Version,proto3" json:"version,omitempty"`
+	Options        uint32        `protobuf:"varint,12,opt,name=Options,proto3" json:"options,omitempty"`
+	Signatures     [][]byte      `protobuf:"bytes,13,rep,name=Signatures,proto3" json:"signatures,omitempty"`
+	NotBeforeRound uint64        `protobuf:"varint,14,opt,name=NotBeforeRound,proto3" json:"notBeforeRound,omitempty"`
+	NotBeforeEpoch uint32        `protobuf:"varint,15,opt,name=NotBeforeEpoch,proto3" json:"notBeforeEpoch,omitempty"`
+	NonceLane      uint32        `protobuf:"varint,16,opt,name=NonceLane,proto3" json:"nonceLane,omitempty"`
 }
 
 func (m *Transaction) Reset()      { *m = Transaction{} }
@@ -140,6 +146,48 @@ func (m *Transaction) GetSignature() []byte {
 	return nil
 }
 
+func (m *Transaction) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Transaction) GetOptions() uint32 {
+	if m != nil {
+		return m.Options
+	}
+	return 0
+}
+
+func (m *Transaction) GetSignatures() [][]byte {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *Transaction) GetNotBeforeRound() uint64 {
+	if m != nil {
+		return m.NotBeforeRound
+	}
+	return 0
+}
+
+func (m *Transaction) GetNotBeforeEpoch() uint32 {
+	if m != nil {
+		return m.NotBeforeEpoch
+	}
+	return 0
+}
+
+func (m *Transaction) GetNonceLane() uint32 {
+	if m != nil {
+		return m.NonceLane
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Transaction)(nil), "proto.Transaction")
 }
@@ -147,36 +195,44 @@ func init() {
 func init() { proto.RegisterFile("transaction.proto", fileDescriptor_2cc4e03d2c28c490) }
 
 var fileDescriptor_2cc4e03d2c28c490 = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xbd, 0x10, 0xe7, 0xcf, 0xa6, 0x42, 0x62, 0x11, 0x60, 0x38, 0xec, 0x56, 0x08, 0x55,
-	0x3d, 0xd0, 0x58, 0x02, 0x71, 0xea, 0xa9, 0x81, 0x0a, 0x55, 0x42, 0x11, 0x72, 0x80, 0x03, 0xb7,
-	0x8d, 0xbd, 0xb8, 0x2b, 0xea, 0xdd, 0x6a, 0xbd, 0x31, 0xe2, 0xc6, 0x23, 0xf0, 0x0e, 0x5c, 0x10,
-	0x4f, 0xc2, 0x31, 0xc7, 0x9c, 0x0c, 0x71, 0x2e, 0xc8, 0xa7, 0x3e, 0x02, 0xf2, 0x24, 0xad, 0x97,
-	0x9e, 0xec, 0xf9, 0xcd, 0xf7, 0xed, 0x37, 0x1a, 0x0d, 0xbe, 0x6d, 0x0d, 0x57, 0x39, 0x8f, 0xad,
-	0xd4, 0x6a, 0x74, 0x6e, 0xb4, 0xd5, 0xc4, 0x87, 0xcf, 0xc3, 0x83, 0x54, 0xda, 0xd3, 0xf9, 0x6c,
-	0x14, 0xeb, 0x2c, 0x4c, 0x75, 0xaa, 0x43, 0xc0, 0xb3, 0xf9, 0x47, 0xa8, 0xa0, 0x80, 0xbf, 0x8d,
-	0xeb, 0xd1, 0xf7, 0x0e, 0x1e, 0xbe, 0x6d, 0xdf, 0x22, 0x0c, 0xfb, 0x13, 0xad, 0x62, 0x11, 0xa0,
-	0x5d, 0xb4, 0xdf, 0x19, 0x0f, 0xea, 0x92, 0xf9, 0xaa, 0x01, 0xd1, 0x86, 0x93, 0x04, 0xfb, 0xef,
-	0xf9, 0xd9, 0x5c, 0x04, 0x37, 0x76, 0xd1, 0xfe, 0xce, 0x78, 0xd2, 0x08, 0x8a, 0x06, 0xfc, 0xfc,
-	0xcd, 0x8e, 0x32, 0x6e, 0x4f, 0xc3, 0x99, 0x4c, 0x47, 0x27, 0xca, 0x1e, 0x3a, 0x83, 0x1c, 0x9f,
-	0x19, 0xad, 0x92, 0x89, 0xb0, 0x9f, 0xb5, 0xf9, 0x14, 0x0a, 0xa8, 0x0e, 0x52, 0x1d, 0x26, 0xdc,
-	0xf2, 0xd1, 0x58, 0xa6, 0x27, 0xca, 0xbe, 0xe0, 0xb9, 0x15, 0x26, 0xda, 0x3c, 0x4e, 0xf6, 0x70,
-	0x2f, 0x8a, 0x8b, 0xa3, 0x24, 0x31, 0xc1, 0x4d, 0xc8, 0xd9, 0xa9, 0x4b, 0xd6, 0x37, 0x22, 0x16,
-	0xb2, 0x10, 0x26, 0xba, 0x6c, 0x92, 0x43, 0x3c, 0x8c, 0xe2, 0xe2, 0x5d, 0x2e, 0xcc, 0x84, 0x67,
-	0x22, 0xe8, 0x80, 0xf6, 0x41, 0x5d, 0xb2, 0xbb, 0xa6, 0xc5, 0x4f, 0x74, 0x26, 0xad, 0xc8, 0xce,
-	0xed, 0x97, 0xc8, 0x55, 0x93, 0xc7, 0xb8, 0x37, 0x55, 0x09, 0x84, 0xf8, 0x60, 0xc4, 0x75, 0xc9,
-	0xba, 0xb9, 0x50, 0x49, 0x13, 0xb1, 0x6d, 0x35, 0x11, 0x53, 0x95, 0x5c, 0x45, 0x74, 0xdb, 0x88,
-	0xbc, 0xc5, 0x6e, 0x84, 0xa3, 0x26, 0x4f, 0x71, 0xff, 0x15, 0xcf, 0xdf, 0x18, 0x19, 0x8b, 0xa0,
-	0x07, 0x1b, 0xbd, 0x57, 0x97, 0x8c, 0xa4, 0x5b, 0xe6, 0xd8, 0xae, 0x74, 0x5b, 0xcf, 0x6b, 0x99,
-	0x49, 0x1b, 0xf4, 0xff, 0xf3, 0x00, 0xbb, 0xe6, 0x01, 0x46, 0xf6, 0x70, 0xe7, 0x25, 0xb7, 0x3c,
-	0x18, 0xc0, 0x74, 0xa4, 0x2e, 0xd9, 0xad, 0x66, 0xb7, 0x8e, 0x16, 0xfa, 0xe4, 0x39, 0x1e, 0x4c,
-	0x65, 0xaa, 0xb8, 0x9d, 0x1b, 0x11, 0x60, 0x10, 0xdf, 0xaf, 0x4b, 0x76, 0x27, 0xbf, 0x84, 0x8e,
-	0xa3, 0x55, 0x8e, 0x8f, 0x17, 0x2b, 0xea, 0x2d, 0x57, 0xd4, 0xbb, 0x58, 0x51, 0xf4, 0xb5, 0xa2,
-	0xe8, 0x47, 0x45, 0xd1, 0xaf, 0x8a, 0xa2, 0x45, 0x45, 0xd1, 0xb2, 0xa2, 0xe8, 0x4f, 0x45, 0xd1,
-	0xdf, 0x8a, 0x7a, 0x17, 0x15, 0x45, 0xdf, 0xd6, 0xd4, 0x5b, 0xac, 0xa9, 0xb7, 0x5c, 0x53, 0xef,
-	0xc3, 0xd0, 0x39, 0xd4, 0x59, 0x17, 0x6e, 0xee, 0xd9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7d,
-	0x35, 0x79, 0x01, 0xbe, 0x02, 0x00, 0x00,
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0x33, 0x5f, 0x9b, 0xa6, 0x9d, 0xa4, 0xfd, 0xe8, 0x40, 0x61, 0x40, 0x68, 0x26, 0x42,
+	0xa8, 0xca, 0x82, 0x26, 0x12, 0x08, 0x09, 0xa9, 0xab, 0x9a, 0x56, 0xa8, 0x52, 0x15, 0x90, 0x0b,
+	0x5d, 0xb0, 0x9b, 0xd8, 0x53, 0xd7, 0xa2, 0x9e, 0x89, 0xc6, 0x93, 0x20, 0x76, 0x88, 0x27, 0xe0,
+	0x31, 0x10, 0x4f, 0xc2, 0xb2, 0xcb, 0xae, 0x0c, 0x75, 0x36, 0xc8, 0xab, 0x3e, 0x02, 0xf2, 0xcd,
+	0xbf, 0x49, 0xd5, 0x95, 0x3d, 0xe7, 0x9e, 0xdf, 0x3d, 0x57, 0x57, 0x1e, 0xe3, 0x4d, 0x6b, 0x84,
+	0x4a, 0x45, 0x60, 0x63, 0xad, 0xda, 0x7d, 0xa3, 0xad, 0x26, 0x55, 0x78, 0x3c, 0xda, 0x89, 0x62,
+	0x7b, 0x36, 0xe8, 0xb5, 0x03, 0x9d, 0x74, 0x22, 0x1d, 0xe9, 0x0e, 0xc8, 0xbd, 0xc1, 0x29, 0x9c,
+	0xe0, 0x00, 0x6f, 0x63, 0xea, 0xc9, 0xb7, 0x1a, 0xae, 0xbf, 0x9f, 0xf7, 0x22, 0x1c, 0x57, 0xbb,
+	0x5a, 0x05, 0x92, 0xa2, 0x26, 0x6a, 0x2d, 0x7b, 0x6b, 0x45, 0xc6, 0xab, 0xaa, 0x14, 0xfc, 0xb1,
+	0x4e, 0x42, 0x5c, 0x3d, 0x11, 0xe7, 0x03, 0x49, 0xff, 0x6b, 0xa2, 0x56, 0xc3, 0xeb, 0x96, 0x86,
+	0x61, 0x29, 0xfc, 0xfc, 0xcd, 0xf7, 0x12, 0x61, 0xcf, 0x3a, 0xbd, 0x38, 0x6a, 0x1f, 0x2a, 0xbb,
+	0xeb, 0x0c, 0x72, 0x70, 0x6e, 0xb4, 0x0a, 0xbb, 0xd2, 0x7e, 0xd6, 0xe6, 0x53, 0x47, 0xc2, 0x69,
+	0x27, 0xd2, 0x9d, 0x50, 0x58, 0xd1, 0xf6, 0xe2, 0xe8, 0x50, 0xd9, 0xd7, 0x22, 0xb5, 0xd2, 0xf8,
+	0xe3, 0xe6, 0x64, 0x1b, 0xd7, 0xfc, 0x60, 0xb8, 0x17, 0x86, 0x86, 0x2e, 0x41, 0x4e, 0xa3, 0xc8,
+	0xf8, 0xaa, 0x91, 0x81, 0x8c, 0x87, 0xd2, 0xf8, 0xd3, 0x22, 0xd9, 0xc5, 0x75, 0x3f, 0x18, 0x7e,
+	0x48, 0xa5, 0xe9, 0x8a, 0x44, 0xd2, 0x65, 0xf0, 0x3e, 0x2c, 0x32, 0xbe, 0x65, 0xe6, 0xf2, 0x33,
+	0x9d, 0xc4, 0x56, 0x26, 0x7d, 0xfb, 0xc5, 0x77, 0xdd, 0xe4, 0x29, 0xae, 0x1d, 0xab, 0x10, 0x42,
+	0xaa, 0x00, 0xe2, 0x22, 0xe3, 0x2b, 0xa9, 0x54, 0x61, 0x19, 0x31, 0x29, 0x95, 0x11, 0xc7, 0x2a,
+	0x9c, 0x45, 0xac, 0xcc, 0x23, 0x52, 0x15, 0xde, 0x16, 0xe1, 0xb8, 0xc9, 0x73, 0xbc, 0xfa, 0x46,
+	0xa4, 0xef, 0x4c, 0x1c, 0x48, 0x5a, 0x83, 0x8d, 0xde, 0x2f, 0x32, 0x4e, 0xa2, 0x89, 0xe6, 0x60,
+	0x33, 0xdf, 0x84, 0x39, 0x8a, 0x93, 0xd8, 0xd2, 0xd5, 0x05, 0x06, 0xb4, 0x1b, 0x0c, 0x68, 0x64,
+	0x1b, 0x2f, 0xef, 0x0b, 0x2b, 0xe8, 0x1a, 0x4c, 0x47, 0x8a, 0x8c, 0x6f, 0x94, 0xbb, 0x75, 0xbc,
+	0x50, 0x27, 0x2f, 0xf1, 0xda, 0x71, 0x1c, 0x29, 0x61, 0x07, 0x46, 0x52, 0x0c, 0xe6, 0x07, 0x45,
+	0xc6, 0xef, 0xa6, 0x53, 0xd1, 0x21, 0xe6, 0x4e, 0xd2, 0xc1, 0xb5, 0x13, 0x69, 0xd2, 0x58, 0x2b,
+	0x5a, 0x6f, 0xa2, 0xd6, 0xba, 0xb7, 0x55, 0x64, 0x7c, 0x73, 0x38, 0x96, 0x1c, 0x64, 0xea, 0x2a,
+	0x81, 0xb7, 0xfd, 0xf2, 0x83, 0x4a, 0x69, 0x63, 0x0e, 0xe8, 0xb1, 0xe4, 0x02, 0x13, 0x17, 0x79,
+	0x85, 0xf1, 0x2c, 0x2e, 0xa5, 0xeb, 0xcd, 0xa5, 0x56, 0xc3, 0xa3, 0x45, 0xc6, 0xef, 0xcd, 0x26,
+	0x73, 0x31, 0xc7, 0x4b, 0xf6, 0xf1, 0x46, 0x57, 0x5b, 0x4f, 0x9e, 0x6a, 0x23, 0x7d, 0x3d, 0x50,
+	0x21, 0xdd, 0x80, 0xa5, 0x3d, 0x2e, 0x32, 0x4e, 0xd5, 0x42, 0xc5, 0xe9, 0x70, 0x83, 0x59, 0xe8,
+	0x72, 0xd0, 0xd7, 0xc1, 0x19, 0xfd, 0x1f, 0xe6, 0x5e, 0xec, 0x02, 0x95, 0x5b, 0xbb, 0x40, 0xa5,
+	0x5c, 0x2f, 0xdc, 0x92, 0x23, 0xa1, 0x24, 0xbd, 0x03, 0x0d, 0x60, 0xbd, 0x6a, 0x2a, 0xba, 0xeb,
+	0x9d, 0x39, 0xbd, 0x83, 0x8b, 0x2b, 0x56, 0xb9, 0xbc, 0x62, 0x95, 0xeb, 0x2b, 0x86, 0xbe, 0xe6,
+	0x0c, 0xfd, 0xc8, 0x19, 0xfa, 0x95, 0x33, 0x74, 0x91, 0x33, 0x74, 0x99, 0x33, 0xf4, 0x27, 0x67,
+	0xe8, 0x6f, 0xce, 0x2a, 0xd7, 0x39, 0x43, 0xdf, 0x47, 0xac, 0x72, 0x31, 0x62, 0x95, 0xcb, 0x11,
+	0xab, 0x7c, 0xac, 0x3b, 0xff, 0x81, 0xde, 0x0a, 0x5c, 0xe9, 0x17, 0xff, 0x06, 0x00, 0xfc, 0x01,
+	0x82, 0xd1, 0x1d, 0x04, 0x00, 0x00,
 }
 
 func (this *Transaction) Equal(that interface{}) bool {
@@ -231,13 +287,36 @@ func (this *Transaction) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Signature, that1.Signature) {
 		return false
 	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Options != that1.Options {
+		return false
+	}
+	if len(this.Signatures) != len(that1.Signatures) {
+		return false
+	}
+	for i := range this.Signatures {
+		if !bytes.Equal(this.Signatures[i], that1.Signatures[i]) {
+			return false
+		}
+	}
+	if this.NotBeforeRound != that1.NotBeforeRound {
+		return false
+	}
+	if this.NotBeforeEpoch != that1.NotBeforeEpoch {
+		return false
+	}
+	if this.NonceLane != that1.NonceLane {
+		return false
+	}
 	return true
 }
 func (this *Transaction) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 14)
+	s := make([]string, 0, 20)
 	s = append(s, "&transaction.Transaction{")
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
@@ -249,6 +328,12 @@ func (this *Transaction) GoString() string {
 	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
 	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
 	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	s = append(s, "Signatures: "+fmt.Sprintf("%#v", this.Signatures)+",\n")
+	s = append(s, "NotBeforeRound: "+fmt.Sprintf("%#v", this.NotBeforeRound)+",\n")
+	s = append(s, "NotBeforeEpoch: "+fmt.Sprintf("%#v", this.NotBeforeEpoch)+",\n")
+	s = append(s, "NonceLane: "+fmt.Sprintf("%#v", this.NonceLane)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -280,6 +365,42 @@ func (m *Transaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NonceLane != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.NonceLane))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.NotBeforeEpoch != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.NotBeforeEpoch))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.NotBeforeRound != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.NotBeforeRound))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signatures[iNdEx])
+			copy(dAtA[i:], m.Signatures[iNdEx])
+			i = encodeVarintTransaction(dAtA, i, uint64(len(m.Signatures[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.Options != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Options))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Version != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -406,6 +527,27 @@ func (m *Transaction) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTransaction(uint64(l))
 	}
+	if m.Version != 0 {
+		n += 1 + sovTransaction(uint64(m.Version))
+	}
+	if m.Options != 0 {
+		n += 1 + sovTransaction(uint64(m.Options))
+	}
+	if len(m.Signatures) > 0 {
+		for _, b := range m.Signatures {
+			l = len(b)
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if m.NotBeforeRound != 0 {
+		n += 1 + sovTransaction(uint64(m.NotBeforeRound))
+	}
+	if m.NotBeforeEpoch != 0 {
+		n += 1 + sovTransaction(uint64(m.NotBeforeEpoch))
+	}
+	if m.NonceLane != 0 {
+		n += 2 + sovTransaction(uint64(m.NonceLane))
+	}
 	return n
 }
 
@@ -430,6 +572,12 @@ func (this *Transaction) String() string {
 		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
 		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
 		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Options:` + fmt.Sprintf("%v", this.Options) + `,`,
+		`Signatures:` + fmt.Sprintf("%v", this.Signatures) + `,`,
+		`NotBeforeRound:` + fmt.Sprintf("%v", this.NotBeforeRound) + `,`,
+		`NotBeforeEpoch:` + fmt.Sprintf("%v", this.NotBeforeEpoch) + `,`,
+		`NonceLane:` + fmt.Sprintf("%v", this.NonceLane) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -770,6 +918,133 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			m.Options = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Options |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, make([]byte, postIndex-iNdEx))
+			copy(m.Signatures[len(m.Signatures)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBeforeRound", wireType)
+			}
+			m.NotBeforeRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotBeforeRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBeforeEpoch", wireType)
+			}
+			m.NotBeforeEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotBeforeEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceLane", wireType)
+			}
+			m.NonceLane = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonceLane |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/mock"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -207,3 +208,102 @@ func TestTransaction_GetDataForSigningShouldWork(t *testing.T) {
 	assert.True(t, marshalizerWasCalled)
 	assert.Equal(t, 2, numEncodeCalled)
 }
+
+func TestTransaction_GetDataForSigningShouldOmitTheVersionAndOptionsIfNotSet(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		Nonce:    1,
+		Value:    big.NewInt(10),
+		GasPrice: 2,
+		GasLimit: 3,
+	}
+
+	buff, err := tx.GetDataForSigning(&mock.PubkeyConverterStub{}, &marshal.TxJsonMarshalizer{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"nonce":1,"value":"10","receiver":"","sender":"","gasPrice":2,"gasLimit":3}`, string(buff))
+
+	tx.Version = transaction.VersionWithOptions
+	tx.Options = transaction.MaskSignedWithHash
+	buff, err = tx.GetDataForSigning(&mock.PubkeyConverterStub{}, &marshal.TxJsonMarshalizer{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"nonce":1,"value":"10","receiver":"","sender":"","gasPrice":2,"gasLimit":3,"version":2,"options":1}`, string(buff))
+
+	tx.NotBeforeRound = 4
+	tx.NotBeforeEpoch = 5
+	buff, err = tx.GetDataForSigning(&mock.PubkeyConverterStub{}, &marshal.TxJsonMarshalizer{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"nonce":1,"value":"10","receiver":"","sender":"","gasPrice":2,"gasLimit":3,"version":2,"options":1,"notBeforeRound":4,"notBeforeEpoch":5}`, string(buff))
+
+	tx.NonceLane = 6
+	buff, err = tx.GetDataForSigning(&mock.PubkeyConverterStub{}, &marshal.TxJsonMarshalizer{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"nonce":1,"value":"10","receiver":"","sender":"","gasPrice":2,"gasLimit":3,"version":2,"options":1,"notBeforeRound":4,"notBeforeEpoch":5,"nonceLane":6}`, string(buff))
+}
+
+func TestTransaction_HasOptionSignedWithHash(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{Options: transaction.MaskSignedWithHash}
+	assert.False(t, tx.HasOptionSignedWithHash())
+
+	tx.Version = transaction.InitialVersion
+	assert.False(t, tx.HasOptionSignedWithHash())
+
+	tx.Version = transaction.VersionWithOptions
+	assert.True(t, tx.HasOptionSignedWithHash())
+
+	tx.Options = 0
+	assert.False(t, tx.HasOptionSignedWithHash())
+}
+
+func TestTransaction_IsTimeLocked(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	assert.False(t, tx.IsTimeLocked())
+
+	tx.NotBeforeRound = 1
+	assert.True(t, tx.IsTimeLocked())
+
+	tx = &transaction.Transaction{NotBeforeEpoch: 1}
+	assert.True(t, tx.IsTimeLocked())
+}
+
+func TestTransaction_CanBeExecutedAt(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	assert.True(t, tx.CanBeExecutedAt(0, 0))
+
+	tx.NotBeforeRound = 10
+	tx.NotBeforeEpoch = 2
+	assert.False(t, tx.CanBeExecutedAt(9, 2))
+	assert.False(t, tx.CanBeExecutedAt(10, 1))
+	assert.True(t, tx.CanBeExecutedAt(10, 2))
+	assert.True(t, tx.CanBeExecutedAt(11, 3))
+}
+
+func TestTransaction_UsesNonceLane(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	assert.False(t, tx.UsesNonceLane())
+
+	tx.NonceLane = 1
+	assert.True(t, tx.UsesNonceLane())
+}
+
+func TestTransaction_JsonMarshalShouldOmitTheVersionIfNotSet(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{Nonce: 1, Value: big.NewInt(10)}
+	buff, err := json.Marshal(tx)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(buff), "version")
+
+	tx.Version = transaction.VersionWithOptions
+	buff, err = json.Marshal(tx)
+	assert.Nil(t, err)
+	assert.Contains(t, string(buff), `"version":2`)
+}
@@ -1,6 +1,7 @@
 package trie
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -81,6 +82,14 @@ func (bn *branchNode) setHasher(hasher hashing.Hasher) {
 	bn.hasher = hasher
 }
 
+func (bn *branchNode) getVersion() byte {
+	return bn.version
+}
+
+func (bn *branchNode) setVersion(version byte) {
+	bn.version = version
+}
+
 func (bn *branchNode) getCollapsed() (node, error) {
 	err := bn.isEmptyOrNil()
 	if err != nil {
@@ -298,8 +307,7 @@ func (bn *branchNode) getEncodedNode() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	marshaledNode = append(marshaledNode, branch)
-	return marshaledNode, nil
+	return encodeNodeWithVersion(marshaledNode, branch, bn.version), nil
 }
 
 func (bn *branchNode) resolveCollapsed(pos byte, db data.DBWriteCacher) error {
@@ -606,6 +614,7 @@ func (bn *branchNode) deepClone() node {
 	}
 
 	clonedNode.dirty = bn.dirty
+	clonedNode.version = bn.version
 	clonedNode.marsh = bn.marsh
 	clonedNode.hasher = bn.hasher
 
@@ -676,6 +685,19 @@ func (bn *branchNode) setDirty(dirty bool) {
 	bn.dirty = dirty
 }
 
+func (bn *branchNode) setVersionOfUnhashedNodes(version byte) {
+	if bn.hash != nil {
+		return
+	}
+
+	bn.version = version
+	for i := range bn.children {
+		if bn.children[i] != nil {
+			bn.children[i].setVersionOfUnhashedNodes(version)
+		}
+	}
+}
+
 func (bn *branchNode) loadChildren(getNode func([]byte) (node, error)) ([][]byte, []node, error) {
 	err := bn.isEmptyOrNil()
 	if err != nil {
@@ -704,24 +726,34 @@ func (bn *branchNode) loadChildren(getNode func([]byte) (node, error)) ([][]byte
 	return missingChildren, existingChildren, nil
 }
 
-func (bn *branchNode) getAllLeaves(leaves map[string][]byte, key []byte, db data.DBWriteCacher, marshalizer marshal.Marshalizer) error {
+func (bn *branchNode) iterateLeaves(
+	ctx context.Context,
+	key []byte,
+	db data.DBWriteCacher,
+	handler func(key []byte, value []byte) error,
+) error {
 	err := bn.isEmptyOrNil()
 	if err != nil {
-		return fmt.Errorf("getAllLeaves error %w", err)
+		return fmt.Errorf("iterateLeaves error %w", err)
 	}
 
 	for i := range bn.children {
-		err = resolveIfCollapsed(bn, byte(i), db)
-		if err != nil {
-			return err
+		select {
+		case <-ctx.Done():
+			return ErrContextClosing
+		default:
 		}
 
-		if bn.children[i] == nil {
+		if bn.children[i] == nil && len(bn.EncodedChildren[i]) == 0 {
 			continue
 		}
 
-		childKey := append(key, byte(i))
-		err = bn.children[i].getAllLeaves(leaves, childKey, db, marshalizer)
+		child, err := getChildForIteration(bn.children[i], bn.EncodedChildren[i], db, bn.marsh, bn.hasher)
+		if err != nil {
+			return err
+		}
+
+		err = child.iterateLeaves(ctx, concat(key, byte(i)), db, handler)
 		if err != nil {
 			return err
 		}
@@ -2,6 +2,7 @@ package trie
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -18,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func getTestMarshAndHasher() (marshal.Marshalizer, hashing.Hasher) {
@@ -1337,3 +1339,29 @@ func TestBranchNode_getDirtyHashesFromCleanNode(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 0, len(dirtyHashes))
 }
+
+func TestBranchNode_iterateLeavesShouldNotResolveCollapsedChildren(t *testing.T) {
+	t.Parallel()
+
+	tr, _, _ := newEmptyTrie()
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Update([]byte("ddog"), []byte("cat"))
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	collapsedTrie, _ := tr.Recreate(rootHash)
+
+	leaves := make(map[string][]byte)
+	err := collapsedTrie.IterateAllLeaves(context.Background(), func(key []byte, value []byte) error {
+		leaves[string(key)] = value
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(leaves))
+
+	bn, ok := collapsedTrie.(*patriciaMerkleTrie).root.(*branchNode)
+	require.True(t, ok)
+	for i := range bn.children {
+		assert.Nil(t, bn.children[i])
+	}
+}
@@ -0,0 +1,489 @@
+package trie
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const maxNodesToRequestPerCheck = 1000
+
+const noParentIndex = -1
+
+// ConsistencyCheckStatistics holds the results of the consistency checks done on a trie
+type ConsistencyCheckStatistics struct {
+	Name              string
+	RootHash          []byte
+	NumChecks         uint64
+	NumCheckedNodes   uint64
+	NumMissingNodes   uint64
+	NumCorruptedNodes uint64
+	NumPendingNodes   uint64
+	NumHealedNodes    uint64
+	LastCheckDuration time.Duration
+}
+
+// IsConsistent returns true if the last check did not find any missing or corrupted nodes
+func (ccs ConsistencyCheckStatistics) IsConsistent() bool {
+	return ccs.NumMissingNodes == 0 && ccs.NumCorruptedNodes == 0
+}
+
+// String returns the human readable representation of the statistics
+func (ccs ConsistencyCheckStatistics) String() string {
+	return fmt.Sprintf("root hash: %s, checks: %d, checked nodes: %d, missing nodes: %d, corrupted nodes: %d, "+
+		"pending nodes: %d, healed nodes: %d, last check duration: %v",
+		hex.EncodeToString(ccs.RootHash),
+		ccs.NumChecks,
+		ccs.NumCheckedNodes,
+		ccs.NumMissingNodes,
+		ccs.NumCorruptedNodes,
+		ccs.NumPendingNodes,
+		ccs.NumHealedNodes,
+		ccs.LastCheckDuration,
+	)
+}
+
+// ArgTrieConsistencyChecker is the argument DTO used to create a trie consistency checker
+type ArgTrieConsistencyChecker struct {
+	Name                     string
+	TrieStorage              data.StorageManager
+	Marshalizer              marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	RootHashProvider         RootHashProvider
+	LeafRootHashExtractor    LeafRootHashExtractor
+	RequestHandler           RequestHandler
+	InterceptedNodes         storage.Cacher
+	ShardId                  uint32
+	Topic                    string
+	RequestMissingNodes      bool
+	MaxCheckedNodesPerSecond int
+}
+
+type trieConsistencyChecker struct {
+	name                     string
+	trieStorage              data.StorageManager
+	marshalizer              marshal.Marshalizer
+	hasher                   hashing.Hasher
+	rootHashProvider         RootHashProvider
+	leafRootHashExtractor    LeafRootHashExtractor
+	requestHandler           RequestHandler
+	interceptedNodes         storage.Cacher
+	shardId                  uint32
+	topic                    string
+	requestMissingNodes      bool
+	maxCheckedNodesPerSecond int
+
+	mutCheck       sync.Mutex
+	closed         uint32
+	mutStatistics  sync.RWMutex
+	statistics     ConsistencyCheckStatistics
+	mutPending     sync.Mutex
+	pendingNodes   map[string]struct{}
+	numHealedNodes uint64
+}
+
+type nodeToCheck struct {
+	hash         []byte
+	isMainTrie   bool
+	parentIndex  int
+	isExpanded   bool
+	isIncomplete bool
+}
+
+type checkResult struct {
+	numCheckedNodes   uint64
+	missingNodes      [][]byte
+	numMissingNodes   uint64
+	numCorruptedNodes uint64
+}
+
+// NewTrieConsistencyChecker creates a checker able to verify that all the nodes of a committed trie are
+// present in the storage and hash correctly. Missing or corrupted nodes can be requested from the network
+func NewTrieConsistencyChecker(args ArgTrieConsistencyChecker) (*trieConsistencyChecker, error) {
+	err := checkConsistencyCheckerArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	tcc := &trieConsistencyChecker{
+		name:                     args.Name,
+		trieStorage:              args.TrieStorage,
+		marshalizer:              args.Marshalizer,
+		hasher:                   args.Hasher,
+		rootHashProvider:         args.RootHashProvider,
+		leafRootHashExtractor:    args.LeafRootHashExtractor,
+		requestHandler:           args.RequestHandler,
+		interceptedNodes:         args.InterceptedNodes,
+		shardId:                  args.ShardId,
+		topic:                    args.Topic,
+		requestMissingNodes:      args.RequestMissingNodes,
+		maxCheckedNodesPerSecond: args.MaxCheckedNodesPerSecond,
+		statistics:               ConsistencyCheckStatistics{Name: args.Name},
+		pendingNodes:             make(map[string]struct{}),
+	}
+
+	if tcc.requestMissingNodes {
+		tcc.interceptedNodes.RegisterHandler(tcc.trieNodeReceived, core.UniqueIdentifier())
+	}
+
+	return tcc, nil
+}
+
+func checkConsistencyCheckerArgs(args ArgTrieConsistencyChecker) error {
+	if len(args.Name) == 0 {
+		return ErrInvalidCheckerName
+	}
+	if check.IfNil(args.TrieStorage) {
+		return ErrNilTrieStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+	if check.IfNil(args.RootHashProvider) {
+		return ErrNilRootHashProvider
+	}
+	if args.MaxCheckedNodesPerSecond < 1 {
+		return fmt.Errorf("%w, provided %d", ErrInvalidMaxCheckedNodesPerSecond, args.MaxCheckedNodesPerSecond)
+	}
+	if !args.RequestMissingNodes {
+		return nil
+	}
+	if check.IfNil(args.RequestHandler) {
+		return ErrNilRequestHandler
+	}
+	if check.IfNil(args.InterceptedNodes) {
+		return data.ErrNilCacher
+	}
+	if len(args.Topic) == 0 {
+		return ErrInvalidTrieTopic
+	}
+
+	return nil
+}
+
+// Name returns the name of the checked trie
+func (tcc *trieConsistencyChecker) Name() string {
+	return tcc.name
+}
+
+// Check walks the trie from the current root hash and verifies that every referenced node exists in the storage
+// and hashes correctly. The snapshot mode, which keeps the walked nodes from being pruned, is released between the
+// batches of checked nodes. If the root hash changes meanwhile, the walk restarts from the new root, skipping the
+// subtrees already verified
+func (tcc *trieConsistencyChecker) Check() (ConsistencyCheckStatistics, error) {
+	tcc.mutCheck.Lock()
+	defer tcc.mutCheck.Unlock()
+
+	tcc.trieStorage.EnterSnapshotMode()
+	defer tcc.trieStorage.ExitSnapshotMode()
+
+	startTime := time.Now()
+	result := &checkResult{
+		missingNodes: make([][]byte, 0),
+	}
+
+	rootHash, err := tcc.checkCurrentRoot(result)
+	if err != nil {
+		return tcc.Statistics(), err
+	}
+
+	if tcc.requestMissingNodes && len(result.missingNodes) > 0 {
+		tcc.requestNodes(result.missingNodes)
+	}
+
+	tcc.updateStatistics(rootHash, result, time.Since(startTime))
+	statistics := tcc.Statistics()
+	if !statistics.IsConsistent() {
+		log.Warn("trie consistency check found inconsistencies",
+			"trie", tcc.name,
+			"root hash", rootHash,
+			"missing nodes", statistics.NumMissingNodes,
+			"corrupted nodes", statistics.NumCorruptedNodes,
+		)
+		return statistics, nil
+	}
+
+	log.Debug("trie consistency check finished",
+		"trie", tcc.name,
+		"root hash", rootHash,
+		"checked nodes", statistics.NumCheckedNodes,
+		"duration", statistics.LastCheckDuration,
+	)
+
+	return statistics, nil
+}
+
+// checkCurrentRoot walks the trie depth first. A node is marked as visited only after its whole subtree, including
+// the referenced data tries, has been verified, so the visited subtrees can be skipped after a restart or when
+// several leaves reference the same data trie
+func (tcc *trieConsistencyChecker) checkCurrentRoot(result *checkResult) ([]byte, error) {
+	db := tcc.trieStorage.Database()
+	visited := make(map[string]struct{})
+	rootHash := tcc.rootHashProvider.RootHash()
+	stack := createCheckStack(rootHash)
+	numCheckedInBatch := 0
+	batchStartTime := time.Now()
+
+	for len(stack) > 0 {
+		if atomic.LoadUint32(&tcc.closed) == 1 {
+			return nil, ErrConsistencyCheckInterrupted
+		}
+
+		if numCheckedInBatch == tcc.maxCheckedNodesPerSecond {
+			tcc.releaseSnapshotModeUntilNextBatch(batchStartTime)
+			numCheckedInBatch = 0
+			batchStartTime = time.Now()
+
+			currentRootHash := tcc.rootHashProvider.RootHash()
+			if !bytes.Equal(currentRootHash, rootHash) {
+				log.Debug("trie consistency checker: root hash changed, restarting the walk",
+					"trie", tcc.name,
+					"old root hash", rootHash,
+					"new root hash", currentRootHash,
+				)
+				rootHash = currentRootHash
+				stack = createCheckStack(rootHash)
+				result.resetInconsistencies()
+				continue
+			}
+		}
+
+		lastIndex := len(stack) - 1
+		current := stack[lastIndex]
+		if current.isExpanded {
+			stack = stack[:lastIndex]
+			if current.isIncomplete {
+				markParentIncomplete(stack, current.parentIndex)
+				continue
+			}
+			visited[string(current.hash)] = struct{}{}
+			continue
+		}
+
+		_, isVisited := visited[string(current.hash)]
+		if isVisited {
+			stack = stack[:lastIndex]
+			continue
+		}
+
+		result.numCheckedNodes++
+		numCheckedInBatch++
+		n, ok := tcc.getVerifiedNode(db, current.hash, result)
+		if !ok {
+			stack = stack[:lastIndex]
+			markParentIncomplete(stack, current.parentIndex)
+			continue
+		}
+
+		stack[lastIndex].isExpanded = true
+		for _, childHash := range getChildrenHashes(n) {
+			stack = append(stack, nodeToCheck{hash: childHash, isMainTrie: current.isMainTrie, parentIndex: lastIndex})
+		}
+
+		dataTrieRootHash, isDataTrie := tcc.getReferencedRootHash(n, current.isMainTrie)
+		if isDataTrie {
+			stack = append(stack, nodeToCheck{hash: dataTrieRootHash, isMainTrie: false, parentIndex: lastIndex})
+		}
+	}
+
+	return rootHash, nil
+}
+
+func (tcc *trieConsistencyChecker) releaseSnapshotModeUntilNextBatch(batchStartTime time.Time) {
+	tcc.trieStorage.ExitSnapshotMode()
+	time.Sleep(time.Second - time.Since(batchStartTime))
+	tcc.trieStorage.EnterSnapshotMode()
+}
+
+func createCheckStack(rootHash []byte) []nodeToCheck {
+	if len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
+		return nil
+	}
+
+	return []nodeToCheck{{hash: rootHash, isMainTrie: true, parentIndex: noParentIndex}}
+}
+
+func markParentIncomplete(stack []nodeToCheck, parentIndex int) {
+	if parentIndex == noParentIndex {
+		return
+	}
+
+	stack[parentIndex].isIncomplete = true
+}
+
+func (tcc *trieConsistencyChecker) getVerifiedNode(db data.DBWriteCacher, hash []byte, result *checkResult) (node, bool) {
+	encNode, err := db.Get(hash)
+	if err != nil {
+		log.Debug("trie consistency checker: missing node", "trie", tcc.name, "hash", hash, "error", err.Error())
+		result.numMissingNodes++
+		result.addNodeToRequest(hash)
+		return nil, false
+	}
+
+	computedHash := tcc.hasher.Compute(string(encNode))
+	if !bytes.Equal(computedHash, hash) {
+		log.Debug("trie consistency checker: hash mismatch", "trie", tcc.name, "hash", hash, "computed hash", computedHash)
+		result.numCorruptedNodes++
+		result.addNodeToRequest(hash)
+		return nil, false
+	}
+
+	n, err := decodeNode(encNode, tcc.marshalizer, tcc.hasher)
+	if err != nil {
+		log.Debug("trie consistency checker: undecodable node", "trie", tcc.name, "hash", hash, "error", err.Error())
+		result.numCorruptedNodes++
+		result.addNodeToRequest(hash)
+		return nil, false
+	}
+
+	return n, true
+}
+
+func (tcc *trieConsistencyChecker) getReferencedRootHash(n node, isMainTrie bool) ([]byte, bool) {
+	if !isMainTrie || check.IfNil(tcc.leafRootHashExtractor) {
+		return nil, false
+	}
+
+	ln, ok := n.(*leafNode)
+	if !ok {
+		return nil, false
+	}
+
+	rootHash, ok := tcc.leafRootHashExtractor.ExtractRootHash(ln.Value)
+	if !ok || len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
+		return nil, false
+	}
+
+	return rootHash, true
+}
+
+func (cr *checkResult) resetInconsistencies() {
+	cr.missingNodes = make([][]byte, 0)
+	cr.numMissingNodes = 0
+	cr.numCorruptedNodes = 0
+}
+
+func (cr *checkResult) addNodeToRequest(hash []byte) {
+	if len(cr.missingNodes) >= maxNodesToRequestPerCheck {
+		return
+	}
+
+	cr.missingNodes = append(cr.missingNodes, hash)
+}
+
+func getChildrenHashes(n node) [][]byte {
+	switch trieNode := n.(type) {
+	case *branchNode:
+		childrenHashes := make([][]byte, 0, len(trieNode.EncodedChildren))
+		for _, childHash := range trieNode.EncodedChildren {
+			if len(childHash) == 0 {
+				continue
+			}
+			childrenHashes = append(childrenHashes, childHash)
+		}
+		return childrenHashes
+	case *extensionNode:
+		if len(trieNode.EncodedChild) == 0 {
+			return nil
+		}
+		return [][]byte{trieNode.EncodedChild}
+	default:
+		return nil
+	}
+}
+
+func (tcc *trieConsistencyChecker) requestNodes(hashes [][]byte) {
+	tcc.mutPending.Lock()
+	for _, hash := range hashes {
+		tcc.pendingNodes[string(hash)] = struct{}{}
+	}
+	tcc.mutPending.Unlock()
+
+	for _, hash := range hashes {
+		val, ok := tcc.interceptedNodes.Get(hash)
+		if ok {
+			tcc.trieNodeReceived(hash, val)
+		}
+	}
+
+	log.Debug("trie consistency checker: requesting nodes", "trie", tcc.name, "num nodes", len(hashes))
+	tcc.requestHandler.RequestTrieNodes(tcc.shardId, hashes, tcc.topic)
+}
+
+func (tcc *trieConsistencyChecker) trieNodeReceived(hash []byte, val interface{}) {
+	tcc.mutPending.Lock()
+	defer tcc.mutPending.Unlock()
+
+	_, isPending := tcc.pendingNodes[string(hash)]
+	if !isPending {
+		return
+	}
+
+	interceptedNode, ok := val.(*InterceptedTrieNode)
+	if !ok || !bytes.Equal(interceptedNode.Hash(), hash) {
+		return
+	}
+
+	err := tcc.trieStorage.Database().Put(hash, interceptedNode.EncodedNode())
+	if err != nil {
+		log.Debug("trie consistency checker: cannot save the received node", "trie", tcc.name, "hash", hash, "error", err.Error())
+		return
+	}
+
+	delete(tcc.pendingNodes, string(hash))
+	atomic.AddUint64(&tcc.numHealedNodes, 1)
+	log.Debug("trie consistency checker: healed node", "trie", tcc.name, "hash", hash)
+}
+
+func (tcc *trieConsistencyChecker) updateStatistics(rootHash []byte, result *checkResult, duration time.Duration) {
+	tcc.mutStatistics.Lock()
+	defer tcc.mutStatistics.Unlock()
+
+	tcc.statistics.RootHash = rootHash
+	tcc.statistics.NumChecks++
+	tcc.statistics.NumCheckedNodes = result.numCheckedNodes
+	tcc.statistics.NumMissingNodes = result.numMissingNodes
+	tcc.statistics.NumCorruptedNodes = result.numCorruptedNodes
+	tcc.statistics.LastCheckDuration = duration
+}
+
+// Statistics returns the results of the last consistency check
+func (tcc *trieConsistencyChecker) Statistics() ConsistencyCheckStatistics {
+	tcc.mutPending.Lock()
+	numPendingNodes := uint64(len(tcc.pendingNodes))
+	tcc.mutPending.Unlock()
+
+	tcc.mutStatistics.RLock()
+	defer tcc.mutStatistics.RUnlock()
+
+	statistics := tcc.statistics
+	statistics.NumPendingNodes = numPendingNodes
+	statistics.NumHealedNodes = atomic.LoadUint64(&tcc.numHealedNodes)
+
+	return statistics
+}
+
+// Close interrupts the check in progress, if any
+func (tcc *trieConsistencyChecker) Close() error {
+	atomic.StoreUint32(&tcc.closed, 1)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tcc *trieConsistencyChecker) IsInterfaceNil() bool {
+	return tcc == nil
+}
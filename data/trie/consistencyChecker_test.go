@@ -0,0 +1,317 @@
+package trie_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createConsistencyCheckerArgs() trie.ArgTrieConsistencyChecker {
+	trieStorage, marshalizer, hasher, _ := getDefaultTrieParameters()
+	interceptedNodes, _ := lrucache.NewCache(100)
+
+	return trie.ArgTrieConsistencyChecker{
+		Name:                     "accounts",
+		TrieStorage:              trieStorage,
+		Marshalizer:              marshalizer,
+		Hasher:                   hasher,
+		RootHashProvider:         &mock.RootHashProviderStub{},
+		RequestHandler:           &mock.RequestHandlerStub{},
+		InterceptedNodes:         interceptedNodes,
+		ShardId:                  0,
+		Topic:                    "accountTrieNodes",
+		RequestMissingNodes:      true,
+		MaxCheckedNodesPerSecond: 1000,
+	}
+}
+
+func createCommittedTrie(t *testing.T, args *trie.ArgTrieConsistencyChecker) data.Trie {
+	tr, err := trie.NewTrie(args.TrieStorage, args.Marshalizer, args.Hasher, 5)
+	require.Nil(t, err)
+
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Update([]byte("ddog"), []byte("cat"))
+	require.Nil(t, tr.Commit())
+
+	args.RootHashProvider = &mock.RootHashProviderStub{
+		RootHashCalled: func() []byte {
+			rootHash, _ := tr.Root()
+			return rootHash
+		},
+	}
+
+	return tr
+}
+
+func getSerializedNodes(t *testing.T, tr data.Trie) [][]byte {
+	rootHash, _ := tr.Root()
+	encodedNodes, _, err := tr.GetSerializedNodes(rootHash, 1<<20)
+	require.Nil(t, err)
+
+	return encodedNodes
+}
+
+func TestNewTrieConsistencyChecker_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createConsistencyCheckerArgs()
+	args.Name = ""
+	tcc, err := trie.NewTrieConsistencyChecker(args)
+	assert.Nil(t, tcc)
+	assert.Equal(t, trie.ErrInvalidCheckerName, err)
+
+	args = createConsistencyCheckerArgs()
+	args.TrieStorage = nil
+	tcc, err = trie.NewTrieConsistencyChecker(args)
+	assert.Nil(t, tcc)
+	assert.Equal(t, trie.ErrNilTrieStorage, err)
+
+	args = createConsistencyCheckerArgs()
+	args.RootHashProvider = nil
+	tcc, err = trie.NewTrieConsistencyChecker(args)
+	assert.Nil(t, tcc)
+	assert.Equal(t, trie.ErrNilRootHashProvider, err)
+
+	args = createConsistencyCheckerArgs()
+	args.MaxCheckedNodesPerSecond = 0
+	tcc, err = trie.NewTrieConsistencyChecker(args)
+	assert.Nil(t, tcc)
+	assert.True(t, errors.Is(err, trie.ErrInvalidMaxCheckedNodesPerSecond))
+
+	args = createConsistencyCheckerArgs()
+	args.RequestHandler = nil
+	tcc, err = trie.NewTrieConsistencyChecker(args)
+	assert.Nil(t, tcc)
+	assert.Equal(t, trie.ErrNilRequestHandler, err)
+
+	args.RequestMissingNodes = false
+	tcc, err = trie.NewTrieConsistencyChecker(args)
+	assert.NotNil(t, tcc)
+	assert.Nil(t, err)
+}
+
+func TestTrieConsistencyChecker_CheckConsistentTrie(t *testing.T) {
+	t.Parallel()
+
+	args := createConsistencyCheckerArgs()
+	tr := createCommittedTrie(t, &args)
+	numNodes := uint64(len(getSerializedNodes(t, tr)))
+
+	tcc, _ := trie.NewTrieConsistencyChecker(args)
+	statistics, err := tcc.Check()
+	assert.Nil(t, err)
+	assert.True(t, statistics.IsConsistent())
+	assert.Equal(t, numNodes, statistics.NumCheckedNodes)
+	assert.Equal(t, uint64(1), statistics.NumChecks)
+	assert.Equal(t, "accounts", statistics.Name)
+}
+
+func TestTrieConsistencyChecker_CheckEmptyTrieShouldNotCheckAnyNode(t *testing.T) {
+	t.Parallel()
+
+	args := createConsistencyCheckerArgs()
+
+	tcc, _ := trie.NewTrieConsistencyChecker(args)
+	statistics, err := tcc.Check()
+	assert.Nil(t, err)
+	assert.True(t, statistics.IsConsistent())
+	assert.Equal(t, uint64(0), statistics.NumCheckedNodes)
+}
+
+func TestTrieConsistencyChecker_CheckShouldFindMissingAndCorruptedNodes(t *testing.T) {
+	t.Parallel()
+
+	args := createConsistencyCheckerArgs()
+	args.RequestMissingNodes = false
+	tr := createCommittedTrie(t, &args)
+	encodedNodes := getSerializedNodes(t, tr)
+	require.True(t, len(encodedNodes) > 2)
+
+	missingHash := args.Hasher.Compute(string(encodedNodes[1]))
+	corruptedHash := args.Hasher.Compute(string(encodedNodes[2]))
+	db := args.TrieStorage.Database()
+	_ = db.Remove(missingHash)
+	_ = db.Put(corruptedHash, []byte("corrupted node"))
+
+	tcc, _ := trie.NewTrieConsistencyChecker(args)
+	statistics, err := tcc.Check()
+	assert.Nil(t, err)
+	assert.False(t, statistics.IsConsistent())
+	assert.Equal(t, uint64(1), statistics.NumMissingNodes)
+	assert.Equal(t, uint64(1), statistics.NumCorruptedNodes)
+}
+
+func TestTrieConsistencyChecker_CheckShouldWalkTheReferencedTries(t *testing.T) {
+	t.Parallel()
+
+	args := createConsistencyCheckerArgs()
+	dataTrie := createCommittedTrie(t, &args)
+	dataTrieRootHash, _ := dataTrie.Root()
+	numDataTrieNodes := len(getSerializedNodes(t, dataTrie))
+
+	mainTrie, _ := trie.NewTrie(args.TrieStorage, args.Marshalizer, args.Hasher, 5)
+	_ = mainTrie.Update([]byte("account"), []byte("account with data trie"))
+	_ = mainTrie.Commit()
+	mainTrieRootHash, _ := mainTrie.Root()
+	numMainTrieNodes := len(getSerializedNodes(t, mainTrie))
+
+	args.RootHashProvider = &mock.RootHashProviderStub{
+		RootHashCalled: func() []byte {
+			return mainTrieRootHash
+		},
+	}
+	args.LeafRootHashExtractor = &mock.LeafRootHashExtractorStub{
+		ExtractRootHashCalled: func(leafValue []byte) ([]byte, bool) {
+			return dataTrieRootHash, string(leafValue) == "account with data trie"
+		},
+	}
+
+	tcc, _ := trie.NewTrieConsistencyChecker(args)
+	statistics, err := tcc.Check()
+	assert.Nil(t, err)
+	assert.True(t, statistics.IsConsistent())
+	assert.Equal(t, uint64(numMainTrieNodes+numDataTrieNodes), statistics.NumCheckedNodes)
+}
+
+func TestTrieConsistencyChecker_MissingNodesShouldBeRequestedAndHealed(t *testing.T) {
+	t.Parallel()
+
+	args := createConsistencyCheckerArgs()
+	tr := createCommittedTrie(t, &args)
+	encodedNodes := getSerializedNodes(t, tr)
+	missingNode := encodedNodes[1]
+	missingHash := args.Hasher.Compute(string(missingNode))
+	_ = args.TrieStorage.Database().Remove(missingHash)
+
+	mutRequested := sync.Mutex{}
+	requestedHashes := make([][]byte, 0)
+	args.RequestHandler = &mock.RequestHandlerStub{
+		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			mutRequested.Lock()
+			requestedHashes = append(requestedHashes, hashes...)
+			mutRequested.Unlock()
+			assert.Equal(t, args.Topic, topic)
+		},
+	}
+
+	tcc, _ := trie.NewTrieConsistencyChecker(args)
+	statistics, _ := tcc.Check()
+	assert.Equal(t, uint64(1), statistics.NumMissingNodes)
+	assert.Equal(t, uint64(1), statistics.NumPendingNodes)
+	mutRequested.Lock()
+	assert.Equal(t, [][]byte{missingHash}, requestedHashes)
+	mutRequested.Unlock()
+
+	interceptedNode, err := trie.NewInterceptedTrieNode(missingNode, args.Marshalizer, args.Hasher)
+	require.Nil(t, err)
+	args.InterceptedNodes.Put(missingHash, interceptedNode, len(missingNode))
+
+	for i := 0; i < 100 && tcc.Statistics().NumHealedNodes == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	statistics, _ = tcc.Check()
+	assert.True(t, statistics.IsConsistent())
+	assert.Equal(t, uint64(1), statistics.NumHealedNodes)
+	assert.Equal(t, uint64(0), statistics.NumPendingNodes)
+}
+
+func TestTrieConsistencyChecker_CheckAfterCloseShouldBeInterrupted(t *testing.T) {
+	t.Parallel()
+
+	args := createConsistencyCheckerArgs()
+	_ = createCommittedTrie(t, &args)
+
+	tcc, _ := trie.NewTrieConsistencyChecker(args)
+	_ = tcc.Close()
+
+	_, err := tcc.Check()
+	assert.Equal(t, trie.ErrConsistencyCheckInterrupted, err)
+}
+
+func TestTrieConsistencyChecker_CheckShouldWalkTheSharedDataTrieOnce(t *testing.T) {
+	t.Parallel()
+
+	args := createConsistencyCheckerArgs()
+	dataTrie := createCommittedTrie(t, &args)
+	dataTrieRootHash, _ := dataTrie.Root()
+	numDataTrieNodes := len(getSerializedNodes(t, dataTrie))
+
+	mainTrie, _ := trie.NewTrie(args.TrieStorage, args.Marshalizer, args.Hasher, 5)
+	_ = mainTrie.Update([]byte("account1"), []byte("account with data trie 1"))
+	_ = mainTrie.Update([]byte("account2"), []byte("account with data trie 2"))
+	_ = mainTrie.Commit()
+	mainTrieRootHash, _ := mainTrie.Root()
+	numMainTrieNodes := len(getSerializedNodes(t, mainTrie))
+
+	args.RootHashProvider = &mock.RootHashProviderStub{
+		RootHashCalled: func() []byte {
+			return mainTrieRootHash
+		},
+	}
+	args.LeafRootHashExtractor = &mock.LeafRootHashExtractorStub{
+		ExtractRootHashCalled: func(leafValue []byte) ([]byte, bool) {
+			return dataTrieRootHash, true
+		},
+	}
+
+	tcc, _ := trie.NewTrieConsistencyChecker(args)
+	statistics, err := tcc.Check()
+	assert.Nil(t, err)
+	assert.True(t, statistics.IsConsistent())
+	assert.Equal(t, uint64(numMainTrieNodes+numDataTrieNodes), statistics.NumCheckedNodes)
+}
+
+func TestTrieConsistencyChecker_RootHashChangedBetweenBatchesShouldRestartFromTheNewRoot(t *testing.T) {
+	t.Parallel()
+
+	args := createConsistencyCheckerArgs()
+	args.RequestMissingNodes = false
+	args.MaxCheckedNodesPerSecond = 2
+	tr := createCommittedTrie(t, &args)
+	oldRootHash, _ := tr.Root()
+	oldNodes := getSerializedNodes(t, tr)
+
+	_ = tr.Update([]byte("doe"), []byte("deer"))
+	require.Nil(t, tr.Commit())
+	newRootHash, _ := tr.Root()
+	newNodes := make(map[string]struct{})
+	for _, encodedNode := range getSerializedNodes(t, tr) {
+		newNodes[string(encodedNode)] = struct{}{}
+	}
+
+	numRootHashCalls := 0
+	args.RootHashProvider = &mock.RootHashProviderStub{
+		RootHashCalled: func() []byte {
+			numRootHashCalls++
+			if numRootHashCalls == 1 {
+				return oldRootHash
+			}
+			if numRootHashCalls == 2 {
+				// the old root is pruned while the checker is not in snapshot mode
+				for _, encodedNode := range oldNodes {
+					_, isInNewTrie := newNodes[string(encodedNode)]
+					if !isInNewTrie {
+						_ = args.TrieStorage.Database().Remove(args.Hasher.Compute(string(encodedNode)))
+					}
+				}
+			}
+			return newRootHash
+		},
+	}
+
+	tcc, _ := trie.NewTrieConsistencyChecker(args)
+	statistics, err := tcc.Check()
+	assert.Nil(t, err)
+	assert.True(t, statistics.IsConsistent())
+	assert.Equal(t, newRootHash, statistics.RootHash)
+}
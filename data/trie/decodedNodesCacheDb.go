@@ -0,0 +1,137 @@
+package trie
+
+import (
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+var _ data.DBWriteCacher = (*decodedNodesCacheDb)(nil)
+
+// decodedNodesCacheDb wraps the trie database and keeps the recently read trie nodes in a decoded form, so that
+// the hot nodes do not have to be unmarshalled each time they are resolved. As all the tries created from the same
+// storage manager read nodes through the same database, the cache is shared by the main trie and the data tries.
+//
+// The nodes are kept by their hash, so a cached node can not become stale: Commit writes each node under the hash of
+// its encoding, thus never changing the node found under an existing hash, and Recreate reads the root through the
+// cache, failing as expected if the root was pruned, as Prune removes the hashes from both the database and the
+// cache. The cached nodes are never handed out, each read returning a copy which shares the immutable byte slices
+// with the cached node, so the changes done on a trie do not reach the cache or the other tries
+type decodedNodesCacheDb struct {
+	data.DBWriteCacher
+	cache     storage.Cacher
+	numHits   uint64
+	numMisses uint64
+}
+
+// NewDecodedNodesCacheDb creates a trie database wrapper which caches the decoded nodes in the provided cacher
+func NewDecodedNodesCacheDb(db data.DBWriteCacher, cache storage.Cacher) (*decodedNodesCacheDb, error) {
+	if check.IfNil(db) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(cache) {
+		return nil, data.ErrNilCacher
+	}
+
+	return &decodedNodesCacheDb{
+		DBWriteCacher: db,
+		cache:         cache,
+	}, nil
+}
+
+// Remove removes the key from the database and from the decoded nodes cache
+func (db *decodedNodesCacheDb) Remove(key []byte) error {
+	db.cache.Remove(key)
+
+	return db.DBWriteCacher.Remove(key)
+}
+
+// Close clears and releases the decoded nodes cache and closes the database
+func (db *decodedNodesCacheDb) Close() error {
+	db.cache.Clear()
+	storageUnit.CloseCache(db.cache)
+
+	return db.DBWriteCacher.Close()
+}
+
+func (db *decodedNodesCacheDb) getDecodedNode(
+	hash []byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (node, error) {
+	val, ok := db.cache.Get(hash)
+	if ok {
+		cachedNode, isNode := val.(node)
+		if isNode {
+			atomic.AddUint64(&db.numHits, 1)
+			return copyCollapsedNode(cachedNode, marshalizer, hasher), nil
+		}
+	}
+	atomic.AddUint64(&db.numMisses, 1)
+
+	encNode, err := db.DBWriteCacher.Get(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedNode, err := decodeNode(encNode, marshalizer, hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	db.cache.Put(hash, decodedNode, len(encNode))
+
+	return copyCollapsedNode(decodedNode, marshalizer, hasher), nil
+}
+
+// NumHits returns the number of trie nodes found in the decoded nodes cache
+func (db *decodedNodesCacheDb) NumHits() uint64 {
+	return atomic.LoadUint64(&db.numHits)
+}
+
+// NumMisses returns the number of trie nodes that had to be read from the database and decoded
+func (db *decodedNodesCacheDb) NumMisses() uint64 {
+	return atomic.LoadUint64(&db.numMisses)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (db *decodedNodesCacheDb) IsInterfaceNil() bool {
+	return db == nil
+}
+
+// copyCollapsedNode returns a copy of a node freshly decoded from the database, which can be changed without
+// affecting the original. The byte slices of a node are only replaced and never changed in place, so they are shared
+// between the two nodes, only the structures holding them being copied
+func copyCollapsedNode(n node, marshalizer marshal.Marshalizer, hasher hashing.Hasher) node {
+	var nodeCopy node
+	switch collapsed := n.(type) {
+	case *branchNode:
+		bn := &branchNode{baseNode: &baseNode{}}
+		bn.EncodedChildren = make([][]byte, len(collapsed.EncodedChildren))
+		copy(bn.EncodedChildren, collapsed.EncodedChildren)
+		nodeCopy = bn
+	case *extensionNode:
+		en := &extensionNode{baseNode: &baseNode{}}
+		en.Key = collapsed.Key
+		en.EncodedChild = collapsed.EncodedChild
+		nodeCopy = en
+	case *leafNode:
+		ln := &leafNode{baseNode: &baseNode{}}
+		ln.Key = collapsed.Key
+		ln.Value = collapsed.Value
+		nodeCopy = ln
+	default:
+		return n
+	}
+
+	nodeCopy.setMarshalizer(marshalizer)
+	nodeCopy.setHasher(hasher)
+	nodeCopy.setVersion(n.getVersion())
+
+	return nodeCopy
+}
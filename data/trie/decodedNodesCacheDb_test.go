@@ -0,0 +1,157 @@
+package trie
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDecodedNodesCacheDb() *decodedNodesCacheDb {
+	cache, _ := lrucache.NewCache(1000)
+	db, _ := NewDecodedNodesCacheDb(memorydb.New(), cache)
+
+	return db
+}
+
+func createTrieWithDecodedNodesCache(db data.DBWriteCacher) *patriciaMerkleTrie {
+	marsh, hasher := getTestMarshAndHasher()
+	evictionWaitList, _ := mock.NewEvictionWaitingList(100, mock.NewMemDbMock(), marsh)
+	generalCfg := config.TrieStorageManagerConfig{
+		PruningBufferLen:   1000,
+		SnapshotsBufferLen: 10,
+		MaxSnapshots:       2,
+	}
+	trieStorage, _ := NewTrieStorageManager(db, marsh, hasher, config.DBConfig{}, evictionWaitList, generalCfg)
+	tr, _ := NewTrie(trieStorage, marsh, hasher, 5)
+
+	return tr
+}
+
+func TestNewDecodedNodesCacheDb_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cache, _ := lrucache.NewCache(10)
+	db, err := NewDecodedNodesCacheDb(nil, cache)
+	assert.Nil(t, db)
+	assert.Equal(t, ErrNilDatabase, err)
+
+	db, err = NewDecodedNodesCacheDb(memorydb.New(), nil)
+	assert.Nil(t, db)
+	assert.Equal(t, data.ErrNilCacher, err)
+}
+
+func TestDecodedNodesCacheDb_GetDecodedNodeShouldCacheTheNode(t *testing.T) {
+	t.Parallel()
+
+	marsh, hasher := getTestMarshAndHasher()
+	db := createDecodedNodesCacheDb()
+	_, collapsedBn := getBnAndCollapsedBn(marsh, hasher)
+	encNode, _ := collapsedBn.getEncodedNode()
+	hash := hasher.Compute(string(encNode))
+	_ = db.Put(hash, encNode)
+
+	firstNode, err := getNodeFromDBAndDecode(hash, db, marsh, hasher)
+	require.Nil(t, err)
+	secondNode, err := getNodeFromDBAndDecode(hash, db, marsh, hasher)
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(1), db.NumHits())
+	assert.Equal(t, uint64(1), db.NumMisses())
+	expectedNode, _ := decodeNode(encNode, marsh, hasher)
+	assert.Equal(t, expectedNode, secondNode)
+	assert.False(t, firstNode == secondNode)
+}
+
+func TestDecodedNodesCacheDb_ChangingAReturnedNodeShouldNotAffectTheCache(t *testing.T) {
+	t.Parallel()
+
+	marsh, hasher := getTestMarshAndHasher()
+	db := createDecodedNodesCacheDb()
+	_, collapsedBn := getBnAndCollapsedBn(marsh, hasher)
+	encNode, _ := collapsedBn.getEncodedNode()
+	hash := hasher.Compute(string(encNode))
+	_ = db.Put(hash, encNode)
+
+	n, _ := getNodeFromDBAndDecode(hash, db, marsh, hasher)
+	bn := n.(*branchNode)
+	bn.EncodedChildren[2] = []byte("changed child")
+	bn.setDirty(true)
+	bn.setGivenHash([]byte("changed hash"))
+
+	n, _ = getNodeFromDBAndDecode(hash, db, marsh, hasher)
+	expectedNode, _ := decodeNode(encNode, marsh, hasher)
+	assert.Equal(t, expectedNode, n)
+}
+
+func TestDecodedNodesCacheDb_RemoveShouldInvalidateTheCachedNode(t *testing.T) {
+	t.Parallel()
+
+	marsh, hasher := getTestMarshAndHasher()
+	db := createDecodedNodesCacheDb()
+	ln, _ := newLeafNode([]byte("dog"), []byte("puppy"), marsh, hasher)
+	encNode, _ := ln.getEncodedNode()
+	hash := hasher.Compute(string(encNode))
+	_ = db.Put(hash, encNode)
+
+	_, _ = getNodeFromDBAndDecode(hash, db, marsh, hasher)
+	_ = db.Put(hash, encNode)
+	_, _ = getNodeFromDBAndDecode(hash, db, marsh, hasher)
+	assert.Equal(t, uint64(1), db.NumHits())
+	assert.Equal(t, uint64(1), db.NumMisses())
+
+	err := db.Remove(hash)
+	require.Nil(t, err)
+
+	n, err := getNodeFromDBAndDecode(hash, db, marsh, hasher)
+	assert.Nil(t, n)
+	assert.NotNil(t, err)
+	assert.Equal(t, uint64(2), db.NumMisses())
+}
+
+func TestDecodedNodesCacheDb_TrieOperations(t *testing.T) {
+	t.Parallel()
+
+	db := createDecodedNodesCacheDb()
+	tr := createTrieWithDecodedNodesCache(db)
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Update([]byte("ddog"), []byte("cat"))
+	require.Nil(t, tr.Commit())
+	rootHash, _ := tr.Root()
+
+	firstTrie, err := tr.Recreate(rootHash)
+	require.Nil(t, err)
+	secondTrie, err := tr.Recreate(rootHash)
+	require.Nil(t, err)
+
+	val, err := firstTrie.Get([]byte("dog"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("puppy"), val)
+	_ = firstTrie.Update([]byte("dog"), []byte("doge"))
+	require.Nil(t, firstTrie.Commit())
+
+	val, err = secondTrie.Get([]byte("dog"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("puppy"), val)
+	assert.True(t, db.NumHits() > 0)
+
+	newRootHash, _ := firstTrie.Root()
+	tr.CancelPrune(rootHash, data.NewRoot)
+	tr.Prune(rootHash, data.OldRoot)
+
+	prunedTrie, err := tr.Recreate(rootHash)
+	assert.Nil(t, prunedTrie)
+	assert.NotNil(t, err)
+
+	newTrie, err := tr.Recreate(newRootHash)
+	require.Nil(t, err)
+	val, err = newTrie.Get([]byte("dog"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("doge"), val)
+}
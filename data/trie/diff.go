@@ -0,0 +1,253 @@
+package trie
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// LeafDiffType defines the type of change found for a leaf when comparing two tries
+type LeafDiffType uint8
+
+const (
+	// LeafAdded marks a leaf that is present only in the second trie
+	LeafAdded LeafDiffType = iota
+	// LeafRemoved marks a leaf that is present only in the first trie
+	LeafRemoved
+	// LeafModified marks a leaf that is present in both tries, having different values
+	LeafModified
+)
+
+// String returns the human readable representation of the leaf diff type
+func (ldt LeafDiffType) String() string {
+	switch ldt {
+	case LeafAdded:
+		return "added"
+	case LeafRemoved:
+		return "removed"
+	case LeafModified:
+		return "modified"
+	default:
+		return "unknown"
+	}
+}
+
+// LeafDiff holds a leaf that differs between two tries. OldValue is nil for added leaves
+// and NewValue is nil for removed leaves
+type LeafDiff struct {
+	Type     LeafDiffType
+	Key      []byte
+	OldValue []byte
+	NewValue []byte
+}
+
+// diffCursor points to a subtree of a trie. A subtree either starts with a whole node, or it is placed
+// inside an extension or a leaf node, after the first offset nibbles of the node's key.
+// A cursor holding only the hash points to a node that was not yet loaded from the storage
+type diffCursor struct {
+	hash   []byte
+	n      node
+	offset int
+}
+
+func (dc diffCursor) isEmpty() bool {
+	return dc.n == nil && len(dc.hash) == 0
+}
+
+func (dc diffCursor) isWholeNode() bool {
+	return dc.offset == 0 && len(dc.hash) > 0
+}
+
+type trieDiffer struct {
+	fromDb      data.DBWriteCacher
+	toDb        data.DBWriteCacher
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+	handler     func(diff LeafDiff) error
+}
+
+// GetTrieDiff compares two committed tries and calls the handler for every added, removed or modified leaf,
+// in the order of the leaves' hex keys. Subtrees having the same hash in both tries are skipped without being
+// loaded from the storage. The tries are read from their databases, so the in memory tries are not altered.
+// The comparison stops at the first error returned by the handler
+func GetTrieDiff(fromTrie data.Trie, toTrie data.Trie, handler func(diff LeafDiff) error) error {
+	if check.IfNil(fromTrie) || check.IfNil(toTrie) {
+		return ErrNilTrie
+	}
+	if handler == nil {
+		return ErrNilDiffHandler
+	}
+
+	fromCursor, err := getRootCursor(fromTrie)
+	if err != nil {
+		return err
+	}
+	toCursor, err := getRootCursor(toTrie)
+	if err != nil {
+		return err
+	}
+
+	pmt, ok := fromTrie.(*patriciaMerkleTrie)
+	if !ok {
+		return ErrWrongTypeAssertion
+	}
+
+	td := &trieDiffer{
+		fromDb:      fromTrie.Database(),
+		toDb:        toTrie.Database(),
+		marshalizer: pmt.marshalizer,
+		hasher:      pmt.hasher,
+		handler:     handler,
+	}
+
+	return td.diff(fromCursor, toCursor, make([]byte, 0))
+}
+
+func getRootCursor(tr data.Trie) (diffCursor, error) {
+	pmt, ok := tr.(*patriciaMerkleTrie)
+	if !ok {
+		return diffCursor{}, ErrWrongTypeAssertion
+	}
+
+	pmt.mutOperation.RLock()
+	defer pmt.mutOperation.RUnlock()
+
+	if pmt.root == nil {
+		return diffCursor{}, nil
+	}
+	rootHash := pmt.root.getHash()
+	if pmt.root.isDirty() || len(rootHash) == 0 {
+		return diffCursor{}, ErrTrieNotCommitted
+	}
+
+	return diffCursor{hash: rootHash}, nil
+}
+
+func (td *trieDiffer) diff(from diffCursor, to diffCursor, path []byte) error {
+	if from.isEmpty() && to.isEmpty() {
+		return nil
+	}
+	if from.isWholeNode() && to.isWholeNode() && bytes.Equal(from.hash, to.hash) {
+		return nil
+	}
+
+	var err error
+	from, err = td.load(from, td.fromDb)
+	if err != nil {
+		return err
+	}
+	to, err = td.load(to, td.toDb)
+	if err != nil {
+		return err
+	}
+
+	if isLeafOrEmpty(from) && isLeafOrEmpty(to) {
+		return td.diffLeaves(from, to, path)
+	}
+
+	for pos := byte(0); pos < nrOfChildren; pos++ {
+		err = td.diff(getChildCursor(from, pos), getChildCursor(to, pos), concat(path, pos))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (td *trieDiffer) load(dc diffCursor, db data.DBWriteCacher) (diffCursor, error) {
+	if dc.n != nil || len(dc.hash) == 0 {
+		return dc, nil
+	}
+
+	n, err := getNodeFromDBAndDecode(dc.hash, db, td.marshalizer, td.hasher)
+	if err != nil {
+		return diffCursor{}, err
+	}
+	n.setGivenHash(dc.hash)
+	dc.n = n
+
+	return dc, nil
+}
+
+func (td *trieDiffer) diffLeaves(from diffCursor, to diffCursor, path []byte) error {
+	fromKey, fromValue, err := getLeafKeyAndValue(from, path)
+	if err != nil {
+		return err
+	}
+	toKey, toValue, err := getLeafKeyAndValue(to, path)
+	if err != nil {
+		return err
+	}
+
+	if fromKey != nil && bytes.Equal(fromKey, toKey) {
+		if bytes.Equal(fromValue, toValue) {
+			return nil
+		}
+
+		return td.handler(LeafDiff{Type: LeafModified, Key: fromKey, OldValue: fromValue, NewValue: toValue})
+	}
+
+	if fromKey != nil {
+		err = td.handler(LeafDiff{Type: LeafRemoved, Key: fromKey, OldValue: fromValue})
+		if err != nil {
+			return err
+		}
+	}
+	if toKey != nil {
+		return td.handler(LeafDiff{Type: LeafAdded, Key: toKey, NewValue: toValue})
+	}
+
+	return nil
+}
+
+func isLeafOrEmpty(dc diffCursor) bool {
+	if dc.n == nil {
+		return true
+	}
+
+	_, isLeaf := dc.n.(*leafNode)
+	return isLeaf
+}
+
+func getLeafKeyAndValue(dc diffCursor, path []byte) ([]byte, []byte, error) {
+	ln, ok := dc.n.(*leafNode)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	key, err := hexToKeyBytes(concat(path, ln.Key[dc.offset:]...))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key, ln.Value, nil
+}
+
+// getChildCursor returns the cursor of the subtree found under the given nibble of the cursor's path
+func getChildCursor(dc diffCursor, pos byte) diffCursor {
+	switch n := dc.n.(type) {
+	case *branchNode:
+		return diffCursor{hash: n.EncodedChildren[pos], n: n.children[pos]}
+	case *extensionNode:
+		if dc.offset >= len(n.Key) || n.Key[dc.offset] != pos {
+			return diffCursor{}
+		}
+		if dc.offset+1 < len(n.Key) {
+			return diffCursor{n: n, offset: dc.offset + 1}
+		}
+
+		return diffCursor{hash: n.EncodedChild, n: n.child}
+	case *leafNode:
+		if dc.offset >= len(n.Key) || n.Key[dc.offset] != pos {
+			return diffCursor{}
+		}
+
+		return diffCursor{n: n, offset: dc.offset + 1}
+	default:
+		return diffCursor{}
+	}
+}
@@ -0,0 +1,185 @@
+package trie_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func collectTrieDiff(t *testing.T, fromTrie data.Trie, toTrie data.Trie) map[string]trie.LeafDiff {
+	diffs := make(map[string]trie.LeafDiff)
+	err := trie.GetTrieDiff(fromTrie, toTrie, func(diff trie.LeafDiff) error {
+		_, found := diffs[string(diff.Key)]
+		require.False(t, found, "key reported twice: %s", diff.Key)
+
+		diffs[string(diff.Key)] = diff
+		return nil
+	})
+	require.Nil(t, err)
+
+	return diffs
+}
+
+func computeExpectedDiff(fromLeaves map[string][]byte, toLeaves map[string][]byte) map[string]trie.LeafDiff {
+	diffs := make(map[string]trie.LeafDiff)
+	for key, oldValue := range fromLeaves {
+		newValue, found := toLeaves[key]
+		if !found {
+			diffs[key] = trie.LeafDiff{Type: trie.LeafRemoved, Key: []byte(key), OldValue: oldValue}
+			continue
+		}
+		if !bytes.Equal(oldValue, newValue) {
+			diffs[key] = trie.LeafDiff{Type: trie.LeafModified, Key: []byte(key), OldValue: oldValue, NewValue: newValue}
+		}
+	}
+	for key, newValue := range toLeaves {
+		_, found := fromLeaves[key]
+		if !found {
+			diffs[key] = trie.LeafDiff{Type: trie.LeafAdded, Key: []byte(key), NewValue: newValue}
+		}
+	}
+
+	return diffs
+}
+
+func TestGetTrieDiff_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	handler := func(_ trie.LeafDiff) error { return nil }
+
+	assert.Equal(t, trie.ErrNilTrie, trie.GetTrieDiff(nil, tr, handler))
+	assert.Equal(t, trie.ErrNilTrie, trie.GetTrieDiff(tr, nil, handler))
+	assert.Equal(t, trie.ErrNilDiffHandler, trie.GetTrieDiff(tr, tr, nil))
+}
+
+func TestGetTrieDiff_UncommittedTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+
+	err := trie.GetTrieDiff(emptyTrie(), tr, func(_ trie.LeafDiff) error { return nil })
+	assert.Equal(t, trie.ErrTrieNotCommitted, err)
+}
+
+func TestGetTrieDiff_SameRootHashShouldNotReportDiffs(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	require.Nil(t, tr.Commit())
+	rootHash, _ := tr.Root()
+	recreatedTrie, err := tr.Recreate(rootHash)
+	require.Nil(t, err)
+
+	diffs := collectTrieDiff(t, tr, recreatedTrie)
+	assert.Equal(t, 0, len(diffs))
+}
+
+func TestGetTrieDiff_EmptyTries(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	require.Nil(t, tr.Commit())
+
+	diffs := collectTrieDiff(t, emptyTrie(), tr)
+	assert.Equal(t, 3, len(diffs))
+	assert.Equal(t, trie.LeafAdded, diffs["doe"].Type)
+	assert.Equal(t, []byte("reindeer"), diffs["doe"].NewValue)
+	assert.Nil(t, diffs["doe"].OldValue)
+
+	diffs = collectTrieDiff(t, tr, emptyTrie())
+	assert.Equal(t, 3, len(diffs))
+	assert.Equal(t, trie.LeafRemoved, diffs["ddog"].Type)
+	assert.Equal(t, []byte("cat"), diffs["ddog"].OldValue)
+	assert.Nil(t, diffs["ddog"].NewValue)
+}
+
+func TestGetTrieDiff_AddedRemovedAndModifiedLeaves(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	require.Nil(t, tr.Commit())
+	oldRootHash, _ := tr.Root()
+
+	_ = tr.Update([]byte("dog"), []byte("good boy"))
+	_ = tr.Delete([]byte("ddog"))
+	_ = tr.Update([]byte("doge"), []byte("coin"))
+	require.Nil(t, tr.Commit())
+
+	oldTrie, err := tr.Recreate(oldRootHash)
+	require.Nil(t, err)
+
+	diffs := collectTrieDiff(t, oldTrie, tr)
+	require.Equal(t, 3, len(diffs))
+	assert.Equal(t, trie.LeafDiff{Type: trie.LeafModified, Key: []byte("dog"), OldValue: []byte("puppy"), NewValue: []byte("good boy")}, diffs["dog"])
+	assert.Equal(t, trie.LeafDiff{Type: trie.LeafRemoved, Key: []byte("ddog"), OldValue: []byte("cat")}, diffs["ddog"])
+	assert.Equal(t, trie.LeafDiff{Type: trie.LeafAdded, Key: []byte("doge"), NewValue: []byte("coin")}, diffs["doge"])
+
+	diffs = collectTrieDiff(t, tr, oldTrie)
+	require.Equal(t, 3, len(diffs))
+	assert.Equal(t, trie.LeafModified, diffs["dog"].Type)
+	assert.Equal(t, trie.LeafAdded, diffs["ddog"].Type)
+	assert.Equal(t, trie.LeafRemoved, diffs["doge"].Type)
+}
+
+func TestGetTrieDiff_TriesFromDifferentStoragesShouldMatchLeavesComparison(t *testing.T) {
+	t.Parallel()
+
+	fromTrie := emptyTrie()
+	toTrie := emptyTrie()
+	for i := 0; i < 500; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		value := []byte(fmt.Sprintf("value%d", i))
+
+		if i%7 != 0 {
+			_ = fromTrie.Update(key, value)
+		}
+		if i%5 == 0 {
+			value = []byte(fmt.Sprintf("changed value%d", i))
+		}
+		if i%11 != 0 {
+			_ = toTrie.Update(key, value)
+		}
+	}
+	require.Nil(t, fromTrie.Commit())
+	require.Nil(t, toTrie.Commit())
+
+	fromLeaves, _ := fromTrie.GetAllLeaves()
+	toLeaves, _ := toTrie.GetAllLeaves()
+	expectedDiffs := computeExpectedDiff(fromLeaves, toLeaves)
+	require.True(t, len(expectedDiffs) > 0)
+
+	diffs := collectTrieDiff(t, fromTrie, toTrie)
+	assert.Equal(t, expectedDiffs, diffs)
+}
+
+func TestGetTrieDiff_HandlerErrorShouldStopTheComparison(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	require.Nil(t, tr.Commit())
+
+	expectedErr := errors.New("expected error")
+	numCalls := 0
+	err := trie.GetTrieDiff(emptyTrie(), tr, func(_ trie.LeafDiff) error {
+		numCalls++
+		return expectedErr
+	})
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestLeafDiffType_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "added", trie.LeafAdded.String())
+	assert.Equal(t, "removed", trie.LeafRemoved.String())
+	assert.Equal(t, "modified", trie.LeafModified.String())
+	assert.Equal(t, "unknown", trie.LeafDiffType(100).String())
+}
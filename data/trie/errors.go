@@ -76,6 +76,9 @@ var ErrNilEvictionWaitingList = errors.New("nil eviction waiting list provided")
 // ErrNilPathManager signals that a nil path manager has been provided
 var ErrNilPathManager = errors.New("nil path manager")
 
+// ErrNilStorerWrapper signals that a nil storer wrapper has been provided
+var ErrNilStorerWrapper = errors.New("nil storer wrapper")
+
 // ErrInvalidTrieTopic signals that invalid trie topic has been provided
 var ErrInvalidTrieTopic = errors.New("invalid trie topic")
 
@@ -87,3 +90,45 @@ var ErrInvalidIdentifier = errors.New("invalid identifier")
 
 // ErrInvalidLevelValue signals that the given value for maxTrieLevelInMemory is invalid
 var ErrInvalidLevelValue = errors.New("invalid trie level in memory value")
+
+// ErrNilRootHashProvider signals that a nil root hash provider has been provided
+var ErrNilRootHashProvider = errors.New("nil root hash provider")
+
+// ErrInvalidCheckerName signals that an empty consistency checker name has been provided
+var ErrInvalidCheckerName = errors.New("invalid consistency checker name")
+
+// ErrInvalidMaxCheckedNodesPerSecond signals that an invalid max number of checked nodes per second has been provided
+var ErrInvalidMaxCheckedNodesPerSecond = errors.New("invalid max checked nodes per second")
+
+// ErrConsistencyCheckInterrupted signals that the consistency check was interrupted before walking the whole trie
+var ErrConsistencyCheckInterrupted = errors.New("consistency check interrupted")
+
+// ErrTrieNotCommitted signals that the operation requires the trie to be committed first
+var ErrTrieNotCommitted = errors.New("trie not committed")
+
+// ErrInvalidProof signals that the provided Merkle proof is invalid
+var ErrInvalidProof = errors.New("invalid proof")
+
+// ErrMissingProofNode signals that the proof does not contain a node referenced on the key path
+var ErrMissingProofNode = errors.New("missing proof node")
+
+// ErrReadOnlyTrieStorage signals that a write operation was attempted on a read only trie storage
+var ErrReadOnlyTrieStorage = errors.New("read only trie storage")
+
+// ErrNilDiffHandler signals that a nil trie diff handler has been provided
+var ErrNilDiffHandler = errors.New("nil trie diff handler")
+
+// ErrNilLeafHandler signals that a nil leaf handler has been provided
+var ErrNilLeafHandler = errors.New("nil leaf handler")
+
+// ErrContextClosing signals that the operation was interrupted because the context is closing
+var ErrContextClosing = errors.New("context closing")
+
+// ErrNilTrieSyncStatistics signals that a nil trie sync statistics handler has been provided
+var ErrNilTrieSyncStatistics = errors.New("nil trie sync statistics handler")
+
+// ErrInvalidMaxInFlightNodes signals that an invalid maximum number of in flight trie nodes has been provided
+var ErrInvalidMaxInFlightNodes = errors.New("invalid maximum number of in flight trie nodes")
+
+// ErrInvalidNodesEncodingVersion signals that the trie nodes encoding version is not supported
+var ErrInvalidNodesEncodingVersion = errors.New("invalid trie nodes encoding version")
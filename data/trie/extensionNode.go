@@ -2,6 +2,7 @@ package trie
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -65,6 +66,14 @@ func (en *extensionNode) setHasher(hasher hashing.Hasher) {
 	en.hasher = hasher
 }
 
+func (en *extensionNode) getVersion() byte {
+	return en.version
+}
+
+func (en *extensionNode) setVersion(version byte) {
+	en.version = version
+}
+
 func (en *extensionNode) getCollapsed() (node, error) {
 	err := en.isEmptyOrNil()
 	if err != nil {
@@ -210,8 +219,7 @@ func (en *extensionNode) getEncodedNode() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	marshaledNode = append(marshaledNode, extension)
-	return marshaledNode, nil
+	return encodeNodeWithVersion(marshaledNode, extension, en.version), nil
 }
 
 func (en *extensionNode) resolveCollapsed(_ byte, db data.DBWriteCacher) error {
@@ -485,6 +493,7 @@ func (en *extensionNode) deepClone() node {
 	}
 
 	clonedNode.dirty = en.dirty
+	clonedNode.version = en.version
 
 	if en.child != nil {
 		clonedNode.child = en.child.deepClone()
@@ -553,6 +562,17 @@ func (en *extensionNode) setDirty(dirty bool) {
 	en.dirty = dirty
 }
 
+func (en *extensionNode) setVersionOfUnhashedNodes(version byte) {
+	if en.hash != nil {
+		return
+	}
+
+	en.version = version
+	if en.child != nil {
+		en.child.setVersionOfUnhashedNodes(version)
+	}
+}
+
 func (en *extensionNode) loadChildren(getNode func([]byte) (node, error)) ([][]byte, []node, error) {
 	err := en.isEmptyOrNil()
 	if err != nil {
@@ -573,22 +593,21 @@ func (en *extensionNode) loadChildren(getNode func([]byte) (node, error)) ([][]b
 	return nil, []node{child}, nil
 }
 
-func (en *extensionNode) getAllLeaves(leaves map[string][]byte, key []byte, db data.DBWriteCacher, marshalizer marshal.Marshalizer) error {
+func (en *extensionNode) iterateLeaves(
+	ctx context.Context,
+	key []byte,
+	db data.DBWriteCacher,
+	handler func(key []byte, value []byte) error,
+) error {
 	err := en.isEmptyOrNil()
 	if err != nil {
-		return fmt.Errorf("getAllLeaves error %w", err)
+		return fmt.Errorf("iterateLeaves error %w", err)
 	}
 
-	err = resolveIfCollapsed(en, 0, db)
+	child, err := getChildForIteration(en.child, en.EncodedChild, db, en.marsh, en.hasher)
 	if err != nil {
 		return err
 	}
 
-	childKey := append(key, en.Key...)
-	err = en.child.getAllLeaves(leaves, childKey, db, marshalizer)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return child.iterateLeaves(ctx, concat(key, en.Key...), db, handler)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/adaptivecache"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
@@ -24,6 +25,9 @@ type trieCreator struct {
 	hasher                   hashing.Hasher
 	pathManager              storage.PathManagerHandler
 	trieStorageManagerConfig config.TrieStorageManagerConfig
+	storerWrapper            storage.StorerWrapper
+	decodedNodesCacheCfg     config.CacheConfig
+	memoryBudget             *adaptivecache.MemoryBudget
 }
 
 var log = logger.GetOrCreate("trie")
@@ -41,6 +45,9 @@ func NewTrieFactory(
 	if check.IfNil(args.PathManager) {
 		return nil, trie.ErrNilPathManager
 	}
+	if check.IfNil(args.StorerWrapper) {
+		return nil, trie.ErrNilStorerWrapper
+	}
 
 	return &trieCreator{
 		evictionWaitingListCfg:   args.EvictionWaitingListCfg,
@@ -49,6 +56,9 @@ func NewTrieFactory(
 		hasher:                   args.Hasher,
 		pathManager:              args.PathManager,
 		trieStorageManagerConfig: args.TrieStorageManagerConfig,
+		storerWrapper:            args.StorerWrapper,
+		decodedNodesCacheCfg:     args.DecodedNodesCacheCfg,
+		memoryBudget:             args.MemoryBudget,
 	}, nil
 }
 
@@ -63,14 +73,21 @@ func (tc *trieCreator) Create(
 
 	dbConfig := factory.GetDBFromConfig(trieStorageCfg.DB)
 	dbConfig.FilePath = path.Join(trieStoragePath, mainDb)
-	accountsTrieStorage, err := storageUnit.NewStorageUnitFromConf(
-		factory.GetCacherFromConfig(trieStorageCfg.Cache),
+	trieCacheConfig := factory.GetCacherFromConfig(trieStorageCfg.Cache)
+	trieCacheConfig.MemoryBudget = tc.memoryBudget
+	trieStorageUnit, err := storageUnit.NewStorageUnitFromConf(
+		trieCacheConfig,
 		dbConfig,
 		factory.GetBloomFromConfig(trieStorageCfg.Bloom),
 	)
 	if err != nil {
 		return nil, nil, err
 	}
+	var accountsTrieStorage data.DBWriteCacher = tc.storerWrapper.Wrap(trieStorageCfg.DB.FilePath, trieStorageUnit)
+	accountsTrieStorage, err = tc.wrapWithDecodedNodesCache(accountsTrieStorage, trieStorageCfg.DB.FilePath)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	log.Trace("trie pruning status", "enabled", pruningEnabled)
 	if !pruningEnabled {
@@ -132,6 +149,23 @@ func (tc *trieCreator) Create(
 	return trieStorage, newTrie, nil
 }
 
+// wrapWithDecodedNodesCache adds a decoded trie nodes cache in front of the trie database, if the cache is enabled.
+// Each trie database gets its own cache, shared by the main trie and by the data tries stored in it
+func (tc *trieCreator) wrapWithDecodedNodesCache(db data.DBWriteCacher, identifier string) (data.DBWriteCacher, error) {
+	if tc.decodedNodesCacheCfg.Capacity == 0 {
+		return db, nil
+	}
+
+	cacheCfg := factory.GetCacherFromConfig(tc.decodedNodesCacheCfg)
+	cache, err := storageUnit.NewCacheWithMemoryBudget(cacheCfg.Type, cacheCfg.Capacity, cacheCfg.Shards, cacheCfg.SizeInBytes, tc.memoryBudget)
+	if err != nil {
+		return nil, err
+	}
+	storageUnit.SetCacheName(cache, DecodedNodesCacheNamePrefix+identifier)
+
+	return trie.NewDecodedNodesCacheDb(db, cache)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tc *trieCreator) IsInterfaceNil() bool {
 	return tc == nil
@@ -16,9 +16,10 @@ import (
 
 func getArgs() TrieFactoryArgs {
 	return TrieFactoryArgs{
-		Marshalizer: &mock.MarshalizerMock{},
-		Hasher:      &mock.HasherMock{},
-		PathManager: &mock.PathManagerStub{},
+		Marshalizer:   &mock.MarshalizerMock{},
+		Hasher:        &mock.HasherMock{},
+		PathManager:   &mock.PathManagerStub{},
+		StorerWrapper: &mock.StorerWrapperStub{},
 	}
 }
 
@@ -63,6 +64,17 @@ func TestNewTrieFactory_NilPathManagerShouldErr(t *testing.T) {
 	assert.Equal(t, trie.ErrNilPathManager, err)
 }
 
+func TestNewTrieFactory_NilStorerWrapperShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgs()
+	args.StorerWrapper = nil
+	tf, err := NewTrieFactory(args)
+
+	assert.Nil(t, tf)
+	assert.Equal(t, trie.ErrNilStorerWrapper, err)
+}
+
 func TestNewTrieFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -144,3 +156,32 @@ func TestTrieFactory_CreateWithPRunningShouldWork(t *testing.T) {
 	require.NotNil(t, tr)
 	require.Nil(t, err)
 }
+
+func TestTrieFactory_CreateWithDecodedNodesCacheShouldWrapTheDatabase(t *testing.T) {
+	t.Parallel()
+
+	args := getArgs()
+	args.DecodedNodesCacheCfg = config.CacheConfig{Type: "LRU", Capacity: 1000}
+	tf, _ := NewTrieFactory(args)
+
+	maxTrieLevelInMemory := uint(5)
+	trieStorage, tr, err := tf.Create(createTrieStorageCfg(), "0", false, maxTrieLevelInMemory)
+	require.Nil(t, err)
+	require.NotNil(t, tr)
+
+	_, isCacheDb := trieStorage.Database().(interface{ NumHits() uint64 })
+	assert.True(t, isCacheDb)
+}
+
+func TestTrieFactory_CreateWithInvalidDecodedNodesCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgs()
+	args.DecodedNodesCacheCfg = config.CacheConfig{Type: "invalid type", Capacity: 1000}
+	tf, _ := NewTrieFactory(args)
+
+	maxTrieLevelInMemory := uint(5)
+	_, tr, err := tf.Create(createTrieStorageCfg(), "0", false, maxTrieLevelInMemory)
+	assert.Nil(t, tr)
+	assert.Equal(t, storage.ErrNotSupportedCacheType, err)
+}
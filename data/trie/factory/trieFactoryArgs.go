@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/adaptivecache"
 )
 
 // UserAccountTrie represents the use account identifier
@@ -13,6 +14,10 @@ const UserAccountTrie = "userAccount"
 // PeerAccountTrie represents the peer account identifier
 const PeerAccountTrie = "peerAccount"
 
+// DecodedNodesCacheNamePrefix is the prefix of the name under which the statistics of a decoded trie nodes cache are
+// reported, followed by the trie database identifier
+const DecodedNodesCacheNamePrefix = "DecodedTrieNodes_"
+
 // TrieFactoryArgs holds arguments for creating a trie factory
 type TrieFactoryArgs struct {
 	EvictionWaitingListCfg   config.EvictionWaitingListConfig
@@ -21,4 +26,7 @@ type TrieFactoryArgs struct {
 	Hasher                   hashing.Hasher
 	PathManager              storage.PathManagerHandler
 	TrieStorageManagerConfig config.TrieStorageManagerConfig
+	StorerWrapper            storage.StorerWrapper
+	DecodedNodesCacheCfg     config.CacheConfig
+	MemoryBudget             *adaptivecache.MemoryBudget
 }
@@ -1,6 +1,7 @@
 package trie
 
 import (
+	"context"
 	"io"
 	"sync"
 	"time"
@@ -38,12 +39,15 @@ type node interface {
 	isValid() bool
 	setDirty(bool)
 	loadChildren(func([]byte) (node, error)) ([][]byte, []node, error)
-	getAllLeaves(map[string][]byte, []byte, data.DBWriteCacher, marshal.Marshalizer) error
+	iterateLeaves(ctx context.Context, key []byte, db data.DBWriteCacher, handler func(key []byte, value []byte) error) error
 
 	getMarshalizer() marshal.Marshalizer
 	setMarshalizer(marshal.Marshalizer)
 	getHasher() hashing.Hasher
 	setHasher(hashing.Hasher)
+	getVersion() byte
+	setVersion(byte)
+	setVersionOfUnhashedNodes(version byte)
 }
 
 type atomicBuffer interface {
@@ -56,9 +60,25 @@ type snapshotNode interface {
 	commit(force bool, level byte, maxTrieLevelInMemory uint, originDb data.DBWriteCacher, targetDb data.DBWriteCacher) error
 }
 
+type decodedNodesGetter interface {
+	getDecodedNode(hash []byte, marshalizer marshal.Marshalizer, hasher hashing.Hasher) (node, error)
+}
+
 // RequestHandler defines the methods through which request to data can be made
 type RequestHandler interface {
 	RequestTrieNodes(destShardID uint32, hashes [][]byte, topic string)
 	RequestInterval() time.Duration
 	IsInterfaceNil() bool
 }
+
+// RootHashProvider provides the root hash of the committed trie state that has to be checked
+type RootHashProvider interface {
+	RootHash() []byte
+	IsInterfaceNil() bool
+}
+
+// LeafRootHashExtractor extracts, from a leaf's value, the root hash of the trie referenced by that leaf
+type LeafRootHashExtractor interface {
+	ExtractRootHash(leafValue []byte) ([]byte, bool)
+	IsInterfaceNil() bool
+}
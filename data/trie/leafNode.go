@@ -2,6 +2,7 @@ package trie
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -64,6 +65,14 @@ func (ln *leafNode) setHasher(hasher hashing.Hasher) {
 	ln.hasher = hasher
 }
 
+func (ln *leafNode) getVersion() byte {
+	return ln.version
+}
+
+func (ln *leafNode) setVersion(version byte) {
+	ln.version = version
+}
+
 func (ln *leafNode) getCollapsed() (node, error) {
 	return ln, nil
 }
@@ -132,8 +141,7 @@ func (ln *leafNode) getEncodedNode() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	marshaledNode = append(marshaledNode, leaf)
-	return marshaledNode, nil
+	return encodeNodeWithVersion(marshaledNode, leaf, ln.version), nil
 }
 
 func (ln *leafNode) resolveCollapsed(_ byte, _ data.DBWriteCacher) error {
@@ -303,6 +311,7 @@ func (ln *leafNode) deepClone() node {
 	}
 
 	clonedNode.dirty = ln.dirty
+	clonedNode.version = ln.version
 	clonedNode.marsh = ln.marsh
 	clonedNode.hasher = ln.hasher
 
@@ -335,22 +344,39 @@ func (ln *leafNode) setDirty(dirty bool) {
 	ln.dirty = dirty
 }
 
+func (ln *leafNode) setVersionOfUnhashedNodes(version byte) {
+	if ln.hash != nil {
+		return
+	}
+
+	ln.version = version
+}
+
 func (ln *leafNode) loadChildren(_ func([]byte) (node, error)) ([][]byte, []node, error) {
 	return nil, nil, nil
 }
 
-func (ln *leafNode) getAllLeaves(leaves map[string][]byte, key []byte, _ data.DBWriteCacher, _ marshal.Marshalizer) error {
+func (ln *leafNode) iterateLeaves(
+	ctx context.Context,
+	key []byte,
+	_ data.DBWriteCacher,
+	handler func(key []byte, value []byte) error,
+) error {
 	err := ln.isEmptyOrNil()
 	if err != nil {
-		return fmt.Errorf("getAllLeaves error %w", err)
+		return fmt.Errorf("iterateLeaves error %w", err)
 	}
 
-	nodeKey := append(key, ln.Key...)
-	nodeKey, err = hexToKeyBytes(nodeKey)
+	select {
+	case <-ctx.Done():
+		return ErrContextClosing
+	default:
+	}
+
+	nodeKey, err := hexToKeyBytes(concat(key, ln.Key...))
 	if err != nil {
 		return err
 	}
 
-	leaves[string(nodeKey)] = ln.Value
-	return nil
+	return handler(nodeKey, ln.Value)
 }
@@ -19,10 +19,11 @@ const (
 )
 
 type baseNode struct {
-	hash   []byte
-	dirty  bool
-	marsh  marshal.Marshalizer
-	hasher hashing.Hasher
+	hash    []byte
+	dirty   bool
+	version byte
+	marsh   marshal.Marshalizer
+	hasher  hashing.Hasher
 }
 
 type branchNode struct {
@@ -93,6 +94,16 @@ func encodeNodeAndCommitToDB(n node, db data.DBWriteCacher) error {
 }
 
 func getNodeFromDBAndDecode(n []byte, db data.DBWriteCacher, marshalizer marshal.Marshalizer, hasher hashing.Hasher) (node, error) {
+	cacheDb, ok := db.(decodedNodesGetter)
+	if ok {
+		decodedNode, err := cacheDb.getDecodedNode(n, marshalizer, hasher)
+		if err != nil {
+			return nil, fmt.Errorf("getNodeFromDB error %w for key %v", err, hex.EncodeToString(n))
+		}
+
+		return decodedNode, nil
+	}
+
 	encChild, err := db.Get(n)
 	if err != nil {
 		return nil, fmt.Errorf("getNodeFromDB error %w for key %v", err, hex.EncodeToString(n))
@@ -106,6 +117,28 @@ func getNodeFromDBAndDecode(n []byte, db data.DBWriteCacher, marshalizer marshal
 	return decodedNode, nil
 }
 
+// getChildForIteration returns the given child if it is loaded in memory, otherwise it loads the child from the
+// database, without attaching it to the parent node, so that walking the whole trie does not keep it in memory
+func getChildForIteration(
+	child node,
+	encodedChild []byte,
+	db data.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (node, error) {
+	if child != nil {
+		return child, nil
+	}
+
+	loadedChild, err := getNodeFromDBAndDecode(encodedChild, db, marshalizer, hasher)
+	if err != nil {
+		return nil, err
+	}
+	loadedChild.setGivenHash(encodedChild)
+
+	return loadedChild, nil
+}
+
 func resolveIfCollapsed(n node, pos byte, db data.DBWriteCacher) error {
 	err := n.isEmptyOrNil()
 	if err != nil {
@@ -146,25 +179,24 @@ func hasValidHash(n node) (bool, error) {
 }
 
 func decodeNode(encNode []byte, marshalizer marshal.Marshalizer, hasher hashing.Hasher) (node, error) {
-	if encNode == nil || len(encNode) < 1 {
-		return nil, ErrInvalidEncoding
+	marshaledNode, nodeType, version, err := splitEncodedNode(encNode)
+	if err != nil {
+		return nil, err
 	}
 
-	nodeType := encNode[len(encNode)-1]
-	encNode = encNode[:len(encNode)-1]
-
 	newNode, err := getEmptyNodeOfType(nodeType)
 	if err != nil {
 		return nil, err
 	}
 
-	err = marshalizer.Unmarshal(newNode, encNode)
+	err = marshalizer.Unmarshal(newNode, marshaledNode)
 	if err != nil {
 		return nil, err
 	}
 
 	newNode.setMarshalizer(marshalizer)
 	newNode.setHasher(hasher)
+	newNode.setVersion(version)
 
 	return newNode, nil
 }
@@ -0,0 +1,55 @@
+package trie
+
+const (
+	// legacyNodesEncodingVersion is the encoding of the nodes written before the encoding was versioned, made of the
+	// marshaled node followed by the node type
+	legacyNodesEncodingVersion = 0
+	// maxNodesEncodingVersion is the latest nodes encoding version that can be read and written
+	maxNodesEncodingVersion = 1
+	// versionedNodeFlag marks the node type byte of a versioned encoding, the byte before it holding the version
+	versionedNodeFlag = 0x80
+)
+
+// Nodes encodings:
+//  - legacy (version 0): marshaled node | node type
+//  - versioned (version >= 1): marshaled node | version | node type with the versioned node flag set
+//
+// The legacy node types never have the versioned node flag set, so the two encodings can not be mistaken one for
+// another. A node keeps the encoding version it was read with, which means that the nodes already written are never
+// rewritten: only the new and the changed nodes are encoded with the version active when they are hashed. The node
+// hash being computed over the whole encoding, the root hashes are the same as before as long as the legacy encoding
+// is used, and a future change of the marshaled node layout only needs a new version.
+
+func encodeNodeWithVersion(marshaledNode []byte, nodeType byte, version byte) []byte {
+	if version == legacyNodesEncodingVersion {
+		return append(marshaledNode, nodeType)
+	}
+
+	return append(marshaledNode, version, nodeType|versionedNodeFlag)
+}
+
+// splitEncodedNode returns the marshaled node, the node type and the encoding version of an encoded node
+func splitEncodedNode(encNode []byte) ([]byte, byte, byte, error) {
+	if len(encNode) < 1 {
+		return nil, 0, 0, ErrInvalidEncoding
+	}
+
+	nodeType := encNode[len(encNode)-1]
+	if nodeType&versionedNodeFlag == 0 {
+		return encNode[:len(encNode)-1], nodeType, legacyNodesEncodingVersion, nil
+	}
+	if len(encNode) < 2 {
+		return nil, 0, 0, ErrInvalidEncoding
+	}
+
+	version := encNode[len(encNode)-2]
+	if !isNodesEncodingVersionSupported(version) || version == legacyNodesEncodingVersion {
+		return nil, 0, 0, ErrInvalidNodesEncodingVersion
+	}
+
+	return encNode[:len(encNode)-2], nodeType &^ versionedNodeFlag, version, nil
+}
+
+func isNodesEncodingVersionSupported(version byte) bool {
+	return version <= maxNodesEncodingVersion
+}
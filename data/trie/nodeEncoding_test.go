@@ -0,0 +1,93 @@
+package trie
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeNodeWithVersion_LegacyVersionShouldAppendOnlyTheNodeType(t *testing.T) {
+	t.Parallel()
+
+	encNode := encodeNodeWithVersion([]byte("marshaled node"), leaf, legacyNodesEncodingVersion)
+	assert.Equal(t, append([]byte("marshaled node"), leaf), encNode)
+}
+
+func TestEncodeNodeWithVersion_VersionedEncodingShouldAppendTheHeader(t *testing.T) {
+	t.Parallel()
+
+	encNode := encodeNodeWithVersion([]byte("marshaled node"), branch, 1)
+	assert.Equal(t, append([]byte("marshaled node"), 1, branch|versionedNodeFlag), encNode)
+}
+
+func TestSplitEncodedNode_InvalidEncodingsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, _, _, err := splitEncodedNode(nil)
+	assert.Equal(t, ErrInvalidEncoding, err)
+
+	_, _, _, err = splitEncodedNode([]byte{leaf | versionedNodeFlag})
+	assert.Equal(t, ErrInvalidEncoding, err)
+
+	_, _, _, err = splitEncodedNode([]byte{1, legacyNodesEncodingVersion, leaf | versionedNodeFlag})
+	assert.Equal(t, ErrInvalidNodesEncodingVersion, err)
+
+	_, _, _, err = splitEncodedNode([]byte{1, maxNodesEncodingVersion + 1, leaf | versionedNodeFlag})
+	assert.Equal(t, ErrInvalidNodesEncodingVersion, err)
+}
+
+func TestSplitEncodedNode_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshaledNode, nodeType, version, err := splitEncodedNode([]byte{1, 2, extension})
+	require.Nil(t, err)
+	assert.Equal(t, []byte{1, 2}, marshaledNode)
+	assert.Equal(t, byte(extension), nodeType)
+	assert.Equal(t, byte(legacyNodesEncodingVersion), version)
+
+	marshaledNode, nodeType, version, err = splitEncodedNode([]byte{1, 2, 1, extension | versionedNodeFlag})
+	require.Nil(t, err)
+	assert.Equal(t, []byte{1, 2}, marshaledNode)
+	assert.Equal(t, byte(extension), nodeType)
+	assert.Equal(t, byte(1), version)
+}
+
+func TestDecodeNode_ShouldKeepTheEncodingVersion(t *testing.T) {
+	t.Parallel()
+
+	marsh, hasher := getTestMarshAndHasher()
+	for _, version := range []byte{legacyNodesEncodingVersion, 1} {
+		ln, _ := newLeafNode([]byte("dog"), []byte("puppy"), marsh, hasher)
+		ln.setVersion(version)
+		encNode, err := ln.getEncodedNode()
+		require.Nil(t, err)
+
+		decodedNode, err := decodeNode(encNode, marsh, hasher)
+		require.Nil(t, err)
+		assert.Equal(t, version, decodedNode.getVersion())
+
+		reEncodedNode, err := decodedNode.getEncodedNode()
+		require.Nil(t, err)
+		assert.Equal(t, encNode, reEncodedNode)
+	}
+}
+
+func TestNodesEncodingVersions_ShouldResultInDifferentHashes(t *testing.T) {
+	t.Parallel()
+
+	marsh, hasher := getTestMarshAndHasher()
+	legacyBn, _ := getBnAndCollapsedBn(marsh, hasher)
+	versionedBn, _ := getBnAndCollapsedBn(marsh, hasher)
+	versionedBn.setVersionOfUnhashedNodes(1)
+
+	_ = legacyBn.setRootHash()
+	_ = versionedBn.setRootHash()
+
+	assert.NotEqual(t, legacyBn.getHash(), versionedBn.getHash())
+	for i := range versionedBn.children {
+		if versionedBn.children[i] != nil {
+			assert.Equal(t, byte(1), versionedBn.children[i].getVersion())
+		}
+	}
+}
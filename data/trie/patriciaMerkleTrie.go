@@ -2,6 +2,7 @@ package trie
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"sync"
@@ -193,13 +194,21 @@ func (tr *patriciaMerkleTrie) Root() ([]byte, error) {
 	if hash != nil {
 		return hash, nil
 	}
-	err := tr.root.setRootHash()
+	err := tr.setRootHash()
 	if err != nil {
 		return nil, err
 	}
 	return tr.root.getHash(), nil
 }
 
+// setRootHash hashes the root, the nodes which were not hashed yet being first set to use the nodes encoding
+// version of the trie storage, while the already hashed nodes keep the encoding they were hashed with
+func (tr *patriciaMerkleTrie) setRootHash() error {
+	tr.root.setVersionOfUnhashedNodes(tr.trieStorage.GetNodesEncodingVersion())
+
+	return tr.root.setRootHash()
+}
+
 // Commit adds all the dirty nodes to the database
 func (tr *patriciaMerkleTrie) Commit() error {
 	tr.mutOperation.Lock()
@@ -211,7 +220,7 @@ func (tr *patriciaMerkleTrie) Commit() error {
 	if !tr.root.isDirty() {
 		return nil
 	}
-	err := tr.root.setRootHash()
+	err := tr.setRootHash()
 	if err != nil {
 		return err
 	}
@@ -425,7 +434,7 @@ func (tr *patriciaMerkleTrie) GetDirtyHashes() (data.ModifiedHashes, error) {
 		return nil, nil
 	}
 
-	err := tr.root.setRootHash()
+	err := tr.setRootHash()
 	if err != nil {
 		return nil, err
 	}
@@ -584,17 +593,14 @@ func (tr *patriciaMerkleTrie) GetSerializedNodes(rootHash []byte, maxBuffToSend
 	return nodes, remainingSpace, nil
 }
 
-// GetAllLeaves iterates the trie and returns a map that contains all leafNodes information
+// GetAllLeaves iterates the trie and returns a map that contains all leafNodes information. As all the leaves are
+// kept in memory, IterateAllLeaves should be used for large tries
 func (tr *patriciaMerkleTrie) GetAllLeaves() (map[string][]byte, error) {
-	tr.mutOperation.RLock()
-	defer tr.mutOperation.RUnlock()
-
-	if tr.root == nil {
-		return map[string][]byte{}, nil
-	}
-
 	leaves := make(map[string][]byte)
-	err := tr.root.getAllLeaves(leaves, []byte{}, tr.Database(), tr.marshalizer)
+	err := tr.IterateAllLeaves(context.Background(), func(key []byte, value []byte) error {
+		leaves[string(key)] = value
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -602,6 +608,28 @@ func (tr *patriciaMerkleTrie) GetAllLeaves() (map[string][]byte, error) {
 	return leaves, nil
 }
 
+// IterateAllLeaves calls the handler for each leaf of the trie, in the order of the trie paths. The nodes that are not
+// loaded in memory are read from the database without being kept, so the memory used does not grow with the trie
+// size. The iteration stops when the context is done or when the handler returns an error. The trie is locked for
+// reading during the iteration, so the handler must not call back into the trie and must not modify the given slices
+func (tr *patriciaMerkleTrie) IterateAllLeaves(ctx context.Context, handler func(key []byte, value []byte) error) error {
+	if ctx == nil {
+		return ErrNilContext
+	}
+	if handler == nil {
+		return ErrNilLeafHandler
+	}
+
+	tr.mutOperation.RLock()
+	defer tr.mutOperation.RUnlock()
+
+	if tr.root == nil {
+		return nil
+	}
+
+	return tr.root.iterateLeaves(ctx, make([]byte, 0), tr.Database(), handler)
+}
+
 // IsPruningEnabled returns true if state pruning is enabled
 func (tr *patriciaMerkleTrie) IsPruningEnabled() bool {
 	return tr.trieStorage.IsPruningEnabled()
@@ -1,7 +1,11 @@
 package trie_test
 
 import (
+	"bytes"
+	"context"
 	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
@@ -20,6 +24,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var emptyTrieHash = make([]byte, 32)
@@ -491,6 +496,107 @@ func TestPatriciaMerkleTrie_GetAllLeaves(t *testing.T) {
 	assert.Equal(t, []byte("cat"), leaves["ddog"])
 }
 
+func TestPatriciaMerkleTrie_IterateAllLeavesNilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+
+	err := tr.IterateAllLeaves(nil, func(_ []byte, _ []byte) error { return nil })
+	assert.Equal(t, trie.ErrNilContext, err)
+
+	err = tr.IterateAllLeaves(context.Background(), nil)
+	assert.Equal(t, trie.ErrNilLeafHandler, err)
+}
+
+func TestPatriciaMerkleTrie_IterateAllLeavesEmptyTrie(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	err := emptyTrie().IterateAllLeaves(context.Background(), func(_ []byte, _ []byte) error {
+		numCalls++
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, numCalls)
+}
+
+// getTriePath returns the nibbles used by the trie to store the given key, followed by the terminator
+func getTriePath(key []byte) []byte {
+	path := make([]byte, 0, len(key)*2+1)
+	for i := len(key) - 1; i >= 0; i-- {
+		path = append(path, key[i]&0x0f, key[i]>>4)
+	}
+
+	return append(path, 16)
+}
+
+func TestPatriciaMerkleTrie_IterateAllLeavesShouldCallHandlerInOrderForAllLeaves(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := initTrieMultipleValues(200)
+	_ = tr.Update([]byte("short"), []byte("value"))
+	require.Nil(t, tr.Commit())
+	rootHash, _ := tr.Root()
+	collapsedTrie, _ := tr.Recreate(rootHash)
+
+	expectedLeaves, err := tr.GetAllLeaves()
+	require.Nil(t, err)
+
+	for _, currentTrie := range []data.Trie{tr, collapsedTrie} {
+		leaves := make(map[string][]byte)
+		var lastPath []byte
+		err = currentTrie.IterateAllLeaves(context.Background(), func(key []byte, value []byte) error {
+			path := getTriePath(key)
+			assert.True(t, bytes.Compare(lastPath, path) < 0)
+			lastPath = path
+
+			leaves[string(key)] = value
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, expectedLeaves, leaves)
+	}
+}
+
+func TestPatriciaMerkleTrie_IterateAllLeavesContextDoneShouldStop(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := initTrieMultipleValues(100)
+	require.Nil(t, tr.Commit())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	numCalls := 0
+	err := tr.IterateAllLeaves(ctx, func(_ []byte, _ []byte) error {
+		numCalls++
+		if numCalls == 5 {
+			cancel()
+		}
+		return nil
+	})
+	assert.Equal(t, trie.ErrContextClosing, err)
+	assert.Equal(t, 5, numCalls)
+
+	err = tr.IterateAllLeaves(ctx, func(_ []byte, _ []byte) error {
+		assert.Fail(t, "should not have been called")
+		return nil
+	})
+	assert.Equal(t, trie.ErrContextClosing, err)
+}
+
+func TestPatriciaMerkleTrie_IterateAllLeavesHandlerErrorShouldStop(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	expectedErr := errors.New("expected error")
+	numCalls := 0
+	err := tr.IterateAllLeaves(context.Background(), func(_ []byte, _ []byte) error {
+		numCalls++
+		return expectedErr
+	})
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 1, numCalls)
+}
+
 func TestPatriciaMerkleTrie_String(t *testing.T) {
 	t.Parallel()
 
@@ -809,3 +915,104 @@ func BenchmarkPatriciaMerkleTrie_RootHashAfterChanging30000NodesInBatchesOf200(b
 		}
 	}
 }
+
+func getTrieWithNodesEncodingVersion(enableEpoch uint32) (data.Trie, data.StorageManager) {
+	marshalizer := &mock.ProtobufMarshalizerMock{}
+	hasher := &mock.KeccakMock{}
+	generalCfg := config.TrieStorageManagerConfig{
+		PruningBufferLen:                1000,
+		SnapshotsBufferLen:              10,
+		MaxSnapshots:                    2,
+		NodesEncodingVersion:            1,
+		NodesEncodingVersionEnableEpoch: enableEpoch,
+	}
+	evictionWaitingList, _ := mock.NewEvictionWaitingList(100, mock.NewMemDbMock(), marshalizer)
+	trieStorage, _ := trie.NewTrieStorageManager(mock.NewMemDbMock(), marshalizer, hasher, config.DBConfig{}, evictionWaitingList, generalCfg)
+	tr, _ := trie.NewTrie(trieStorage, marshalizer, hasher, 5)
+
+	return tr, trieStorage
+}
+
+func TestPatriciaMerkleTrie_RootHashesShouldBeUnchangedBeforeTheNodesEncodingVersionEnableEpoch(t *testing.T) {
+	t.Parallel()
+
+	// root hashes computed before the nodes encoding was versioned
+	expectedRootHash, _ := hex.DecodeString("827cc2dae4ffad775d6a7039a92cc8a7144b7db7d4f4b6830879fb456da68d97")
+	expectedRootHashMultipleValues, _ := hex.DecodeString("439b4e398111084e1cb8125c94d727fb89dd9b7affa491eb26c5504b3aa380e6")
+
+	tr, trieStorage := getTrieWithNodesEncodingVersion(5)
+	trieStorage.EpochConfirmed(4)
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Update([]byte("ddog"), []byte("cat"))
+	rootHash, _ := tr.Root()
+	assert.Equal(t, expectedRootHash, rootHash)
+
+	tr, trieStorage = getTrieWithNodesEncodingVersion(5)
+	trieStorage.EpochConfirmed(4)
+	hsh := keccak.Keccak{}
+	for i := 0; i < 100; i++ {
+		value := hsh.Compute(string(rune(i)))
+		_ = tr.Update(value, value)
+	}
+	rootHash, _ = tr.Root()
+	assert.Equal(t, expectedRootHashMultipleValues, rootHash)
+
+	trieStorage.EpochConfirmed(5)
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	rootHash, _ = tr.Root()
+	legacyTr, _ := initTrieMultipleValues(100)
+	_ = legacyTr.Update([]byte("doe"), []byte("reindeer"))
+	legacyRootHash, _ := legacyTr.Root()
+	assert.NotEqual(t, legacyRootHash, rootHash)
+}
+
+func TestPatriciaMerkleTrie_NodesEncodingVersionShouldOnlyApplyToTheChangedNodes(t *testing.T) {
+	t.Parallel()
+
+	tr, trieStorage := getTrieWithNodesEncodingVersion(5)
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Update([]byte("ddog"), []byte("cat"))
+	require.Nil(t, tr.Commit())
+	oldRootHash, _ := tr.Root()
+
+	trieStorage.EpochConfirmed(5)
+	_ = tr.Update([]byte("dog"), []byte("doge"))
+	require.Nil(t, tr.Commit())
+	newRootHash, _ := tr.Root()
+
+	newTr, err := tr.Recreate(newRootHash)
+	require.Nil(t, err)
+	val, _ := newTr.Get([]byte("dog"))
+	assert.Equal(t, []byte("doge"), val)
+	val, _ = newTr.Get([]byte("doe"))
+	assert.Equal(t, []byte("reindeer"), val)
+	val, _ = newTr.Get([]byte("ddog"))
+	assert.Equal(t, []byte("cat"), val)
+
+	numLegacyNodes := 0
+	numVersionedNodes := 0
+	it, _ := trie.NewIterator(newTr)
+	for {
+		encNode, errMarshal := it.MarshalizedNode()
+		require.Nil(t, errMarshal)
+		if encNode[len(encNode)-1] < 0x80 {
+			numLegacyNodes++
+		} else {
+			numVersionedNodes++
+		}
+
+		if !it.HasNext() {
+			break
+		}
+		require.Nil(t, it.Next())
+	}
+	assert.True(t, numLegacyNodes > 0)
+	assert.True(t, numVersionedNodes > 0)
+
+	oldTr, err := tr.Recreate(oldRootHash)
+	require.Nil(t, err)
+	val, _ = oldTr.Get([]byte("dog"))
+	assert.Equal(t, []byte("puppy"), val)
+}
@@ -0,0 +1,162 @@
+package trie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// GetProof returns the Merkle proof for the given key. The proof contains the encoded nodes found on the path
+// from the root to the given key, if the key is present, or to the node that proves that the key is absent.
+// The trie has to be committed before computing proofs
+func (tr *patriciaMerkleTrie) GetProof(key []byte) ([][]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return make([][]byte, 0), nil
+	}
+	if tr.root.isDirty() {
+		return nil, ErrTrieNotCommitted
+	}
+
+	proof := make([][]byte, 0)
+	addToProof := func(n node) error {
+		encNode, err := n.getEncodedNode()
+		if err != nil {
+			return err
+		}
+
+		proof = append(proof, encNode)
+		return nil
+	}
+
+	_, err := walkKeyPath(tr.root, keyBytesToHex(key), tr.trieStorage.Database(), addToProof)
+	if err != nil {
+		return nil, fmt.Errorf("trie get proof error: %w", err)
+	}
+
+	return proof, nil
+}
+
+// VerifyProof checks the Merkle proof of the given key against the provided root hash. It returns the value
+// proven for the key or nil if the proof shows that the key is not present in the trie
+func VerifyProof(
+	rootHash []byte,
+	key []byte,
+	proof [][]byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) ([]byte, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+	if len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
+		if len(proof) != 0 {
+			return nil, fmt.Errorf("%w, the proof for an empty trie should be empty", ErrInvalidProof)
+		}
+
+		return nil, nil
+	}
+
+	db := newProofDb(proof, hasher)
+	root, err := getNodeFromDBAndDecode(rootHash, db, marshalizer, hasher)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %s", ErrInvalidProof, err.Error())
+	}
+
+	value, err := walkKeyPath(root, keyBytesToHex(key), db, func(_ node) error { return nil })
+	if err != nil {
+		return nil, fmt.Errorf("%w, %s", ErrInvalidProof, err.Error())
+	}
+
+	return value, nil
+}
+
+// walkKeyPath follows the given hex key from the given node and returns the value found at the end of the path,
+// or nil if the key is not present. The handler is called for each node found on the path
+func walkKeyPath(n node, hexKey []byte, db data.DBWriteCacher, handler func(n node) error) ([]byte, error) {
+	for {
+		err := handler(n)
+		if err != nil {
+			return nil, err
+		}
+
+		nextNode, nextKey, err := n.getNext(hexKey, db)
+		if errors.Is(err, ErrNodeNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if nextNode == nil {
+			return getLeafValue(n)
+		}
+
+		n, hexKey = nextNode, nextKey
+	}
+}
+
+func getLeafValue(n node) ([]byte, error) {
+	ln, ok := n.(*leafNode)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return ln.Value, nil
+}
+
+// proofDb is a read only database that holds the nodes of a Merkle proof, indexed by their computed hashes.
+// A missing node is not reported as ErrNodeNotFound, so that an incomplete proof can not pass as a proof of absence
+type proofDb struct {
+	nodes map[string][]byte
+}
+
+func newProofDb(proof [][]byte, hasher hashing.Hasher) *proofDb {
+	nodes := make(map[string][]byte, len(proof))
+	for _, encNode := range proof {
+		nodes[string(hasher.Compute(string(encNode)))] = encNode
+	}
+
+	return &proofDb{
+		nodes: nodes,
+	}
+}
+
+// Put returns an error as the proof database is read only
+func (pdb *proofDb) Put(_, _ []byte) error {
+	return ErrInvalidProof
+}
+
+// Get returns the proof node having the given hash
+func (pdb *proofDb) Get(key []byte) ([]byte, error) {
+	encNode, ok := pdb.nodes[string(key)]
+	if !ok {
+		return nil, ErrMissingProofNode
+	}
+
+	return encNode, nil
+}
+
+// Remove returns an error as the proof database is read only
+func (pdb *proofDb) Remove(_ []byte) error {
+	return ErrInvalidProof
+}
+
+// Close does nothing
+func (pdb *proofDb) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pdb *proofDb) IsInterfaceNil() bool {
+	return pdb == nil
+}
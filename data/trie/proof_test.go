@@ -0,0 +1,137 @@
+package trie_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatriciaMerkleTrie_GetProofUncommittedTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+
+	proof, err := tr.GetProof([]byte("dog"))
+	assert.Nil(t, proof)
+	assert.Equal(t, trie.ErrTrieNotCommitted, err)
+}
+
+func TestPatriciaMerkleTrie_GetProofEmptyTrie(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	marshalizer := &mock.ProtobufMarshalizerMock{}
+	hasher := &mock.KeccakMock{}
+
+	proof, err := tr.GetProof([]byte("dog"))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(proof))
+
+	value, err := trie.VerifyProof(trie.EmptyTrieHash, []byte("dog"), proof, marshalizer, hasher)
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+
+	_, err = trie.VerifyProof(trie.EmptyTrieHash, []byte("dog"), [][]byte{[]byte("node")}, marshalizer, hasher)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+}
+
+func TestPatriciaMerkleTrie_GetProofAndVerifyExistingKeys(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	require.Nil(t, tr.Commit())
+	rootHash, _ := tr.Root()
+	marshalizer := &mock.ProtobufMarshalizerMock{}
+	hasher := &mock.KeccakMock{}
+
+	values := map[string]string{
+		"doe":  "reindeer",
+		"dog":  "puppy",
+		"ddog": "cat",
+	}
+	for key, expectedValue := range values {
+		proof, err := tr.GetProof([]byte(key))
+		require.Nil(t, err)
+		assert.True(t, len(proof) > 0)
+
+		value, err := trie.VerifyProof(rootHash, []byte(key), proof, marshalizer, hasher)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(expectedValue), value)
+	}
+}
+
+func TestPatriciaMerkleTrie_GetProofAndVerifyMissingKeys(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	require.Nil(t, tr.Commit())
+	rootHash, _ := tr.Root()
+	marshalizer := &mock.ProtobufMarshalizerMock{}
+	hasher := &mock.KeccakMock{}
+
+	for _, key := range []string{"do", "dogs", "horse", "d"} {
+		proof, err := tr.GetProof([]byte(key))
+		require.Nil(t, err)
+		assert.True(t, len(proof) > 0)
+
+		value, err := trie.VerifyProof(rootHash, []byte(key), proof, marshalizer, hasher)
+		assert.Nil(t, err)
+		assert.Nil(t, value)
+	}
+}
+
+func TestVerifyProof_TamperedProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	require.Nil(t, tr.Commit())
+	rootHash, _ := tr.Root()
+	marshalizer := &mock.ProtobufMarshalizerMock{}
+	hasher := &mock.KeccakMock{}
+
+	proof, _ := tr.GetProof([]byte("dog"))
+	lastNode := proof[len(proof)-1]
+	tamperedNode := append([]byte{}, lastNode...)
+	tamperedNode[0]++
+	proof[len(proof)-1] = tamperedNode
+
+	value, err := trie.VerifyProof(rootHash, []byte("dog"), proof, marshalizer, hasher)
+	assert.Nil(t, value)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+}
+
+func TestVerifyProof_TruncatedProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	require.Nil(t, tr.Commit())
+	rootHash, _ := tr.Root()
+	marshalizer := &mock.ProtobufMarshalizerMock{}
+	hasher := &mock.KeccakMock{}
+
+	proof, _ := tr.GetProof([]byte("dog"))
+	require.True(t, len(proof) > 1)
+
+	value, err := trie.VerifyProof(rootHash, []byte("dog"), proof[:len(proof)-1], marshalizer, hasher)
+	assert.Nil(t, value)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+}
+
+func TestVerifyProof_WrongRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	require.Nil(t, tr.Commit())
+	marshalizer := &mock.ProtobufMarshalizerMock{}
+	hasher := &mock.KeccakMock{}
+
+	proof, _ := tr.GetProof([]byte("dog"))
+
+	value, err := trie.VerifyProof([]byte("wrong root hash"), []byte("dog"), proof, marshalizer, hasher)
+	assert.Nil(t, value)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+}
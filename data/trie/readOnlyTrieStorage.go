@@ -0,0 +1,129 @@
+package trie
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// readOnlyTrieStorage is a trie storage manager used to read past states of a trie. The trie nodes are searched
+// in the main database and, if not found there, in the snapshot databases of the wrapped trie storage manager.
+// All the write operations are rejected, so the live trie storage is never altered
+type readOnlyTrieStorage struct {
+	trieStorage data.StorageManager
+	db          *snapshotsReaderDb
+}
+
+// NewReadOnlyTrieStorage creates a read only trie storage manager on top of the provided trie storage manager
+func NewReadOnlyTrieStorage(trieStorage data.StorageManager) (*readOnlyTrieStorage, error) {
+	if check.IfNil(trieStorage) {
+		return nil, ErrNilTrieStorage
+	}
+
+	return &readOnlyTrieStorage{
+		trieStorage: trieStorage,
+		db: &snapshotsReaderDb{
+			trieStorage: trieStorage,
+		},
+	}, nil
+}
+
+// Database returns a read only database that searches the trie nodes in the main database and in the snapshots
+func (rots *readOnlyTrieStorage) Database() data.DBWriteCacher {
+	return rots.db
+}
+
+// TakeSnapshot does nothing as the trie storage is read only
+func (rots *readOnlyTrieStorage) TakeSnapshot(_ []byte) {
+}
+
+// SetCheckpoint does nothing as the trie storage is read only
+func (rots *readOnlyTrieStorage) SetCheckpoint(_ []byte) {
+}
+
+// Prune does nothing as the trie storage is read only
+func (rots *readOnlyTrieStorage) Prune(_ []byte, _ data.TriePruningIdentifier) {
+}
+
+// CancelPrune does nothing as the trie storage is read only
+func (rots *readOnlyTrieStorage) CancelPrune(_ []byte, _ data.TriePruningIdentifier) {
+}
+
+// MarkForEviction does nothing as the trie storage is read only
+func (rots *readOnlyTrieStorage) MarkForEviction(_ []byte, _ data.ModifiedHashes) error {
+	return nil
+}
+
+// GetSnapshotThatContainsHash returns the snapshot of the wrapped trie storage that contains the given hash
+func (rots *readOnlyTrieStorage) GetSnapshotThatContainsHash(rootHash []byte) data.SnapshotDbHandler {
+	return rots.trieStorage.GetSnapshotThatContainsHash(rootHash)
+}
+
+// IsPruningEnabled returns false as the trie storage is read only
+func (rots *readOnlyTrieStorage) IsPruningEnabled() bool {
+	return false
+}
+
+// EnterSnapshotMode does nothing as the trie storage is read only
+func (rots *readOnlyTrieStorage) EnterSnapshotMode() {
+}
+
+// ExitSnapshotMode does nothing as the trie storage is read only
+func (rots *readOnlyTrieStorage) ExitSnapshotMode() {
+}
+
+// EpochConfirmed does nothing as the trie storage is read only
+func (rots *readOnlyTrieStorage) EpochConfirmed(_ uint32) {
+}
+
+// GetNodesEncodingVersion returns the nodes encoding version of the wrapped trie storage
+func (rots *readOnlyTrieStorage) GetNodesEncodingVersion() byte {
+	return rots.trieStorage.GetNodesEncodingVersion()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rots *readOnlyTrieStorage) IsInterfaceNil() bool {
+	return rots == nil
+}
+
+// snapshotsReaderDb is a read only database that searches a key in the main trie database and, if not found
+// there, in the snapshot databases. A snapshot is referenced only for the duration of a single read,
+// so it can be safely removed by the trie storage manager afterwards
+type snapshotsReaderDb struct {
+	trieStorage data.StorageManager
+}
+
+// Put returns an error as the database is read only
+func (srd *snapshotsReaderDb) Put(_, _ []byte) error {
+	return ErrReadOnlyTrieStorage
+}
+
+// Get returns the value found under the given key in the main database or in the snapshots
+func (srd *snapshotsReaderDb) Get(key []byte) ([]byte, error) {
+	val, err := srd.trieStorage.Database().Get(key)
+	if err == nil {
+		return val, nil
+	}
+
+	snapshot := srd.trieStorage.GetSnapshotThatContainsHash(key)
+	if check.IfNil(snapshot) {
+		return nil, err
+	}
+	defer snapshot.DecreaseNumReferences()
+
+	return snapshot.Get(key)
+}
+
+// Remove returns an error as the database is read only
+func (srd *snapshotsReaderDb) Remove(_ []byte) error {
+	return ErrReadOnlyTrieStorage
+}
+
+// Close does nothing as the databases are owned by the wrapped trie storage manager
+func (srd *snapshotsReaderDb) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (srd *snapshotsReaderDb) IsInterfaceNil() bool {
+	return srd == nil
+}
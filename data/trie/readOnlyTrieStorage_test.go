@@ -0,0 +1,114 @@
+package trie
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReadOnlyTrieStorage_NilTrieStorageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rots, err := NewReadOnlyTrieStorage(nil)
+	assert.Nil(t, rots)
+	assert.Equal(t, ErrNilTrieStorage, err)
+}
+
+func TestReadOnlyTrieStorage_GetShouldSearchInMainDbAndSnapshots(t *testing.T) {
+	t.Parallel()
+
+	mainDb := memorydb.New()
+	_ = mainDb.Put([]byte("main key"), []byte("main value"))
+	snapshot := &snapshotDb{DBWriteCacher: memorydb.New()}
+	_ = snapshot.Put([]byte("snapshot key"), []byte("snapshot value"))
+
+	rots, _ := NewReadOnlyTrieStorage(&mock.StorageManagerStub{
+		DatabaseCalled: func() data.DBWriteCacher {
+			return mainDb
+		},
+		GetSnapshotThatContainsHashCalled: func(rootHash []byte) data.SnapshotDbHandler {
+			_, err := snapshot.Get(rootHash)
+			if err != nil {
+				return nil
+			}
+
+			snapshot.IncreaseNumReferences()
+			return snapshot
+		},
+	})
+	db := rots.Database()
+
+	val, err := db.Get([]byte("main key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("main value"), val)
+
+	val, err = db.Get([]byte("snapshot key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("snapshot value"), val)
+	assert.False(t, snapshot.IsInUse())
+
+	val, err = db.Get([]byte("missing key"))
+	assert.Nil(t, val)
+	assert.NotNil(t, err)
+}
+
+func TestReadOnlyTrieStorage_WritesShouldBeRejected(t *testing.T) {
+	t.Parallel()
+
+	mainDb := memorydb.New()
+	_ = mainDb.Put([]byte("key"), []byte("value"))
+	rots, _ := NewReadOnlyTrieStorage(&mock.StorageManagerStub{
+		DatabaseCalled: func() data.DBWriteCacher {
+			return mainDb
+		},
+	})
+	db := rots.Database()
+
+	assert.Equal(t, ErrReadOnlyTrieStorage, db.Put([]byte("key"), []byte("new value")))
+	assert.Equal(t, ErrReadOnlyTrieStorage, db.Remove([]byte("key")))
+	assert.False(t, rots.IsPruningEnabled())
+
+	val, _ := mainDb.Get([]byte("key"))
+	assert.Equal(t, []byte("value"), val)
+}
+
+func TestReadOnlyTrieStorage_RecreatePrunedTrieFromSnapshotShouldNotAlterMainDb(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	tr.TakeSnapshot(rootHash)
+	time.Sleep(snapshotDelay)
+
+	_ = tr.Update([]byte("doge"), []byte("doge"))
+	_ = tr.Commit()
+
+	tr.CancelPrune(rootHash, data.NewRoot)
+	tr.Prune(rootHash, data.OldRoot)
+	time.Sleep(pruningDelay)
+
+	_, err := tr.Database().Get(rootHash)
+	require.NotNil(t, err)
+
+	rots, _ := NewReadOnlyTrieStorage(tr.trieStorage)
+	readOnlyTrie, _ := NewTrie(rots, tr.marshalizer, tr.hasher, tr.maxTrieLevelInMemory)
+	oldTrie, err := readOnlyTrie.Recreate(rootHash)
+	require.Nil(t, err)
+
+	val, err := oldTrie.Get([]byte("dog"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("puppy"), val)
+
+	val, err = oldTrie.Get([]byte("doge"))
+	assert.Nil(t, err)
+	assert.Nil(t, val)
+
+	_, err = tr.Database().Get(rootHash)
+	assert.NotNil(t, err)
+}
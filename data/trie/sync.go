@@ -3,6 +3,7 @@ package trie
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -14,68 +15,96 @@ import (
 
 var _ data.TrieSyncer = (*trieSyncer)(nil)
 
-type trieNodeInfo struct {
-	trieNode node
-	received bool
+const progressLogInterval = 10 * time.Second
+
+// ArgTrieSyncer is the argument DTO used to create a trie syncer
+type ArgTrieSyncer struct {
+	RequestHandler     RequestHandler
+	InterceptedNodes   storage.Cacher
+	Trie               data.Trie
+	ShardId            uint32
+	Topic              string
+	TrieSyncStatistics data.SyncStatisticsHandler
+	MaxInFlightNodes   int
 }
 
 type trieSyncer struct {
-	rootFound               bool
 	shardId                 uint32
 	topic                   string
-	rootHash                []byte
-	nodesForTrie            map[string]trieNodeInfo
-	waitTimeBetweenRequests time.Duration
 	trie                    *patriciaMerkleTrie
 	requestHandler          RequestHandler
 	interceptedNodes        storage.Cacher
-	mutOperation            sync.RWMutex
+	statistics              data.SyncStatisticsHandler
+	maxInFlightNodes        int
+	waitTimeBetweenRequests time.Duration
 	handlerID               string
-}
 
-const maxNewMissingAddedPerTurn = 10
+	mutSync       sync.Mutex
+	mutOperation  sync.Mutex
+	missingNodes  map[string]time.Time
+	receivedNodes map[string]*InterceptedTrieNode
+	chReceived    chan struct{}
+}
 
 // NewTrieSyncer creates a new instance of trieSyncer
-func NewTrieSyncer(
-	requestHandler RequestHandler,
-	interceptedNodes storage.Cacher,
-	trie data.Trie,
-	shardId uint32,
-	topic string,
-) (*trieSyncer, error) {
-	if check.IfNil(requestHandler) {
-		return nil, ErrNilRequestHandler
-	}
-	if check.IfNil(interceptedNodes) {
-		return nil, data.ErrNilCacher
-	}
-	if check.IfNil(trie) {
-		return nil, ErrNilTrie
-	}
-	if len(topic) == 0 {
-		return nil, ErrInvalidTrieTopic
+func NewTrieSyncer(arg ArgTrieSyncer) (*trieSyncer, error) {
+	err := checkTrieSyncerArgs(arg)
+	if err != nil {
+		return nil, err
 	}
 
-	pmt, ok := trie.(*patriciaMerkleTrie)
+	pmt, ok := arg.Trie.(*patriciaMerkleTrie)
 	if !ok {
 		return nil, ErrWrongTypeAssertion
 	}
 
 	ts := &trieSyncer{
-		requestHandler:          requestHandler,
-		interceptedNodes:        interceptedNodes,
+		requestHandler:          arg.RequestHandler,
+		interceptedNodes:        arg.InterceptedNodes,
 		trie:                    pmt,
-		nodesForTrie:            make(map[string]trieNodeInfo),
-		topic:                   topic,
-		shardId:                 shardId,
+		topic:                   arg.Topic,
+		shardId:                 arg.ShardId,
+		statistics:              arg.TrieSyncStatistics,
+		maxInFlightNodes:        arg.MaxInFlightNodes,
 		waitTimeBetweenRequests: time.Second,
 		handlerID:               core.UniqueIdentifier(),
+		missingNodes:            make(map[string]time.Time),
+		receivedNodes:           make(map[string]*InterceptedTrieNode),
+		chReceived:              make(chan struct{}, 1),
 	}
 
 	return ts, nil
 }
 
-// StartSyncing completes the trie, asking for missing trie nodes on the network
+func checkTrieSyncerArgs(arg ArgTrieSyncer) error {
+	if check.IfNil(arg.RequestHandler) {
+		return ErrNilRequestHandler
+	}
+	if check.IfNil(arg.InterceptedNodes) {
+		return data.ErrNilCacher
+	}
+	if check.IfNil(arg.Trie) {
+		return ErrNilTrie
+	}
+	if len(arg.Topic) == 0 {
+		return ErrInvalidTrieTopic
+	}
+	if check.IfNil(arg.TrieSyncStatistics) {
+		return ErrNilTrieSyncStatistics
+	}
+	if arg.MaxInFlightNodes < 1 {
+		return fmt.Errorf("%w, provided %d", ErrInvalidMaxInFlightNodes, arg.MaxInFlightNodes)
+	}
+
+	return nil
+}
+
+// StartSyncing completes the trie, asking for missing trie nodes on the network. The trie is walked depth first and
+// every missing node is requested as soon as it is discovered, so several subtrees are fetched at the same time, from
+// the peers chosen by the request handler, with at most MaxInFlightNodes nodes waited for at any moment.
+// Each node is saved in the trie storage as soon as it is available, which means that an interrupted sync can be
+// resumed without requesting the saved nodes again and that the nodes shared with other tries using the same storage
+// are not requested twice
 func (ts *trieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
 	if len(rootHash) == 0 {
 		return nil
@@ -84,199 +113,253 @@ func (ts *trieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
 		return ErrNilContext
 	}
 
+	ts.mutSync.Lock()
+	defer ts.mutSync.Unlock()
+
 	ts.mutOperation.Lock()
-	ts.nodesForTrie = make(map[string]trieNodeInfo)
-	ts.nodesForTrie[string(rootHash)] = trieNodeInfo{received: false}
+	ts.missingNodes = make(map[string]time.Time)
+	ts.receivedNodes = make(map[string]*InterceptedTrieNode)
 	ts.mutOperation.Unlock()
 
-	ts.rootFound = false
-	ts.rootHash = rootHash
+	ts.interceptedNodes.RegisterHandler(ts.trieNodeIntercepted, ts.handlerID)
+	defer ts.interceptedNodes.UnRegisterHandler(ts.handlerID)
 
+	nodesToCheck := [][]byte{rootHash}
+	lastProgressLogTime := time.Now()
 	for {
-		shouldRetryAfterRequest, err := ts.checkIfSynced()
+		var err error
+		nodesToCheck, err = ts.checkNodes(nodesToCheck)
 		if err != nil {
 			return err
 		}
 
-		numUnResolved := ts.requestNodes()
-		if !shouldRetryAfterRequest && numUnResolved == 0 {
-			err = ts.trie.Commit()
-			if err != nil {
-				return err
-			}
+		numMissingNodes := ts.requestMissingNodes()
+		if len(nodesToCheck) == 0 && numMissingNodes == 0 {
+			return ts.setSyncedRoot(rootHash)
+		}
 
-			return nil
+		if time.Since(lastProgressLogTime) > progressLogInterval {
+			ts.logProgress(rootHash, numMissingNodes)
+			lastProgressLogTime = time.Now()
 		}
 
 		select {
+		case <-ts.chReceived:
 		case <-time.After(ts.waitTimeBetweenRequests):
-			continue
 		case <-ctx.Done():
 			return ErrTimeIsOut
 		}
 	}
 }
 
-func (ts *trieSyncer) checkIfSynced() (bool, error) {
-	var currentNode node
-	var err error
-	var nextNodes []node
-	missingNodes := make(map[string]struct{})
-	currentMissingNodes := make([][]byte, 0)
-	checkedNodes := make(map[string]struct{})
+// checkNodes walks the trie depth first, starting from the given nodes and from the missing nodes that arrived in the
+// meantime. The walk is paused when the in flight nodes limit is reached and the nodes left to be checked are returned
+func (ts *trieSyncer) checkNodes(nodesToCheck [][]byte) ([][]byte, error) {
+	nodesToCheck = append(nodesToCheck, ts.extractArrivedNodes()...)
 
-	newElement := true
-	shouldRetryAfterRequest := false
+	for len(nodesToCheck) > 0 {
+		hash := nodesToCheck[len(nodesToCheck)-1]
 
-	ts.mutOperation.Lock()
-	defer ts.mutOperation.Unlock()
-
-	for newElement {
-		newElement = false
-
-		for nodeHash, nodeInfo := range ts.nodesForTrie {
-			currentMissingNodes = currentMissingNodes[:0]
-			if _, ok := checkedNodes[nodeHash]; ok {
-				continue
+		n, err := ts.getNode(hash)
+		if err == ErrNodeNotFound {
+			if !ts.addMissingNode(hash) {
+				return nodesToCheck, nil
 			}
 
-			currentNode = nodeInfo.trieNode
-			if !nodeInfo.received {
-				currentNode, err = ts.getNode([]byte(nodeHash))
-				if err != nil {
-					continue
-				}
-			}
-
-			if !ts.rootFound && bytes.Equal([]byte(nodeHash), ts.rootHash) {
-				ts.trie.root = currentNode
-				ts.rootFound = true
-			}
-
-			checkedNodes[nodeHash] = struct{}{}
+			nodesToCheck = nodesToCheck[:len(nodesToCheck)-1]
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
 
-			currentMissingNodes, nextNodes, err = currentNode.loadChildren(ts.getNode)
-			if err != nil {
-				return false, err
-			}
-			log.Trace("loaded children for node", "hash", currentNode.getHash())
+		nodesToCheck = nodesToCheck[:len(nodesToCheck)-1]
+		nodesToCheck = append(nodesToCheck, getChildrenHashes(n)...)
+	}
 
-			if len(currentMissingNodes) > 0 {
-				for _, hash := range currentMissingNodes {
-					missingNodes[string(hash)] = struct{}{}
-				}
+	return nodesToCheck, nil
+}
 
-				nextNodes = append(nextNodes, currentNode)
-				_ = ts.addNew(nextNodes)
-				shouldRetryAfterRequest = true
+func (ts *trieSyncer) extractArrivedNodes() [][]byte {
+	ts.mutOperation.Lock()
+	defer ts.mutOperation.Unlock()
 
-				if len(missingNodes) > maxNewMissingAddedPerTurn {
-					newElement = false
-				}
+	arrivedNodes := make([][]byte, 0)
+	for hash := range ts.missingNodes {
+		_, isReceived := ts.receivedNodes[hash]
+		if !isReceived && !ts.interceptedNodes.Has([]byte(hash)) {
+			continue
+		}
 
-				continue
-			}
+		delete(ts.missingNodes, hash)
+		arrivedNodes = append(arrivedNodes, []byte(hash))
+	}
 
-			nextNodes, err = currentNode.getChildren(ts.trie.Database())
-			if err != nil {
-				return false, err
-			}
+	return arrivedNodes
+}
 
-			tmpNewElement := ts.addNew(nextNodes)
-			newElement = newElement || tmpNewElement
+// getNode returns the node from the storage or, if the node was received, saves it in the storage and returns it
+func (ts *trieSyncer) getNode(hash []byte) (node, error) {
+	db := ts.trie.Database()
+	encNode, err := db.Get(hash)
+	if err == nil {
+		ts.mutOperation.Lock()
+		delete(ts.receivedNodes, string(hash))
+		ts.mutOperation.Unlock()
+
+		ts.statistics.AddNumFoundInStorage(1)
+		return decodeNode(encNode, ts.trie.marshalizer, ts.trie.hasher)
+	}
 
-			delete(ts.nodesForTrie, nodeHash)
-		}
+	interceptedNode, ok := ts.getReceivedNode(hash)
+	if !ok {
+		return nil, ErrNodeNotFound
 	}
 
-	for hash := range missingNodes {
-		ts.nodesForTrie[hash] = trieNodeInfo{received: false}
+	encNode = interceptedNode.EncodedNode()
+	err = db.Put(hash, encNode)
+	if err != nil {
+		return nil, err
 	}
 
-	return shouldRetryAfterRequest, nil
+	ts.statistics.AddNumReceived(1)
+	ts.statistics.AddNumBytesReceived(uint64(len(encNode)))
+
+	return interceptedNode.node, nil
 }
 
-// adds new elements to needed hash map, lock ts.nodeHashesMutex before calling
-func (ts *trieSyncer) addNew(nextNodes []node) bool {
-	newElement := false
-	for _, nextNode := range nextNodes {
-		nextHash := string(nextNode.getHash())
-
-		nodeInfo, ok := ts.nodesForTrie[nextHash]
-		if !ok || !nodeInfo.received {
-			newElement = true
-			ts.nodesForTrie[nextHash] = trieNodeInfo{
-				trieNode: nextNode,
-				received: true,
-			}
-		}
+func trieNode(data interface{}) (node, error) {
+	n, ok := data.(*InterceptedTrieNode)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
 	}
 
-	return newElement
+	return n.node, nil
 }
 
-// Trie returns the synced trie
-func (ts *trieSyncer) Trie() data.Trie {
-	return ts.trie
-}
+func (ts *trieSyncer) getReceivedNode(hash []byte) (*InterceptedTrieNode, bool) {
+	ts.mutOperation.Lock()
+	interceptedNode, ok := ts.receivedNodes[string(hash)]
+	delete(ts.receivedNodes, string(hash))
+	ts.mutOperation.Unlock()
+	if ok {
+		return interceptedNode, true
+	}
 
-func (ts *trieSyncer) getNode(hash []byte) (node, error) {
-	nodeInfo, ok := ts.nodesForTrie[string(hash)]
-	if ok && nodeInfo.received {
-		return nodeInfo.trieNode, nil
+	val, ok := ts.interceptedNodes.Get(hash)
+	if !ok {
+		return nil, false
 	}
 
-	n, ok := ts.interceptedNodes.Get(hash)
-	if ok {
-		return trieNode(n)
+	interceptedNode, ok = val.(*InterceptedTrieNode)
+	if !ok || !bytes.Equal(interceptedNode.Hash(), hash) {
+		return nil, false
 	}
 
-	return nil, ErrNodeNotFound
+	return interceptedNode, true
 }
 
-func trieNode(data interface{}) (node, error) {
-	n, ok := data.(*InterceptedTrieNode)
-	if !ok {
-		return nil, ErrWrongTypeAssertion
+// addMissingNode marks the node as missing, returning false if the in flight nodes limit was reached
+func (ts *trieSyncer) addMissingNode(hash []byte) bool {
+	ts.mutOperation.Lock()
+	defer ts.mutOperation.Unlock()
+
+	_, isMissing := ts.missingNodes[string(hash)]
+	if isMissing {
+		return true
+	}
+	if len(ts.missingNodes) >= ts.maxInFlightNodes {
+		return false
 	}
 
-	return n.node, nil
+	ts.missingNodes[string(hash)] = time.Time{}
+
+	return true
 }
 
-func (ts *trieSyncer) requestNodes() uint32 {
-	ts.mutOperation.RLock()
-	numUnResolvedNodes := uint32(len(ts.nodesForTrie))
+// requestMissingNodes requests the missing nodes that were not requested yet and the ones that were requested
+// too long ago, returning the number of missing nodes
+func (ts *trieSyncer) requestMissingNodes() int {
+	ts.mutOperation.Lock()
 	hashes := make([][]byte, 0)
-	for hash, nodeInfo := range ts.nodesForTrie {
-		if !nodeInfo.received {
-			hashes = append(hashes, []byte(hash))
+	for hash, lastRequestTime := range ts.missingNodes {
+		if time.Since(lastRequestTime) < ts.waitTimeBetweenRequests {
+			continue
 		}
+
+		hashes = append(hashes, []byte(hash))
+		ts.missingNodes[hash] = time.Now()
+	}
+	numMissingNodes := len(ts.missingNodes)
+	ts.mutOperation.Unlock()
+
+	if len(hashes) > 0 {
+		ts.requestHandler.RequestTrieNodes(ts.shardId, hashes, ts.topic)
 	}
-	ts.requestHandler.RequestTrieNodes(ts.shardId, hashes, ts.topic)
-	ts.mutOperation.RUnlock()
 
-	return numUnResolvedNodes
+	return numMissingNodes
 }
 
-func (ts *trieSyncer) trieNodeIntercepted(hash []byte, val interface{}) {
-	ts.mutOperation.Lock()
-	defer ts.mutOperation.Unlock()
+func (ts *trieSyncer) setSyncedRoot(rootHash []byte) error {
+	rootNode, err := getNodeFromDBAndDecode(rootHash, ts.trie.Database(), ts.trie.marshalizer, ts.trie.hasher)
+	if err != nil {
+		return err
+	}
+	rootNode.setGivenHash(rootHash)
 
-	log.Trace("trie node intercepted", "hash", hash)
+	ts.trie.mutOperation.Lock()
+	ts.trie.root = rootNode
+	ts.trie.mutOperation.Unlock()
+
+	log.Debug("trie synced",
+		"topic", ts.topic,
+		"root hash", rootHash,
+		"synced nodes", ts.statistics.NumSynced(),
+		"received", core.ConvertBytes(ts.statistics.NumBytesReceived()),
+	)
+
+	return nil
+}
 
-	n, ok := ts.nodesForTrie[string(hash)]
-	if !ok || n.received {
+func (ts *trieSyncer) logProgress(rootHash []byte, numMissingNodes int) {
+	log.Debug("trie sync in progress",
+		"topic", ts.topic,
+		"root hash", rootHash,
+		"synced nodes", ts.statistics.NumSynced(),
+		"received nodes", ts.statistics.NumReceived(),
+		"nodes found in storage", ts.statistics.NumFoundInStorage(),
+		"missing nodes", numMissingNodes,
+		"speed", core.ConvertBytes(ts.statistics.BytesPerSecond())+"/s",
+	)
+}
+
+// Trie returns the synced trie
+func (ts *trieSyncer) Trie() data.Trie {
+	return ts.trie
+}
+
+func (ts *trieSyncer) trieNodeIntercepted(hash []byte, val interface{}) {
+	interceptedNode, ok := val.(*InterceptedTrieNode)
+	if !ok {
 		return
 	}
 
-	node, err := trieNode(val)
-	if err != nil {
+	ts.mutOperation.Lock()
+	_, isMissing := ts.missingNodes[string(hash)]
+	if isMissing {
+		ts.receivedNodes[string(hash)] = interceptedNode
+	}
+	ts.mutOperation.Unlock()
+
+	if !isMissing {
 		return
 	}
 
-	ts.nodesForTrie[string(hash)] = trieNodeInfo{
-		trieNode: node,
-		received: true,
+	log.Trace("trie node intercepted", "hash", hash)
+
+	select {
+	case ts.chReceived <- struct{}{}:
+	default:
 	}
 }
 
@@ -0,0 +1,90 @@
+package trie
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// trieSyncStatistics accumulates the progress of one or more trie syncers. The same instance can be shared between
+// the syncers of the main trie and of the data tries, so the reported values cover the whole state being synced
+type trieSyncStatistics struct {
+	numReceived       uint64
+	numFoundInStorage uint64
+	numBytesReceived  uint64
+	mutStartTime      sync.RWMutex
+	startTime         time.Time
+}
+
+// NewTrieSyncStatistics returns a new trie sync statistics instance
+func NewTrieSyncStatistics() *trieSyncStatistics {
+	return &trieSyncStatistics{
+		startTime: time.Now(),
+	}
+}
+
+// AddNumReceived adds the number of trie nodes received from the network
+func (tss *trieSyncStatistics) AddNumReceived(value int) {
+	atomic.AddUint64(&tss.numReceived, uint64(value))
+}
+
+// AddNumFoundInStorage adds the number of trie nodes that did not need to be requested, as they were
+// already present in the storage
+func (tss *trieSyncStatistics) AddNumFoundInStorage(value int) {
+	atomic.AddUint64(&tss.numFoundInStorage, uint64(value))
+}
+
+// AddNumBytesReceived adds the number of bytes received from the network
+func (tss *trieSyncStatistics) AddNumBytesReceived(value uint64) {
+	atomic.AddUint64(&tss.numBytesReceived, value)
+}
+
+// NumReceived returns the number of trie nodes received from the network
+func (tss *trieSyncStatistics) NumReceived() int {
+	return int(atomic.LoadUint64(&tss.numReceived))
+}
+
+// NumFoundInStorage returns the number of trie nodes found in the storage
+func (tss *trieSyncStatistics) NumFoundInStorage() int {
+	return int(atomic.LoadUint64(&tss.numFoundInStorage))
+}
+
+// NumSynced returns the number of synced trie nodes, either received or found in the storage
+func (tss *trieSyncStatistics) NumSynced() int {
+	return tss.NumReceived() + tss.NumFoundInStorage()
+}
+
+// NumBytesReceived returns the number of bytes received from the network
+func (tss *trieSyncStatistics) NumBytesReceived() uint64 {
+	return atomic.LoadUint64(&tss.numBytesReceived)
+}
+
+// BytesPerSecond returns the average download speed since the statistics were created or last reset
+func (tss *trieSyncStatistics) BytesPerSecond() uint64 {
+	tss.mutStartTime.RLock()
+	elapsedTime := time.Since(tss.startTime)
+	tss.mutStartTime.RUnlock()
+
+	seconds := elapsedTime.Seconds()
+	if seconds <= 0 {
+		return 0
+	}
+
+	return uint64(float64(tss.NumBytesReceived()) / seconds)
+}
+
+// Reset clears all the counters and restarts the speed measurement
+func (tss *trieSyncStatistics) Reset() {
+	atomic.StoreUint64(&tss.numReceived, 0)
+	atomic.StoreUint64(&tss.numFoundInStorage, 0)
+	atomic.StoreUint64(&tss.numBytesReceived, 0)
+
+	tss.mutStartTime.Lock()
+	tss.startTime = time.Now()
+	tss.mutStartTime.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tss *trieSyncStatistics) IsInterfaceNil() bool {
+	return tss == nil
+}
@@ -0,0 +1,41 @@
+package trie
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrieSyncStatistics_AddShouldAccumulate(t *testing.T) {
+	t.Parallel()
+
+	tss := NewTrieSyncStatistics()
+	assert.False(t, tss.IsInterfaceNil())
+
+	tss.AddNumReceived(2)
+	tss.AddNumReceived(3)
+	tss.AddNumFoundInStorage(4)
+	tss.AddNumBytesReceived(100)
+	tss.AddNumBytesReceived(50)
+
+	assert.Equal(t, 5, tss.NumReceived())
+	assert.Equal(t, 4, tss.NumFoundInStorage())
+	assert.Equal(t, 9, tss.NumSynced())
+	assert.Equal(t, uint64(150), tss.NumBytesReceived())
+}
+
+func TestTrieSyncStatistics_ResetShouldClearTheCounters(t *testing.T) {
+	t.Parallel()
+
+	tss := NewTrieSyncStatistics()
+	tss.AddNumReceived(2)
+	tss.AddNumFoundInStorage(4)
+	tss.AddNumBytesReceived(100)
+
+	tss.Reset()
+
+	assert.Equal(t, 0, tss.NumReceived())
+	assert.Equal(t, 0, tss.NumFoundInStorage())
+	assert.Equal(t, uint64(0), tss.NumBytesReceived())
+	assert.Equal(t, uint64(0), tss.BytesPerSecond())
+}
@@ -1,36 +1,316 @@
 package trie
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-func TestTrieSync_InterceptedNodeShouldNotBeAddedToNodesForTrieIfNodeReceived(t *testing.T) {
-	t.Parallel()
+func createMockTrieSyncerArgument() ArgTrieSyncer {
+	return ArgTrieSyncer{
+		RequestHandler:     &mock.RequestHandlerStub{},
+		InterceptedNodes:   &mock.CacherMock{},
+		Trie:               &patriciaMerkleTrie{},
+		ShardId:            0,
+		Topic:              "trieNodes",
+		TrieSyncStatistics: NewTrieSyncStatistics(),
+		MaxInFlightNodes:   10,
+	}
+}
 
+func createSyncTestTrie(db data.DBWriteCacher) *patriciaMerkleTrie {
 	marsh, hasher := getTestMarshAndHasher()
-	ts, err := NewTrieSyncer(&mock.RequestHandlerStub{}, &mock.CacherMock{}, &patriciaMerkleTrie{}, 0, "trieNodes")
-	assert.Nil(t, err)
-	assert.NotNil(t, ts)
+	trieStorage, _ := NewTrieStorageManagerWithoutPruning(db)
+	tr, _ := NewTrie(trieStorage, marsh, hasher, 5)
+
+	return tr
+}
+
+func createCommittedSyncTestTrie(t *testing.T, numLeaves int) *patriciaMerkleTrie {
+	tr := createSyncTestTrie(mock.NewMemDbMock())
+	for i := 0; i < numLeaves; i++ {
+		_ = tr.Update([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	require.Nil(t, tr.Commit())
+
+	return tr
+}
+
+// createResolvingRequestHandler returns a request handler that puts the requested nodes of the source trie in the
+// intercepted nodes cacher, recording every requested hash
+func createResolvingRequestHandler(
+	t *testing.T,
+	source *patriciaMerkleTrie,
+	cacher storage.Cacher,
+	requestedHashes map[string]int,
+	mutRequested *sync.Mutex,
+) *mock.RequestHandlerStub {
+	return &mock.RequestHandlerStub{
+		RequestTrieNodesCalled: func(_ uint32, hashes [][]byte, _ string) {
+			mutRequested.Lock()
+			for _, hash := range hashes {
+				requestedHashes[string(hash)]++
+			}
+			mutRequested.Unlock()
+
+			for _, hash := range hashes {
+				encNode, err := source.Database().Get(hash)
+				require.Nil(t, err)
+
+				interceptedNode, err := NewInterceptedTrieNode(encNode, source.marshalizer, source.hasher)
+				require.Nil(t, err)
+				cacher.Put(hash, interceptedNode, len(encNode))
+			}
+		},
+	}
+}
+
+func TestNewTrieSyncer_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockTrieSyncerArgument()
+	arg.RequestHandler = nil
+	ts, err := NewTrieSyncer(arg)
+	assert.Nil(t, ts)
+	assert.Equal(t, ErrNilRequestHandler, err)
+
+	arg = createMockTrieSyncerArgument()
+	arg.InterceptedNodes = nil
+	ts, err = NewTrieSyncer(arg)
+	assert.Nil(t, ts)
+	assert.Equal(t, data.ErrNilCacher, err)
+
+	arg = createMockTrieSyncerArgument()
+	arg.Trie = nil
+	ts, err = NewTrieSyncer(arg)
+	assert.Nil(t, ts)
+	assert.Equal(t, ErrNilTrie, err)
 
-	bn, collapsedBn := getBnAndCollapsedBn(marsh, hasher)
-	encodedNode, err := collapsedBn.getEncodedNode()
+	arg = createMockTrieSyncerArgument()
+	arg.Topic = ""
+	ts, err = NewTrieSyncer(arg)
+	assert.Nil(t, ts)
+	assert.Equal(t, ErrInvalidTrieTopic, err)
+
+	arg = createMockTrieSyncerArgument()
+	arg.TrieSyncStatistics = nil
+	ts, err = NewTrieSyncer(arg)
+	assert.Nil(t, ts)
+	assert.Equal(t, ErrNilTrieSyncStatistics, err)
+
+	arg = createMockTrieSyncerArgument()
+	arg.MaxInFlightNodes = 0
+	ts, err = NewTrieSyncer(arg)
+	assert.Nil(t, ts)
+	assert.True(t, errors.Is(err, ErrInvalidMaxInFlightNodes))
+}
+
+func TestNewTrieSyncer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ts, err := NewTrieSyncer(createMockTrieSyncerArgument())
 	assert.Nil(t, err)
+	assert.False(t, ts.IsInterfaceNil())
+}
+
+func TestTrieSync_StartSyncingEmptyRootHashOrNilContext(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := NewTrieSyncer(createMockTrieSyncerArgument())
+
+	assert.Nil(t, ts.StartSyncing(nil, context.Background()))
+	assert.Equal(t, ErrNilContext, ts.StartSyncing([]byte("root hash"), nil))
+}
+
+func TestTrieSync_StartSyncingShouldSyncTheWholeTrieWithBoundedInFlightNodes(t *testing.T) {
+	t.Parallel()
+
+	source := createCommittedSyncTestTrie(t, 200)
+	rootHash, _ := source.Root()
+	cacher, _ := lrucache.NewCache(10000)
+	requestedHashes := make(map[string]int)
+	mutRequested := &sync.Mutex{}
+	maxInFlightNodes := 5
+	maxRequestedAtOnce := 0
+	resolvingHandler := createResolvingRequestHandler(t, source, cacher, requestedHashes, mutRequested)
+
+	arg := createMockTrieSyncerArgument()
+	arg.InterceptedNodes = cacher
+	arg.Trie = createSyncTestTrie(mock.NewMemDbMock())
+	arg.MaxInFlightNodes = maxInFlightNodes
+	arg.RequestHandler = &mock.RequestHandlerStub{
+		RequestTrieNodesCalled: func(shardId uint32, hashes [][]byte, topic string) {
+			if len(hashes) > maxRequestedAtOnce {
+				maxRequestedAtOnce = len(hashes)
+			}
+			resolvingHandler.RequestTrieNodes(shardId, hashes, topic)
+		},
+	}
+	ts, _ := NewTrieSyncer(arg)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err := ts.StartSyncing(rootHash, ctx)
+	require.Nil(t, err)
+
+	syncedRootHash, _ := ts.Trie().Root()
+	assert.Equal(t, rootHash, syncedRootHash)
 
-	interceptedNode, err := NewInterceptedTrieNode(encodedNode, marsh, hasher)
+	expectedLeaves, _ := source.GetAllLeaves()
+	syncedLeaves, err := ts.Trie().GetAllLeaves()
 	assert.Nil(t, err)
+	assert.Equal(t, expectedLeaves, syncedLeaves)
 
-	hash := "nodeHash"
-	ts.nodesForTrie[hash] = trieNodeInfo{
-		trieNode: bn,
-		received: true,
+	assert.True(t, maxRequestedAtOnce <= maxInFlightNodes)
+	assert.Equal(t, len(requestedHashes), arg.TrieSyncStatistics.NumReceived())
+	assert.True(t, arg.TrieSyncStatistics.NumBytesReceived() > 0)
+	assert.Equal(t, 0, len(ts.missingNodes))
+	assert.Equal(t, 0, len(ts.receivedNodes))
+}
+
+func TestTrieSync_StartSyncingShouldNotRequestNodesFromStorage(t *testing.T) {
+	t.Parallel()
+
+	source := createCommittedSyncTestTrie(t, 100)
+	rootHash, _ := source.Root()
+
+	arg := createMockTrieSyncerArgument()
+	arg.Trie = createSyncTestTrie(source.Database())
+	arg.RequestHandler = &mock.RequestHandlerStub{
+		RequestTrieNodesCalled: func(_ uint32, _ [][]byte, _ string) {
+			assert.Fail(t, "should not have requested nodes")
+		},
+	}
+	ts, _ := NewTrieSyncer(arg)
+
+	err := ts.StartSyncing(rootHash, context.Background())
+	require.Nil(t, err)
+
+	syncedRootHash, _ := ts.Trie().Root()
+	assert.Equal(t, rootHash, syncedRootHash)
+	assert.Equal(t, 0, arg.TrieSyncStatistics.NumReceived())
+	assert.True(t, arg.TrieSyncStatistics.NumFoundInStorage() > 0)
+}
+
+func TestTrieSync_StartSyncingShouldResumeAnInterruptedSync(t *testing.T) {
+	t.Parallel()
+
+	source := createCommittedSyncTestTrie(t, 200)
+	rootHash, _ := source.Root()
+	cacher, _ := lrucache.NewCache(10000)
+	requestedHashes := make(map[string]int)
+	mutRequested := &sync.Mutex{}
+	resolvingHandler := createResolvingRequestHandler(t, source, cacher, requestedHashes, mutRequested)
+	db := mock.NewMemDbMock()
+
+	numCalls := 0
+	arg := createMockTrieSyncerArgument()
+	arg.InterceptedNodes = cacher
+	arg.Trie = createSyncTestTrie(db)
+	arg.RequestHandler = &mock.RequestHandlerStub{
+		RequestTrieNodesCalled: func(shardId uint32, hashes [][]byte, topic string) {
+			numCalls++
+			if numCalls > 3 {
+				return
+			}
+			resolvingHandler.RequestTrieNodes(shardId, hashes, topic)
+		},
+	}
+	ts, _ := NewTrieSyncer(arg)
+	ts.waitTimeBetweenRequests = 10 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	err := ts.StartSyncing(rootHash, ctx)
+	cancel()
+	require.Equal(t, ErrTimeIsOut, err)
+
+	mutRequested.Lock()
+	persistedHashes := make([][]byte, 0)
+	for hash := range requestedHashes {
+		_, errGet := db.Get([]byte(hash))
+		if errGet == nil {
+			persistedHashes = append(persistedHashes, []byte(hash))
+		}
+		delete(requestedHashes, hash)
+	}
+	mutRequested.Unlock()
+	require.True(t, len(persistedHashes) > 0)
+
+	cacher.Clear()
+	arg.RequestHandler = resolvingHandler
+	arg.Trie = createSyncTestTrie(db)
+	resumingSyncer, _ := NewTrieSyncer(arg)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err = resumingSyncer.StartSyncing(rootHash, ctx)
+	require.Nil(t, err)
+
+	mutRequested.Lock()
+	for _, hash := range persistedHashes {
+		_, isRequestedAgain := requestedHashes[string(hash)]
+		assert.False(t, isRequestedAgain)
 	}
+	mutRequested.Unlock()
+
+	expectedLeaves, _ := source.GetAllLeaves()
+	syncedLeaves, err := resumingSyncer.Trie().GetAllLeaves()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedLeaves, syncedLeaves)
+}
+
+func TestTrieSync_StartSyncingContextDoneShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockTrieSyncerArgument()
+	arg.Trie = createSyncTestTrie(mock.NewMemDbMock())
+	ts, _ := NewTrieSyncer(arg)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := ts.StartSyncing([]byte("missing root hash"), ctx)
+	assert.Equal(t, ErrTimeIsOut, err)
+}
+
+func TestTrieSync_InterceptedNodeShouldBeKeptOnlyIfMissing(t *testing.T) {
+	t.Parallel()
+
+	marsh, hasher := getTestMarshAndHasher()
+	ts, _ := NewTrieSyncer(createMockTrieSyncerArgument())
+
+	_, collapsedBn := getBnAndCollapsedBn(marsh, hasher)
+	encodedNode, _ := collapsedBn.getEncodedNode()
+	interceptedNode, _ := NewInterceptedTrieNode(encodedNode, marsh, hasher)
+
+	ts.trieNodeIntercepted(interceptedNode.Hash(), interceptedNode)
+	assert.Equal(t, 0, len(ts.receivedNodes))
+
+	assert.True(t, ts.addMissingNode(interceptedNode.Hash()))
+	ts.trieNodeIntercepted(interceptedNode.Hash(), interceptedNode)
+	assert.Equal(t, interceptedNode, ts.receivedNodes[string(interceptedNode.Hash())])
+}
+
+func TestTrieSync_AddMissingNodeShouldRespectTheInFlightLimit(t *testing.T) {
+	t.Parallel()
 
-	ts.trieNodeIntercepted([]byte(hash), interceptedNode)
+	arg := createMockTrieSyncerArgument()
+	arg.MaxInFlightNodes = 2
+	ts, _ := NewTrieSyncer(arg)
 
-	nodeInfo, ok := ts.nodesForTrie[hash]
-	assert.True(t, ok)
-	assert.Equal(t, bn, nodeInfo.trieNode)
+	assert.True(t, ts.addMissingNode([]byte("hash1")))
+	assert.True(t, ts.addMissingNode([]byte("hash2")))
+	assert.True(t, ts.addMissingNode([]byte("hash1")))
+	assert.False(t, ts.addMissingNode([]byte("hash3")))
+	assert.Equal(t, 2, len(ts.missingNodes))
 }
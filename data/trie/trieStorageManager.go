@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strconv"
 	"sync"
+	"sync/atomic"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -39,6 +40,10 @@ type trieStorageManager struct {
 
 	dbEvictionWaitingList data.DBRemoveCacher
 	storageOperationMutex sync.RWMutex
+
+	nodesEncodingVersion            byte
+	nodesEncodingVersionEnableEpoch uint32
+	epoch                           uint32
 }
 
 type snapshotsQueueEntry struct {
@@ -67,6 +72,9 @@ func NewTrieStorageManager(
 	if check.IfNil(ewl) {
 		return nil, ErrNilEvictionWaitingList
 	}
+	if !isNodesEncodingVersionSupported(generalConfig.NodesEncodingVersion) {
+		return nil, ErrInvalidNodesEncodingVersion
+	}
 
 	snapshots, snapshotId, err := getSnapshotsAndSnapshotId(snapshotDbCfg)
 	if err != nil {
@@ -83,6 +91,9 @@ func NewTrieStorageManager(
 		snapshotReq:           make(chan *snapshotsQueueEntry, generalConfig.SnapshotsBufferLen),
 		snapshotInProgress:    0,
 		maxSnapshots:          generalConfig.MaxSnapshots,
+
+		nodesEncodingVersion:            generalConfig.NodesEncodingVersion,
+		nodesEncodingVersionEnableEpoch: generalConfig.NodesEncodingVersionEnableEpoch,
 	}
 
 	go tsm.storageProcessLoop(marshalizer, hasher)
@@ -544,6 +555,22 @@ func (tsm *trieStorageManager) IsPruningEnabled() bool {
 	return true
 }
 
+// EpochConfirmed is called with the epoch of the block being processed or created, which decides the encoding
+// version of the trie nodes hashed and written from now on
+func (tsm *trieStorageManager) EpochConfirmed(epoch uint32) {
+	atomic.StoreUint32(&tsm.epoch, epoch)
+}
+
+// GetNodesEncodingVersion returns the encoding version of the new and changed trie nodes. Until the configured
+// enable epoch the legacy encoding is used, so the root hashes stay the same as the ones computed by older nodes
+func (tsm *trieStorageManager) GetNodesEncodingVersion() byte {
+	if atomic.LoadUint32(&tsm.epoch) < tsm.nodesEncodingVersionEnableEpoch {
+		return legacyNodesEncodingVersion
+	}
+
+	return tsm.nodesEncodingVersion
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tsm *trieStorageManager) IsInterfaceNil() bool {
 	return tsm == nil
@@ -52,6 +52,15 @@ func TestNewTrieStorageManagerNilEwlAndPruningEnabled(t *testing.T) {
 	assert.Equal(t, ErrNilEvictionWaitingList, err)
 }
 
+func TestNewTrieStorageManagerUnsupportedNodesEncodingVersion(t *testing.T) {
+	t.Parallel()
+
+	generalCfg := config.TrieStorageManagerConfig{NodesEncodingVersion: maxNodesEncodingVersion + 1}
+	ts, err := NewTrieStorageManager(mock.NewMemDbMock(), &mock.MarshalizerMock{}, &mock.HasherMock{}, config.DBConfig{}, &mock.EvictionWaitingList{}, generalCfg)
+	assert.Nil(t, ts)
+	assert.Equal(t, ErrInvalidNodesEncodingVersion, err)
+}
+
 func TestNewTrieStorageManagerOkVals(t *testing.T) {
 	t.Parallel()
 
@@ -693,3 +702,20 @@ func TestShouldNotRemoveSnapshotDbIfItIsStillInUse(t *testing.T) {
 	assert.Nil(t, val)
 	assert.NotNil(t, err)
 }
+
+func TestTrieStorageManager_GetNodesEncodingVersionShouldSwitchAtTheEnableEpoch(t *testing.T) {
+	t.Parallel()
+
+	generalCfg := config.TrieStorageManagerConfig{
+		NodesEncodingVersion:            1,
+		NodesEncodingVersionEnableEpoch: 5,
+	}
+	ts, _ := NewTrieStorageManager(mock.NewMemDbMock(), &mock.MarshalizerMock{}, &mock.HasherMock{}, config.DBConfig{}, &mock.EvictionWaitingList{}, generalCfg)
+	assert.Equal(t, byte(legacyNodesEncodingVersion), ts.GetNodesEncodingVersion())
+
+	ts.EpochConfirmed(4)
+	assert.Equal(t, byte(legacyNodesEncodingVersion), ts.GetNodesEncodingVersion())
+
+	ts.EpochConfirmed(5)
+	assert.Equal(t, byte(1), ts.GetNodesEncodingVersion())
+}
@@ -38,6 +38,19 @@ func (bc *ChainStorer) GetStorer(unitType UnitType) storage.Storer {
 	return storer
 }
 
+// GetAllStorers returns a shallow copy of the storers map
+func (bc *ChainStorer) GetAllStorers() map[UnitType]storage.Storer {
+	bc.lock.RLock()
+	defer bc.lock.RUnlock()
+
+	storers := make(map[UnitType]storage.Storer, len(bc.chain))
+	for unitType, storer := range bc.chain {
+		storers[unitType] = storer
+	}
+
+	return storers
+}
+
 // Has returns true if the key is found in the selected Unit or false otherwise
 // It can return an error if the provided unit type is not supported or if the
 // underlying implementation of the storage unit reports an error.
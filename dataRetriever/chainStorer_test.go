@@ -134,6 +134,23 @@ func TestGetAll_ReturnsCorrectly(t *testing.T) {
 	assert.Equal(t, map[string][]byte{key1: val1, key2: val2}, t2)
 }
 
+func TestGetAllStorers_ReturnsCopyOfTheStorersMap(t *testing.T) {
+	s1 := &mock.StorerStub{}
+	s2 := &mock.StorerStub{}
+
+	b := dataRetriever.NewChainStorer()
+	b.AddStorer(1, s1)
+	b.AddStorer(2, s2)
+
+	storers := b.GetAllStorers()
+	assert.Equal(t, 2, len(storers))
+	assert.True(t, storers[1] == s1)
+	assert.True(t, storers[2] == s2)
+
+	delete(storers, 1)
+	assert.True(t, b.GetStorer(1) == s1)
+}
+
 func TestDestroy_ErrrorsWhenStorerDestroyErrors(t *testing.T) {
 	s := &mock.StorerStub{}
 	destroyError := errors.New("error")
@@ -1,7 +1,28 @@
 package dataRetriever
 
+import "time"
+
 // TxPoolNumSendersToPreemptivelyEvict instructs tx pool eviction algorithm to remove this many senders when eviction takes place
 const TxPoolNumSendersToPreemptivelyEvict = uint32(100)
 
 // TxPoolNumTxsToPreemptivelyEvict instructs tx pool eviction algorithm to remove this many transactions when eviction takes place
 const TxPoolNumTxsToPreemptivelyEvict = uint32(1000)
+
+// TxPoolMaxNumTimeLockedTxs is the maximum number of transactions kept in the holding area of the tx pool, waiting
+// for their time lock to be satisfied
+const TxPoolMaxNumTimeLockedTxs = 10000
+
+// TxPoolMaxRoundsToHoldTimeLockedTxs is the maximum number of rounds a transaction is kept in the holding area of the
+// tx pool. It covers the farthest time lock accepted by the interceptors, including the locks on the next epoch
+const TxPoolMaxRoundsToHoldTimeLockedTxs = 28800
+
+// TxPoolJournalFlushInterval is the interval at which the changes recorded by the tx pool journal are written to its
+// persister, in a single batch
+const TxPoolJournalFlushInterval = time.Second
+
+// TxPoolJournalPruneInterval is the interval at which the tx pool journal drops the entries older than its maximum age
+const TxPoolJournalPruneInterval = time.Minute
+
+// TxPoolSweepExpiredInterval is the interval at which the tx pool removes the transactions older than the configured
+// maximum age
+const TxPoolSweepExpiredInterval = 10 * time.Second
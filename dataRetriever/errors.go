@@ -178,3 +178,21 @@ var ErrNilResolverDebugHandler = errors.New("nil resolver debug handler")
 
 // ErrMissingData signals that the required data is missing
 var ErrMissingData = errors.New("missing data")
+
+// ErrNilPersister signals that a nil persister has been provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilTxPool signals that a nil transactions pool has been provided
+var ErrNilTxPool = errors.New("nil transactions pool")
+
+// ErrInvalidTxPoolJournalConfig signals that the transactions pool journal configuration is invalid
+var ErrInvalidTxPoolJournalConfig = errors.New("invalid transactions pool journal configuration")
+
+// ErrExpiredTxPoolJournalEntry signals that a transactions pool journal entry is older than the configured maximum age
+var ErrExpiredTxPoolJournalEntry = errors.New("expired transactions pool journal entry")
+
+// ErrTimeLockedTxsHoldingAreaFull signals that the holding area of the time locked transactions is full
+var ErrTimeLockedTxsHoldingAreaFull = errors.New("time locked transactions holding area is full")
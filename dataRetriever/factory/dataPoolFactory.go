@@ -22,6 +22,7 @@ type ArgsDataPool struct {
 	Config           *config.Config
 	EconomicsData    *economics.EconomicsData
 	ShardCoordinator sharding.Coordinator
+	TxPoolJournal    dataRetriever.TxPoolJournal
 }
 
 // NewDataPoolFromConfig will return a new instance of a PoolsHolder
@@ -36,6 +37,7 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 		MinGasPrice:    args.EconomicsData.MinGasPrice(),
 		NumberOfShards: args.ShardCoordinator.NumberOfShards(),
 		SelfShardID:    args.ShardCoordinator.SelfId(),
+		Journal:        args.TxPoolJournal,
 	})
 	if err != nil {
 		log.Error("error creating txpool")
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
@@ -38,6 +39,12 @@ func (ut UnitType) String() string {
 		return "BootstrapUnit"
 	case StatusMetricsUnit:
 		return "StatusMetricsUnit"
+	case TxLogsUnit:
+		return "TxLogsUnit"
+	case AccountsChangeSetUnit:
+		return "AccountsChangeSetUnit"
+	case ReceiptsUnit:
+		return "ReceiptsUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -72,6 +79,10 @@ const (
 	StatusMetricsUnit UnitType = 10
 	// TxLogsUnit is the status metrics storage unit identifier
 	TxLogsUnit UnitType = 11
+	// AccountsChangeSetUnit is the accounts change sets storage unit identifier
+	AccountsChangeSetUnit UnitType = 12
+	// ReceiptsUnit is the transaction receipts storage unit identifier
+	ReceiptsUnit UnitType = 13
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -252,6 +263,23 @@ type ShardedDataCacherNotifier interface {
 	CreateShardStore(cacheId string)
 }
 
+// TxPoolRemovalNotifier defines the transactions pool which notifies about the transactions removed by its caches:
+// the transactions evicted, replaced by a transaction with a higher gas price or expired
+type TxPoolRemovalNotifier interface {
+	RegisterRemovalHandler(handler func(txHashes [][]byte))
+	IsInterfaceNil() bool
+}
+
+// TxPoolJournal defines the journal which records the transactions added to and removed from the transactions pool,
+// so that the pool can be reloaded after the node restarts
+type TxPoolJournal interface {
+	RecordAddition(txHash []byte, tx data.TransactionHandler, cacheID string)
+	RecordRemoval(txHash []byte)
+	Reload(txPool ShardedDataCacherNotifier, accounts state.AccountsAdapter) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // ShardIdHashMap represents a map for shardId and hash
 type ShardIdHashMap interface {
 	Load(shardId uint32) ([]byte, bool)
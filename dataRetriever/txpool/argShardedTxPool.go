@@ -9,11 +9,13 @@ import (
 )
 
 // ArgShardedTxPool is the argument for ShardedTxPool's constructor
+// A nil Journal disables the recording of the pool's transactions
 type ArgShardedTxPool struct {
 	Config         storageUnit.CacheConfig
 	MinGasPrice    uint64
 	NumberOfShards uint32
 	SelfShardID    uint32
+	Journal        dataRetriever.TxPoolJournal `json:"-"`
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
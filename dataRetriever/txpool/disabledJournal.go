@@ -0,0 +1,41 @@
+package txpool
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+var _ dataRetriever.TxPoolJournal = (*disabledJournal)(nil)
+
+// disabledJournal is a transactions pool journal which records nothing
+type disabledJournal struct {
+}
+
+// NewDisabledJournal creates a new disabled transactions pool journal
+func NewDisabledJournal() *disabledJournal {
+	return &disabledJournal{}
+}
+
+// RecordAddition does nothing
+func (journal *disabledJournal) RecordAddition(_ []byte, _ data.TransactionHandler, _ string) {
+}
+
+// RecordRemoval does nothing
+func (journal *disabledJournal) RecordRemoval(_ []byte) {
+}
+
+// Reload does nothing
+func (journal *disabledJournal) Reload(_ dataRetriever.ShardedDataCacherNotifier, _ state.AccountsAdapter) error {
+	return nil
+}
+
+// Close does nothing
+func (journal *disabledJournal) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (journal *disabledJournal) IsInterfaceNil() bool {
+	return journal == nil
+}
@@ -13,4 +13,13 @@ type txCache interface {
 	RemoveTxByHash(txHash []byte) bool
 	ImmunizeTxsAgainstEviction(keys [][]byte)
 	ForEachTransaction(function txcache.ForEachTransaction)
+	RegisterRemovalHandler(handler func(txHashes [][]byte))
+}
+
+type expiredTxsHolder interface {
+	HasExpiredTx(txHash []byte) bool
+}
+
+type expiredTxsSweeper interface {
+	SweepExpired()
 }
@@ -0,0 +1,395 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. journalEntry.proto
+package txpool
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ dataRetriever.TxPoolJournal = (*txPoolJournal)(nil)
+
+type accountNonceNotifier interface {
+	NotifyAccountNonce(accountKey []byte, nonce uint64)
+}
+
+type reloadedTxAdder interface {
+	addReloadedData(key []byte, value interface{}, cacheID string, insertionTimestamp int64)
+}
+
+// ArgTxPoolJournal is the argument for the transactions pool journal's constructor
+type ArgTxPoolJournal struct {
+	Persister   storage.Persister
+	Marshalizer marshal.Marshalizer
+	SelfShardID uint32
+	MaxNumTxs   uint32
+	MaxAge      time.Duration
+}
+
+// txPoolJournal persists the transactions of the pool, by their hash, so that they survive a node restart.
+// The recorded hashes and their timestamps are indexed in memory, while the changes are buffered and written to the
+// persister in batches, by a background goroutine which also drops the entries older than the maximum age
+type txPoolJournal struct {
+	mutex       sync.Mutex
+	recorded    map[string]int64
+	pending     map[string][]byte
+	mutexFlush  sync.Mutex
+	isClosed    bool
+	persister   storage.Persister
+	marshalizer marshal.Marshalizer
+	selfShardID uint32
+	maxNumTxs   uint32
+	maxAge      time.Duration
+	cancel      context.CancelFunc
+}
+
+// NewTxPoolJournal creates a new transactions pool journal
+func NewTxPoolJournal(args ArgTxPoolJournal) (*txPoolJournal, error) {
+	if check.IfNil(args.Persister) {
+		return nil, dataRetriever.ErrNilPersister
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, dataRetriever.ErrNilMarshalizer
+	}
+	if args.MaxNumTxs == 0 {
+		return nil, fmt.Errorf("%w: MaxNumTxs is not valid", dataRetriever.ErrInvalidTxPoolJournalConfig)
+	}
+	if args.MaxAge <= 0 {
+		return nil, fmt.Errorf("%w: MaxAge is not valid", dataRetriever.ErrInvalidTxPoolJournalConfig)
+	}
+
+	journal := &txPoolJournal{
+		recorded:    make(map[string]int64),
+		pending:     make(map[string][]byte),
+		persister:   args.Persister,
+		marshalizer: args.Marshalizer,
+		selfShardID: args.SelfShardID,
+		maxNumTxs:   args.MaxNumTxs,
+		maxAge:      args.MaxAge,
+	}
+	journal.persister.RangeKeys(func(key []byte, val []byte) bool {
+		entry := &JournalEntry{}
+		err := journal.marshalizer.Unmarshal(entry, val)
+		if err != nil {
+			log.Debug("NewTxPoolJournal: unmarshal entry", "txHash", key, "err", err)
+			journal.pending[string(key)] = nil
+			return true
+		}
+
+		journal.recorded[string(key)] = entry.Timestamp
+		return true
+	})
+
+	log.Debug("NewTxPoolJournal", "numTxs", len(journal.recorded), "maxNumTxs", journal.maxNumTxs, "maxAge", journal.maxAge)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	journal.cancel = cancel
+	go journal.processLoop(ctx)
+
+	return journal, nil
+}
+
+func (journal *txPoolJournal) processLoop(ctx context.Context) {
+	flushTicker := time.NewTicker(dataRetriever.TxPoolJournalFlushInterval)
+	defer flushTicker.Stop()
+	pruneTicker := time.NewTicker(dataRetriever.TxPoolJournalPruneInterval)
+	defer pruneTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("txPoolJournal's go routine is stopping...")
+			return
+		case <-pruneTicker.C:
+			journal.prune()
+		case <-flushTicker.C:
+			journal.flush()
+		}
+	}
+}
+
+// RecordAddition records a transaction added to the pool. A transaction already recorded keeps its initial
+// timestamp, while the new transactions are not recorded at all once the journal is full
+func (journal *txPoolJournal) RecordAddition(txHash []byte, tx data.TransactionHandler, cacheID string) {
+	if !journal.canRecord(txHash) {
+		return
+	}
+
+	timestamp := time.Now().Unix()
+	entryBuff, err := journal.createEntry(tx, cacheID, timestamp)
+	if err != nil {
+		log.Debug("txPoolJournal.RecordAddition()", "txHash", txHash, "err", err)
+		return
+	}
+
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+
+	if !journal.canRecordNoLock(txHash) {
+		return
+	}
+
+	journal.recorded[string(txHash)] = timestamp
+	journal.pending[string(txHash)] = entryBuff
+}
+
+func (journal *txPoolJournal) canRecord(txHash []byte) bool {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+
+	return journal.canRecordNoLock(txHash)
+}
+
+// This function should only be used in critical section (journal.mutex)
+func (journal *txPoolJournal) canRecordNoLock(txHash []byte) bool {
+	_, isRecorded := journal.recorded[string(txHash)]
+	if isRecorded {
+		return false
+	}
+	if uint32(len(journal.recorded)) >= journal.maxNumTxs {
+		log.Trace("txPoolJournal.RecordAddition(): journal is full", "txHash", txHash)
+		return false
+	}
+
+	return true
+}
+
+func (journal *txPoolJournal) createEntry(tx data.TransactionHandler, cacheID string, timestamp int64) ([]byte, error) {
+	txBuff, err := journal.marshalizer.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &JournalEntry{
+		CacheID:   cacheID,
+		Timestamp: timestamp,
+		TxBuff:    txBuff,
+	}
+
+	return journal.marshalizer.Marshal(entry)
+}
+
+// RecordRemoval records a transaction removed from the pool
+func (journal *txPoolJournal) RecordRemoval(txHash []byte) {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+
+	journal.removeEntryNoLock(string(txHash))
+}
+
+// This function should only be used in critical section (journal.mutex)
+func (journal *txPoolJournal) removeEntryNoLock(txHash string) {
+	_, isRecorded := journal.recorded[txHash]
+	if !isRecorded {
+		return
+	}
+
+	delete(journal.recorded, txHash)
+	journal.pending[txHash] = nil
+}
+
+// prune drops the entries older than the configured maximum age
+func (journal *txPoolJournal) prune() {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+
+	minTimestamp := time.Now().Add(-journal.maxAge).Unix()
+	numPruned := 0
+	for txHash, timestamp := range journal.recorded {
+		if timestamp < minTimestamp {
+			journal.removeEntryNoLock(txHash)
+			numPruned++
+		}
+	}
+
+	log.Debug("txPoolJournal.prune()", "numPruned", numPruned, "numTxs", len(journal.recorded))
+}
+
+// flush writes the buffered changes to the persister. The writes happen outside of the critical section of the
+// recording functions, so that the pool is not slowed down by the persister
+func (journal *txPoolJournal) flush() {
+	journal.mutexFlush.Lock()
+	defer journal.mutexFlush.Unlock()
+
+	if journal.isClosed {
+		return
+	}
+
+	journal.mutex.Lock()
+	pending := journal.pending
+	journal.pending = make(map[string][]byte)
+	journal.mutex.Unlock()
+
+	for txHash, entryBuff := range pending {
+		var err error
+		if entryBuff == nil {
+			err = journal.persister.Remove([]byte(txHash))
+		} else {
+			err = journal.persister.Put([]byte(txHash), entryBuff)
+		}
+		if err != nil {
+			log.Debug("txPoolJournal.flush()", "txHash", []byte(txHash), "err", err)
+		}
+	}
+}
+
+// Reload adds back to the pool the recorded transactions which are not older than the configured maximum age.
+// The transactions sent from the self shard are also validated against the current nonce and balance of their
+// sender, which are then notified to the pool. The reloaded transactions keep the moment they were recorded as the
+// moment they were added in the pool, so they expire as if the node was not restarted. The dropped transactions are
+// removed from the journal
+func (journal *txPoolJournal) Reload(txPool dataRetriever.ShardedDataCacherNotifier, accounts state.AccountsAdapter) error {
+	if check.IfNil(txPool) {
+		return dataRetriever.ErrNilTxPool
+	}
+	if check.IfNil(accounts) {
+		return dataRetriever.ErrNilAccountsAdapter
+	}
+
+	journal.flush()
+	entries := journal.readEntries()
+	minTimestamp := time.Now().Add(-journal.maxAge).Unix()
+	senderNoncesByCacheID := make(map[string]map[string]uint64)
+	numReloaded := 0
+
+	for txHash, entry := range entries {
+		tx, err := journal.validateEntry(entry, minTimestamp, accounts, senderNoncesByCacheID)
+		if err != nil {
+			log.Trace("txPoolJournal.Reload(): transaction dropped", "txHash", []byte(txHash), "err", err)
+			journal.RecordRemoval([]byte(txHash))
+			continue
+		}
+
+		adder, ok := txPool.(reloadedTxAdder)
+		if ok {
+			adder.addReloadedData([]byte(txHash), tx, entry.CacheID, time.Unix(entry.Timestamp, 0).UnixNano())
+		} else {
+			txPool.AddData([]byte(txHash), tx, tx.Size(), entry.CacheID)
+		}
+		numReloaded++
+	}
+
+	for cacheID, senderNonces := range senderNoncesByCacheID {
+		cache, ok := txPool.ShardDataStore(cacheID).(accountNonceNotifier)
+		if !ok {
+			continue
+		}
+
+		for sender, nonce := range senderNonces {
+			cache.NotifyAccountNonce([]byte(sender), nonce)
+		}
+	}
+
+	log.Info("transactions pool reloaded from journal", "numRecorded", len(entries), "numReloaded", numReloaded)
+
+	return nil
+}
+
+func (journal *txPoolJournal) readEntries() map[string]*JournalEntry {
+	journal.mutexFlush.Lock()
+	defer journal.mutexFlush.Unlock()
+
+	entries := make(map[string]*JournalEntry)
+	journal.persister.RangeKeys(func(key []byte, val []byte) bool {
+		entry := &JournalEntry{}
+		err := journal.marshalizer.Unmarshal(entry, val)
+		if err != nil {
+			log.Debug("txPoolJournal.readEntries(): unmarshal entry", "txHash", key, "err", err)
+			return true
+		}
+
+		entries[string(key)] = entry
+		return true
+	})
+
+	return entries
+}
+
+func (journal *txPoolJournal) validateEntry(
+	entry *JournalEntry,
+	minTimestamp int64,
+	accounts state.AccountsAdapter,
+	senderNoncesByCacheID map[string]map[string]uint64,
+) (*transaction.Transaction, error) {
+	if entry.Timestamp < minTimestamp {
+		return nil, dataRetriever.ErrExpiredTxPoolJournalEntry
+	}
+
+	tx := &transaction.Transaction{}
+	err := journal.marshalizer.Unmarshal(tx, entry.TxBuff)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceShardID, _, err := process.ParseShardCacherIdentifier(entry.CacheID)
+	if err != nil {
+		return nil, err
+	}
+	if sourceShardID != journal.selfShardID {
+		return tx, nil
+	}
+
+	account, err := accounts.GetExistingAccount(tx.SndAddr)
+	if err != nil {
+		return nil, err
+	}
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	laneNonce, err := state.GetNonceForLane(userAccount, tx.NonceLane)
+	if err != nil {
+		return nil, err
+	}
+	if laneNonce > tx.Nonce {
+		return nil, process.ErrLowerNonceInTransaction
+	}
+
+	txCost := big.NewInt(0).SetUint64(tx.GasPrice)
+	txCost.Mul(txCost, big.NewInt(0).SetUint64(tx.GasLimit))
+	if tx.Value != nil {
+		txCost.Add(txCost, tx.Value)
+	}
+	if userAccount.GetBalance().Cmp(txCost) < 0 {
+		return nil, process.ErrInsufficientFunds
+	}
+
+	senderNonces, ok := senderNoncesByCacheID[entry.CacheID]
+	if !ok {
+		senderNonces = make(map[string]uint64)
+		senderNoncesByCacheID[entry.CacheID] = senderNonces
+	}
+	senderNonces[string(core.ComputeNonceLaneSenderKey(tx.SndAddr, tx.NonceLane))] = laneNonce
+
+	return tx, nil
+}
+
+// Close writes the buffered changes, then closes the underlying persister
+func (journal *txPoolJournal) Close() error {
+	journal.cancel()
+	journal.flush()
+
+	journal.mutexFlush.Lock()
+	defer journal.mutexFlush.Unlock()
+
+	journal.isClosed = true
+	return journal.persister.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (journal *txPoolJournal) IsInterfaceNil() bool {
+	return journal == nil
+}
@@ -0,0 +1,308 @@
+package txpool
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+	"github.com/stretchr/testify/require"
+)
+
+func createDefaultTxPoolJournalArgs() ArgTxPoolJournal {
+	return ArgTxPoolJournal{
+		Persister:   memorydb.New(),
+		Marshalizer: &mock.MarshalizerMock{},
+		SelfShardID: 0,
+		MaxNumTxs:   100,
+		MaxAge:      time.Hour,
+	}
+}
+
+func createJournalTx(sender string, nonce uint64, value int64) *transaction.Transaction {
+	return &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		Nonce:    nonce,
+		Value:    big.NewInt(value),
+		GasPrice: 10,
+		GasLimit: 10,
+	}
+}
+
+func createAccountsWithUserAccount(address string, nonce uint64, balance int64) *mock.AccountsStub {
+	account, _ := state.NewUserAccount([]byte(address))
+	account.IncreaseNonce(nonce)
+	_ = account.AddToBalance(big.NewInt(balance))
+
+	return &mock.AccountsStub{
+		GetExistingAccountCalled: func(addr []byte) (state.AccountHandler, error) {
+			if string(addr) == address {
+				return account, nil
+			}
+			return nil, errors.New("account not found")
+		},
+	}
+}
+
+func TestNewTxPoolJournal_InvalidArgumentsShouldErr(t *testing.T) {
+	args := createDefaultTxPoolJournalArgs()
+	args.Persister = nil
+	journal, err := NewTxPoolJournal(args)
+	require.True(t, check.IfNil(journal))
+	require.Equal(t, dataRetriever.ErrNilPersister, err)
+
+	args = createDefaultTxPoolJournalArgs()
+	args.Marshalizer = nil
+	journal, err = NewTxPoolJournal(args)
+	require.True(t, check.IfNil(journal))
+	require.Equal(t, dataRetriever.ErrNilMarshalizer, err)
+
+	args = createDefaultTxPoolJournalArgs()
+	args.MaxNumTxs = 0
+	journal, err = NewTxPoolJournal(args)
+	require.True(t, check.IfNil(journal))
+	require.True(t, errors.Is(err, dataRetriever.ErrInvalidTxPoolJournalConfig))
+
+	args = createDefaultTxPoolJournalArgs()
+	args.MaxAge = 0
+	journal, err = NewTxPoolJournal(args)
+	require.True(t, check.IfNil(journal))
+	require.True(t, errors.Is(err, dataRetriever.ErrInvalidTxPoolJournalConfig))
+}
+
+func TestNewTxPoolJournal_ShouldCountTheRecordedTxs(t *testing.T) {
+	args := createDefaultTxPoolJournalArgs()
+	entryBuff, _ := args.Marshalizer.Marshal(&JournalEntry{CacheID: "0", Timestamp: 42})
+	_ = args.Persister.Put([]byte("hash-1"), entryBuff)
+	_ = args.Persister.Put([]byte("hash-2"), entryBuff)
+
+	journal, err := NewTxPoolJournal(args)
+	require.Nil(t, err)
+	require.False(t, check.IfNil(journal))
+	require.Len(t, journal.recorded, 2)
+}
+
+func TestNewTxPoolJournal_ShouldRemoveTheCorruptedEntries(t *testing.T) {
+	args := createDefaultTxPoolJournalArgs()
+	entryBuff, _ := args.Marshalizer.Marshal(&JournalEntry{CacheID: "0", Timestamp: 42})
+	_ = args.Persister.Put([]byte("hash-1"), entryBuff)
+	_ = args.Persister.Put([]byte("hash-corrupted"), []byte("corrupted entry"))
+
+	journal, _ := NewTxPoolJournal(args)
+	require.Equal(t, map[string]int64{"hash-1": 42}, journal.recorded)
+
+	journal.flush()
+	require.Nil(t, args.Persister.Has([]byte("hash-1")))
+	require.NotNil(t, args.Persister.Has([]byte("hash-corrupted")))
+}
+
+func TestTxPoolJournal_RecordAdditionAndRemoval(t *testing.T) {
+	args := createDefaultTxPoolJournalArgs()
+	journal, _ := NewTxPoolJournal(args)
+
+	journal.RecordAddition([]byte("hash-1"), createJournalTx("alice", 1, 0), "0")
+	journal.RecordAddition([]byte("hash-2"), createJournalTx("alice", 2, 0), "0_1")
+	require.Len(t, journal.recorded, 2)
+	require.NotNil(t, args.Persister.Has([]byte("hash-2")))
+
+	journal.flush()
+	buff, err := args.Persister.Get([]byte("hash-2"))
+	require.Nil(t, err)
+	entry := &JournalEntry{}
+	_ = args.Marshalizer.Unmarshal(entry, buff)
+	require.Equal(t, "0_1", entry.CacheID)
+	tx := &transaction.Transaction{}
+	_ = args.Marshalizer.Unmarshal(tx, entry.TxBuff)
+	require.Equal(t, uint64(2), tx.Nonce)
+
+	journal.RecordRemoval([]byte("hash-1"))
+	journal.RecordRemoval([]byte("missing hash"))
+	require.Len(t, journal.recorded, 1)
+	require.Nil(t, args.Persister.Has([]byte("hash-1")))
+
+	journal.flush()
+	require.NotNil(t, args.Persister.Has([]byte("hash-1")))
+	require.Len(t, journal.pending, 0)
+}
+
+func TestTxPoolJournal_RecordAdditionShouldKeepTheInitialTimestamp(t *testing.T) {
+	args := createDefaultTxPoolJournalArgs()
+	entryBuff, _ := args.Marshalizer.Marshal(&JournalEntry{CacheID: "0", Timestamp: 42})
+	_ = args.Persister.Put([]byte("hash-1"), entryBuff)
+	journal, _ := NewTxPoolJournal(args)
+
+	journal.RecordAddition([]byte("hash-1"), createJournalTx("alice", 1, 0), "0")
+	journal.flush()
+
+	buff, _ := args.Persister.Get([]byte("hash-1"))
+	require.Equal(t, entryBuff, buff)
+}
+
+func TestTxPoolJournal_RecordAdditionShouldNotExceedMaxNumTxs(t *testing.T) {
+	args := createDefaultTxPoolJournalArgs()
+	args.MaxNumTxs = 2
+	journal, _ := NewTxPoolJournal(args)
+
+	journal.RecordAddition([]byte("hash-1"), createJournalTx("alice", 1, 0), "0")
+	journal.RecordAddition([]byte("hash-2"), createJournalTx("alice", 2, 0), "0")
+	journal.RecordAddition([]byte("hash-3"), createJournalTx("alice", 3, 0), "0")
+	require.Len(t, journal.recorded, 2)
+	journal.flush()
+	require.NotNil(t, args.Persister.Has([]byte("hash-3")))
+
+	journal.RecordRemoval([]byte("hash-1"))
+	journal.RecordAddition([]byte("hash-3"), createJournalTx("alice", 3, 0), "0")
+	journal.flush()
+	require.Nil(t, args.Persister.Has([]byte("hash-3")))
+}
+
+func TestTxPoolJournal_PruneShouldDropTheOldEntries(t *testing.T) {
+	args := createDefaultTxPoolJournalArgs()
+	entryBuff, _ := args.Marshalizer.Marshal(&JournalEntry{CacheID: "0", Timestamp: time.Now().Add(-2 * time.Hour).Unix()})
+	_ = args.Persister.Put([]byte("hash-old"), entryBuff)
+	journal, _ := NewTxPoolJournal(args)
+	journal.RecordAddition([]byte("hash-new"), createJournalTx("alice", 1, 0), "0")
+
+	journal.prune()
+	journal.flush()
+
+	require.Len(t, journal.recorded, 1)
+	require.NotNil(t, args.Persister.Has([]byte("hash-old")))
+	require.Nil(t, args.Persister.Has([]byte("hash-new")))
+}
+
+func TestTxPoolJournal_CloseShouldWriteTheBufferedChanges(t *testing.T) {
+	args := createDefaultTxPoolJournalArgs()
+	journal, _ := NewTxPoolJournal(args)
+	journal.RecordAddition([]byte("hash-1"), createJournalTx("alice", 1, 0), "0")
+
+	err := journal.Close()
+	require.Nil(t, err)
+
+	journal, _ = NewTxPoolJournal(args)
+	require.Len(t, journal.recorded, 1)
+}
+
+func TestTxPoolJournal_ReloadNilArgumentsShouldErr(t *testing.T) {
+	journal, _ := NewTxPoolJournal(createDefaultTxPoolJournalArgs())
+	pool, _ := newTxPoolToTest()
+
+	err := journal.Reload(nil, &mock.AccountsStub{})
+	require.Equal(t, dataRetriever.ErrNilTxPool, err)
+
+	err = journal.Reload(pool, nil)
+	require.Equal(t, dataRetriever.ErrNilAccountsAdapter, err)
+}
+
+func TestTxPoolJournal_ReloadShouldDropTheExpiredAndInvalidTxs(t *testing.T) {
+	args := createDefaultTxPoolJournalArgs()
+	journal, _ := NewTxPoolJournal(args)
+
+	journal.RecordAddition([]byte("hash-valid"), createJournalTx("alice", 5, 100), "0")
+	journal.RecordAddition([]byte("hash-lower-nonce"), createJournalTx("alice", 4, 100), "0")
+	journal.RecordAddition([]byte("hash-insufficient-balance"), createJournalTx("alice", 6, 1000), "0_1")
+	journal.RecordAddition([]byte("hash-missing-sender"), createJournalTx("bob", 1, 0), "0")
+	journal.RecordAddition([]byte("hash-cross-shard"), createJournalTx("carol", 1, 0), "1_0")
+	_ = journal.Close()
+	_ = args.Persister.Put([]byte("hash-corrupted"), []byte("corrupted entry"))
+
+	txBuff, _ := args.Marshalizer.Marshal(createJournalTx("alice", 7, 0))
+	expiredEntryBuff, _ := args.Marshalizer.Marshal(&JournalEntry{
+		CacheID:   "0",
+		Timestamp: time.Now().Add(-2 * time.Hour).Unix(),
+		TxBuff:    txBuff,
+	})
+	_ = args.Persister.Put([]byte("hash-expired"), expiredEntryBuff)
+	journal, _ = NewTxPoolJournal(args)
+	require.Len(t, journal.recorded, 6)
+
+	// alice's balance covers 100 value + 10 * 10 gas
+	accounts := createAccountsWithUserAccount("alice", 5, 200)
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	err := journal.Reload(pool, accounts)
+	require.Nil(t, err)
+
+	_, ok := pool.SearchFirstData([]byte("hash-valid"))
+	require.True(t, ok)
+	_, ok = pool.SearchFirstData([]byte("hash-cross-shard"))
+	require.True(t, ok)
+	require.Equal(t, int64(2), pool.GetCounts().GetTotal())
+
+	require.Len(t, journal.recorded, 2)
+	journal.flush()
+	require.Nil(t, args.Persister.Has([]byte("hash-valid")))
+	require.Nil(t, args.Persister.Has([]byte("hash-cross-shard")))
+	for _, droppedHash := range []string{"hash-lower-nonce", "hash-insufficient-balance", "hash-missing-sender", "hash-corrupted", "hash-expired"} {
+		require.NotNil(t, args.Persister.Has([]byte(droppedHash)), droppedHash)
+	}
+}
+
+func TestTxPoolJournal_ReloadShouldNotifyTheSendersNonces(t *testing.T) {
+	args := createDefaultTxPoolJournalArgs()
+	journal, _ := NewTxPoolJournal(args)
+	journal.RecordAddition([]byte("hash-valid"), createJournalTx("alice", 7, 0), "0")
+
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	err := journal.Reload(pool, createAccountsWithUserAccount("alice", 5, 200))
+	require.Nil(t, err)
+
+	// The initial nonce gap is known, so nothing is selected for alice
+	cache := pool.getTxCache("0").(*txcache.TxCache)
+	require.Len(t, cache.SelectTransactions(10, 10), 0)
+}
+
+func TestTxPoolJournal_ReloadShouldKeepTheAgeOfTheTxs(t *testing.T) {
+	args := createDefaultTxPoolJournalArgs()
+	recordedTxs := map[string]struct {
+		nonce      uint64
+		minutesAgo time.Duration
+	}{
+		"hash-old":    {nonce: 5, minutesAgo: 30},
+		"hash-recent": {nonce: 6, minutesAgo: 1},
+	}
+	for txHash, recordedTx := range recordedTxs {
+		txBuff, _ := args.Marshalizer.Marshal(createJournalTx("alice", recordedTx.nonce, 0))
+		entryBuff, _ := args.Marshalizer.Marshal(&JournalEntry{
+			CacheID:   "0",
+			Timestamp: time.Now().Add(-recordedTx.minutesAgo * time.Minute).Unix(),
+			TxBuff:    txBuff,
+		})
+		_ = args.Persister.Put([]byte(txHash), entryBuff)
+	}
+	journal, _ := NewTxPoolJournal(args)
+
+	config := storageUnit.CacheConfig{
+		Capacity:             100,
+		SizePerSender:        10,
+		SizeInBytes:          409600,
+		SizeInBytesPerSender: 40960,
+		Shards:               1,
+		MaxTxAgeInSeconds:    600,
+	}
+	poolAsInterface, _ := NewShardedTxPool(ArgShardedTxPool{Config: config, MinGasPrice: 200000000000, NumberOfShards: 4, SelfShardID: 0})
+	pool := poolAsInterface.(*shardedTxPool)
+	defer func() {
+		_ = pool.Close()
+	}()
+
+	err := journal.Reload(pool, createAccountsWithUserAccount("alice", 5, 1000))
+	require.Nil(t, err)
+	require.Equal(t, int64(2), pool.GetCounts().GetTotal())
+
+	pool.sweepExpired()
+
+	require.True(t, pool.HasExpiredTx([]byte("hash-old")))
+	require.False(t, pool.HasExpiredTx([]byte("hash-recent")))
+	_, ok := pool.SearchFirstData([]byte("hash-recent"))
+	require.True(t, ok)
+}
@@ -1,10 +1,14 @@
 package txpool
 
 import (
+	"context"
 	"strconv"
 	"sync"
+	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/close"
 	"github.com/ElrondNetwork/elrond-go/core/counting"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -15,6 +19,8 @@ import (
 
 var _ counting.Countable = (*shardedTxPool)(nil)
 var _ dataRetriever.ShardedDataCacherNotifier = (*shardedTxPool)(nil)
+var _ dataRetriever.TxPoolRemovalNotifier = (*shardedTxPool)(nil)
+var _ close.Closer = (*shardedTxPool)(nil)
 
 var log = logger.GetOrCreate("txpool")
 
@@ -24,9 +30,14 @@ type shardedTxPool struct {
 	backingMap                   map[string]*txPoolShard
 	mutexAddCallbacks            sync.RWMutex
 	onAddCallbacks               []func(key []byte, value interface{})
+	mutexRemovalHandlers         sync.RWMutex
+	removalHandlers              []func(txHashes [][]byte)
 	configPrototypeDestinationMe txcache.ConfigDestinationMe
 	configPrototypeSourceMe      txcache.ConfigSourceMe
 	selfShardID                  uint32
+	journal                      dataRetriever.TxPoolJournal
+	timeLockedTxs                *timeLockedTxsHolder
+	cancelFunc                   func()
 }
 
 type txPoolShard struct {
@@ -50,14 +61,17 @@ func NewShardedTxPool(args ArgShardedTxPool) (dataRetriever.ShardedDataCacherNot
 	halfOfCapacity := args.Config.Capacity / 2
 
 	configPrototypeSourceMe := txcache.ConfigSourceMe{
-		NumChunks:                     args.Config.Shards,
-		EvictionEnabled:               true,
-		NumBytesThreshold:             uint32(halfOfSizeInBytes),
-		CountThreshold:                halfOfCapacity,
-		NumBytesPerSenderThreshold:    args.Config.SizeInBytesPerSender,
-		CountPerSenderThreshold:       args.Config.SizePerSender,
-		NumSendersToPreemptivelyEvict: dataRetriever.TxPoolNumSendersToPreemptivelyEvict,
-		MinGasPriceNanoErd:            uint32(args.MinGasPrice / oneBillion),
+		NumChunks:                            args.Config.Shards,
+		EvictionEnabled:                      true,
+		NumBytesThreshold:                    uint32(halfOfSizeInBytes),
+		CountThreshold:                       halfOfCapacity,
+		NumBytesPerSenderThreshold:           args.Config.SizeInBytesPerSender,
+		CountPerSenderThreshold:              args.Config.SizePerSender,
+		NumSendersToPreemptivelyEvict:        dataRetriever.TxPoolNumSendersToPreemptivelyEvict,
+		MinGasPriceNanoErd:                   uint32(args.MinGasPrice / oneBillion),
+		ReplacementMinGasPriceBumpPercentage: args.Config.ReplacementMinGasPriceBumpPercentage,
+		MaxTxAgeInSeconds:                    args.Config.MaxTxAgeInSeconds,
+		SelectionStrategy:                    txcache.SelectionStrategy(args.Config.SelectionStrategy),
 	}
 
 	//  NumberOfShards - 1 (for self shard) + 1 (for metachain)
@@ -70,19 +84,73 @@ func NewShardedTxPool(args ArgShardedTxPool) (dataRetriever.ShardedDataCacherNot
 		NumItemsToPreemptivelyEvict: dataRetriever.TxPoolNumTxsToPreemptivelyEvict,
 	}
 
+	journal := args.Journal
+	if check.IfNil(journal) {
+		journal = NewDisabledJournal()
+	}
+
 	shardedTxPoolObject := &shardedTxPool{
 		mutexBackingMap:              sync.RWMutex{},
 		backingMap:                   make(map[string]*txPoolShard),
 		mutexAddCallbacks:            sync.RWMutex{},
 		onAddCallbacks:               make([]func(key []byte, value interface{}), 0),
+		removalHandlers:              make([]func(txHashes [][]byte), 0),
 		configPrototypeDestinationMe: configPrototypeDestinationMe,
 		configPrototypeSourceMe:      configPrototypeSourceMe,
 		selfShardID:                  args.SelfShardID,
+		journal:                      journal,
+		timeLockedTxs:                newTimeLockedTxsHolder(dataRetriever.TxPoolMaxNumTimeLockedTxs, dataRetriever.TxPoolMaxRoundsToHoldTimeLockedTxs),
+	}
+
+	if args.Config.MaxTxAgeInSeconds > 0 {
+		var ctx context.Context
+		ctx, shardedTxPoolObject.cancelFunc = context.WithCancel(context.Background())
+		go shardedTxPoolObject.sweepExpiredLoop(ctx, dataRetriever.TxPoolSweepExpiredInterval)
 	}
 
 	return shardedTxPoolObject, nil
 }
 
+// sweepExpiredLoop removes the expired transactions periodically, independently of the selections, until the pool
+// is closed
+func (txPool *shardedTxPool) sweepExpiredLoop(ctx context.Context, interval time.Duration) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("shardedTxPool's sweep expired go routine is stopping...")
+			return
+		case <-time.After(interval):
+		}
+
+		txPool.sweepExpired()
+	}
+}
+
+// Close stops the go routine which removes the expired transactions
+func (txPool *shardedTxPool) Close() error {
+	if txPool.cancelFunc != nil {
+		txPool.cancelFunc()
+	}
+
+	return nil
+}
+
+func (txPool *shardedTxPool) sweepExpired() {
+	txPool.mutexBackingMap.RLock()
+	caches := make([]txCache, 0, len(txPool.backingMap))
+	for _, shard := range txPool.backingMap {
+		caches = append(caches, shard.Cache)
+	}
+	txPool.mutexBackingMap.RUnlock()
+
+	for _, cache := range caches {
+		sweeper, ok := cache.(expiredTxsSweeper)
+		if ok {
+			sweeper.SweepExpired()
+		}
+	}
+}
+
 // ShardDataStore returns the requested cache, as the generic Cacher interface
 func (txPool *shardedTxPool) ShardDataStore(cacheID string) storage.Cacher {
 	cache := txPool.getTxCache(cacheID)
@@ -117,6 +185,7 @@ func (txPool *shardedTxPool) createShard(cacheID string) *txPoolShard {
 	shard, ok := txPool.backingMap[cacheID]
 	if !ok {
 		cache := txPool.createTxCache(cacheID)
+		cache.RegisterRemovalHandler(txPool.onRemovedByCache)
 		shard = &txPoolShard{
 			CacheID: cacheID,
 			Cache:   cache,
@@ -154,14 +223,51 @@ func (txPool *shardedTxPool) createTxCache(cacheID string) txCache {
 	return cache
 }
 
+// onRemovedByCache is notified about the transactions evicted, replaced or expired inside the caches
+func (txPool *shardedTxPool) onRemovedByCache(txHashes [][]byte) {
+	for _, txHash := range txHashes {
+		txPool.journal.RecordRemoval(txHash)
+	}
+
+	txPool.mutexRemovalHandlers.RLock()
+	defer txPool.mutexRemovalHandlers.RUnlock()
+
+	for _, handler := range txPool.removalHandlers {
+		handler(txHashes)
+	}
+}
+
+// RegisterRemovalHandler registers a new handler to be called when transactions are removed by the caches themselves,
+// so that the components holding the hashes of the pooled transactions (such as the white lists) can forget them
+func (txPool *shardedTxPool) RegisterRemovalHandler(handler func(txHashes [][]byte)) {
+	if handler == nil {
+		log.Error("attempt to register a nil removal handler")
+		return
+	}
+
+	txPool.mutexRemovalHandlers.Lock()
+	txPool.removalHandlers = append(txPool.removalHandlers, handler)
+	txPool.mutexRemovalHandlers.Unlock()
+}
+
 // ImmunizeSetOfDataAgainstEviction marks the items as non-evictable
 func (txPool *shardedTxPool) ImmunizeSetOfDataAgainstEviction(keys [][]byte, cacheID string) {
 	shard := txPool.getOrCreateShard(cacheID)
 	shard.Cache.ImmunizeTxsAgainstEviction(keys)
 }
 
-// AddData adds the transaction to the cache
+// AddData adds the transaction to the cache. The transactions which can not be executed yet, because of their time
+// lock, are kept in a separate holding area until they get released
 func (txPool *shardedTxPool) AddData(key []byte, value interface{}, _ int, cacheID string) {
+	txPool.addData(key, value, cacheID, 0)
+}
+
+// addReloadedData adds a transaction reloaded from the journal, which keeps the moment it was first added in the pool
+func (txPool *shardedTxPool) addReloadedData(key []byte, value interface{}, cacheID string, insertionTimestamp int64) {
+	txPool.addData(key, value, cacheID, insertionTimestamp)
+}
+
+func (txPool *shardedTxPool) addData(key []byte, value interface{}, cacheID string, insertionTimestamp int64) {
 	valueAsTransaction, ok := value.(data.TransactionHandler)
 	if !ok {
 		return
@@ -179,6 +285,17 @@ func (txPool *shardedTxPool) AddData(key []byte, value interface{}, _ int, cache
 		SenderShardID:   sourceShardID,
 		ReceiverShardID: destinationShardID,
 	}
+	wrapper.SetInsertionTimestamp(insertionTimestamp)
+
+	isHeld, err := txPool.timeLockedTxs.hold(wrapper, cacheID)
+	if err != nil {
+		log.Debug("shardedTxPool.AddData(): time locked tx dropped", "txHash", wrapper.TxHash, "error", err)
+		return
+	}
+	if isHeld {
+		txPool.journal.RecordAddition(wrapper.TxHash, wrapper.Tx, cacheID)
+		return
+	}
 
 	txPool.addTx(wrapper, cacheID)
 }
@@ -187,7 +304,13 @@ func (txPool *shardedTxPool) AddData(key []byte, value interface{}, _ int, cache
 func (txPool *shardedTxPool) addTx(tx *txcache.WrappedTransaction, cacheID string) {
 	shard := txPool.getOrCreateShard(cacheID)
 	cache := shard.Cache
-	_, added := cache.AddTx(tx)
+
+	// The addition is recorded first, so that a transaction evicted by the cache right away is removed from the journal
+	txPool.journal.RecordAddition(tx.TxHash, tx.Tx, cacheID)
+	ok, added := cache.AddTx(tx)
+	if !ok {
+		txPool.journal.RecordRemoval(tx.TxHash)
+	}
 	if added {
 		txPool.onAdded(tx.TxHash, tx)
 	}
@@ -202,10 +325,36 @@ func (txPool *shardedTxPool) onAdded(key []byte, value interface{}) {
 	}
 }
 
+// ReleaseTimeLockedTxs moves the transactions executable at the provided round and epoch from the holding area to
+// the caches the transactions are selected from. The round and the epoch should be the ones of a committed block
+func (txPool *shardedTxPool) ReleaseTimeLockedTxs(round uint64, epoch uint32) {
+	released, expired := txPool.timeLockedTxs.release(round, epoch)
+	for _, heldTx := range released {
+		txPool.addTx(heldTx.tx, heldTx.cacheID)
+	}
+	for _, heldTx := range expired {
+		txPool.journal.RecordRemoval(heldTx.tx.TxHash)
+	}
+
+	if len(released) > 0 || len(expired) > 0 {
+		log.Debug("shardedTxPool.ReleaseTimeLockedTxs()", "round", round, "epoch", epoch,
+			"numReleased", len(released), "numExpired", len(expired), "numStillHeld", txPool.timeLockedTxs.len())
+	}
+}
+
 // SearchFirstData searches the transaction against all shard data store, retrieving the first found
 func (txPool *shardedTxPool) SearchFirstData(key []byte) (interface{}, bool) {
 	tx, ok := txPool.searchFirstTx(key)
-	return tx, ok
+	if ok {
+		return tx, true
+	}
+
+	heldTx, ok := txPool.timeLockedTxs.get(key)
+	if ok {
+		return heldTx.Tx, true
+	}
+
+	return nil, false
 }
 
 // searchFirstTx searches the transaction against all shard data store, retrieving the first found
@@ -225,6 +374,21 @@ func (txPool *shardedTxPool) searchFirstTx(txHash []byte) (tx data.TransactionHa
 	return nil, false
 }
 
+// HasExpiredTx returns whether the transaction was recently removed from the pool because it was too old
+func (txPool *shardedTxPool) HasExpiredTx(txHash []byte) bool {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	for _, shard := range txPool.backingMap {
+		cache, ok := shard.Cache.(expiredTxsHolder)
+		if ok && cache.HasExpiredTx(txHash) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // RemoveData removes the transaction from the pool
 func (txPool *shardedTxPool) RemoveData(key []byte, cacheID string) {
 	txPool.removeTx(key, cacheID)
@@ -233,7 +397,9 @@ func (txPool *shardedTxPool) RemoveData(key []byte, cacheID string) {
 // removeTx removes the transaction from the pool
 func (txPool *shardedTxPool) removeTx(txHash []byte, cacheID string) bool {
 	shard := txPool.getOrCreateShard(cacheID)
-	return shard.Cache.RemoveTxByHash(txHash)
+	txPool.journal.RecordRemoval(txHash)
+	removedFromHoldingArea := txPool.timeLockedTxs.remove(txHash)
+	return shard.Cache.RemoveTxByHash(txHash) || removedFromHoldingArea
 }
 
 // RemoveSetOfDataFromPool removes a bunch of transactions from the pool
@@ -260,6 +426,9 @@ func (txPool *shardedTxPool) RemoveDataFromAllShards(key []byte) {
 
 // removeTxFromAllShards removes the transaction from the pool (it searches in all shards)
 func (txPool *shardedTxPool) removeTxFromAllShards(txHash []byte) {
+	txPool.journal.RecordRemoval(txHash)
+	_ = txPool.timeLockedTxs.remove(txHash)
+
 	txPool.mutexBackingMap.RLock()
 	defer txPool.mutexBackingMap.RUnlock()
 
@@ -291,6 +460,8 @@ func (txPool *shardedTxPool) Clear() {
 	txPool.mutexBackingMap.Lock()
 	txPool.backingMap = make(map[string]*txPoolShard)
 	txPool.mutexBackingMap.Unlock()
+
+	txPool.timeLockedTxs.clear()
 }
 
 // ClearShardStore clears a specific cache
@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -12,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/stretchr/testify/require"
 )
 
@@ -87,7 +89,7 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 }
 
 func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
-	config := storageUnit.CacheConfig{SizeInBytes: 419430400, SizeInBytesPerSender: 614400, Capacity: 600000, SizePerSender: 1000, Shards: 1}
+	config := storageUnit.CacheConfig{SizeInBytes: 419430400, SizeInBytesPerSender: 614400, Capacity: 600000, SizePerSender: 1000, Shards: 1, ReplacementMinGasPriceBumpPercentage: 10, SelectionStrategy: "FeeMarket"}
 	args := ArgShardedTxPool{Config: config, MinGasPrice: 200000000000, NumberOfShards: 2}
 
 	poolAsInterface, err := NewShardedTxPool(args)
@@ -102,6 +104,8 @@ func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
 	require.Equal(t, 100, int(pool.configPrototypeSourceMe.NumSendersToPreemptivelyEvict))
 	require.Equal(t, 200, int(pool.configPrototypeSourceMe.MinGasPriceNanoErd))
 	require.Equal(t, 300000, int(pool.configPrototypeSourceMe.CountThreshold))
+	require.Equal(t, 10, int(pool.configPrototypeSourceMe.ReplacementMinGasPriceBumpPercentage))
+	require.Equal(t, txcache.FeeMarketSelection, pool.configPrototypeSourceMe.SelectionStrategy)
 
 	require.Equal(t, 150000, int(pool.configPrototypeDestinationMe.MaxNumItems))
 	require.Equal(t, 104857600, int(pool.configPrototypeDestinationMe.MaxNumBytes))
@@ -192,6 +196,103 @@ func Test_AddData_CallsOnAddedHandlers(t *testing.T) {
 	require.Equal(t, uint32(1), atomic.LoadUint32(&numAdded))
 }
 
+func Test_AddData_ReplacesTransactionWhenGasPriceBumpedEnough(t *testing.T) {
+	config := storageUnit.CacheConfig{
+		Capacity:                             100,
+		SizePerSender:                        10,
+		SizeInBytes:                          409600,
+		SizeInBytesPerSender:                 40960,
+		Shards:                               1,
+		ReplacementMinGasPriceBumpPercentage: 10,
+	}
+	args := ArgShardedTxPool{Config: config, MinGasPrice: 200000000000, NumberOfShards: 4, SelfShardID: 0}
+	poolAsInterface, _ := NewShardedTxPool(args)
+	pool := poolAsInterface.(*shardedTxPool)
+
+	addedKeys := make(chan string, 10)
+	pool.RegisterHandler(func(key []byte, value interface{}) {
+		addedKeys <- string(key)
+	})
+	removedKeys := make([]string, 0)
+	pool.RegisterRemovalHandler(func(txHashes [][]byte) {
+		for _, txHash := range txHashes {
+			removedKeys = append(removedKeys, string(txHash))
+		}
+	})
+
+	pool.AddData([]byte("hash-x"), createTxWithGasPrice("alice", 42, 200000000000), 0, "0_1")
+	pool.AddData([]byte("hash-y"), createTxWithGasPrice("alice", 42, 210000000000), 0, "0_2")
+	pool.AddData([]byte("hash-z"), createTxWithGasPrice("alice", 42, 240000000000), 0, "0")
+
+	waitABit()
+	require.Equal(t, 3, len(addedKeys))
+	require.ElementsMatch(t, []string{"hash-x", "hash-y", "hash-z"}, []string{<-addedKeys, <-addedKeys, <-addedKeys})
+
+	// "hash-y" was not enough of a bump for "hash-x", while "hash-z" replaces both
+	require.ElementsMatch(t, []string{"hash-x", "hash-y"}, removedKeys)
+	require.Equal(t, 1, pool.getTxCache("0").Len())
+	require.Equal(t, int64(1), pool.GetCounts().GetTotal())
+	require.Len(t, pool.getTxCache("0").(*txcache.TxCache).SelectTransactions(10, 10), 1)
+
+	// The replaced transactions are still searchable by hash, until removed from the pool
+	_, ok := pool.SearchFirstData([]byte("hash-x"))
+	require.True(t, ok)
+	_, ok = pool.SearchFirstData([]byte("hash-z"))
+	require.True(t, ok)
+
+	pool.RemoveSetOfDataFromPool([][]byte{[]byte("hash-x"), []byte("hash-y")}, "0")
+	_, ok = pool.SearchFirstData([]byte("hash-x"))
+	require.False(t, ok)
+	_, ok = pool.SearchFirstData([]byte("hash-y"))
+	require.False(t, ok)
+}
+
+func Test_AddData_And_RemoveData_ShouldRecordInJournal(t *testing.T) {
+	journalArgs := createDefaultTxPoolJournalArgs()
+	journal, _ := NewTxPoolJournal(journalArgs)
+	config := storageUnit.CacheConfig{
+		Capacity:             100,
+		SizePerSender:        10,
+		SizeInBytes:          409600,
+		SizeInBytesPerSender: 40960,
+		Shards:               1,
+	}
+	args := ArgShardedTxPool{Config: config, MinGasPrice: 200000000000, NumberOfShards: 4, SelfShardID: 0, Journal: journal}
+	poolAsInterface, _ := NewShardedTxPool(args)
+
+	poolAsInterface.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	poolAsInterface.AddData([]byte("hash-y"), createTx("alice", 43), 0, "0_1")
+	poolAsInterface.AddData([]byte("hash-z"), createTx("bob", 42), 0, "2_0")
+	require.Len(t, journal.recorded, 3)
+
+	poolAsInterface.RemoveData([]byte("hash-x"), "0")
+	poolAsInterface.RemoveSetOfDataFromPool([][]byte{[]byte("hash-y")}, "0_1")
+	poolAsInterface.RemoveDataFromAllShards([]byte("hash-z"))
+	require.Len(t, journal.recorded, 0)
+}
+
+func Test_AddData_RemovedByTheCacheShouldBeRemovedFromJournal(t *testing.T) {
+	journal, _ := NewTxPoolJournal(createDefaultTxPoolJournalArgs())
+	config := storageUnit.CacheConfig{
+		Capacity:             100,
+		SizePerSender:        2,
+		SizeInBytes:          409600,
+		SizeInBytesPerSender: 40960,
+		Shards:               1,
+	}
+	args := ArgShardedTxPool{Config: config, MinGasPrice: 200000000000, NumberOfShards: 4, SelfShardID: 0, Journal: journal}
+	poolAsInterface, _ := NewShardedTxPool(args)
+
+	poolAsInterface.AddData([]byte("hash-alice-1"), createTx("alice", 1), 0, "0")
+	poolAsInterface.AddData([]byte("hash-alice-2"), createTx("alice", 2), 0, "0")
+	poolAsInterface.AddData([]byte("hash-alice-3"), createTx("alice", 3), 0, "0")
+
+	// The transaction with the highest nonce is evicted, as alice exceeds her count limit
+	_, isRecorded := journal.recorded["hash-alice-3"]
+	require.False(t, isRecorded)
+	require.Len(t, journal.recorded, 2)
+}
+
 func Test_SearchFirstData(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
@@ -214,6 +315,160 @@ func Test_SearchFirstData(t *testing.T) {
 	require.Equal(t, tx, foundTx)
 }
 
+func Test_AddData_TimeLockedTxShouldBeHeldUntilReleased(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	cache := pool.getTxCache("0")
+
+	tx := &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, NotBeforeRound: 10, NotBeforeEpoch: 1}
+	pool.AddData([]byte("hash-x"), tx, 0, "0")
+	require.Equal(t, 0, cache.Len())
+	require.Equal(t, 1, pool.timeLockedTxs.len())
+
+	foundTx, ok := pool.SearchFirstData([]byte("hash-x"))
+	require.True(t, ok)
+	require.Equal(t, tx, foundTx)
+
+	pool.ReleaseTimeLockedTxs(10, 0)
+	require.Equal(t, 0, cache.Len())
+	require.Equal(t, 1, pool.timeLockedTxs.len())
+
+	pool.ReleaseTimeLockedTxs(10, 1)
+	require.Equal(t, 1, cache.Len())
+	require.Equal(t, 0, pool.timeLockedTxs.len())
+
+	foundTx, ok = pool.SearchFirstData([]byte("hash-x"))
+	require.True(t, ok)
+	require.Equal(t, tx, foundTx)
+}
+
+func Test_AddData_TimeLockedTxExecutableAtLastReleaseShouldNotBeHeld(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	cache := pool.getTxCache("0")
+
+	pool.ReleaseTimeLockedTxs(20, 2)
+	pool.AddData([]byte("hash-x"), &transaction.Transaction{SndAddr: []byte("alice"), NotBeforeRound: 15}, 0, "0")
+	require.Equal(t, 1, cache.Len())
+	require.Equal(t, 0, pool.timeLockedTxs.len())
+}
+
+func Test_AddData_TimeLockedTxShouldBeDroppedWhenHoldingAreaIsFull(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	pool.timeLockedTxs = newTimeLockedTxsHolder(1, dataRetriever.TxPoolMaxRoundsToHoldTimeLockedTxs)
+
+	pool.AddData([]byte("hash-x"), &transaction.Transaction{SndAddr: []byte("alice"), NotBeforeRound: 10}, 0, "0")
+	pool.AddData([]byte("hash-y"), &transaction.Transaction{SndAddr: []byte("bob"), NotBeforeRound: 10}, 0, "0")
+	require.Equal(t, 1, pool.timeLockedTxs.len())
+
+	_, ok := pool.SearchFirstData([]byte("hash-y"))
+	require.False(t, ok)
+
+	isHeld, err := pool.timeLockedTxs.hold(&txcache.WrappedTransaction{
+		Tx:     &transaction.Transaction{SndAddr: []byte("carol"), NotBeforeRound: 10},
+		TxHash: []byte("hash-z"),
+	}, "0")
+	require.False(t, isHeld)
+	require.Equal(t, dataRetriever.ErrTimeLockedTxsHoldingAreaFull, err)
+}
+
+func Test_ReleaseTimeLockedTxs_ShouldDropExpiredTxs(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	pool.timeLockedTxs = newTimeLockedTxsHolder(dataRetriever.TxPoolMaxNumTimeLockedTxs, 5)
+	cache := pool.getTxCache("0")
+
+	pool.AddData([]byte("hash-x"), &transaction.Transaction{SndAddr: []byte("alice"), NotBeforeEpoch: 1}, 0, "0")
+	require.Equal(t, 1, pool.timeLockedTxs.len())
+
+	// the transactions held before the first release count their rounds from the first released round
+	pool.ReleaseTimeLockedTxs(100, 0)
+	pool.ReleaseTimeLockedTxs(105, 0)
+	require.Equal(t, 1, pool.timeLockedTxs.len())
+
+	pool.ReleaseTimeLockedTxs(106, 0)
+	require.Equal(t, 0, pool.timeLockedTxs.len())
+	require.Equal(t, 0, cache.Len())
+
+	_, ok := pool.SearchFirstData([]byte("hash-x"))
+	require.False(t, ok)
+}
+
+func Test_RemoveData_ShouldRemoveTimeLockedTx(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), &transaction.Transaction{SndAddr: []byte("alice"), NotBeforeRound: 10}, 0, "0")
+	pool.AddData([]byte("hash-y"), &transaction.Transaction{SndAddr: []byte("bob"), NotBeforeRound: 10}, 0, "0")
+	require.Equal(t, 2, pool.timeLockedTxs.len())
+
+	pool.RemoveData([]byte("hash-x"), "0")
+	pool.RemoveDataFromAllShards([]byte("hash-y"))
+	require.Equal(t, 0, pool.timeLockedTxs.len())
+
+	_, ok := pool.SearchFirstData([]byte("hash-x"))
+	require.False(t, ok)
+}
+
+func Test_HasExpiredTx(t *testing.T) {
+	config := storageUnit.CacheConfig{
+		Capacity:             100,
+		SizePerSender:        10,
+		SizeInBytes:          409600,
+		SizeInBytesPerSender: 40960,
+		Shards:               1,
+		MaxTxAgeInSeconds:    1,
+	}
+	args := ArgShardedTxPool{Config: config, MinGasPrice: 200000000000, NumberOfShards: 4, SelfShardID: 0}
+	poolAsInterface, _ := NewShardedTxPool(args)
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("bob", 43), 0, "0_1")
+	require.False(t, pool.HasExpiredTx([]byte("hash-x")))
+
+	time.Sleep(1100 * time.Millisecond)
+	pool.AddData([]byte("hash-z"), createTx("carol", 44), 0, "0")
+	pool.sweepExpired()
+
+	require.True(t, pool.HasExpiredTx([]byte("hash-x")))
+	require.True(t, pool.HasExpiredTx([]byte("hash-y")))
+	require.False(t, pool.HasExpiredTx([]byte("hash-z")))
+	_, ok := pool.SearchFirstData([]byte("hash-x"))
+	require.False(t, ok)
+	_, ok = pool.SearchFirstData([]byte("hash-z"))
+	require.True(t, ok)
+}
+
+func Test_CloseShouldStopTheSweepExpiredLoop(t *testing.T) {
+	pool := &shardedTxPool{backingMap: make(map[string]*txPoolShard)}
+	ctx, cancel := context.WithCancel(context.Background())
+	pool.cancelFunc = cancel
+
+	loopStopped := make(chan struct{})
+	go func() {
+		pool.sweepExpiredLoop(ctx, time.Millisecond)
+		close(loopStopped)
+	}()
+
+	err := pool.Close()
+	require.Nil(t, err)
+
+	select {
+	case <-loopStopped:
+	case <-time.After(time.Second):
+		require.Fail(t, "the sweep expired loop should have been stopped")
+	}
+}
+
+func Test_CloseWithoutSweepExpiredLoopShouldNotPanic(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	require.Nil(t, pool.Close())
+}
+
 func Test_RemoveData(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
@@ -366,6 +621,15 @@ func createTx(sender string, nonce uint64) data.TransactionHandler {
 	}
 }
 
+func createTxWithGasPrice(sender string, nonce uint64, gasPrice uint64) data.TransactionHandler {
+	return &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		GasLimit: 50000,
+	}
+}
+
 func waitABit() {
 	time.Sleep(10 * time.Millisecond)
 }
@@ -0,0 +1,141 @@
+package txpool
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+// timeLockedTransaction defines the transactions which might not be executable before a given round or epoch
+type timeLockedTransaction interface {
+	IsTimeLocked() bool
+	CanBeExecutedAt(round uint64, epoch uint32) bool
+}
+
+type timeLockedTx struct {
+	tx          *txcache.WrappedTransaction
+	cacheID     string
+	heldAtRound uint64
+}
+
+// timeLockedTxsHolder is the holding area of the pool for the transactions whose time lock is not yet satisfied. These
+// transactions are kept outside the caches the transactions are selected from, until they get released or expire
+type timeLockedTxsHolder struct {
+	mutTxs       sync.RWMutex
+	txs          map[string]*timeLockedTx
+	maxNumTxs    int
+	maxNumRounds uint64
+	isRoundKnown bool
+	lastRound    uint64
+	lastEpoch    uint32
+}
+
+func newTimeLockedTxsHolder(maxNumTxs int, maxNumRounds uint64) *timeLockedTxsHolder {
+	return &timeLockedTxsHolder{
+		txs:          make(map[string]*timeLockedTx),
+		maxNumTxs:    maxNumTxs,
+		maxNumRounds: maxNumRounds,
+	}
+}
+
+// hold keeps the transaction in the holding area, if it is not executable at the last released round and epoch. An
+// error is returned if the transaction should be held, but the holding area is full
+func (holder *timeLockedTxsHolder) hold(tx *txcache.WrappedTransaction, cacheID string) (bool, error) {
+	lockedTx, ok := tx.Tx.(timeLockedTransaction)
+	if !ok || !lockedTx.IsTimeLocked() {
+		return false, nil
+	}
+
+	holder.mutTxs.Lock()
+	defer holder.mutTxs.Unlock()
+
+	if lockedTx.CanBeExecutedAt(holder.lastRound, holder.lastEpoch) {
+		return false, nil
+	}
+
+	_, exists := holder.txs[string(tx.TxHash)]
+	if exists {
+		return true, nil
+	}
+	if len(holder.txs) >= holder.maxNumTxs {
+		return false, dataRetriever.ErrTimeLockedTxsHoldingAreaFull
+	}
+
+	holder.txs[string(tx.TxHash)] = &timeLockedTx{
+		tx:          tx,
+		cacheID:     cacheID,
+		heldAtRound: holder.lastRound,
+	}
+
+	return true, nil
+}
+
+// release removes from the holding area and returns the transactions executable at the provided round and epoch. The
+// transactions held for more than the maximum number of rounds are dropped
+func (holder *timeLockedTxsHolder) release(round uint64, epoch uint32) (released []*timeLockedTx, expired []*timeLockedTx) {
+	holder.mutTxs.Lock()
+	defer holder.mutTxs.Unlock()
+
+	released = make([]*timeLockedTx, 0)
+	expired = make([]*timeLockedTx, 0)
+	for txHash, heldTx := range holder.txs {
+		if !holder.isRoundKnown {
+			// the transactions held before the first release start counting their rounds from the first known round
+			heldTx.heldAtRound = round
+		}
+
+		lockedTx, ok := heldTx.tx.Tx.(timeLockedTransaction)
+		if ok && !lockedTx.CanBeExecutedAt(round, epoch) {
+			if round > heldTx.heldAtRound+holder.maxNumRounds {
+				expired = append(expired, heldTx)
+				delete(holder.txs, txHash)
+			}
+			continue
+		}
+
+		released = append(released, heldTx)
+		delete(holder.txs, txHash)
+	}
+
+	holder.isRoundKnown = true
+	holder.lastRound = round
+	holder.lastEpoch = epoch
+
+	return released, expired
+}
+
+func (holder *timeLockedTxsHolder) get(txHash []byte) (*txcache.WrappedTransaction, bool) {
+	holder.mutTxs.RLock()
+	defer holder.mutTxs.RUnlock()
+
+	heldTx, ok := holder.txs[string(txHash)]
+	if !ok {
+		return nil, false
+	}
+
+	return heldTx.tx, true
+}
+
+func (holder *timeLockedTxsHolder) remove(txHash []byte) bool {
+	holder.mutTxs.Lock()
+	defer holder.mutTxs.Unlock()
+
+	_, ok := holder.txs[string(txHash)]
+	delete(holder.txs, string(txHash))
+
+	return ok
+}
+
+func (holder *timeLockedTxsHolder) clear() {
+	holder.mutTxs.Lock()
+	holder.txs = make(map[string]*timeLockedTx)
+	holder.mutTxs.Unlock()
+}
+
+func (holder *timeLockedTxsHolder) len() int {
+	holder.mutTxs.RLock()
+	defer holder.mutTxs.RUnlock()
+
+	return len(holder.txs)
+}
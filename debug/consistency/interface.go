@@ -0,0 +1,12 @@
+package consistency
+
+import "github.com/ElrondNetwork/elrond-go/data/trie"
+
+// TrieConsistencyChecker defines the behavior of a component able to check the consistency of a trie
+type TrieConsistencyChecker interface {
+	Name() string
+	Check() (trie.ConsistencyCheckStatistics, error)
+	Statistics() trie.ConsistencyCheckStatistics
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,58 @@
+package consistency
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/debug"
+)
+
+type rootHashProvider struct {
+	chainHandler  data.ChainHandler
+	getRootHash func(header data.HeaderHandler) []byte
+}
+
+// NewAccountsRootHashProvider creates a root hash provider returning the accounts state root hash
+// of the last committed block
+func NewAccountsRootHashProvider(chainHandler data.ChainHandler) (*rootHashProvider, error) {
+	return newRootHashProvider(chainHandler, func(header data.HeaderHandler) []byte {
+		return header.GetRootHash()
+	})
+}
+
+// NewValidatorStatisticsRootHashProvider creates a root hash provider returning the validator statistics
+// root hash of the last committed block
+func NewValidatorStatisticsRootHashProvider(chainHandler data.ChainHandler) (*rootHashProvider, error) {
+	return newRootHashProvider(chainHandler, func(header data.HeaderHandler) []byte {
+		return header.GetValidatorStatsRootHash()
+	})
+}
+
+func newRootHashProvider(chainHandler data.ChainHandler, getRootHash func(header data.HeaderHandler) []byte) (*rootHashProvider, error) {
+	if check.IfNil(chainHandler) {
+		return nil, debug.ErrNilChainHandler
+	}
+
+	return &rootHashProvider{
+		chainHandler:  chainHandler,
+		getRootHash: getRootHash,
+	}, nil
+}
+
+// RootHash returns the root hash found in the current block header or, if no block was committed yet,
+// the one found in the genesis header
+func (rhp *rootHashProvider) RootHash() []byte {
+	header := rhp.chainHandler.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		header = rhp.chainHandler.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return nil
+	}
+
+	return rhp.getRootHash(header)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rhp *rootHashProvider) IsInterfaceNil() bool {
+	return rhp == nil
+}
@@ -0,0 +1,154 @@
+package consistency
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/debug"
+)
+
+var log = logger.GetOrCreate("debug/consistency")
+
+const minCheckInterval = time.Second
+
+// QueryCheck is the query string used to trigger a new consistency check of all tries
+const QueryCheck = "check"
+
+// triesChecker periodically runs the registered trie consistency checkers
+type triesChecker struct {
+	checkInterval time.Duration
+	mutCheckers   sync.RWMutex
+	checkers      []TrieConsistencyChecker
+	chanCheckNow  chan struct{}
+	cancelFunc    func()
+}
+
+// NewTriesChecker creates a component that runs, in background, the consistency checks of the registered tries
+func NewTriesChecker(checkInterval time.Duration) (*triesChecker, error) {
+	if checkInterval < minCheckInterval {
+		return nil, fmt.Errorf("%w for the check interval, minimum is %v", debug.ErrInvalidValue, minCheckInterval)
+	}
+
+	return &triesChecker{
+		checkInterval: checkInterval,
+		checkers:      make([]TrieConsistencyChecker, 0),
+		chanCheckNow:  make(chan struct{}, 1),
+	}, nil
+}
+
+// AddChecker registers a new trie consistency checker
+func (tc *triesChecker) AddChecker(checker TrieConsistencyChecker) error {
+	if check.IfNil(checker) {
+		return debug.ErrNilTrieConsistencyChecker
+	}
+
+	tc.mutCheckers.Lock()
+	tc.checkers = append(tc.checkers, checker)
+	tc.mutCheckers.Unlock()
+
+	return nil
+}
+
+// StartChecking starts the background go routine that checks the registered tries
+func (tc *triesChecker) StartChecking() {
+	var ctx context.Context
+	ctx, tc.cancelFunc = context.WithCancel(context.Background())
+
+	go tc.checkLoop(ctx)
+}
+
+func (tc *triesChecker) checkLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("tries consistency checker's go routine is stopping...")
+			return
+		case <-time.After(tc.checkInterval):
+		case <-tc.chanCheckNow:
+		}
+
+		tc.checkAll()
+	}
+}
+
+func (tc *triesChecker) checkAll() {
+	for _, checker := range tc.getCheckers() {
+		_, err := checker.Check()
+		if err != nil {
+			log.Debug("trie consistency check", "trie", checker.Name(), "error", err.Error())
+		}
+	}
+}
+
+func (tc *triesChecker) getCheckers() []TrieConsistencyChecker {
+	tc.mutCheckers.RLock()
+	defer tc.mutCheckers.RUnlock()
+
+	checkers := make([]TrieConsistencyChecker, len(tc.checkers))
+	copy(checkers, tc.checkers)
+
+	return checkers
+}
+
+// CheckNow triggers a new check of all the registered tries, without waiting for the check interval to elapse
+func (tc *triesChecker) CheckNow() {
+	select {
+	case tc.chanCheckNow <- struct{}{}:
+	default:
+	}
+}
+
+// Statistics returns the last consistency check results of all the registered tries
+func (tc *triesChecker) Statistics() []trie.ConsistencyCheckStatistics {
+	checkers := tc.getCheckers()
+	statistics := make([]trie.ConsistencyCheckStatistics, 0, len(checkers))
+	for _, checker := range checkers {
+		statistics = append(statistics, checker.Statistics())
+	}
+
+	return statistics
+}
+
+// Query returns the consistency check results for the searched trie name or for all tries if "*" is provided.
+// The "check" query will trigger a new check of all the registered tries
+func (tc *triesChecker) Query(search string) []string {
+	if search == QueryCheck {
+		tc.CheckNow()
+		return []string{"tries consistency check triggered"}
+	}
+
+	lines := make([]string, 0)
+	for _, statistics := range tc.Statistics() {
+		if search == "*" || search == statistics.Name {
+			lines = append(lines, fmt.Sprintf("%s: %s", statistics.Name, statistics.String()))
+		}
+	}
+
+	return lines
+}
+
+// Close stops the background checks and interrupts the check in progress, if any
+func (tc *triesChecker) Close() error {
+	if tc.cancelFunc != nil {
+		tc.cancelFunc()
+	}
+
+	for _, checker := range tc.getCheckers() {
+		err := checker.Close()
+		if err != nil {
+			log.Debug("closing trie consistency checker", "trie", checker.Name(), "error", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tc *triesChecker) IsInterfaceNil() bool {
+	return tc == nil
+}
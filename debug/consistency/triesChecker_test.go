@@ -0,0 +1,94 @@
+package consistency
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type trieCheckerStub struct {
+	name      string
+	numChecks uint32
+	closed    uint32
+}
+
+func (tcs *trieCheckerStub) Name() string {
+	return tcs.name
+}
+
+func (tcs *trieCheckerStub) Check() (trie.ConsistencyCheckStatistics, error) {
+	atomic.AddUint32(&tcs.numChecks, 1)
+	return tcs.Statistics(), nil
+}
+
+func (tcs *trieCheckerStub) Statistics() trie.ConsistencyCheckStatistics {
+	return trie.ConsistencyCheckStatistics{
+		Name:      tcs.name,
+		NumChecks: uint64(atomic.LoadUint32(&tcs.numChecks)),
+	}
+}
+
+func (tcs *trieCheckerStub) Close() error {
+	atomic.StoreUint32(&tcs.closed, 1)
+	return nil
+}
+
+func (tcs *trieCheckerStub) IsInterfaceNil() bool {
+	return tcs == nil
+}
+
+func TestNewTriesChecker_InvalidIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tc, err := NewTriesChecker(time.Millisecond)
+	assert.Nil(t, tc)
+	assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+}
+
+func TestTriesChecker_AddNilCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := NewTriesChecker(time.Second)
+	err := tc.AddChecker(nil)
+	assert.Equal(t, debug.ErrNilTrieConsistencyChecker, err)
+}
+
+func TestTriesChecker_QueryCheckShouldTriggerTheChecks(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := NewTriesChecker(time.Hour)
+	accountsChecker := &trieCheckerStub{name: "accounts"}
+	peerChecker := &trieCheckerStub{name: "peer"}
+	require.Nil(t, tc.AddChecker(accountsChecker))
+	require.Nil(t, tc.AddChecker(peerChecker))
+
+	tc.StartChecking()
+	lines := tc.Query(QueryCheck)
+	assert.Equal(t, 1, len(lines))
+
+	for i := 0; i < 100 && atomic.LoadUint32(&peerChecker.numChecks) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&accountsChecker.numChecks))
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&peerChecker.numChecks))
+
+	lines = tc.Query("*")
+	require.Equal(t, 2, len(lines))
+	assert.True(t, strings.HasPrefix(lines[0], "accounts: "))
+	assert.True(t, strings.HasPrefix(lines[1], "peer: "))
+
+	lines = tc.Query("peer")
+	require.Equal(t, 1, len(lines))
+	assert.True(t, strings.Contains(lines[0], "checks: 1"))
+
+	_ = tc.Close()
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&accountsChecker.closed))
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&peerChecker.closed))
+}
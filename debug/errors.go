@@ -4,3 +4,9 @@ import "errors"
 
 // ErrInvalidValue signals that the provided value is invalid
 var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNilTrieConsistencyChecker signals that a nil trie consistency checker has been provided
+var ErrNilTrieConsistencyChecker = errors.New("nil trie consistency checker")
+
+// ErrNilChainHandler signals that a nil chain handler has been provided
+var ErrNilChainHandler = errors.New("nil chain handler")
@@ -0,0 +1,157 @@
+package storer
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.StorerWithPutInEpoch = (*instrumentedStorer)(nil)
+
+type cacheHitsCounter interface {
+	NumCacheHits() uint64
+}
+
+type bloomFilterStatisticsHandler interface {
+	BloomFilterStatistics() storage.BloomFilterStatistics
+}
+
+// instrumentedStorer wraps a storer and records its usage statistics while the debugger is enabled
+type instrumentedStorer struct {
+	identifier string
+	storer     storage.Storer
+	counters   *unitCounters
+	debugger   *storersDebugger
+}
+
+// Put adds the data in the wrapped storer
+func (is *instrumentedStorer) Put(key, data []byte) error {
+	if !is.debugger.IsEnabled() {
+		return is.storer.Put(key, data)
+	}
+
+	startTime := time.Now()
+	err := is.storer.Put(key, data)
+	duration := time.Since(startTime)
+
+	is.counters.addPut(len(data), duration)
+	is.debugger.checkSlowOperation(is.identifier, "put", key, duration)
+
+	return err
+}
+
+// Get returns the value for the given key from the wrapped storer
+func (is *instrumentedStorer) Get(key []byte) ([]byte, error) {
+	return is.instrumentGet("get", key, func() ([]byte, error) {
+		return is.storer.Get(key)
+	})
+}
+
+// SearchFirst returns the first value found for the given key in the wrapped storer
+func (is *instrumentedStorer) SearchFirst(key []byte) ([]byte, error) {
+	return is.instrumentGet("search first", key, func() ([]byte, error) {
+		return is.storer.SearchFirst(key)
+	})
+}
+
+// GetFromEpoch returns the value for the given key from the provided epoch of the wrapped storer
+func (is *instrumentedStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error) {
+	return is.instrumentGet("get from epoch", key, func() ([]byte, error) {
+		return is.storer.GetFromEpoch(key, epoch)
+	})
+}
+
+func (is *instrumentedStorer) instrumentGet(operation string, key []byte, getHandler func() ([]byte, error)) ([]byte, error) {
+	if !is.debugger.IsEnabled() {
+		return getHandler()
+	}
+
+	startTime := time.Now()
+	data, err := getHandler()
+	duration := time.Since(startTime)
+
+	is.counters.addGet(len(data), err, duration)
+	is.debugger.checkSlowOperation(is.identifier, operation, key, duration)
+
+	return data, err
+}
+
+// Has checks if the key is present in the wrapped storer
+func (is *instrumentedStorer) Has(key []byte) error {
+	if is.debugger.IsEnabled() {
+		is.counters.addHas()
+	}
+
+	return is.storer.Has(key)
+}
+
+// HasInEpoch checks if the key is present in the provided epoch of the wrapped storer
+func (is *instrumentedStorer) HasInEpoch(key []byte, epoch uint32) error {
+	if is.debugger.IsEnabled() {
+		is.counters.addHas()
+	}
+
+	return is.storer.HasInEpoch(key, epoch)
+}
+
+// Remove removes the key from the wrapped storer
+func (is *instrumentedStorer) Remove(key []byte) error {
+	if is.debugger.IsEnabled() {
+		is.counters.addRemove()
+	}
+
+	return is.storer.Remove(key)
+}
+
+// ClearCache clears the cache of the wrapped storer
+func (is *instrumentedStorer) ClearCache() {
+	is.storer.ClearCache()
+}
+
+// DestroyUnit destroys the wrapped storer
+func (is *instrumentedStorer) DestroyUnit() error {
+	return is.storer.DestroyUnit()
+}
+
+// Close closes the wrapped storer
+func (is *instrumentedStorer) Close() error {
+	return is.storer.Close()
+}
+
+// SetEpochForPutOperation sets the epoch for put operations if the wrapped storer supports it
+func (is *instrumentedStorer) SetEpochForPutOperation(epoch uint32) {
+	storerWithPutInEpoch, ok := is.storer.(storage.StorerWithPutInEpoch)
+	if ok {
+		storerWithPutInEpoch.SetEpochForPutOperation(epoch)
+	}
+}
+
+// BloomFilterStatistics returns the bloom filters' statistics of the wrapped storer, if available
+func (is *instrumentedStorer) BloomFilterStatistics() storage.BloomFilterStatistics {
+	statisticsHandler, ok := is.storer.(bloomFilterStatisticsHandler)
+	if !ok {
+		return storage.BloomFilterStatistics{}
+	}
+
+	return statisticsHandler.BloomFilterStatistics()
+}
+
+func (is *instrumentedStorer) statistics() UnitStatistics {
+	statistics := is.counters.statistics()
+	counter, ok := is.storer.(cacheHitsCounter)
+	if ok {
+		statistics.NumCacheHits = counter.NumCacheHits()
+	}
+
+	return statistics
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (is *instrumentedStorer) IsInterfaceNil() bool {
+	return is == nil
+}
+
+func keyToString(key []byte) string {
+	return hex.EncodeToString(key)
+}
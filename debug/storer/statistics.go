@@ -0,0 +1,129 @@
+package storer
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// latencyBucketsLimits holds the upper limits of the latency histograms' buckets. The last bucket of a histogram
+// counts the operations lasting more than the last limit
+var latencyBucketsLimits = []time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	500 * time.Millisecond,
+}
+
+const numLatencyBuckets = 7
+
+// LatencyHistogram holds the number of operations for each latency bucket
+type LatencyHistogram [numLatencyBuckets]uint64
+
+// String returns the histogram in a human readable form
+func (lh LatencyHistogram) String() string {
+	buckets := make([]string, 0, numLatencyBuckets)
+	for i, limit := range latencyBucketsLimits {
+		buckets = append(buckets, fmt.Sprintf("<%v:%d", limit, lh[i]))
+	}
+	buckets = append(buckets, fmt.Sprintf(">=%v:%d", latencyBucketsLimits[len(latencyBucketsLimits)-1], lh[numLatencyBuckets-1]))
+
+	return strings.Join(buckets, " ")
+}
+
+// UnitStatistics holds a snapshot of the usage statistics of a storer
+type UnitStatistics struct {
+	NumPuts      uint64
+	NumGets      uint64
+	NumGetMisses uint64
+	NumHas       uint64
+	NumRemoves   uint64
+	NumCacheHits uint64
+	BytesWritten uint64
+	BytesRead    uint64
+	PutLatency   LatencyHistogram
+	GetLatency   LatencyHistogram
+}
+
+// String returns the statistics in a human readable form
+func (us UnitStatistics) String() string {
+	return fmt.Sprintf("puts: %d, gets: %d, get misses: %d, has: %d, removes: %d, cache hits: %d, "+
+		"bytes written: %d, bytes read: %d, put latency: [%s], get latency: [%s]",
+		us.NumPuts, us.NumGets, us.NumGetMisses, us.NumHas, us.NumRemoves, us.NumCacheHits,
+		us.BytesWritten, us.BytesRead, us.PutLatency.String(), us.GetLatency.String())
+}
+
+type latencyCounters [numLatencyBuckets]uint64
+
+func (lc *latencyCounters) add(duration time.Duration) {
+	for i, limit := range latencyBucketsLimits {
+		if duration < limit {
+			atomic.AddUint64(&lc[i], 1)
+			return
+		}
+	}
+
+	atomic.AddUint64(&lc[numLatencyBuckets-1], 1)
+}
+
+func (lc *latencyCounters) histogram() LatencyHistogram {
+	histogram := LatencyHistogram{}
+	for i := range lc {
+		histogram[i] = atomic.LoadUint64(&lc[i])
+	}
+
+	return histogram
+}
+
+// unitCounters holds the counters of a storer which are updated concurrently
+type unitCounters struct {
+	numPuts      uint64
+	numGets      uint64
+	numGetMisses uint64
+	numHas       uint64
+	numRemoves   uint64
+	bytesWritten uint64
+	bytesRead    uint64
+	putLatency   latencyCounters
+	getLatency   latencyCounters
+}
+
+func (uc *unitCounters) addPut(numBytes int, duration time.Duration) {
+	atomic.AddUint64(&uc.numPuts, 1)
+	atomic.AddUint64(&uc.bytesWritten, uint64(numBytes))
+	uc.putLatency.add(duration)
+}
+
+func (uc *unitCounters) addGet(numBytes int, err error, duration time.Duration) {
+	atomic.AddUint64(&uc.numGets, 1)
+	if err != nil {
+		atomic.AddUint64(&uc.numGetMisses, 1)
+	}
+	atomic.AddUint64(&uc.bytesRead, uint64(numBytes))
+	uc.getLatency.add(duration)
+}
+
+func (uc *unitCounters) addHas() {
+	atomic.AddUint64(&uc.numHas, 1)
+}
+
+func (uc *unitCounters) addRemove() {
+	atomic.AddUint64(&uc.numRemoves, 1)
+}
+
+func (uc *unitCounters) statistics() UnitStatistics {
+	return UnitStatistics{
+		NumPuts:      atomic.LoadUint64(&uc.numPuts),
+		NumGets:      atomic.LoadUint64(&uc.numGets),
+		NumGetMisses: atomic.LoadUint64(&uc.numGetMisses),
+		NumHas:       atomic.LoadUint64(&uc.numHas),
+		NumRemoves:   atomic.LoadUint64(&uc.numRemoves),
+		BytesWritten: atomic.LoadUint64(&uc.bytesWritten),
+		BytesRead:    atomic.LoadUint64(&uc.bytesRead),
+		PutLatency:   uc.putLatency.histogram(),
+		GetLatency:   uc.getLatency.histogram(),
+	}
+}
@@ -0,0 +1,152 @@
+package storer
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	atomicFlag "github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("debug/storer")
+
+const minSlowOperationThresholdInMs = 1
+
+// QueryEnable is the query that enables the storers' instrumentation
+const QueryEnable = "enable"
+
+// QueryDisable is the query that disables the storers' instrumentation
+const QueryDisable = "disable"
+
+type storersDebugger struct {
+	isEnabled              atomicFlag.Flag
+	slowOperationThreshold int64
+	mutStorers             sync.RWMutex
+	storers                map[string]*instrumentedStorer
+}
+
+// NewStorersDebugger creates a debugger able to wrap storers in order to record their usage statistics.
+// The storers are always wrapped so the instrumentation can be switched on and off while the node is running
+func NewStorersDebugger(config config.StorerDebugConfig) (*storersDebugger, error) {
+	if config.SlowOperationThresholdInMs < minSlowOperationThresholdInMs {
+		return nil, fmt.Errorf("%w for SlowOperationThresholdInMs, minimum is %d", debug.ErrInvalidValue, minSlowOperationThresholdInMs)
+	}
+
+	sd := &storersDebugger{
+		slowOperationThreshold: int64(time.Duration(config.SlowOperationThresholdInMs) * time.Millisecond),
+		storers:                make(map[string]*instrumentedStorer),
+	}
+	sd.isEnabled.Toggle(config.Enabled)
+
+	return sd, nil
+}
+
+// Wrap returns an instrumented storer which records the usage statistics under the provided identifier
+func (sd *storersDebugger) Wrap(identifier string, storer storage.Storer) storage.Storer {
+	if check.IfNil(storer) {
+		return storer
+	}
+
+	sd.mutStorers.Lock()
+	defer sd.mutStorers.Unlock()
+
+	counters := &unitCounters{}
+	existing, ok := sd.storers[identifier]
+	if ok {
+		log.Debug("storer identifier already wrapped, the statistics will be merged", "identifier", identifier)
+		counters = existing.counters
+	}
+
+	wrapped := &instrumentedStorer{
+		identifier: identifier,
+		storer:     storer,
+		counters:   counters,
+		debugger:   sd,
+	}
+	sd.storers[identifier] = wrapped
+
+	return wrapped
+}
+
+// SetEnabled switches the instrumentation on or off
+func (sd *storersDebugger) SetEnabled(enabled bool) {
+	sd.isEnabled.Toggle(enabled)
+	log.Info("storers instrumentation", "enabled", enabled)
+}
+
+// IsEnabled returns true if the instrumentation is switched on
+func (sd *storersDebugger) IsEnabled() bool {
+	return sd.isEnabled.IsSet()
+}
+
+// SetSlowOperationThreshold sets the duration above which the storers' operations are logged
+func (sd *storersDebugger) SetSlowOperationThreshold(threshold time.Duration) {
+	atomic.StoreInt64(&sd.slowOperationThreshold, int64(threshold))
+}
+
+func (sd *storersDebugger) checkSlowOperation(identifier string, operation string, key []byte, duration time.Duration) {
+	if int64(duration) < atomic.LoadInt64(&sd.slowOperationThreshold) {
+		return
+	}
+
+	log.Warn("slow storer operation",
+		"unit", identifier,
+		"operation", operation,
+		"key", keyToString(key),
+		"duration", duration,
+	)
+}
+
+// Statistics returns the usage statistics of all the wrapped storers
+func (sd *storersDebugger) Statistics() map[string]UnitStatistics {
+	sd.mutStorers.RLock()
+	defer sd.mutStorers.RUnlock()
+
+	statistics := make(map[string]UnitStatistics, len(sd.storers))
+	for identifier, storer := range sd.storers {
+		statistics[identifier] = storer.statistics()
+	}
+
+	return statistics
+}
+
+// Query returns the usage statistics of the storer with the provided identifier or of all the storers if
+// the search string is "*". The "enable" and "disable" queries switch the instrumentation on and off
+func (sd *storersDebugger) Query(search string) []string {
+	switch search {
+	case QueryEnable:
+		sd.SetEnabled(true)
+		return []string{"storers instrumentation enabled"}
+	case QueryDisable:
+		sd.SetEnabled(false)
+		return []string{"storers instrumentation disabled"}
+	}
+
+	statistics := sd.Statistics()
+	identifiers := make([]string, 0, len(statistics))
+	for identifier := range statistics {
+		if search == "*" || search == identifier {
+			identifiers = append(identifiers, identifier)
+		}
+	}
+	sort.Strings(identifiers)
+
+	lines := make([]string, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		lines = append(lines, fmt.Sprintf("%s: %s", identifier, statistics[identifier].String()))
+	}
+
+	return lines
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sd *storersDebugger) IsInterfaceNil() bool {
+	return sd == nil
+}
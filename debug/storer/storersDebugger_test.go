@@ -0,0 +1,143 @@
+package storer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestStorer(t *testing.T) storage.Storer {
+	cache, _ := lrucache.NewCache(10)
+	unit, err := storageUnit.NewStorageUnit(cache, memorydb.New())
+	require.Nil(t, err)
+
+	return unit
+}
+
+func createEnabledConfig() config.StorerDebugConfig {
+	return config.StorerDebugConfig{
+		Enabled:                    true,
+		SlowOperationThresholdInMs: 100,
+	}
+}
+
+func TestNewStorersDebugger_InvalidThresholdShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sd, err := NewStorersDebugger(config.StorerDebugConfig{})
+	assert.Nil(t, sd)
+	assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+}
+
+func TestNewStorersDebugger_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sd, err := NewStorersDebugger(createEnabledConfig())
+	assert.Nil(t, err)
+	assert.False(t, sd.IsInterfaceNil())
+	assert.True(t, sd.IsEnabled())
+}
+
+func TestStorersDebugger_WrapShouldRecordStatistics(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := NewStorersDebugger(createEnabledConfig())
+	wrapped := sd.Wrap("unit", createTestStorer(t))
+
+	key, val := []byte("key"), []byte("value")
+	_ = wrapped.Put(key, val)
+	_, _ = wrapped.Get(key)
+	_, _ = wrapped.Get([]byte("missing"))
+	_ = wrapped.Has(key)
+	_ = wrapped.Remove(key)
+
+	statistics := sd.Statistics()["unit"]
+	assert.Equal(t, uint64(1), statistics.NumPuts)
+	assert.Equal(t, uint64(2), statistics.NumGets)
+	assert.Equal(t, uint64(1), statistics.NumGetMisses)
+	assert.Equal(t, uint64(1), statistics.NumHas)
+	assert.Equal(t, uint64(1), statistics.NumRemoves)
+	assert.Equal(t, uint64(2), statistics.NumCacheHits)
+	assert.Equal(t, uint64(len(val)), statistics.BytesWritten)
+	assert.Equal(t, uint64(len(val)), statistics.BytesRead)
+	assert.Equal(t, uint64(1), sumHistogram(statistics.PutLatency))
+	assert.Equal(t, uint64(2), sumHistogram(statistics.GetLatency))
+}
+
+func TestStorersDebugger_DisabledShouldNotRecordStatistics(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := NewStorersDebugger(createEnabledConfig())
+	wrapped := sd.Wrap("unit", createTestStorer(t))
+	sd.SetEnabled(false)
+
+	key, val := []byte("key"), []byte("value")
+	err := wrapped.Put(key, val)
+	assert.Nil(t, err)
+	recovered, err := wrapped.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+
+	statistics := sd.Statistics()["unit"]
+	assert.Equal(t, uint64(0), statistics.NumPuts)
+	assert.Equal(t, uint64(0), statistics.NumGets)
+}
+
+func TestStorersDebugger_QueryShouldSwitchAndReturnStatistics(t *testing.T) {
+	t.Parallel()
+
+	cfg := createEnabledConfig()
+	cfg.Enabled = false
+	sd, _ := NewStorersDebugger(cfg)
+	wrappedA := sd.Wrap("unitA", createTestStorer(t))
+	_ = sd.Wrap("unitB", createTestStorer(t))
+
+	lines := sd.Query(QueryEnable)
+	assert.Equal(t, 1, len(lines))
+	assert.True(t, sd.IsEnabled())
+
+	_ = wrappedA.Put([]byte("key"), []byte("value"))
+
+	lines = sd.Query("*")
+	require.Equal(t, 2, len(lines))
+	assert.True(t, strings.HasPrefix(lines[0], "unitA: puts: 1"))
+	assert.True(t, strings.HasPrefix(lines[1], "unitB: puts: 0"))
+
+	lines = sd.Query("unitB")
+	assert.Equal(t, 1, len(lines))
+
+	_ = sd.Query(QueryDisable)
+	assert.False(t, sd.IsEnabled())
+}
+
+func TestLatencyCounters_AddShouldSelectTheBucket(t *testing.T) {
+	t.Parallel()
+
+	lc := &latencyCounters{}
+	lc.add(time.Microsecond)
+	lc.add(7 * time.Millisecond)
+	lc.add(time.Second)
+
+	histogram := lc.histogram()
+	assert.Equal(t, LatencyHistogram{1, 0, 1, 0, 0, 0, 1}, histogram)
+	assert.Equal(t, "<1ms:1 <5ms:0 <10ms:1 <50ms:0 <100ms:0 <500ms:0 >=500ms:1", histogram.String())
+}
+
+func sumHistogram(histogram LatencyHistogram) uint64 {
+	sum := uint64(0)
+	for _, val := range histogram {
+		sum += val
+	}
+
+	return sum
+}
@@ -91,6 +91,9 @@ func checkArguments(args ArgsEpochStartBootstrap) error {
 	if check.IfNil(args.StatusHandler) {
 		return fmt.Errorf("%s: %w", baseErrorMessage, epochStart.ErrNilStatusHandler)
 	}
+	if check.IfNil(args.StorerWrapper) {
+		return fmt.Errorf("%s: %w", baseErrorMessage, epochStart.ErrNilStorerWrapper)
+	}
 
 	return nil
 }
@@ -1,6 +1,8 @@
 package disabled
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 )
@@ -84,8 +86,18 @@ func (a *accountsAdapter) ClosePersister() error {
 	return nil
 }
 
-// GetAllLeaves -
-func (a *accountsAdapter) GetAllLeaves(_ []byte) (map[string][]byte, error) {
+// IterateAllLeaves -
+func (a *accountsAdapter) IterateAllLeaves(_ context.Context, _ []byte, _ func(key []byte, value []byte) error) error {
+	return nil
+}
+
+// GetTrie -
+func (a *accountsAdapter) GetTrie(_ []byte) (data.Trie, error) {
+	return nil, nil
+}
+
+// GetProof -
+func (a *accountsAdapter) GetProof(_ []byte, _ []byte) ([][]byte, error) {
 	return nil, nil
 }
 
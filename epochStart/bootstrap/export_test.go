@@ -308,6 +308,34 @@ func getGeneralConfig() config.Config {
 				MaxOpenFiles:      10,
 			},
 		},
+		AccountsChangeSetStorage: config.StorageConfig{
+			Cache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 1000,
+				Shards:   1,
+			},
+			DB: config.DBConfig{
+				FilePath:          "AccountsChangeSets",
+				Type:              string(storageUnit.LvlDBSerial),
+				BatchDelaySeconds: 2,
+				MaxBatchSize:      100,
+				MaxOpenFiles:      10,
+			},
+		},
+		ReceiptsStorage: config.StorageConfig{
+			Cache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 1000,
+				Shards:   1,
+			},
+			DB: config.DBConfig{
+				FilePath:          "Receipts",
+				Type:              string(storageUnit.LvlDBSerial),
+				BatchDelaySeconds: 2,
+				MaxBatchSize:      100,
+				MaxOpenFiles:      10,
+			},
+		},
 	}
 }
 
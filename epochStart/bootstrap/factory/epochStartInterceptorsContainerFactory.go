@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
@@ -77,6 +78,7 @@ func NewEpochStartInterceptorsContainer(args ArgsEpochStartInterceptorContainer)
 		MultiSigner:             multiSigner,
 		DataPool:                args.DataPool,
 		Accounts:                accountsAdapter,
+		BlockChain:              blockchain.NewMetaChain(),
 		AddressPubkeyConverter:  args.AddressPubkeyConv,
 		SingleSigner:            args.SingleSigner,
 		BlockSingleSigner:       args.BlockSingleSigner,
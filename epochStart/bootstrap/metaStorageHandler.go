@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/adaptivecache"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
 )
 
@@ -35,6 +36,7 @@ func NewMetaStorageHandler(
 	hasher hashing.Hasher,
 	currentEpoch uint32,
 	uint64Converter typeConverters.Uint64ByteSliceConverter,
+	memoryBudget *adaptivecache.MemoryBudget,
 ) (*metaStorageHandler, error) {
 	epochStartNotifier := &disabled.EpochStartNotifier{}
 	storageFactory, err := factory.NewStorageServiceFactory(
@@ -43,6 +45,7 @@ func NewMetaStorageHandler(
 		pathManagerHandler,
 		epochStartNotifier,
 		currentEpoch,
+		memoryBudget,
 	)
 	if err != nil {
 		return nil, err
@@ -22,7 +22,7 @@ func TestNewMetaStorageHandler_InvalidConfigErr(t *testing.T) {
 	hasher := &mock.HasherMock{}
 	uit64Cvt := &mock.Uint64ByteSliceConverterMock{}
 
-	mtStrHandler, err := NewMetaStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt)
+	mtStrHandler, err := NewMetaStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt, nil)
 	assert.True(t, check.IfNil(mtStrHandler))
 	assert.NotNil(t, err)
 }
@@ -39,7 +39,7 @@ func TestNewMetaStorageHandler_CreateForMetaErr(t *testing.T) {
 	hasher := &mock.HasherMock{}
 	uit64Cvt := &mock.Uint64ByteSliceConverterMock{}
 
-	mtStrHandler, err := NewMetaStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt)
+	mtStrHandler, err := NewMetaStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt, nil)
 	assert.False(t, check.IfNil(mtStrHandler))
 	assert.Nil(t, err)
 }
@@ -56,7 +56,7 @@ func TestMetaStorageHandler_saveLastHeader(t *testing.T) {
 	hasher := &mock.HasherMock{}
 	uit64Cvt := &mock.Uint64ByteSliceConverterMock{}
 
-	mtStrHandler, _ := NewMetaStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt)
+	mtStrHandler, _ := NewMetaStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt, nil)
 
 	header := &block.MetaBlock{Nonce: 0}
 
@@ -82,7 +82,7 @@ func TestMetaStorageHandler_saveLastCrossNotarizedHeaders(t *testing.T) {
 	hasher := &mock.HasherMock{}
 	uit64Cvt := &mock.Uint64ByteSliceConverterMock{}
 
-	mtStrHandler, _ := NewMetaStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt)
+	mtStrHandler, _ := NewMetaStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt, nil)
 
 	hdr1 := &block.Header{Nonce: 1}
 	hdr2 := &block.Header{Nonce: 2}
@@ -114,7 +114,7 @@ func TestMetaStorageHandler_saveTriggerRegistry(t *testing.T) {
 	hasher := &mock.HasherMock{}
 	uit64Cvt := &mock.Uint64ByteSliceConverterMock{}
 
-	mtStrHandler, _ := NewMetaStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt)
+	mtStrHandler, _ := NewMetaStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt, nil)
 
 	components := &ComponentsNeededForBootstrap{
 		EpochStartMetaBlock: &block.MetaBlock{Nonce: 3},
@@ -137,7 +137,7 @@ func TestMetaStorageHandler_saveDataToStorage(t *testing.T) {
 	hasher := &mock.HasherMock{}
 	uit64Cvt := &mock.Uint64ByteSliceConverterMock{}
 
-	mtStrHandler, _ := NewMetaStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt)
+	mtStrHandler, _ := NewMetaStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt, nil)
 
 	components := &ComponentsNeededForBootstrap{
 		EpochStartMetaBlock: &block.MetaBlock{Nonce: 3},
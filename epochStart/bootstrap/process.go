@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/syncer"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
@@ -34,6 +35,7 @@ import (
 	disabledInterceptors "github.com/ElrondNetwork/elrond-go/process/interceptors/disabled"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/adaptivecache"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
 	"github.com/ElrondNetwork/elrond-go/update"
@@ -49,6 +51,8 @@ const maxToRequest = 100
 const gracePeriodInPercentage = float64(0.25)
 const roundGracePeriod = 25
 const numConcurrentTrieSyncers = 50
+const maxInFlightTrieNodes = 1000
+const trieSyncMetricsRefreshInterval = time.Second
 
 // Parameters defines the DTO for the result produced by the bootstrap component
 type Parameters struct {
@@ -97,11 +101,14 @@ type epochStartBootstrap struct {
 	rater                      sharding.ChanceComputer
 	trieContainer              state.TriesHolder
 	trieStorageManagers        map[string]data.StorageManager
+	trieSyncStatistics         data.SyncStatisticsHandler
 	uint64Converter            typeConverters.Uint64ByteSliceConverter
 	nodeShuffler               sharding.NodesShuffler
 	rounder                    epochStart.Rounder
 	addressPubkeyConverter     core.PubkeyConverter
 	statusHandler              core.AppStatusHandler
+	storerWrapper              storage.StorerWrapper
+	memoryBudget               *adaptivecache.MemoryBudget
 
 	// created components
 	requestHandler            process.RequestHandler
@@ -165,6 +172,8 @@ type ArgsEpochStartBootstrap struct {
 	Rounder                    epochStart.Rounder
 	AddressPubkeyConverter     core.PubkeyConverter
 	StatusHandler              core.AppStatusHandler
+	StorerWrapper              storage.StorerWrapper
+	MemoryBudget               *adaptivecache.MemoryBudget
 }
 
 // NewEpochStartBootstrap will return a new instance of epochStartBootstrap
@@ -202,6 +211,8 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 		latestStorageDataProvider:  args.LatestStorageDataProvider,
 		addressPubkeyConverter:     args.AddressPubkeyConverter,
 		statusHandler:              args.StatusHandler,
+		storerWrapper:              args.StorerWrapper,
+		memoryBudget:               args.MemoryBudget,
 		shuffledOut:                false,
 	}
 
@@ -227,6 +238,7 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 
 	epochStartProvider.trieContainer = state.NewDataTriesHolder()
 	epochStartProvider.trieStorageManagers = make(map[string]data.StorageManager)
+	epochStartProvider.trieSyncStatistics = trie.NewTrieSyncStatistics()
 
 	return epochStartProvider, nil
 }
@@ -629,6 +641,7 @@ func (e *epochStartBootstrap) requestAndProcessForMeta() error {
 		e.hasher,
 		e.epochStartMeta.Epoch,
 		e.uint64Converter,
+		e.memoryBudget,
 	)
 	if err != nil {
 		return err
@@ -730,6 +743,7 @@ func (e *epochStartBootstrap) requestAndProcessForShard() error {
 		e.hasher,
 		e.baseData.lastEpoch,
 		e.uint64Converter,
+		e.memoryBudget,
 	)
 	if err != nil {
 		return err
@@ -758,6 +772,8 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 			WaitTime:             trieSyncWaitTime,
 			Cacher:               e.dataPool.TrieNodes(),
 			MaxTrieLevelInMemory: e.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+			TrieSyncStatistics:   e.trieSyncStatistics,
+			MaxInFlightNodes:     maxInFlightTrieNodes,
 		},
 		ShardId:   e.shardCoordinator.SelfId(),
 		Throttler: thr,
@@ -767,7 +783,7 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 		return err
 	}
 
-	err = accountsDBSyncer.SyncAccounts(rootHash)
+	err = e.syncAccountsWithMetrics(accountsDBSyncer, rootHash)
 	if err != nil {
 		return err
 	}
@@ -776,6 +792,34 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 	return nil
 }
 
+func (e *epochStartBootstrap) syncAccountsWithMetrics(accountsDBSyncer epochStart.AccountsDBSyncer, rootHash []byte) error {
+	chDone := make(chan struct{})
+	go e.updateTrieSyncMetrics(chDone)
+
+	err := accountsDBSyncer.SyncAccounts(rootHash)
+	close(chDone)
+
+	return err
+}
+
+func (e *epochStartBootstrap) updateTrieSyncMetrics(chDone chan struct{}) {
+	for {
+		select {
+		case <-chDone:
+			e.setTrieSyncMetrics()
+			return
+		case <-time.After(trieSyncMetricsRefreshInterval):
+			e.setTrieSyncMetrics()
+		}
+	}
+}
+
+func (e *epochStartBootstrap) setTrieSyncMetrics() {
+	e.statusHandler.SetUInt64Value(core.MetricTrieSyncNumProcessedNodes, uint64(e.trieSyncStatistics.NumSynced()))
+	e.statusHandler.SetUInt64Value(core.MetricTrieSyncNumReceivedBytes, e.trieSyncStatistics.NumBytesReceived())
+	e.statusHandler.SetUInt64Value(core.MetricTrieSyncBytesPerSecond, e.trieSyncStatistics.BytesPerSecond())
+}
+
 func (e *epochStartBootstrap) createTriesComponentsForShardId(shardId uint32) error {
 
 	trieFactoryArgs := factory.TrieFactoryArgs{
@@ -785,6 +829,9 @@ func (e *epochStartBootstrap) createTriesComponentsForShardId(shardId uint32) er
 		Hasher:                   e.hasher,
 		PathManager:              e.pathManager,
 		TrieStorageManagerConfig: e.generalConfig.TrieStorageManagerConfig,
+		StorerWrapper:            e.storerWrapper,
+		DecodedNodesCacheCfg:     e.generalConfig.DecodedTrieNodesCache,
+		MemoryBudget:             e.memoryBudget,
 	}
 	trieFactory, err := factory.NewTrieFactory(trieFactoryArgs)
 	if err != nil {
@@ -830,6 +877,8 @@ func (e *epochStartBootstrap) syncPeerAccountsState(rootHash []byte) error {
 			WaitTime:             trieSyncWaitTime,
 			Cacher:               e.dataPool.TrieNodes(),
 			MaxTrieLevelInMemory: e.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
+			TrieSyncStatistics:   e.trieSyncStatistics,
+			MaxInFlightNodes:     maxInFlightTrieNodes,
 		},
 	}
 	accountsDBSyncer, err := syncer.NewValidatorAccountsSyncer(argsValidatorAccountsSyncer)
@@ -837,7 +886,7 @@ func (e *epochStartBootstrap) syncPeerAccountsState(rootHash []byte) error {
 		return err
 	}
 
-	err = accountsDBSyncer.SyncAccounts(rootHash)
+	err = e.syncAccountsWithMetrics(accountsDBSyncer, rootHash)
 	if err != nil {
 		return err
 	}
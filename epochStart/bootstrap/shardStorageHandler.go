@@ -20,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/adaptivecache"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
 )
 
@@ -36,6 +37,7 @@ func NewShardStorageHandler(
 	hasher hashing.Hasher,
 	currentEpoch uint32,
 	uint64Converter typeConverters.Uint64ByteSliceConverter,
+	memoryBudget *adaptivecache.MemoryBudget,
 ) (*shardStorageHandler, error) {
 	epochStartNotifier := &disabled.EpochStartNotifier{}
 	storageFactory, err := factory.NewStorageServiceFactory(
@@ -44,6 +46,7 @@ func NewShardStorageHandler(
 		pathManagerHandler,
 		epochStartNotifier,
 		currentEpoch,
+		memoryBudget,
 	)
 	if err != nil {
 		return nil, err
@@ -25,7 +25,7 @@ func TestNewShardStorageHandler_ShouldWork(t *testing.T) {
 	hasher := &mock.HasherMock{}
 	uit64Cvt := &mock.Uint64ByteSliceConverterMock{}
 
-	shardStrHandler, err := NewShardStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt)
+	shardStrHandler, err := NewShardStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt, nil)
 	assert.False(t, check.IfNil(shardStrHandler))
 	assert.Nil(t, err)
 }
@@ -42,7 +42,7 @@ func TestShardStorageHandler_SaveDataToStorageShardDataNotFound(t *testing.T) {
 	hasher := &mock.HasherMock{}
 	uit64Cvt := &mock.Uint64ByteSliceConverterMock{}
 
-	shardStrHandler, _ := NewShardStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt)
+	shardStrHandler, _ := NewShardStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt, nil)
 
 	components := &ComponentsNeededForBootstrap{
 		EpochStartMetaBlock: &block.MetaBlock{Epoch: 1},
@@ -66,7 +66,7 @@ func TestShardStorageHandler_SaveDataToStorageMissingHeader(t *testing.T) {
 	hasher := &mock.HasherMock{}
 	uit64Cvt := &mock.Uint64ByteSliceConverterMock{}
 
-	shardStrHandler, _ := NewShardStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt)
+	shardStrHandler, _ := NewShardStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt, nil)
 
 	components := &ComponentsNeededForBootstrap{
 		EpochStartMetaBlock: &block.MetaBlock{
@@ -97,7 +97,7 @@ func TestShardStorageHandler_SaveDataToStorage(t *testing.T) {
 	hasher := &mock.HasherMock{}
 	uit64Cvt := &mock.Uint64ByteSliceConverterMock{}
 
-	shardStrHandler, _ := NewShardStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt)
+	shardStrHandler, _ := NewShardStorageHandler(gCfg, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt, nil)
 
 	hash1 := []byte("hash1")
 	hdr1 := block.MetaBlock{
@@ -206,6 +206,9 @@ var ErrNilLatestStorageDataProvider = errors.New("nil latest storage data provid
 // ErrNilShuffler signals that a nil shuffler was provided
 var ErrNilShuffler = errors.New("nil nodes shuffler provided")
 
+// ErrNilStorerWrapper signals that a nil storer wrapper was provided
+var ErrNilStorerWrapper = errors.New("nil storer wrapper provided")
+
 // ErrNotEnoughNumConnectedPeers signals that config is invalid for num of connected peers
 var ErrNotEnoughNumConnectedPeers = errors.New("not enough min num of connected peers from config")
 
@@ -1,6 +1,7 @@
 package facade
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -24,9 +25,15 @@ type NodeHandler interface {
 	// GetValueForKey returns the value of a key from a given account
 	GetValueForKey(address string, key string) (string, error)
 
+	// GetProof returns the Merkle proof of a given account, anchored to the current block's root hash
+	GetProof(address string) (*state.ProofApiResponse, error)
+
+	// GetProofDataTrie returns the Merkle proofs of a key from a given account's data trie and of the account itself
+	GetProofDataTrie(address string, key string) (*state.DataTrieProofApiResponse, error)
+
 	//CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-		gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error)
+		gasLimit uint64, data string, signatureHex string, version uint32, options uint32, signaturesHex []string, notBeforeRound uint64, notBeforeEpoch uint32) (*transaction.Transaction, []byte, error)
 
 	//ValidateTransaction will validate a transaction
 	ValidateTransaction(tx *transaction.Transaction) error
@@ -44,6 +51,16 @@ type NodeHandler interface {
 	//  about the account corelated with provided address
 	GetAccount(address string) (state.UserAccountHandler, error)
 
+	// GetAccountAtBlockNonce returns the account corelated with provided address, as it was after the block
+	// having the provided nonce was committed
+	GetAccountAtBlockNonce(address string, blockNonce uint64) (state.UserAccountHandler, error)
+
+	// GetStateDiff returns the leaves that differ between the tries having the provided root hashes
+	GetStateDiff(fromRootHash string, toRootHash string) (*state.StateDiffApiResponse, error)
+
+	// GetAccountsChangeSet returns the accounts changed by the block having the provided nonce
+	GetAccountsChangeSet(blockNonce uint64) (*state.AccountsChangeSetApiResponse, error)
+
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []data.PubKeyHeartbeat
 
@@ -52,6 +69,16 @@ type NodeHandler interface {
 
 	// ValidatorStatisticsApi return the statistics for all the validators
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+
+	// GetPeerAccounts returns the peer accounts from the peer accounts trie having the provided root hash
+	GetPeerAccounts(ctx context.Context, rootHash string) (*state.PeerAccountsApiResponse, error)
+
+	// ComparePeerAccounts returns the peer accounts that differ between the peer accounts tries having the provided root hashes
+	ComparePeerAccounts(ctx context.Context, fromRootHash string, toRootHash string) (*state.PeerAccountsComparisonApiResponse, error)
+
+	// GetEpochStartValidatorStatsRootHash returns the validator statistics root hash of the provided epoch's start block
+	GetEpochStartValidatorStatsRootHash(epoch uint32) (string, error)
+
 	DirectTrigger(epoch uint32) error
 	IsSelfTrigger() bool
 
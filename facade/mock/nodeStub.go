@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"encoding/hex"
 	"math/big"
 
@@ -19,21 +20,29 @@ type NodeStub struct {
 	GetBalanceHandler          func(address string) (*big.Int, error)
 	GenerateTransactionHandler func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-		gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error)
+		gasLimit uint64, data string, signatureHex string, version uint32, options uint32, signaturesHex []string, notBeforeRound uint64, notBeforeEpoch uint32) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler                     func(tx *transaction.Transaction) error
 	GetTransactionHandler                          func(hash string) (*transaction.ApiTransactionResult, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
 	GetAccountHandler                              func(address string) (state.UserAccountHandler, error)
+	GetAccountAtBlockNonceCalled                   func(address string, blockNonce uint64) (state.UserAccountHandler, error)
+	GetStateDiffCalled                             func(fromRootHash string, toRootHash string) (*state.StateDiffApiResponse, error)
+	GetAccountsChangeSetCalled                     func(blockNonce uint64) (*state.AccountsChangeSetApiResponse, error)
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	GetHeartbeatsHandler                           func() []data.PubKeyHeartbeat
 	ValidatorStatisticsApiCalled                   func() (map[string]*state.ValidatorApiResponse, error)
+	GetPeerAccountsCalled                          func(ctx context.Context, rootHash string) (*state.PeerAccountsApiResponse, error)
+	ComparePeerAccountsCalled                      func(ctx context.Context, fromRootHash string, toRootHash string) (*state.PeerAccountsComparisonApiResponse, error)
+	GetEpochStartValidatorStatsRootHashCalled      func(epoch uint32) (string, error)
 	DirectTriggerCalled                            func(epoch uint32) error
 	IsSelfTriggerCalled                            func() bool
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
 	GetTransactionStatusCalled                     func(hash string) (string, error)
 	GetValueForKeyCalled                           func(address string, key string) (string, error)
+	GetProofCalled                                 func(address string) (*state.ProofApiResponse, error)
+	GetProofDataTrieCalled                         func(address string, key string) (*state.DataTrieProofApiResponse, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
 }
 
@@ -46,6 +55,24 @@ func (ns *NodeStub) GetValueForKey(address string, key string) (string, error) {
 	return "", nil
 }
 
+// GetProof -
+func (ns *NodeStub) GetProof(address string) (*state.ProofApiResponse, error) {
+	if ns.GetProofCalled != nil {
+		return ns.GetProofCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetProofDataTrie -
+func (ns *NodeStub) GetProofDataTrie(address string, key string) (*state.DataTrieProofApiResponse, error) {
+	if ns.GetProofDataTrieCalled != nil {
+		return ns.GetProofDataTrieCalled(address, key)
+	}
+
+	return nil, nil
+}
+
 // GetTransactionStatus -
 func (ns *NodeStub) GetTransactionStatus(hash string) (string, error) {
 	if ns.GetTransactionStatusCalled != nil {
@@ -77,9 +104,9 @@ func (ns *NodeStub) GetBalance(address string) (*big.Int, error) {
 
 // CreateTransaction -
 func (ns *NodeStub) CreateTransaction(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-	gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error) {
+	gasLimit uint64, data string, signatureHex string, version uint32, options uint32, signaturesHex []string, notBeforeRound uint64, notBeforeEpoch uint32) (*transaction.Transaction, []byte, error) {
 
-	return ns.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex)
+	return ns.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, version, options, signaturesHex, notBeforeRound, notBeforeEpoch)
 }
 
 //ValidateTransaction --
@@ -102,6 +129,60 @@ func (ns *NodeStub) GetAccount(address string) (state.UserAccountHandler, error)
 	return ns.GetAccountHandler(address)
 }
 
+// GetAccountAtBlockNonce -
+func (ns *NodeStub) GetAccountAtBlockNonce(address string, blockNonce uint64) (state.UserAccountHandler, error) {
+	if ns.GetAccountAtBlockNonceCalled != nil {
+		return ns.GetAccountAtBlockNonceCalled(address, blockNonce)
+	}
+
+	return nil, nil
+}
+
+// GetStateDiff -
+func (ns *NodeStub) GetStateDiff(fromRootHash string, toRootHash string) (*state.StateDiffApiResponse, error) {
+	if ns.GetStateDiffCalled != nil {
+		return ns.GetStateDiffCalled(fromRootHash, toRootHash)
+	}
+
+	return nil, nil
+}
+
+// GetAccountsChangeSet -
+func (ns *NodeStub) GetAccountsChangeSet(blockNonce uint64) (*state.AccountsChangeSetApiResponse, error) {
+	if ns.GetAccountsChangeSetCalled != nil {
+		return ns.GetAccountsChangeSetCalled(blockNonce)
+	}
+
+	return nil, nil
+}
+
+// GetPeerAccounts -
+func (ns *NodeStub) GetPeerAccounts(ctx context.Context, rootHash string) (*state.PeerAccountsApiResponse, error) {
+	if ns.GetPeerAccountsCalled != nil {
+		return ns.GetPeerAccountsCalled(ctx, rootHash)
+	}
+
+	return nil, nil
+}
+
+// ComparePeerAccounts -
+func (ns *NodeStub) ComparePeerAccounts(ctx context.Context, fromRootHash string, toRootHash string) (*state.PeerAccountsComparisonApiResponse, error) {
+	if ns.ComparePeerAccountsCalled != nil {
+		return ns.ComparePeerAccountsCalled(ctx, fromRootHash, toRootHash)
+	}
+
+	return nil, nil
+}
+
+// GetEpochStartValidatorStatsRootHash -
+func (ns *NodeStub) GetEpochStartValidatorStatsRootHash(epoch uint32) (string, error) {
+	if ns.GetEpochStartValidatorStatsRootHashCalled != nil {
+		return ns.GetEpochStartValidatorStatsRootHashCalled(epoch)
+	}
+
+	return "", nil
+}
+
 // GetHeartbeats -
 func (ns *NodeStub) GetHeartbeats() []data.PubKeyHeartbeat {
 	return ns.GetHeartbeatsHandler()
@@ -1,6 +1,7 @@
 package facade
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/node"
+	stateApi "github.com/ElrondNetwork/elrond-go/api/state"
 	transactionApi "github.com/ElrondNetwork/elrond-go/api/transaction"
 	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
@@ -41,6 +43,7 @@ var _ = node.FacadeHandler(&nodeFacade{})
 var _ = transactionApi.TxService(&nodeFacade{})
 var _ = validator.ValidatorsStatisticsApiHandler(&nodeFacade{})
 var _ = vmValues.FacadeHandler(&nodeFacade{})
+var _ = stateApi.FacadeHandler(&nodeFacade{})
 
 var log = logger.GetOrCreate("facade")
 
@@ -209,6 +212,16 @@ func (nf *nodeFacade) GetValueForKey(address string, key string) (string, error)
 	return nf.node.GetValueForKey(address, key)
 }
 
+// GetProof returns the Merkle proof of the given address
+func (nf *nodeFacade) GetProof(address string) (*state.ProofApiResponse, error) {
+	return nf.node.GetProof(address)
+}
+
+// GetProofDataTrie returns the Merkle proofs of a key in the given address' data trie and of the address itself
+func (nf *nodeFacade) GetProofDataTrie(address string, key string) (*state.DataTrieProofApiResponse, error) {
+	return nf.node.GetProofDataTrie(address, key)
+}
+
 // CreateTransaction creates a transaction from all needed fields
 func (nf *nodeFacade) CreateTransaction(
 	nonce uint64,
@@ -219,9 +232,14 @@ func (nf *nodeFacade) CreateTransaction(
 	gasLimit uint64,
 	txData string,
 	signatureHex string,
+	version uint32,
+	options uint32,
+	signaturesHex []string,
+	notBeforeRound uint64,
+	notBeforeEpoch uint32,
 ) (*transaction.Transaction, []byte, error) {
 
-	return nf.node.CreateTransaction(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, txData, signatureHex)
+	return nf.node.CreateTransaction(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, txData, signatureHex, version, options, signaturesHex, notBeforeRound, notBeforeEpoch)
 }
 
 // ValidateTransaction will validate a transaction
@@ -234,6 +252,21 @@ func (nf *nodeFacade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiRe
 	return nf.node.ValidatorStatisticsApi()
 }
 
+// GetPeerAccounts returns the peer accounts from the peer accounts trie having the provided root hash
+func (nf *nodeFacade) GetPeerAccounts(ctx context.Context, rootHash string) (*state.PeerAccountsApiResponse, error) {
+	return nf.node.GetPeerAccounts(ctx, rootHash)
+}
+
+// ComparePeerAccounts returns the peer accounts that differ between the peer accounts tries having the provided root hashes
+func (nf *nodeFacade) ComparePeerAccounts(ctx context.Context, fromRootHash string, toRootHash string) (*state.PeerAccountsComparisonApiResponse, error) {
+	return nf.node.ComparePeerAccounts(ctx, fromRootHash, toRootHash)
+}
+
+// GetEpochStartValidatorStatsRootHash returns the validator statistics root hash of the provided epoch's start block
+func (nf *nodeFacade) GetEpochStartValidatorStatsRootHash(epoch uint32) (string, error) {
+	return nf.node.GetEpochStartValidatorStatsRootHash(epoch)
+}
+
 // SendBulkTransactions will send a bulk of transactions on the topic channel
 func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return nf.node.SendBulkTransactions(txs)
@@ -260,6 +293,22 @@ func (nf *nodeFacade) GetAccount(address string) (state.UserAccountHandler, erro
 	return nf.node.GetAccount(address)
 }
 
+// GetAccountAtBlockNonce returns the account correlated with provided address, as it was after the block
+// having the provided nonce was committed
+func (nf *nodeFacade) GetAccountAtBlockNonce(address string, blockNonce uint64) (state.UserAccountHandler, error) {
+	return nf.node.GetAccountAtBlockNonce(address, blockNonce)
+}
+
+// GetStateDiff returns the leaves that differ between the tries having the provided root hashes
+func (nf *nodeFacade) GetStateDiff(fromRootHash string, toRootHash string) (*state.StateDiffApiResponse, error) {
+	return nf.node.GetStateDiff(fromRootHash, toRootHash)
+}
+
+// GetAccountsChangeSet returns the accounts changed by the block having the provided nonce
+func (nf *nodeFacade) GetAccountsChangeSet(blockNonce uint64) (*state.AccountsChangeSetApiResponse, error) {
+	return nf.node.GetAccountsChangeSet(blockNonce)
+}
+
 // GetHeartbeats returns the heartbeat status for each public key from initial list or later joined to the network
 func (nf *nodeFacade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 	hbStatus := nf.node.GetHeartbeats()
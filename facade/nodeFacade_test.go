@@ -466,7 +466,7 @@ func TestNodeFacade_CreateTransaction(t *testing.T) {
 	nodeCreateTxWasCalled := false
 	node := &mock.NodeStub{
 		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string,
-			gasPrice uint64, gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error) {
+			gasPrice uint64, gasLimit uint64, data string, signatureHex string, version uint32, options uint32, signaturesHex []string, notBeforeRound uint64, notBeforeEpoch uint32) (*transaction.Transaction, []byte, error) {
 			nodeCreateTxWasCalled = true
 			return nil, nil, nil
 		},
@@ -475,7 +475,7 @@ func TestNodeFacade_CreateTransaction(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	_, _, _ = nf.CreateTransaction(0, "0", "0", "0", 0, 0, "0", "0")
+	_, _, _ = nf.CreateTransaction(0, "0", "0", "0", 0, 0, "0", "0", 0, 0, nil, 0, 0)
 
 	assert.True(t, nodeCreateTxWasCalled)
 }
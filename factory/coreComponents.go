@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/forking"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
 	factoryHasher "github.com/ElrondNetwork/elrond-go/hashing/factory"
 	factoryMarshalizer "github.com/ElrondNetwork/elrond-go/marshal/factory"
@@ -65,5 +66,6 @@ func (ccf *CoreComponentsFactory) Create() (*CoreComponents, error) {
 		Uint64ByteSliceConverter: uint64ByteSliceConverter,
 		StatusHandler:            statusHandler.NewNilStatusHandler(),
 		ChainID:                  ccf.chainID,
+		EpochNotifier:            forking.NewEpochNotifier(),
 	}, nil
 }
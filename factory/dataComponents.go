@@ -2,6 +2,7 @@ package factory
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -10,10 +11,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	dataRetrieverFactory "github.com/ElrondNetwork/elrond-go/dataRetriever/factory"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/adaptivecache"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
 
 // DataComponentsFactoryArgs holds the arguments needed for creating a data components factory
@@ -25,6 +29,7 @@ type DataComponentsFactoryArgs struct {
 	PathManager        storage.PathManagerHandler
 	EpochStartNotifier EpochStartNotifier
 	CurrentEpoch       uint32
+	MemoryBudget       *adaptivecache.MemoryBudget
 }
 
 type dataComponentsFactory struct {
@@ -35,6 +40,7 @@ type dataComponentsFactory struct {
 	pathManager        storage.PathManagerHandler
 	epochStartNotifier EpochStartNotifier
 	currentEpoch       uint32
+	memoryBudget       *adaptivecache.MemoryBudget
 }
 
 // NewDataComponentsFactory will return a new instance of dataComponentsFactory
@@ -63,6 +69,7 @@ func NewDataComponentsFactory(args DataComponentsFactoryArgs) (*dataComponentsFa
 		pathManager:        args.PathManager,
 		epochStartNotifier: args.EpochStartNotifier,
 		currentEpoch:       args.CurrentEpoch,
+		memoryBudget:       args.MemoryBudget,
 	}, nil
 }
 
@@ -79,10 +86,16 @@ func (dcf *dataComponentsFactory) Create() (*DataComponents, error) {
 		return nil, err
 	}
 
+	txPoolJournal, err := dcf.createTxPoolJournal()
+	if err != nil {
+		return nil, err
+	}
+
 	dataPoolArgs := dataRetrieverFactory.ArgsDataPool{
 		Config:           &dcf.config,
 		EconomicsData:    dcf.economicsData,
 		ShardCoordinator: dcf.shardCoordinator,
+		TxPoolJournal:    txPoolJournal,
 	}
 	datapool, err = dataRetrieverFactory.NewDataPoolFromConfig(dataPoolArgs)
 	if err != nil {
@@ -90,12 +103,41 @@ func (dcf *dataComponentsFactory) Create() (*DataComponents, error) {
 	}
 
 	return &DataComponents{
-		Blkc:     blkc,
-		Store:    store,
-		Datapool: datapool,
+		Blkc:          blkc,
+		Store:         store,
+		Datapool:      datapool,
+		TxPoolJournal: txPoolJournal,
 	}, nil
 }
 
+func (dcf *dataComponentsFactory) createTxPoolJournal() (dataRetriever.TxPoolJournal, error) {
+	journalConfig := dcf.config.TxPoolJournal
+	if !journalConfig.Enabled {
+		return txpool.NewDisabledJournal(), nil
+	}
+
+	shardId := core.GetShardIdString(dcf.shardCoordinator.SelfId())
+	arg := storageUnit.ArgDB{
+		DBType:            storageUnit.DBType(journalConfig.DB.Type),
+		Path:              dcf.pathManager.PathForStatic(shardId, journalConfig.DB.FilePath),
+		BatchDelaySeconds: journalConfig.DB.BatchDelaySeconds,
+		MaxBatchSize:      journalConfig.DB.MaxBatchSize,
+		MaxOpenFiles:      journalConfig.DB.MaxOpenFiles,
+	}
+	persister, err := storageUnit.NewDB(arg)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the transactions pool journal persister", err)
+	}
+
+	return txpool.NewTxPoolJournal(txpool.ArgTxPoolJournal{
+		Persister:   persister,
+		Marshalizer: dcf.core.InternalMarshalizer,
+		SelfShardID: dcf.shardCoordinator.SelfId(),
+		MaxNumTxs:   journalConfig.MaxNumTxs,
+		MaxAge:      time.Duration(journalConfig.MaxAgeInSeconds) * time.Second,
+	})
+}
+
 func (dcf *dataComponentsFactory) createBlockChainFromConfig() (data.ChainHandler, error) {
 	if dcf.shardCoordinator.SelfId() < dcf.shardCoordinator.NumberOfShards() {
 		blockChain := blockchain.NewBlockChain()
@@ -127,6 +169,7 @@ func (dcf *dataComponentsFactory) createDataStoreFromConfig() (dataRetriever.Sto
 		dcf.pathManager,
 		dcf.epochStartNotifier,
 		dcf.currentEpoch,
+		dcf.memoryBudget,
 	)
 	if err != nil {
 		return nil, err
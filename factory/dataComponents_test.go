@@ -166,5 +166,8 @@ func getGeneralConfig() config.Config {
 		PeerBlockBodyStorage: storageCfg,
 		BootstrapStorage:     storageCfg,
 		TxLogsStorage:        storageCfg,
+
+		AccountsChangeSetStorage: storageCfg,
+		ReceiptsStorage:          storageCfg,
 	}
 }
@@ -34,6 +34,7 @@ type CoreComponents struct {
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
 	StatusHandler            core.AppStatusHandler
 	ChainID                  []byte
+	EpochNotifier            process.EpochNotifier
 }
 
 // CryptoParams is a DTO for holding block signing parameters
@@ -47,9 +48,10 @@ type CryptoParams struct {
 
 // DataComponents struct holds the data components
 type DataComponents struct {
-	Blkc     data.ChainHandler
-	Store    dataRetriever.StorageService
-	Datapool dataRetriever.PoolsHolder
+	Blkc          data.ChainHandler
+	Store         dataRetriever.StorageService
+	Datapool      dataRetriever.PoolsHolder
+	TxPoolJournal dataRetriever.TxPoolJournal
 }
 
 // TriesComponents holds the tries components
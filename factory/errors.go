@@ -20,6 +20,9 @@ var ErrNilShardCoordinator = errors.New("nil shard coordinator provided")
 // ErrNilPathManager signals that a nil path manager has been provided
 var ErrNilPathManager = errors.New("nil path manager provided")
 
+// ErrNilStorerWrapper signals that a nil storer wrapper has been provided
+var ErrNilStorerWrapper = errors.New("nil storer wrapper provided")
+
 // ErrNilMarshalizer signals that a nil marshalizer has been provided
 var ErrNilMarshalizer = errors.New("nil marshalizer provided")
 
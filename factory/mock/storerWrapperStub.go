@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/storage"
+
+// StorerWrapperStub -
+type StorerWrapperStub struct {
+	WrapCalled func(identifier string, storer storage.Storer) storage.Storer
+}
+
+// Wrap -
+func (sws *StorerWrapperStub) Wrap(identifier string, storer storage.Storer) storage.Storer {
+	if sws.WrapCalled != nil {
+		return sws.WrapCalled(identifier, storer)
+	}
+
+	return storer
+}
+
+// IsInterfaceNil -
+func (sws *StorerWrapperStub) IsInterfaceNil() bool {
+	return sws == nil
+}
@@ -73,6 +73,9 @@ func (scf *stateComponentsFactory) Create() (*StateComponents, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", ErrAccountsAdapterCreation, err.Error())
 	}
+	accountsAdapter.EnableChangeSetsCollection()
+	accountsAdapter.SetStoredBytesEnableEpoch(scf.config.GeneralSettings.StoredBytesEnableEpoch)
+	scf.core.EpochNotifier.RegisterNotifyHandler(accountsAdapter)
 
 	accountFactory = factoryState.NewPeerAccountCreator()
 	merkleTrie = scf.tries.TriesContainer.Get([]byte(factory.PeerAccountTrie))
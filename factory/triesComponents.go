@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/adaptivecache"
 )
 
 // TriesComponentsFactoryArgs holds the arguments needed for creating a tries components factory
@@ -21,7 +22,9 @@ type TriesComponentsFactoryArgs struct {
 	Hasher           hashing.Hasher
 	PathManager      storage.PathManagerHandler
 	ShardCoordinator sharding.Coordinator
+	StorerWrapper    storage.StorerWrapper
 	Config           config.Config
+	MemoryBudget     *adaptivecache.MemoryBudget
 }
 
 type triesComponentsFactory struct {
@@ -29,7 +32,9 @@ type triesComponentsFactory struct {
 	hasher           hashing.Hasher
 	pathManager      storage.PathManagerHandler
 	shardCoordinator sharding.Coordinator
+	storerWrapper    storage.StorerWrapper
 	config           config.Config
+	memoryBudget     *adaptivecache.MemoryBudget
 }
 
 // NewTriesComponentsFactory return a new instance of tries components factory
@@ -46,6 +51,9 @@ func NewTriesComponentsFactory(args TriesComponentsFactoryArgs) (*triesComponent
 	if check.IfNil(args.ShardCoordinator) {
 		return nil, ErrNilShardCoordinator
 	}
+	if check.IfNil(args.StorerWrapper) {
+		return nil, ErrNilStorerWrapper
+	}
 
 	return &triesComponentsFactory{
 		config:           args.Config,
@@ -53,6 +61,8 @@ func NewTriesComponentsFactory(args TriesComponentsFactoryArgs) (*triesComponent
 		hasher:           args.Hasher,
 		pathManager:      args.PathManager,
 		shardCoordinator: args.ShardCoordinator,
+		storerWrapper:    args.StorerWrapper,
+		memoryBudget:     args.MemoryBudget,
 	}, nil
 }
 
@@ -66,6 +76,9 @@ func (tcf *triesComponentsFactory) Create() (*TriesComponents, error) {
 		Hasher:                   tcf.hasher,
 		PathManager:              tcf.pathManager,
 		TrieStorageManagerConfig: tcf.config.TrieStorageManagerConfig,
+		StorerWrapper:            tcf.storerWrapper,
+		DecodedNodesCacheCfg:     tcf.config.DecodedTrieNodesCache,
+		MemoryBudget:             tcf.memoryBudget,
 	}
 	shardIDString := convertShardIDToString(tcf.shardCoordinator.SelfId())
 
@@ -76,6 +76,7 @@ func getTriesArgs() factory.TriesComponentsFactoryArgs {
 		Hasher:           &mock.HasherMock{},
 		PathManager:      &mock.PathManagerStub{},
 		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(2),
+		StorerWrapper:    &mock.StorerWrapperStub{},
 		Config: config.Config{
 			EvictionWaitingList: config.EvictionWaitingListConfig{
 				Size: 10,
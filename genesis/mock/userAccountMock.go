@@ -134,3 +134,8 @@ func (uam *UserAccountMock) SetUserName(_ []byte) {
 func (uam *UserAccountMock) GetUserName() []byte {
 	return nil
 }
+
+// GetStoredBytes -
+func (uam *UserAccountMock) GetStoredBytes() uint64 {
+	return 0
+}
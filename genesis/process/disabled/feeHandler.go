@@ -4,6 +4,9 @@ import (
 	"math"
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
@@ -54,6 +57,19 @@ func (fh *FeeHandler) GetAccumulatedFees() *big.Int {
 func (fh *FeeHandler) ProcessTransactionFee(_ *big.Int, _ *big.Int, _ []byte) {
 }
 
+// ProcessTransactionGasRefund does nothing
+func (fh *FeeHandler) ProcessTransactionGasRefund(_ []byte, _ uint64) {
+}
+
+// ProcessTransactionReceipt does nothing
+func (fh *FeeHandler) ProcessTransactionReceipt(_ []byte, _ data.TransactionHandler, _ core.ReceiptStatus) {
+}
+
+// GetReceipts returns an empty map
+func (fh *FeeHandler) GetReceipts() map[string]*receipt.Receipt {
+	return make(map[string]*receipt.Receipt)
+}
+
 // RevertFees does nothing
 func (fh *FeeHandler) RevertFees(_ [][]byte) {
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/forking"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -230,10 +231,12 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator) (*genesisP
 	}
 
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  arg.PubkeyConv,
-		ShardCoordinator: arg.ShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   vmcommon.NewAtArgumentParser(),
+		PubkeyConverter:      arg.PubkeyConv,
+		ShardCoordinator:     arg.ShardCoordinator,
+		BuiltInFunctions:     builtInFuncs,
+		ArgumentParser:       vmcommon.NewAtArgumentParser(),
+		EpochNotifier:        forking.NewEpochNotifier(),
+		RelayedTxEnableEpoch: math.MaxUint32,
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	if err != nil {
@@ -304,6 +307,7 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator) (*genesisP
 		arg.PubkeyConv,
 		disabledBlockSizeComputationHandler,
 		disabledBalanceComputationHandler,
+		virtualMachineFactory.BlockChainHookImpl(),
 	)
 	if err != nil {
 		return nil, err
@@ -332,7 +336,10 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator) (*genesisP
 		return nil, err
 	}
 
-	queryService, err := smartContract.NewSCQueryService(vmContainer, arg.Economics)
+	queryService, err := smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{
+		VmContainer:  vmContainer,
+		EconomicsFee: arg.Economics,
+	})
 	if err != nil {
 		return nil, err
 	}
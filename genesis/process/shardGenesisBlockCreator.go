@@ -8,6 +8,7 @@ import (
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/forking"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
@@ -210,10 +211,12 @@ func setBalanceToTrie(arg ArgsGenesisBlockCreator, accnt genesis.InitialAccountH
 func createProcessorsForShard(arg ArgsGenesisBlockCreator) (*genesisProcessors, error) {
 	argsParser := vmcommon.NewAtArgumentParser()
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:               arg.GasMap,
-		MapDNSAddresses:      make(map[string]struct{}),
-		EnableUserNameChange: false,
-		Marshalizer:          arg.Marshalizer,
+		GasMap:                      arg.GasMap,
+		MapDNSAddresses:             make(map[string]struct{}),
+		EnableUserNameChange:        false,
+		Marshalizer:                 arg.Marshalizer,
+		EpochNotifier:               forking.NewEpochNotifier(),
+		MultisigAccountsEnableEpoch: math.MaxUint32,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -278,10 +281,12 @@ func createProcessorsForShard(arg ArgsGenesisBlockCreator) (*genesisProcessors,
 	}
 
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  arg.PubkeyConv,
-		ShardCoordinator: arg.ShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   vmcommon.NewAtArgumentParser(),
+		PubkeyConverter:      arg.PubkeyConv,
+		ShardCoordinator:     arg.ShardCoordinator,
+		BuiltInFunctions:     builtInFuncs,
+		ArgumentParser:       vmcommon.NewAtArgumentParser(),
+		EpochNotifier:        forking.NewEpochNotifier(),
+		RelayedTxEnableEpoch: math.MaxUint32,
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	if err != nil {
@@ -337,6 +342,9 @@ func createProcessorsForShard(arg ArgsGenesisBlockCreator) (*genesisProcessors,
 		genesisFeeHandler,
 		receiptTxInterim,
 		badTxInterim,
+		scForwarder,
+		forking.NewEpochNotifier(),
+		math.MaxUint32,
 	)
 	if err != nil {
 		return nil, errors.New("could not create transaction statisticsProcessor: " + err.Error())
@@ -365,6 +373,7 @@ func createProcessorsForShard(arg ArgsGenesisBlockCreator) (*genesisProcessors,
 		disabledBlockTracker,
 		disabledBlockSizeComputationHandler,
 		disabledBalanceComputationHandler,
+		vmFactoryImpl.BlockChainHookImpl(),
 	)
 	if err != nil {
 		return nil, err
@@ -393,7 +402,10 @@ func createProcessorsForShard(arg ArgsGenesisBlockCreator) (*genesisProcessors,
 		return nil, err
 	}
 
-	queryService, err := smartContract.NewSCQueryService(vmContainer, arg.Economics)
+	queryService, err := smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{
+		VmContainer:  vmContainer,
+		EconomicsFee: arg.Economics,
+	})
 	if err != nil {
 		return nil, err
 	}
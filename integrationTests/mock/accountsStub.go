@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"errors"
 
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -23,7 +24,8 @@ type AccountsStub struct {
 	SnapshotStateCalled      func(rootHash []byte)
 	SetStateCheckpointCalled func(rootHash []byte)
 	IsPruningEnabledCalled   func() bool
-	GetAllLeavesCalled       func(rootHash []byte) (map[string][]byte, error)
+	IterateAllLeavesCalled   func(ctx context.Context, rootHash []byte, handler func(key []byte, value []byte) error) error
+	GetProofCalled           func(rootHash []byte, key []byte) ([][]byte, error)
 }
 
 // LoadAccount -
@@ -42,10 +44,18 @@ func (as *AccountsStub) SaveAccount(account state.AccountHandler) error {
 	return nil
 }
 
-// GetAllLeaves -
-func (as *AccountsStub) GetAllLeaves(rootHash []byte) (map[string][]byte, error) {
-	if as.GetAllLeavesCalled != nil {
-		return as.GetAllLeavesCalled(rootHash)
+// IterateAllLeaves -
+func (as *AccountsStub) IterateAllLeaves(ctx context.Context, rootHash []byte, handler func(key []byte, value []byte) error) error {
+	if as.IterateAllLeavesCalled != nil {
+		return as.IterateAllLeavesCalled(ctx, rootHash, handler)
+	}
+	return nil
+}
+
+// GetProof -
+func (as *AccountsStub) GetProof(rootHash []byte, key []byte) ([][]byte, error) {
+	if as.GetProofCalled != nil {
+		return as.GetProofCalled(rootHash, key)
 	}
 	return nil, nil
 }
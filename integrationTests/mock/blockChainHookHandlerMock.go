@@ -15,6 +15,8 @@ type BlockChainHookHandlerMock struct {
 	TempAccountCalled       func(address []byte) state.AccountHandler
 	SetCurrentHeaderCalled  func(hdr data.HeaderHandler)
 	NewAddressCalled        func(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error)
+	CurrentRoundCalled      func() uint64
+	CurrentEpochCalled      func() uint32
 }
 
 // GetBuiltInFunctions -
@@ -64,3 +66,21 @@ func (e *BlockChainHookHandlerMock) NewAddress(creatorAddress []byte, creatorNon
 
 	return make([]byte, 0), nil
 }
+
+// CurrentRound -
+func (e *BlockChainHookHandlerMock) CurrentRound() uint64 {
+	if e.CurrentRoundCalled != nil {
+		return e.CurrentRoundCalled()
+	}
+
+	return 0
+}
+
+// CurrentEpoch -
+func (e *BlockChainHookHandlerMock) CurrentEpoch() uint32 {
+	if e.CurrentEpochCalled != nil {
+		return e.CurrentEpochCalled()
+	}
+
+	return 0
+}
@@ -59,6 +59,11 @@ func (cdb *countingDB) DestroyClosed() error {
 	return cdb.Destroy()
 }
 
+// RangeKeys will iterate over all contained (key, value) pairs
+func (cdb *countingDB) RangeKeys(handler func(key []byte, val []byte) bool) {
+	cdb.db.RangeKeys(handler)
+}
+
 // Reset will reset the number of time the Put method was called
 func (cdb *countingDB) Reset() {
 	cdb.nrOfPut = 0
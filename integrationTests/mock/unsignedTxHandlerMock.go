@@ -3,19 +3,24 @@ package mock
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 )
 
 // UnsignedTxHandlerMock -
 type UnsignedTxHandlerMock struct {
-	CleanProcessedUtxsCalled    func()
-	ProcessTransactionFeeCalled func(cost *big.Int, hash []byte)
-	CreateAllUTxsCalled         func() []data.TransactionHandler
-	VerifyCreatedUTxsCalled     func() error
-	AddTxFeeFromBlockCalled     func(tx data.TransactionHandler)
-	GetAccumulatedFeesCalled    func() *big.Int
-	GetDeveloperFeesCalled      func() *big.Int
-	RevertFeesCalled            func(txHashes [][]byte)
+	CleanProcessedUtxsCalled          func()
+	ProcessTransactionFeeCalled       func(cost *big.Int, hash []byte)
+	ProcessTransactionGasRefundCalled func(txHash []byte, gasRefunded uint64)
+	ProcessTransactionReceiptCalled   func(txHash []byte, tx data.TransactionHandler, status core.ReceiptStatus)
+	GetReceiptsCalled                 func() map[string]*receipt.Receipt
+	CreateAllUTxsCalled               func() []data.TransactionHandler
+	VerifyCreatedUTxsCalled           func() error
+	AddTxFeeFromBlockCalled           func(tx data.TransactionHandler)
+	GetAccumulatedFeesCalled          func() *big.Int
+	GetDeveloperFeesCalled            func() *big.Int
+	RevertFeesCalled                  func(txHashes [][]byte)
 }
 
 // RevertFees -
@@ -72,6 +77,33 @@ func (ut *UnsignedTxHandlerMock) ProcessTransactionFee(cost *big.Int, devFee *bi
 	ut.ProcessTransactionFeeCalled(cost, txHash)
 }
 
+// ProcessTransactionGasRefund -
+func (ut *UnsignedTxHandlerMock) ProcessTransactionGasRefund(txHash []byte, gasRefunded uint64) {
+	if ut.ProcessTransactionGasRefundCalled == nil {
+		return
+	}
+
+	ut.ProcessTransactionGasRefundCalled(txHash, gasRefunded)
+}
+
+// ProcessTransactionReceipt -
+func (ut *UnsignedTxHandlerMock) ProcessTransactionReceipt(txHash []byte, tx data.TransactionHandler, status core.ReceiptStatus) {
+	if ut.ProcessTransactionReceiptCalled == nil {
+		return
+	}
+
+	ut.ProcessTransactionReceiptCalled(txHash, tx, status)
+}
+
+// GetReceipts -
+func (ut *UnsignedTxHandlerMock) GetReceipts() map[string]*receipt.Receipt {
+	if ut.GetReceiptsCalled == nil {
+		return make(map[string]*receipt.Receipt)
+	}
+
+	return ut.GetReceiptsCalled()
+}
+
 // CreateAllUTxs -
 func (ut *UnsignedTxHandlerMock) CreateAllUTxs() []data.TransactionHandler {
 	if ut.CreateAllUTxsCalled == nil {
@@ -199,6 +199,7 @@ func testNodeStartsInEpoch(t *testing.T, shardID uint32, expectedHighestRound ui
 		Rounder:                    rounder,
 		AddressPubkeyConverter:     integrationTests.TestAddressPubkeyConverter,
 		StatusHandler:              &mock.AppStatusHandlerStub{},
+		StorerWrapper:              &mock.StorerWrapperStub{},
 	}
 	epochStartBootstrap, err := bootstrap.NewEpochStartBootstrap(argsBootstrapHandler)
 	assert.Nil(t, err)
@@ -215,7 +216,8 @@ func testNodeStartsInEpoch(t *testing.T, shardID uint32, expectedHighestRound ui
 		shardC,
 		&mock.PathManagerStub{},
 		&mock.EpochStartNotifierStub{},
-		0)
+		0,
+		nil)
 	assert.NoError(t, err)
 	storageServiceShard, err := storageFactory.CreateForMeta()
 	assert.NoError(t, err)
@@ -573,5 +575,33 @@ func getGeneralConfig() config.Config {
 				MaxOpenFiles:      10,
 			},
 		},
+		AccountsChangeSetStorage: config.StorageConfig{
+			Cache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 1000,
+				Shards:   1,
+			},
+			DB: config.DBConfig{
+				FilePath:          "AccountsChangeSets",
+				Type:              string(storageUnit.LvlDBSerial),
+				BatchDelaySeconds: 2,
+				MaxBatchSize:      100,
+				MaxOpenFiles:      10,
+			},
+		},
+		ReceiptsStorage: config.StorageConfig{
+			Cache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 1000,
+				Shards:   1,
+			},
+			DB: config.DBConfig{
+				FilePath:          "Receipts",
+				Type:              string(storageUnit.LvlDBSerial),
+				BatchDelaySeconds: 2,
+				MaxBatchSize:      100,
+				MaxOpenFiles:      10,
+			},
+		},
 	}
 }
@@ -305,6 +305,7 @@ func createHardForkExporter(
 			Uint64Converter:   integrationTests.TestUint64Converter,
 			DataPool:          node.DataPool,
 			StorageService:    node.Storage,
+			BlockChain:        node.BlockChain,
 			RequestHandler:    node.RequestHandler,
 			ShardCoordinator:  node.ShardCoordinator,
 			Messenger:         node.Messenger,
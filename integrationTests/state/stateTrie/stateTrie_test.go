@@ -31,6 +31,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/adaptivecache"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1665,3 +1666,91 @@ func testNodeStateCheckpointSnapshotAndPruning(
 		assert.NotNil(t, err)
 	}
 }
+
+func BenchmarkTxExecutionOnLargeState_WithoutDecodedNodesCache(b *testing.B) {
+	benchmarkTxExecutionOnLargeState(b, createTrieStorageUnit(), true)
+}
+
+func BenchmarkTxExecutionOnLargeState_WithDecodedNodesCache(b *testing.B) {
+	benchmarkTxExecutionOnLargeState(b, createDecodedNodesCacheDb(), true)
+}
+
+func BenchmarkStateReadsOnLargeState_WithoutDecodedNodesCache(b *testing.B) {
+	benchmarkTxExecutionOnLargeState(b, createTrieStorageUnit(), false)
+}
+
+func BenchmarkStateReadsOnLargeState_WithDecodedNodesCache(b *testing.B) {
+	benchmarkTxExecutionOnLargeState(b, createDecodedNodesCacheDb(), false)
+}
+
+func createTrieStorageUnit() storage.Storer {
+	cache, _ := storageUnit.NewCache(storageUnit.LRUCache, 500000, 1, 0)
+	unit, _ := storageUnit.NewStorageUnit(cache, memorydb.New())
+
+	return unit
+}
+
+func createDecodedNodesCacheDb() data.DBWriteCacher {
+	cache, _ := storageUnit.NewCacheWithMemoryBudget(storageUnit.AdaptiveCache, 100000, 1, 104857600, adaptivecache.NewMemoryBudget())
+	db, _ := trie.NewDecodedNodesCacheDb(createTrieStorageUnit(), cache)
+
+	return db
+}
+
+// benchmarkTxExecutionOnLargeState processes blocks of 1000 transactions touching hot accounts of a large state. Each
+// transaction reads a value from the data trie of a hot contract and, if executeTransfers is set, moves balance
+// between two hot accounts. Every block starts from a recreated trie, as after a rollback or a node restart, so the
+// trie nodes below the in memory levels are resolved again from the database.
+// The decoded nodes cache pays off for the reads: the nodes of the hot accounts and contracts are decoded once and
+// then copied from the cache. When the same hot accounts are also changed in every block, most resolved nodes were
+// created by the previous block and are read only once, so the cache mostly adds the cost of keeping them
+func benchmarkTxExecutionOnLargeState(b *testing.B, db data.DBWriteCacher, executeTransfers bool) {
+	numAccounts := 100000
+	numHotAccounts := 5000
+	numContractValues := 1000
+	numTxsPerBlock := 1000
+
+	trieStorage, _ := trie.NewTrieStorageManagerWithoutPruning(db)
+	adb, _ := integrationTests.CreateAccountsDB(integrationTests.UserAccount, trieStorage)
+
+	addresses := make([][]byte, numAccounts)
+	for i := 0; i < numAccounts; i++ {
+		addresses[i] = integrationTests.CreateRandomAddress()
+		account, _ := adb.LoadAccount(addresses[i])
+		_ = account.(state.UserAccountHandler).AddToBalance(big.NewInt(1000000000))
+		_ = adb.SaveAccount(account)
+	}
+
+	contractAddress := integrationTests.CreateRandomAddress()
+	contract, _ := adb.LoadAccount(contractAddress)
+	for i := 0; i < numContractValues; i++ {
+		contract.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+	}
+	_ = adb.SaveAccount(contract)
+
+	rootHash, err := adb.Commit()
+	require.Nil(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err = adb.RecreateTrie(rootHash)
+		require.Nil(b, err)
+
+		contract, err = adb.LoadAccount(contractAddress)
+		require.Nil(b, err)
+		for j := 0; j < numTxsPerBlock; j++ {
+			src, errLoad := adb.LoadAccount(addresses[rand.Intn(numHotAccounts)])
+			require.Nil(b, errLoad)
+			dest, errLoad := adb.LoadAccount(addresses[rand.Intn(numHotAccounts)])
+			require.Nil(b, errLoad)
+			_, _ = contract.(state.UserAccountHandler).DataTrieTracker().RetrieveValue([]byte(fmt.Sprintf("key%d", rand.Intn(numContractValues))))
+
+			if executeTransfers {
+				integrationTests.AdbEmulateBalanceTxSafeExecution(src.(state.UserAccountHandler), dest.(state.UserAccountHandler), adb, big.NewInt(1))
+			}
+		}
+
+		rootHash, err = adb.Commit()
+		require.Nil(b, err)
+	}
+}
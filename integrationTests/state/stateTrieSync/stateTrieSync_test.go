@@ -78,7 +78,16 @@ func TestNode_RequestInterceptTrieNodesWithMessenger(t *testing.T) {
 	)
 
 	waitTime := 100 * time.Second
-	trieSyncer, _ := trie.NewTrieSyncer(requestHandler, nRequester.DataPool.TrieNodes(), requesterTrie, shardID, factory.AccountTrieNodesTopic)
+	arg := trie.ArgTrieSyncer{
+		RequestHandler:     requestHandler,
+		InterceptedNodes:   nRequester.DataPool.TrieNodes(),
+		Trie:               requesterTrie,
+		ShardId:            shardID,
+		Topic:              factory.AccountTrieNodesTopic,
+		TrieSyncStatistics: trie.NewTrieSyncStatistics(),
+		MaxInFlightNodes:   1000,
+	}
+	trieSyncer, _ := trie.NewTrieSyncer(arg)
 	ctx, cancel := context.WithTimeout(context.Background(), waitTime)
 	defer cancel()
 
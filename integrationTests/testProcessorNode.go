@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/accumulator"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/forking"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
 	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -197,6 +198,7 @@ type TestProcessorNode struct {
 	TxCoordinator          process.TransactionCoordinator
 	ScrForwarder           process.IntermediateTransactionHandler
 	BlockchainHook         *hooks.BlockChainHookImpl
+	EpochNotifier          process.EpochNotifier
 	VMContainer            process.VirtualMachinesContainer
 	ArgsParser             process.ArgumentsParser
 	ScProcessor            process.SmartContractProcessor
@@ -452,7 +454,10 @@ func (tpn *TestProcessorNode) initTestNode() {
 	tpn.initBlockTracker()
 	tpn.initInterceptors()
 	tpn.initInnerProcessors()
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData)
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{
+		VmContainer:  tpn.VMContainer,
+		EconomicsFee: tpn.EconomicsData,
+	})
 	tpn.initBlockProcessor(stateCheckpointModulus)
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
 		TestMarshalizer,
@@ -489,6 +494,7 @@ func (tpn *TestProcessorNode) initChainHandler() {
 	} else {
 		tpn.BlockChain = CreateShardChain()
 	}
+	tpn.EpochNotifier = forking.NewEpochNotifier()
 }
 
 func (tpn *TestProcessorNode) initEconomicsData() {
@@ -669,6 +675,7 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			MultiSigner:             TestMultiSig,
 			DataPool:                tpn.DataPool,
 			Accounts:                tpn.AccntState,
+			BlockChain:              tpn.BlockChain,
 			AddressPubkeyConverter:  TestAddressPubkeyConverter,
 			SingleSigner:            tpn.OwnAccount.SingleSigner,
 			BlockSingleSigner:       tpn.OwnAccount.BlockSingleSigner,
@@ -719,6 +726,7 @@ func (tpn *TestProcessorNode) initInterceptors() {
 
 		shardInterContFactArgs := interceptorscontainer.ShardInterceptorsContainerFactoryArgs{
 			Accounts:                tpn.AccntState,
+			BlockChain:              tpn.BlockChain,
 			ShardCoordinator:        tpn.ShardCoordinator,
 			NodesCoordinator:        tpn.NodesCoordinator,
 			Messenger:               tpn.Messenger,
@@ -852,6 +860,7 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 		GasMap:          gasSchedule,
 		MapDNSAddresses: make(map[string]struct{}),
 		Marshalizer:     TestMarshalizer,
+		EpochNotifier:   tpn.EpochNotifier,
 	}
 	builtInFuncs, _ := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 
@@ -892,10 +901,12 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 	tpn.FeeAccumulator, _ = postprocess.NewFeeAccumulator()
 	tpn.ArgsParser = vmcommon.NewAtArgumentParser()
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  TestAddressPubkeyConverter,
-		ShardCoordinator: tpn.ShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   tpn.ArgsParser,
+		PubkeyConverter:      TestAddressPubkeyConverter,
+		ShardCoordinator:     tpn.ShardCoordinator,
+		BuiltInFunctions:     builtInFuncs,
+		ArgumentParser:       tpn.ArgsParser,
+		EpochNotifier:        tpn.EpochNotifier,
+		RelayedTxEnableEpoch: 0,
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	tpn.GasHandler, _ = preprocess.NewGasComputation(tpn.EconomicsData, txTypeHandler)
@@ -933,6 +944,9 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 		tpn.EconomicsData,
 		receiptsHandler,
 		badBlocskHandler,
+		tpn.ScrForwarder,
+		tpn.EpochNotifier,
+		0,
 	)
 
 	fact, _ := shard.NewPreProcessorsContainerFactory(
@@ -953,6 +967,7 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 		tpn.BlockTracker,
 		TestBlockSizeComputationHandler,
 		TestBalanceComputationHandler,
+		vmFactory.BlockChainHookImpl(),
 	)
 	tpn.PreProcessorsContainer, _ = fact.Create()
 
@@ -1024,10 +1039,12 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 	tpn.FeeAccumulator, _ = postprocess.NewFeeAccumulator()
 	tpn.ArgsParser = vmcommon.NewAtArgumentParser()
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  TestAddressPubkeyConverter,
-		ShardCoordinator: tpn.ShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   tpn.ArgsParser,
+		PubkeyConverter:      TestAddressPubkeyConverter,
+		ShardCoordinator:     tpn.ShardCoordinator,
+		BuiltInFunctions:     builtInFuncs,
+		ArgumentParser:       tpn.ArgsParser,
+		EpochNotifier:        tpn.EpochNotifier,
+		RelayedTxEnableEpoch: 0,
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	tpn.GasHandler, _ = preprocess.NewGasComputation(tpn.EconomicsData, txTypeHandler)
@@ -1077,6 +1094,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		TestAddressPubkeyConverter,
 		TestBlockSizeComputationHandler,
 		TestBalanceComputationHandler,
+		vmFactory.BlockChainHookImpl(),
 	)
 	tpn.PreProcessorsContainer, _ = fact.Create()
 
@@ -1138,6 +1156,7 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 		RequestHandler:   tpn.RequestHandler,
 		Core:             nil,
 		BlockChainHook:   tpn.BlockchainHook,
+		EpochNotifier:    tpn.EpochNotifier,
 		HeaderValidator:  tpn.HeaderValidator,
 		Rounder:          tpn.Rounder,
 		BootStorer: &mock.BoostrapStorerMock{
@@ -1361,6 +1380,11 @@ func (tpn *TestProcessorNode) initNode() {
 
 // SendTransaction can send a transaction (it does the dispatching)
 func (tpn *TestProcessorNode) SendTransaction(tx *dataTransaction.Transaction) (string, error) {
+	signaturesHex := make([]string, 0, len(tx.Signatures))
+	for _, signature := range tx.Signatures {
+		signaturesHex = append(signaturesHex, hex.EncodeToString(signature))
+	}
+
 	tx, txHash, err := tpn.Node.CreateTransaction(
 		tx.Nonce,
 		tx.Value.String(),
@@ -1370,6 +1394,11 @@ func (tpn *TestProcessorNode) SendTransaction(tx *dataTransaction.Transaction) (
 		tx.GasLimit,
 		string(tx.Data),
 		hex.EncodeToString(tx.Signature),
+		tx.Version,
+		tx.Options,
+		signaturesHex,
+		tx.NotBeforeRound,
+		tx.NotBeforeEpoch,
 	)
 	if err != nil {
 		return "", err
@@ -121,7 +121,10 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 	tpn.initBootstrapper()
 	tpn.setGenesisBlock()
 	tpn.initNode()
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData)
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{
+		VmContainer:  tpn.VMContainer,
+		EconomicsFee: tpn.EconomicsData,
+	})
 	tpn.addHandlersForCounters()
 	tpn.addGenesisBlocksIntoStorage()
 }
@@ -161,6 +164,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		RequestHandler:    tpn.RequestHandler,
 		Core:              nil,
 		BlockChainHook:    &mock.BlockChainHookHandlerMock{},
+		EpochNotifier:     tpn.EpochNotifier,
 		EpochStartTrigger: &mock.EpochStartTriggerStub{},
 		HeaderValidator:   tpn.HeaderValidator,
 		Rounder:           &mock.RounderMock{},
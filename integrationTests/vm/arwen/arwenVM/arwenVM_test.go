@@ -10,6 +10,7 @@ import (
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/forking"
 	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -21,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	processTransaction "github.com/ElrondNetwork/elrond-go/process/transaction"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
@@ -567,10 +569,12 @@ func TestExecuteTransactionAndTimeToProcessChange(t *testing.T) {
 	pubkeyConv, _ := pubkeyConverter.NewHexPubkeyConverter(32)
 	accnts := vm.CreateInMemoryShardAccountsDB()
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  pubkeyConv,
-		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: make(map[string]struct{}),
-		ArgumentParser:   vmcommon.NewAtArgumentParser(),
+		PubkeyConverter:      pubkeyConv,
+		ShardCoordinator:     shardCoordinator,
+		BuiltInFunctions:     builtInFunctions.NewBuiltInFunctionContainer(),
+		ArgumentParser:       vmcommon.NewAtArgumentParser(),
+		EpochNotifier:        forking.NewEpochNotifier(),
+		RelayedTxEnableEpoch: 0,
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	feeHandler := &mock.FeeHandlerStub{
@@ -597,6 +601,9 @@ func TestExecuteTransactionAndTimeToProcessChange(t *testing.T) {
 		feeHandler,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		forking.NewEpochNotifier(),
+		0,
 	)
 
 	alice := []byte("12345678901234567890123456789111")
@@ -12,6 +12,7 @@ import (
 	arwenConfig "github.com/ElrondNetwork/arwen-wasm-vm/config"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/forking"
 	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	dataTransaction "github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -176,10 +177,12 @@ func CreateTxProcessorWithOneSCExecutorMockVM(accnts state.AccountsAdapter, opGa
 		}}
 	argsParser := vmcommon.NewAtArgumentParser()
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  pubkeyConv,
-		ShardCoordinator: oneShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   argsParser,
+		PubkeyConverter:      pubkeyConv,
+		ShardCoordinator:     oneShardCoordinator,
+		BuiltInFunctions:     builtInFuncs,
+		ArgumentParser:       argsParser,
+		EpochNotifier:        forking.NewEpochNotifier(),
+		RelayedTxEnableEpoch: 0,
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	gasSchedule := make(map[string]map[string]uint64)
@@ -222,6 +225,9 @@ func CreateTxProcessorWithOneSCExecutorMockVM(accnts state.AccountsAdapter, opGa
 		&mock.FeeHandlerStub{},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		forking.NewEpochNotifier(),
+		0,
 	)
 
 	return txProcessor
@@ -269,6 +275,7 @@ func CreateVMAndBlockchainHook(
 		GasMap:          actualGasSchedule,
 		MapDNSAddresses: make(map[string]struct{}),
 		Marshalizer:     testMarshalizer,
+		EpochNotifier:   forking.NewEpochNotifier(),
 	}
 	builtInFuncs, _ := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 
@@ -319,10 +326,12 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 ) (process.TransactionProcessor, process.SmartContractProcessor) {
 	argsParser := vmcommon.NewAtArgumentParser()
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  pubkeyConv,
-		ShardCoordinator: oneShardCoordinator,
-		BuiltInFuncNames: blockChainHook.GetBuiltInFunctions().Keys(),
-		ArgumentParser:   argsParser,
+		PubkeyConverter:      pubkeyConv,
+		ShardCoordinator:     oneShardCoordinator,
+		BuiltInFunctions:     blockChainHook.GetBuiltInFunctions(),
+		ArgumentParser:       argsParser,
+		EpochNotifier:        forking.NewEpochNotifier(),
+		RelayedTxEnableEpoch: 0,
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 
@@ -366,6 +375,9 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		&mock.FeeHandlerStub{},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		forking.NewEpochNotifier(),
+		0,
 	)
 
 	return txProcessor, scProcessor
@@ -562,7 +574,10 @@ func GetIntValueFromSC(gasSchedule map[string]map[string]uint64, accnts state.Ac
 		},
 	}
 
-	scQueryService, _ := smartContract.NewSCQueryService(vmContainer, feeHandler)
+	scQueryService, _ := smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{
+		VmContainer:  vmContainer,
+		EconomicsFee: feeHandler,
+	})
 
 	vmOutput, err := scQueryService.ExecuteQuery(&process.SCQuery{
 		ScAddress: scAddressBytes,
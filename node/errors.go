@@ -168,3 +168,24 @@ var ErrSystemBusyTxHash = errors.New("system busy. try again later")
 
 // ErrUnknownPeerID signals that the provided peer is unknown by the current node
 var ErrUnknownPeerID = errors.New("unknown peer ID")
+
+// ErrNilHistoricalAccountsProvider signals that a nil historical accounts provider has been provided
+var ErrNilHistoricalAccountsProvider = errors.New("nil historical accounts provider")
+
+// ErrAccountNotFound signals that the account was not found
+var ErrAccountNotFound = errors.New("account not found")
+
+// ErrStateDiffRequiresFullArchive signals that the state diff was requested on a node that is not a full archive node
+var ErrStateDiffRequiresFullArchive = errors.New("state diff is available only on full archive nodes")
+
+// ErrAccountsChangeSetNotFound signals that the accounts change set of a block was not found
+var ErrAccountsChangeSetNotFound = errors.New("accounts change set not found")
+
+// ErrNilPeerAccountsExplorer signals that a nil peer accounts explorer has been provided
+var ErrNilPeerAccountsExplorer = errors.New("nil peer accounts explorer")
+
+// ErrPeerAccountsRequireMetachain signals that the peer accounts were requested on a shard node
+var ErrPeerAccountsRequireMetachain = errors.New("peer accounts are available only on metachain nodes")
+
+// ErrNilTxPoolJournal signals that a nil transactions pool journal has been provided
+var ErrNilTxPoolJournal = errors.New("nil transactions pool journal")
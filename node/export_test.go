@@ -7,3 +7,7 @@ import (
 func (n *Node) CreateConsensusTopic(messageProcessor p2p.MessageProcessor) error {
 	return n.createConsensusTopic(messageProcessor)
 }
+
+func (n *Node) ReloadTxPool() {
+	n.reloadTxPool()
+}
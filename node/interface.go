@@ -72,3 +72,8 @@ type Throttler interface {
 	EndProcessing()
 	IsInterfaceNil() bool
 }
+
+// expiredTxsHolder defines a transactions pool which remembers the transactions removed because they were too old
+type expiredTxsHolder interface {
+	HasExpiredTx(txHash []byte) bool
+}
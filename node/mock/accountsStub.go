@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"errors"
 
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -23,7 +24,9 @@ type AccountsStub struct {
 	SnapshotStateCalled      func(rootHash []byte)
 	SetStateCheckpointCalled func(rootHash []byte)
 	IsPruningEnabledCalled   func() bool
-	GetAllLeavesCalled       func(rootHash []byte) (map[string][]byte, error)
+	IterateAllLeavesCalled   func(ctx context.Context, rootHash []byte, handler func(key []byte, value []byte) error) error
+	GetProofCalled           func(rootHash []byte, key []byte) ([][]byte, error)
+	GetTrieCalled            func(rootHash []byte) (data.Trie, error)
 	RecreateAllTriesCalled   func(rootHash []byte) (map[string]data.Trie, error)
 }
 
@@ -51,10 +54,26 @@ func (as *AccountsStub) SaveAccount(account state.AccountHandler) error {
 	return nil
 }
 
-// GetAllLeaves -
-func (as *AccountsStub) GetAllLeaves(rootHash []byte) (map[string][]byte, error) {
-	if as.GetAllLeavesCalled != nil {
-		return as.GetAllLeavesCalled(rootHash)
+// IterateAllLeaves -
+func (as *AccountsStub) IterateAllLeaves(ctx context.Context, rootHash []byte, handler func(key []byte, value []byte) error) error {
+	if as.IterateAllLeavesCalled != nil {
+		return as.IterateAllLeavesCalled(ctx, rootHash, handler)
+	}
+	return nil
+}
+
+// GetTrie -
+func (as *AccountsStub) GetTrie(rootHash []byte) (data.Trie, error) {
+	if as.GetTrieCalled != nil {
+		return as.GetTrieCalled(rootHash)
+	}
+	return nil, nil
+}
+
+// GetProof -
+func (as *AccountsStub) GetProof(rootHash []byte, key []byte) ([][]byte, error) {
+	if as.GetProofCalled != nil {
+		return as.GetProofCalled(rootHash, key)
 	}
 	return nil, nil
 }
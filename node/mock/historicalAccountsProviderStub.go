@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// HistoricalAccountsProviderStub -
+type HistoricalAccountsProviderStub struct {
+	GetAccountsAdapterAtRootHashCalled   func(rootHash []byte) (state.AccountsAdapter, error)
+	GetAccountsAdapterAtBlockNonceCalled func(blockNonce uint64) (state.AccountsAdapter, data.HeaderHandler, error)
+}
+
+// GetAccountsAdapterAtRootHash -
+func (haps *HistoricalAccountsProviderStub) GetAccountsAdapterAtRootHash(rootHash []byte) (state.AccountsAdapter, error) {
+	if haps.GetAccountsAdapterAtRootHashCalled != nil {
+		return haps.GetAccountsAdapterAtRootHashCalled(rootHash)
+	}
+
+	return nil, nil
+}
+
+// GetAccountsAdapterAtBlockNonce -
+func (haps *HistoricalAccountsProviderStub) GetAccountsAdapterAtBlockNonce(blockNonce uint64) (state.AccountsAdapter, data.HeaderHandler, error) {
+	if haps.GetAccountsAdapterAtBlockNonceCalled != nil {
+		return haps.GetAccountsAdapterAtBlockNonceCalled(blockNonce)
+	}
+
+	return nil, nil, nil
+}
+
+// IsInterfaceNil -
+func (haps *HistoricalAccountsProviderStub) IsInterfaceNil() bool {
+	return haps == nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
@@ -37,6 +38,10 @@ func (im *IndexerMock) SaveValidatorsRating(_ string, _ []indexer.ValidatorRatin
 
 }
 
+// SaveAccountsChangeSet -
+func (im *IndexerMock) SaveAccountsChangeSet(_ data.HeaderHandler, _ []byte, _ *state.AccountsChangeSet) {
+}
+
 // SaveValidatorsPubKeys -
 func (im *IndexerMock) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) {
 	panic("implement me")
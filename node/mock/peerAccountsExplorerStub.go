@@ -0,0 +1,45 @@
+package mock
+
+import (
+	"context"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// PeerAccountsExplorerStub -
+type PeerAccountsExplorerStub struct {
+	GetPeerAccountsCalled                     func(ctx context.Context, rootHash []byte) (*state.PeerAccountsApiResponse, error)
+	ComparePeerAccountsCalled                 func(ctx context.Context, fromRootHash []byte, toRootHash []byte) (*state.PeerAccountsComparisonApiResponse, error)
+	GetEpochStartValidatorStatsRootHashCalled func(epoch uint32) ([]byte, error)
+}
+
+// GetPeerAccounts -
+func (paes *PeerAccountsExplorerStub) GetPeerAccounts(ctx context.Context, rootHash []byte) (*state.PeerAccountsApiResponse, error) {
+	if paes.GetPeerAccountsCalled != nil {
+		return paes.GetPeerAccountsCalled(ctx, rootHash)
+	}
+
+	return nil, nil
+}
+
+// ComparePeerAccounts -
+func (paes *PeerAccountsExplorerStub) ComparePeerAccounts(ctx context.Context, fromRootHash []byte, toRootHash []byte) (*state.PeerAccountsComparisonApiResponse, error) {
+	if paes.ComparePeerAccountsCalled != nil {
+		return paes.ComparePeerAccountsCalled(ctx, fromRootHash, toRootHash)
+	}
+
+	return nil, nil
+}
+
+// GetEpochStartValidatorStatsRootHash -
+func (paes *PeerAccountsExplorerStub) GetEpochStartValidatorStatsRootHash(epoch uint32) ([]byte, error) {
+	if paes.GetEpochStartValidatorStatsRootHashCalled != nil {
+		return paes.GetEpochStartValidatorStatsRootHashCalled(epoch)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (paes *PeerAccountsExplorerStub) IsInterfaceNil() bool {
+	return paes == nil
+}
@@ -19,6 +19,7 @@ type ShardedDataStub struct {
 	RemoveSetOfDataFromPoolCalled          func(keys [][]byte, destCacheId string)
 	ImmunizeSetOfDataAgainstEvictionCalled func(keys [][]byte, cacheId string)
 	CreateShardStoreCalled                 func(destCacheId string)
+	HasExpiredTxCalled                     func(txHash []byte) bool
 }
 
 // RegisterHandler -
@@ -31,6 +32,14 @@ func (sd *ShardedDataStub) ShardDataStore(cacheId string) (c storage.Cacher) {
 	return sd.ShardDataStoreCalled(cacheId)
 }
 
+// HasExpiredTx -
+func (sd *ShardedDataStub) HasExpiredTx(txHash []byte) bool {
+	if sd.HasExpiredTxCalled != nil {
+		return sd.HasExpiredTxCalled(txHash)
+	}
+	return false
+}
+
 // AddData -
 func (sd *ShardedDataStub) AddData(key []byte, data interface{}, sizeInBytes int, cacheId string) {
 	sd.AddDataCalled(key, data, sizeInBytes, cacheId)
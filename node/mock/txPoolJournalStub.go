@@ -0,0 +1,52 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+// TxPoolJournalStub -
+type TxPoolJournalStub struct {
+	RecordAdditionCalled func(txHash []byte, tx data.TransactionHandler, cacheID string)
+	RecordRemovalCalled  func(txHash []byte)
+	ReloadCalled         func(txPool dataRetriever.ShardedDataCacherNotifier, accounts state.AccountsAdapter) error
+	CloseCalled          func() error
+}
+
+// RecordAddition -
+func (tpjs *TxPoolJournalStub) RecordAddition(txHash []byte, tx data.TransactionHandler, cacheID string) {
+	if tpjs.RecordAdditionCalled != nil {
+		tpjs.RecordAdditionCalled(txHash, tx, cacheID)
+	}
+}
+
+// RecordRemoval -
+func (tpjs *TxPoolJournalStub) RecordRemoval(txHash []byte) {
+	if tpjs.RecordRemovalCalled != nil {
+		tpjs.RecordRemovalCalled(txHash)
+	}
+}
+
+// Reload -
+func (tpjs *TxPoolJournalStub) Reload(txPool dataRetriever.ShardedDataCacherNotifier, accounts state.AccountsAdapter) error {
+	if tpjs.ReloadCalled != nil {
+		return tpjs.ReloadCalled(txPool, accounts)
+	}
+
+	return nil
+}
+
+// Close -
+func (tpjs *TxPoolJournalStub) Close() error {
+	if tpjs.CloseCalled != nil {
+		return tpjs.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (tpjs *TxPoolJournalStub) IsInterfaceNil() bool {
+	return tpjs == nil
+}
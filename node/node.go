@@ -43,6 +43,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/process/sync/storageBootstrap"
 	procTx "github.com/ElrondNetwork/elrond-go/process/transaction"
@@ -92,9 +93,14 @@ type Node struct {
 	validatorStatistics           process.ValidatorStatisticsProcessor
 	hardforkTrigger               HardforkTrigger
 	validatorsProvider            process.ValidatorsProvider
+	peerAccountsExplorer          process.PeerAccountsExplorer
+	txPoolJournal                 dataRetriever.TxPoolJournal
 	whiteListRequest              process.WhiteListHandler
 	whiteListerVerifiedTxs        process.WhiteListHandler
 	apiTransactionByHashThrottler Throttler
+	historicalAccountsProvider    state.HistoricalAccountsProvider
+	isFullArchive                 bool
+	txVersionEnableEpoch          uint32
 
 	pubKey            crypto.PublicKey
 	privKey           crypto.PrivateKey
@@ -209,6 +215,34 @@ func (n *Node) CreateShardedStores() error {
 	return nil
 }
 
+// reloadTxPool adds back to the transactions pool the transactions recorded in the journal, once the state has
+// been loaded from storage by the bootstrapper. The transactions are validated against the state of the committed
+// block, through a dedicated accounts adapter, so that the reload does not race with the blocks being synchronized
+func (n *Node) reloadTxPool() {
+	if check.IfNil(n.txPoolJournal) || check.IfNil(n.dataPool) || check.IfNil(n.historicalAccountsProvider) {
+		return
+	}
+
+	header := n.blkc.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		header = n.blkc.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return
+	}
+
+	accounts, err := n.historicalAccountsProvider.GetAccountsAdapterAtRootHash(header.GetRootHash())
+	if err != nil {
+		log.Warn("cannot reload the transactions pool from journal", "error", err)
+		return
+	}
+
+	err = n.txPoolJournal.Reload(n.dataPool.Transactions(), accounts)
+	if err != nil {
+		log.Warn("cannot reload the transactions pool from journal", "error", err)
+	}
+}
+
 // StartConsensus will start the consensus service for the current node
 func (n *Node) StartConsensus() error {
 	isGenesisBlockNotInitialized := len(n.blkc.GetGenesisHeaderHash()) == 0 ||
@@ -233,6 +267,7 @@ func (n *Node) StartConsensus() error {
 	}
 
 	bootstrapper.StartSyncingBlocks()
+	n.reloadTxPool()
 
 	epoch := uint32(0)
 	crtBlockHeader := n.blkc.GetCurrentBlockHeader()
@@ -781,11 +816,17 @@ func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
 		return nil
 	}
 
+	multisigAccounts, err := builtInFunctions.NewMultisigAccountsReader(n.accounts, n.blkc, n.internalMarshalizer)
+	if err != nil {
+		return err
+	}
+
 	marshalizedTx, err := n.internalMarshalizer.Marshal(tx)
 	if err != nil {
 		return err
 	}
 
+	maxNotBeforeRound, maxNotBeforeEpoch := n.computeTimeLockHorizon()
 	intTx, err := procTx.NewInterceptedTransaction(
 		marshalizedTx,
 		n.internalMarshalizer,
@@ -797,6 +838,10 @@ func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
 		n.shardCoordinator,
 		n.feeHandler,
 		n.whiteListerVerifiedTxs,
+		n.isTxVersionEnabled(),
+		multisigAccounts,
+		maxNotBeforeRound,
+		maxNotBeforeEpoch,
 	)
 	if err != nil {
 		return err
@@ -816,6 +861,28 @@ func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
 	return err
 }
 
+// computeTimeLockHorizon returns the farthest round and epoch a transaction can be time locked for
+func (n *Node) computeTimeLockHorizon() (uint64, uint32) {
+	currentRound := uint64(0)
+	currentEpoch := uint32(0)
+	if !check.IfNil(n.blkc) && !check.IfNil(n.blkc.GetCurrentBlockHeader()) {
+		currentRound = n.blkc.GetCurrentBlockHeader().GetRound()
+	}
+	if !check.IfNil(n.epochStartTrigger) {
+		currentEpoch = n.epochStartTrigger.Epoch()
+	}
+
+	return currentRound + process.MaxTimeLockRoundsAhead, currentEpoch + process.MaxTimeLockEpochsAhead
+}
+
+func (n *Node) isTxVersionEnabled() bool {
+	if check.IfNil(n.epochStartTrigger) {
+		return false
+	}
+
+	return n.epochStartTrigger.Epoch() >= n.txVersionEnableEpoch
+}
+
 func (n *Node) sendBulkTransactionsFromShard(transactions [][]byte, senderShardId uint32) error {
 	dataPacker, err := partitioning.NewSimpleDataPacker(n.internalMarshalizer)
 	if err != nil {
@@ -863,6 +930,11 @@ func (n *Node) CreateTransaction(
 	gasLimit uint64,
 	dataField string,
 	signatureHex string,
+	version uint32,
+	options uint32,
+	signaturesHex []string,
+	notBeforeRound uint64,
+	notBeforeEpoch uint32,
 ) (*transaction.Transaction, []byte, error) {
 
 	if check.IfNil(n.addressPubkeyConverter) {
@@ -887,20 +959,30 @@ func (n *Node) CreateTransaction(
 		return nil, nil, errors.New("could not fetch signature bytes")
 	}
 
+	signaturesBytes, err := decodeSignatures(signaturesHex)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	valAsBigInt, ok := big.NewInt(0).SetString(value, 10)
 	if !ok {
 		return nil, nil, ErrInvalidValue
 	}
 
 	tx := &transaction.Transaction{
-		Nonce:     nonce,
-		Value:     valAsBigInt,
-		RcvAddr:   receiverAddress,
-		SndAddr:   senderAddress,
-		GasPrice:  gasPrice,
-		GasLimit:  gasLimit,
-		Data:      []byte(dataField),
-		Signature: signatureBytes,
+		Nonce:          nonce,
+		Value:          valAsBigInt,
+		RcvAddr:        receiverAddress,
+		SndAddr:        senderAddress,
+		GasPrice:       gasPrice,
+		GasLimit:       gasLimit,
+		Data:           []byte(dataField),
+		Signature:      signatureBytes,
+		Version:        version,
+		Options:        options,
+		Signatures:     signaturesBytes,
+		NotBeforeRound: notBeforeRound,
+		NotBeforeEpoch: notBeforeEpoch,
 	}
 
 	var txHash []byte
@@ -912,6 +994,24 @@ func (n *Node) CreateTransaction(
 	return tx, txHash, nil
 }
 
+func decodeSignatures(signaturesHex []string) ([][]byte, error) {
+	if len(signaturesHex) == 0 {
+		return nil, nil
+	}
+
+	signatures := make([][]byte, 0, len(signaturesHex))
+	for _, signatureHex := range signaturesHex {
+		signature, err := hex.DecodeString(signatureHex)
+		if err != nil {
+			return nil, errors.New("could not fetch signatures bytes")
+		}
+
+		signatures = append(signatures, signature)
+	}
+
+	return signatures, nil
+}
+
 // GetAccount will return account details for a given address
 func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
 	if check.IfNil(n.addressPubkeyConverter) {
@@ -926,7 +1026,33 @@ func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
 		return nil, err
 	}
 
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	return getUserAccount(n.accounts, addr)
+}
+
+// GetAccountAtBlockNonce returns an account as it was after the block having the provided nonce was committed
+func (n *Node) GetAccountAtBlockNonce(address string, blockNonce uint64) (state.UserAccountHandler, error) {
+	if check.IfNil(n.addressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(n.historicalAccountsProvider) {
+		return nil, ErrNilHistoricalAccountsProvider
+	}
+
+	addr, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, _, err := n.historicalAccountsProvider.GetAccountsAdapterAtBlockNonce(blockNonce)
+	if err != nil {
+		return nil, fmt.Errorf("could not recreate the state at block nonce %d: %w", blockNonce, err)
+	}
+
+	return getUserAccount(accounts, addr)
+}
+
+func getUserAccount(accounts state.AccountsAdapter, addr []byte) (state.UserAccountHandler, error) {
+	accWrp, err := accounts.GetExistingAccount(addr)
 	if err != nil {
 		if err == state.ErrAccNotFound {
 			return state.NewUserAccount(addr)
@@ -0,0 +1,80 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// GetAccountsChangeSet returns the accounts changed by the committed block having the given nonce. The change sets
+// are kept only for the epochs the node did not prune
+func (n *Node) GetAccountsChangeSet(blockNonce uint64) (*state.AccountsChangeSetApiResponse, error) {
+	header, headerHash, err := process.GetHeaderFromStorageWithNonce(
+		blockNonce,
+		n.shardCoordinator.SelfId(),
+		n.store,
+		n.uint64ByteSliceConverter,
+		n.internalMarshalizer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the block having nonce %d: %w", blockNonce, err)
+	}
+
+	buff, err := n.store.Get(dataRetriever.AccountsChangeSetUnit, headerHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for block nonce %d: %s", ErrAccountsChangeSetNotFound, blockNonce, err.Error())
+	}
+
+	changeSet := &state.AccountsChangeSet{}
+	err = n.internalMarshalizer.Unmarshal(changeSet, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &state.AccountsChangeSetApiResponse{
+		BlockNonce: header.GetNonce(),
+		BlockHash:  hex.EncodeToString(headerHash),
+		RootHash:   hex.EncodeToString(changeSet.RootHash),
+		Changes:    make([]state.AccountChangeApiResponse, 0, len(changeSet.Changes)),
+	}
+	for _, change := range changeSet.Changes {
+		response.Changes = append(response.Changes, n.createAccountChangeApiResponse(change))
+	}
+
+	return response, nil
+}
+
+func (n *Node) createAccountChangeApiResponse(change *state.AccountChange) state.AccountChangeApiResponse {
+	dataTrieKeys := make([]string, 0, len(change.DataTrieKeys))
+	for _, key := range change.DataTrieKeys {
+		dataTrieKeys = append(dataTrieKeys, hex.EncodeToString(key))
+	}
+
+	ownerAddress := ""
+	if len(change.OwnerAddress) > 0 {
+		ownerAddress = n.addressPubkeyConverter.Encode(change.OwnerAddress)
+	}
+
+	balance, balanceDelta := "0", "0"
+	if change.Balance != nil {
+		balance = change.Balance.String()
+	}
+	if change.BalanceDelta != nil {
+		balanceDelta = change.BalanceDelta.String()
+	}
+
+	return state.AccountChangeApiResponse{
+		Address:      n.addressPubkeyConverter.Encode(change.Address),
+		IsNew:        change.IsNew,
+		IsRemoved:    change.IsRemoved,
+		Nonce:        change.Nonce,
+		Balance:      balance,
+		BalanceDelta: balanceDelta,
+		CodeHash:     hex.EncodeToString(change.CodeHash),
+		OwnerAddress: ownerAddress,
+		DataTrieKeys: dataTrieKeys,
+	}
+}
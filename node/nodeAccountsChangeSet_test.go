@@ -0,0 +1,123 @@
+package node_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMemStorageUnit() *storageUnit.Unit {
+	cache, _ := lrucache.NewCache(10)
+	unit, _ := storageUnit.NewStorageUnit(cache, memorydb.New())
+
+	return unit
+}
+
+func createAccountsChangeSetNode(store dataRetriever.StorageService) *node.Node {
+	n, _ := node.NewNode(
+		node.WithDataStore(store),
+		node.WithInternalMarshalizer(&marshal.GogoProtoMarshalizer{}, 0),
+		node.WithUint64ByteSliceConverter(uint64ByteSlice.NewBigEndianConverter()),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{}),
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(4)),
+	)
+
+	return n
+}
+
+func createAccountsChangeSetStore(blockNonce uint64, headerHash []byte) *dataRetriever.ChainStorer {
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit, createMemStorageUnit())
+	store.AddStorer(dataRetriever.BlockHeaderUnit, createMemStorageUnit())
+	store.AddStorer(dataRetriever.AccountsChangeSetUnit, createMemStorageUnit())
+
+	buffHeader, _ := marshalizer.Marshal(&block.Header{Nonce: blockNonce})
+	_ = store.Put(dataRetriever.BlockHeaderUnit, headerHash, buffHeader)
+	nonceBytes := uint64ByteSlice.NewBigEndianConverter().ToByteSlice(blockNonce)
+	_ = store.Put(dataRetriever.ShardHdrNonceHashDataUnit, nonceBytes, headerHash)
+
+	return store
+}
+
+func TestNode_GetAccountsChangeSetMissingBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	store := createAccountsChangeSetStore(5, []byte("header hash"))
+	n := createAccountsChangeSetNode(store)
+
+	response, err := n.GetAccountsChangeSet(6)
+	assert.Nil(t, response)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetAccountsChangeSetMissingChangeSetShouldErr(t *testing.T) {
+	t.Parallel()
+
+	store := createAccountsChangeSetStore(5, []byte("header hash"))
+	n := createAccountsChangeSetNode(store)
+
+	response, err := n.GetAccountsChangeSet(5)
+	assert.Nil(t, response)
+	assert.True(t, errors.Is(err, node.ErrAccountsChangeSetNotFound))
+}
+
+func TestNode_GetAccountsChangeSetShouldWork(t *testing.T) {
+	t.Parallel()
+
+	headerHash := []byte("header hash")
+	store := createAccountsChangeSetStore(5, headerHash)
+	changeSet := &state.AccountsChangeSet{
+		RootHash: []byte("root hash"),
+		Changes: []*state.AccountChange{
+			{
+				Address:      []byte("addr"),
+				IsNew:        true,
+				Nonce:        3,
+				Balance:      big.NewInt(7),
+				BalanceDelta: big.NewInt(-3),
+				CodeHash:     []byte("code hash"),
+				OwnerAddress: []byte("ownr"),
+				DataTrieKeys: [][]byte{[]byte("key")},
+			},
+		},
+	}
+	buffChangeSet, _ := (&marshal.GogoProtoMarshalizer{}).Marshal(changeSet)
+	_ = store.Put(dataRetriever.AccountsChangeSetUnit, headerHash, buffChangeSet)
+	n := createAccountsChangeSetNode(store)
+
+	response, err := n.GetAccountsChangeSet(5)
+	require.Nil(t, err)
+
+	expectedResponse := &state.AccountsChangeSetApiResponse{
+		BlockNonce: 5,
+		BlockHash:  "6865616465722068617368",
+		RootHash:   "726f6f742068617368",
+		Changes: []state.AccountChangeApiResponse{
+			{
+				Address:      "61646472",
+				IsNew:        true,
+				Nonce:        3,
+				Balance:      "7",
+				BalanceDelta: "-3",
+				CodeHash:     "636f64652068617368",
+				OwnerAddress: "6f776e72",
+				DataTrieKeys: []string{"6b6579"},
+			},
+		},
+	}
+	assert.Equal(t, expectedResponse, response)
+}
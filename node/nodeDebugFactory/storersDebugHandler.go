@@ -0,0 +1,19 @@
+package nodeDebugFactory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug"
+)
+
+// StorersDebugger is the constant string for the storers' debugger
+const StorersDebugger = "storers debugger"
+
+// AddStorersDebugHandler registers the storers' debugger, so the usage statistics can be queried and the
+// instrumentation can be switched on and off through the node's debug route
+func AddStorersDebugHandler(node NodeWrapper, debugHandler debug.QueryHandler) error {
+	if check.IfNil(node) {
+		return ErrNilNodeWrapper
+	}
+
+	return node.AddQueryHandler(StorersDebugger, debugHandler)
+}
@@ -0,0 +1,19 @@
+package nodeDebugFactory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug"
+)
+
+// TriesConsistencyChecker is the constant string for the tries' consistency checker
+const TriesConsistencyChecker = "tries consistency checker"
+
+// AddTriesConsistencyDebugHandler registers the tries' consistency checker, so the check results can be queried
+// and new checks can be triggered through the node's debug route
+func AddTriesConsistencyDebugHandler(node NodeWrapper, debugHandler debug.QueryHandler) error {
+	if check.IfNil(node) {
+		return ErrNilNodeWrapper
+	}
+
+	return node.AddQueryHandler(TriesConsistencyChecker, debugHandler)
+}
@@ -0,0 +1,85 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// GetPeerAccounts returns the full data of all the peer accounts from the peer accounts trie having the given hex
+// encoded validator statistics root hash
+func (n *Node) GetPeerAccounts(ctx context.Context, rootHash string) (*state.PeerAccountsApiResponse, error) {
+	err := n.checkPeerAccountsExplorer()
+	if err != nil {
+		return nil, err
+	}
+
+	rootHashBytes, err := decodeValidatorStatsRootHash(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.peerAccountsExplorer.GetPeerAccounts(ctx, rootHashBytes)
+}
+
+// ComparePeerAccounts returns the peer accounts that differ between the peer accounts tries having the given hex
+// encoded validator statistics root hashes
+func (n *Node) ComparePeerAccounts(ctx context.Context, fromRootHash string, toRootHash string) (*state.PeerAccountsComparisonApiResponse, error) {
+	err := n.checkPeerAccountsExplorer()
+	if err != nil {
+		return nil, err
+	}
+
+	fromRootHashBytes, err := decodeValidatorStatsRootHash(fromRootHash)
+	if err != nil {
+		return nil, err
+	}
+	toRootHashBytes, err := decodeValidatorStatsRootHash(toRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.peerAccountsExplorer.ComparePeerAccounts(ctx, fromRootHashBytes, toRootHashBytes)
+}
+
+// GetEpochStartValidatorStatsRootHash returns the hex encoded validator statistics root hash of the meta block
+// which started the given epoch
+func (n *Node) GetEpochStartValidatorStatsRootHash(epoch uint32) (string, error) {
+	err := n.checkPeerAccountsExplorer()
+	if err != nil {
+		return "", err
+	}
+
+	rootHash, err := n.peerAccountsExplorer.GetEpochStartValidatorStatsRootHash(epoch)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(rootHash), nil
+}
+
+// checkPeerAccountsExplorer returns an error on the shard nodes, as only the metachain nodes compute the validator
+// statistics and keep the peer accounts trie
+func (n *Node) checkPeerAccountsExplorer() error {
+	if check.IfNil(n.peerAccountsExplorer) {
+		return ErrNilPeerAccountsExplorer
+	}
+	if check.IfNil(n.shardCoordinator) || n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return ErrPeerAccountsRequireMetachain
+	}
+
+	return nil
+}
+
+func decodeValidatorStatsRootHash(hexRootHash string) ([]byte, error) {
+	rootHash, err := hex.DecodeString(hexRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator statistics root hash %s: %w", hexRootHash, err)
+	}
+
+	return rootHash, nil
+}
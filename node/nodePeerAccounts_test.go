@@ -0,0 +1,148 @@
+package node_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNode_PeerAccountsWithoutExplorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	peerAccounts, err := n.GetPeerAccounts(context.Background(), "aa")
+	assert.Nil(t, peerAccounts)
+	assert.Equal(t, node.ErrNilPeerAccountsExplorer, err)
+
+	comparison, err := n.ComparePeerAccounts(context.Background(), "aa", "bb")
+	assert.Nil(t, comparison)
+	assert.Equal(t, node.ErrNilPeerAccountsExplorer, err)
+
+	rootHash, err := n.GetEpochStartValidatorStatsRootHash(1)
+	assert.Empty(t, rootHash)
+	assert.Equal(t, node.ErrNilPeerAccountsExplorer, err)
+}
+
+func createMetachainShardCoordinator() *mock.ShardCoordinatorMock {
+	return &mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}
+}
+
+func TestNode_PeerAccountsOnShardNodeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithPeerAccountsExplorer(&mock.PeerAccountsExplorerStub{}),
+	)
+
+	peerAccounts, err := n.GetPeerAccounts(context.Background(), "aa")
+	assert.Nil(t, peerAccounts)
+	assert.Equal(t, node.ErrPeerAccountsRequireMetachain, err)
+
+	comparison, err := n.ComparePeerAccounts(context.Background(), "aa", "bb")
+	assert.Nil(t, comparison)
+	assert.Equal(t, node.ErrPeerAccountsRequireMetachain, err)
+
+	rootHash, err := n.GetEpochStartValidatorStatsRootHash(1)
+	assert.Empty(t, rootHash)
+	assert.Equal(t, node.ErrPeerAccountsRequireMetachain, err)
+}
+
+func TestNode_GetPeerAccountsInvalidRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(createMetachainShardCoordinator()),
+		node.WithPeerAccountsExplorer(&mock.PeerAccountsExplorerStub{}))
+
+	peerAccounts, err := n.GetPeerAccounts(context.Background(), "not hex")
+	assert.Nil(t, peerAccounts)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetPeerAccountsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	expectedResponse := &state.PeerAccountsApiResponse{RootHash: hex.EncodeToString(rootHash)}
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(createMetachainShardCoordinator()),
+		node.WithPeerAccountsExplorer(&mock.PeerAccountsExplorerStub{
+		GetPeerAccountsCalled: func(_ context.Context, providedRootHash []byte) (*state.PeerAccountsApiResponse, error) {
+			assert.Equal(t, rootHash, providedRootHash)
+			return expectedResponse, nil
+		},
+	}))
+
+	peerAccounts, err := n.GetPeerAccounts(context.Background(), hex.EncodeToString(rootHash))
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, peerAccounts)
+}
+
+func TestNode_ComparePeerAccountsInvalidRootHashesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(createMetachainShardCoordinator()),
+		node.WithPeerAccountsExplorer(&mock.PeerAccountsExplorerStub{}))
+
+	comparison, err := n.ComparePeerAccounts(context.Background(), "not hex", "aa")
+	assert.Nil(t, comparison)
+	assert.NotNil(t, err)
+
+	comparison, err = n.ComparePeerAccounts(context.Background(), "aa", "not hex")
+	assert.Nil(t, comparison)
+	assert.NotNil(t, err)
+}
+
+func TestNode_ComparePeerAccountsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	fromRootHash, toRootHash := []byte("from root hash"), []byte("to root hash")
+	expectedResponse := &state.PeerAccountsComparisonApiResponse{}
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(createMetachainShardCoordinator()),
+		node.WithPeerAccountsExplorer(&mock.PeerAccountsExplorerStub{
+		ComparePeerAccountsCalled: func(_ context.Context, from []byte, to []byte) (*state.PeerAccountsComparisonApiResponse, error) {
+			assert.Equal(t, fromRootHash, from)
+			assert.Equal(t, toRootHash, to)
+			return expectedResponse, nil
+		},
+	}))
+
+	comparison, err := n.ComparePeerAccounts(context.Background(), hex.EncodeToString(fromRootHash), hex.EncodeToString(toRootHash))
+	assert.Nil(t, err)
+	assert.True(t, expectedResponse == comparison)
+}
+
+func TestNode_GetEpochStartValidatorStatsRootHash(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(createMetachainShardCoordinator()),
+		node.WithPeerAccountsExplorer(&mock.PeerAccountsExplorerStub{
+		GetEpochStartValidatorStatsRootHashCalled: func(epoch uint32) ([]byte, error) {
+			if epoch == 2 {
+				return []byte("root hash"), nil
+			}
+			return nil, errExpected
+		},
+	}))
+
+	rootHash, err := n.GetEpochStartValidatorStatsRootHash(2)
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString([]byte("root hash")), rootHash)
+
+	rootHash, err = n.GetEpochStartValidatorStatsRootHash(3)
+	assert.Empty(t, rootHash)
+	assert.Equal(t, errExpected, err)
+}
@@ -0,0 +1,128 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+)
+
+// GetProof returns the Merkle proof of the given account, anchored to the state root hash of the current block
+func (n *Node) GetProof(address string) (*state.ProofApiResponse, error) {
+	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.accounts) || check.IfNil(n.blkc) {
+		return nil, fmt.Errorf("initialize AccountsAdapter, PubkeyConverter and Blockchain first")
+	}
+
+	addr, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address, could not decode from: %w", err)
+	}
+
+	blockNonce, rootHash, err := n.getCurrentStateRootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	accountProof, _, err := n.getProof(blockNonce, rootHash, addr)
+
+	return accountProof, err
+}
+
+// GetProofDataTrie returns the Merkle proof of the value held by the given account under the given key, together
+// with the account's proof, anchored to the state root hash of the current block
+func (n *Node) GetProofDataTrie(address string, key string) (*state.DataTrieProofApiResponse, error) {
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+
+	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.accounts) || check.IfNil(n.blkc) {
+		return nil, fmt.Errorf("initialize AccountsAdapter, PubkeyConverter and Blockchain first")
+	}
+
+	addr, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address, could not decode from: %w", err)
+	}
+
+	blockNonce, rootHash, err := n.getCurrentStateRootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	accountProof, serializedAccount, err := n.getProof(blockNonce, rootHash, addr)
+	if err != nil {
+		return nil, err
+	}
+	if len(serializedAccount) == 0 {
+		return nil, ErrAccountNotFound
+	}
+
+	account := state.NewEmptyUserAccount()
+	err = n.internalMarshalizer.Unmarshal(account, serializedAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	dataTrieProof, leafValue, err := n.getProof(blockNonce, account.RootHash, keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &state.DataTrieProofApiResponse{
+		AccountProof:  accountProof,
+		DataTrieProof: dataTrieProof,
+		Value:         hex.EncodeToString(trimDataTrieValue(leafValue, keyBytes, addr)),
+	}, nil
+}
+
+func (n *Node) getCurrentStateRootHash() (uint64, []byte, error) {
+	header := n.blkc.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		header = n.blkc.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return 0, nil, ErrGenesisBlockNotInitialized
+	}
+
+	return header.GetNonce(), header.GetRootHash(), nil
+}
+
+// getProof computes the proof of the key on the trie having the given root hash and verifies it, so the
+// proven value can be returned together with the proof
+func (n *Node) getProof(blockNonce uint64, rootHash []byte, key []byte) (*state.ProofApiResponse, []byte, error) {
+	proof, err := n.accounts.GetProof(rootHash, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	value, err := trie.VerifyProof(rootHash, key, proof, n.internalMarshalizer, n.hasher)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	encodedProof := make([]string, 0, len(proof))
+	for _, encNode := range proof {
+		encodedProof = append(encodedProof, hex.EncodeToString(encNode))
+	}
+
+	return &state.ProofApiResponse{
+		BlockNonce: blockNonce,
+		RootHash:   hex.EncodeToString(rootHash),
+		Key:        hex.EncodeToString(key),
+		Value:      hex.EncodeToString(value),
+		Proof:      encodedProof,
+	}, value, nil
+}
+
+// trimDataTrieValue removes the key and the account address appended to each value saved in a data trie
+func trimDataTrieValue(leafValue []byte, key []byte, address []byte) []byte {
+	tailLength := len(key) + len(address)
+	if len(leafValue) < tailLength {
+		return leafValue
+	}
+
+	return leafValue[:len(leafValue)-tailLength]
+}
@@ -0,0 +1,169 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var proofsMarshalizer = &marshal.GogoProtoMarshalizer{}
+var proofsHasher = &mock.HasherFake{}
+
+func createCommittedTestTrie(t *testing.T, db data.DBWriteCacher, keysValues map[string][]byte) []byte {
+	trieStorage, _ := trie.NewTrieStorageManagerWithoutPruning(db)
+	tr, err := trie.NewTrie(trieStorage, proofsMarshalizer, proofsHasher, 5)
+	require.Nil(t, err)
+
+	for key, value := range keysValues {
+		require.Nil(t, tr.Update([]byte(key), value))
+	}
+	require.Nil(t, tr.Commit())
+
+	rootHash, _ := tr.Root()
+
+	return rootHash
+}
+
+func createProofsNode(db data.DBWriteCacher, rootHash []byte) *node.Node {
+	accounts := &mock.AccountsStub{
+		GetProofCalled: func(rootHash []byte, key []byte) ([][]byte, error) {
+			trieStorage, _ := trie.NewTrieStorageManagerWithoutPruning(db)
+			tr, _ := trie.NewTrie(trieStorage, proofsMarshalizer, proofsHasher, 5)
+			recreatedTrie, err := tr.Recreate(rootHash)
+			if err != nil {
+				return nil, err
+			}
+
+			return recreatedTrie.GetProof(key)
+		},
+	}
+	blkc := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 7, RootHash: rootHash}
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(proofsMarshalizer, testSizeCheckDelta),
+		node.WithHasher(proofsHasher),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accounts),
+		node.WithBlockChain(blkc),
+	)
+
+	return n
+}
+
+func TestNode_GetProofNotInitializedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	proof, err := n.GetProof(createDummyHexAddress(64))
+	assert.Nil(t, proof)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetProofAccountsAdapterErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(proofsMarshalizer, testSizeCheckDelta),
+		node.WithHasher(proofsHasher),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(&mock.AccountsStub{
+			GetProofCalled: func(_ []byte, _ []byte) ([][]byte, error) {
+				return nil, expectedErr
+			},
+		}),
+		node.WithBlockChain(&mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{RootHash: []byte("root hash")}
+			},
+		}),
+	)
+
+	proof, err := n.GetProof(createDummyHexAddress(64))
+	assert.Nil(t, proof)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestNode_GetProofShouldWork(t *testing.T) {
+	t.Parallel()
+
+	address := createDummyHexAddress(64)
+	addressBytes, _ := hex.DecodeString(address)
+	db := memorydb.New()
+	rootHash := createCommittedTestTrie(t, db, map[string][]byte{
+		string(addressBytes): []byte("serialized account"),
+		"other account":      []byte("other serialized account"),
+	})
+
+	n := createProofsNode(db, rootHash)
+
+	proof, err := n.GetProof(address)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(7), proof.BlockNonce)
+	assert.Equal(t, hex.EncodeToString(rootHash), proof.RootHash)
+	assert.Equal(t, address, proof.Key)
+	assert.Equal(t, hex.EncodeToString([]byte("serialized account")), proof.Value)
+	assert.True(t, len(proof.Proof) > 0)
+}
+
+func TestNode_GetProofDataTrieShouldWork(t *testing.T) {
+	t.Parallel()
+
+	address := createDummyHexAddress(64)
+	addressBytes, _ := hex.DecodeString(address)
+	key := []byte("key")
+	value := []byte("value")
+	db := memorydb.New()
+
+	dataTrieLeafValue := append(append(append([]byte{}, value...), key...), addressBytes...)
+	dataTrieRootHash := createCommittedTestTrie(t, db, map[string][]byte{
+		string(key): dataTrieLeafValue,
+	})
+
+	account, _ := state.NewUserAccount(addressBytes)
+	account.SetRootHash(dataTrieRootHash)
+	serializedAccount, _ := proofsMarshalizer.Marshal(account)
+	rootHash := createCommittedTestTrie(t, db, map[string][]byte{
+		string(addressBytes): serializedAccount,
+	})
+
+	n := createProofsNode(db, rootHash)
+
+	proof, err := n.GetProofDataTrie(address, hex.EncodeToString(key))
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(value), proof.Value)
+	assert.Equal(t, hex.EncodeToString(rootHash), proof.AccountProof.RootHash)
+	assert.Equal(t, hex.EncodeToString(dataTrieRootHash), proof.DataTrieProof.RootHash)
+	assert.Equal(t, hex.EncodeToString(dataTrieLeafValue), proof.DataTrieProof.Value)
+}
+
+func TestNode_GetProofDataTrieMissingAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	rootHash := createCommittedTestTrie(t, db, map[string][]byte{
+		"other account": []byte("other serialized account"),
+	})
+
+	n := createProofsNode(db, rootHash)
+
+	proof, err := n.GetProofDataTrie(createDummyHexAddress(64), "aa")
+	assert.Nil(t, proof)
+	assert.Equal(t, node.ErrAccountNotFound, err)
+}
@@ -0,0 +1,76 @@
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+)
+
+const maxStateDiffEntries = 10000
+
+var errStateDiffLimitReached = errors.New("state diff limit reached")
+
+// GetStateDiff returns the leaves that differ between the tries having the given hex encoded root hashes. The root
+// hashes can belong either to the accounts trie or to data tries. The diff is available only on full archive nodes,
+// as the other nodes prune the old states
+func (n *Node) GetStateDiff(fromRootHash string, toRootHash string) (*state.StateDiffApiResponse, error) {
+	if !n.isFullArchive {
+		return nil, ErrStateDiffRequiresFullArchive
+	}
+	if check.IfNil(n.historicalAccountsProvider) {
+		return nil, ErrNilHistoricalAccountsProvider
+	}
+
+	fromTrie, err := n.getTrieAtRootHash(fromRootHash)
+	if err != nil {
+		return nil, err
+	}
+	toTrie, err := n.getTrieAtRootHash(toRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &state.StateDiffApiResponse{
+		FromRootHash: fromRootHash,
+		ToRootHash:   toRootHash,
+		Diffs:        make([]state.LeafDiffApiResponse, 0),
+	}
+	err = trie.GetTrieDiff(fromTrie, toTrie, func(diff trie.LeafDiff) error {
+		if len(response.Diffs) == maxStateDiffEntries {
+			response.Truncated = true
+			return errStateDiffLimitReached
+		}
+
+		response.Diffs = append(response.Diffs, state.LeafDiffApiResponse{
+			Type:     diff.Type.String(),
+			Key:      hex.EncodeToString(diff.Key),
+			OldValue: hex.EncodeToString(diff.OldValue),
+			NewValue: hex.EncodeToString(diff.NewValue),
+		})
+		return nil
+	})
+	if err != nil && err != errStateDiffLimitReached {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (n *Node) getTrieAtRootHash(hexRootHash string) (data.Trie, error) {
+	rootHash, err := hex.DecodeString(hexRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid root hash %s: %w", hexRootHash, err)
+	}
+
+	accounts, err := n.historicalAccountsProvider.GetAccountsAdapterAtRootHash(rootHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not recreate the state at root hash %s: %w", hexRootHash, err)
+	}
+
+	return accounts.GetTrie(rootHash)
+}
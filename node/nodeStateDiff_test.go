@@ -0,0 +1,132 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createStateDiffNode(db data.DBWriteCacher, isFullArchive bool) *node.Node {
+	provider := &mock.HistoricalAccountsProviderStub{
+		GetAccountsAdapterAtRootHashCalled: func(rootHash []byte) (state.AccountsAdapter, error) {
+			return &mock.AccountsStub{
+				GetTrieCalled: func(rootHash []byte) (data.Trie, error) {
+					trieStorage, _ := trie.NewTrieStorageManagerWithoutPruning(db)
+					tr, _ := trie.NewTrie(trieStorage, proofsMarshalizer, proofsHasher, 5)
+
+					return tr.Recreate(rootHash)
+				},
+			}, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithHistoricalAccountsProvider(provider),
+		node.WithFullArchive(isFullArchive),
+	)
+
+	return n
+}
+
+func TestNode_GetStateDiffNotFullArchiveShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createStateDiffNode(memorydb.New(), false)
+
+	response, err := n.GetStateDiff("aa", "bb")
+	assert.Nil(t, response)
+	assert.Equal(t, node.ErrStateDiffRequiresFullArchive, err)
+}
+
+func TestNode_GetStateDiffNilHistoricalAccountsProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithFullArchive(true))
+
+	response, err := n.GetStateDiff("aa", "bb")
+	assert.Nil(t, response)
+	assert.Equal(t, node.ErrNilHistoricalAccountsProvider, err)
+}
+
+func TestNode_GetStateDiffInvalidRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createStateDiffNode(memorydb.New(), true)
+
+	response, err := n.GetStateDiff("not hex", "bb")
+	assert.Nil(t, response)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetStateDiffRecreateErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	provider := &mock.HistoricalAccountsProviderStub{
+		GetAccountsAdapterAtRootHashCalled: func(rootHash []byte) (state.AccountsAdapter, error) {
+			return nil, expectedErr
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithHistoricalAccountsProvider(provider),
+		node.WithFullArchive(true),
+	)
+
+	response, err := n.GetStateDiff("aa", "bb")
+	assert.Nil(t, response)
+	assert.True(t, errors.Is(err, expectedErr))
+}
+
+func TestNode_GetStateDiffShouldWork(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	fromRootHash := createCommittedTestTrie(t, db, map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+	})
+	toRootHash := createCommittedTestTrie(t, db, map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("changed value2"),
+		"key4": []byte("value4"),
+	})
+	n := createStateDiffNode(db, true)
+
+	response, err := n.GetStateDiff(hex.EncodeToString(fromRootHash), hex.EncodeToString(toRootHash))
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(fromRootHash), response.FromRootHash)
+	assert.Equal(t, hex.EncodeToString(toRootHash), response.ToRootHash)
+	assert.False(t, response.Truncated)
+
+	diffs := make(map[string]state.LeafDiffApiResponse)
+	for _, diff := range response.Diffs {
+		diffs[diff.Key] = diff
+	}
+	require.Equal(t, 3, len(diffs))
+
+	key2 := hex.EncodeToString([]byte("key2"))
+	assert.Equal(t, state.LeafDiffApiResponse{
+		Type:     trie.LeafModified.String(),
+		Key:      key2,
+		OldValue: hex.EncodeToString([]byte("value2")),
+		NewValue: hex.EncodeToString([]byte("changed value2")),
+	}, diffs[key2])
+
+	key3 := hex.EncodeToString([]byte("key3"))
+	assert.Equal(t, trie.LeafRemoved.String(), diffs[key3].Type)
+	assert.Equal(t, "", diffs[key3].NewValue)
+
+	key4 := hex.EncodeToString([]byte("key4"))
+	assert.Equal(t, trie.LeafAdded.String(), diffs[key4].Type)
+	assert.Equal(t, "", diffs[key4].OldValue)
+}
@@ -3,8 +3,11 @@ package node
 import (
 	"encoding/hex"
 	"fmt"
+	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	rewardTxData "github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -41,11 +44,55 @@ func (n *Node) GetTransaction(txHash string) (*transaction.ApiTransactionResult,
 	}
 
 	txBytes, txType, found := n.getTxBytesFromStorage(hash)
-	if found {
-		return n.unmarshalTransaction(txBytes, txType)
+	if !found {
+		return nil, fmt.Errorf("transaction not found")
 	}
 
-	return nil, fmt.Errorf("transaction not found")
+	tx, err := n.unmarshalTransaction(txBytes, txType)
+	if err != nil {
+		return nil, err
+	}
+	if txType == normalTx {
+		tx.Receipt = n.getReceiptFromStorage(hash)
+	}
+
+	return tx, nil
+}
+
+// getReceiptFromStorage returns the receipt generated when the transaction was executed, if it is available
+func (n *Node) getReceiptFromStorage(txHash []byte) *transaction.ApiReceipt {
+	receiptsStorer := n.store.GetStorer(dataRetriever.ReceiptsUnit)
+	if check.IfNil(receiptsStorer) {
+		return nil
+	}
+
+	receiptBytes, err := receiptsStorer.SearchFirst(txHash)
+	if err != nil {
+		return nil
+	}
+
+	rcpt := &receipt.Receipt{}
+	err = n.internalMarshalizer.Unmarshal(rcpt, receiptBytes)
+	if err != nil {
+		log.Debug("getReceiptFromStorage.Unmarshal", "error", err.Error())
+		return nil
+	}
+
+	return &transaction.ApiReceipt{
+		GasUsed:      rcpt.GasUsed,
+		GasRefunded:  rcpt.GasRefunded,
+		Fee:          bigIntToString(rcpt.Fee),
+		DeveloperFee: bigIntToString(rcpt.DeveloperFee),
+		Status:       rcpt.Status,
+	}
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
 }
 
 // GetTransactionStatus gets the transaction status
@@ -72,9 +119,22 @@ func (n *Node) GetTransactionStatus(txHash string) (string, error) {
 		return string(core.TxStatusExecuted), nil
 	}
 
+	if n.isTxExpiredFromPool(hash) {
+		return string(core.TxStatusExpiredFromPool), nil
+	}
+
 	return string(core.TxStatusUnknown), nil
 }
 
+func (n *Node) isTxExpiredFromPool(hash []byte) bool {
+	txsPool, ok := n.dataPool.Transactions().(expiredTxsHolder)
+	if !ok {
+		return false
+	}
+
+	return txsPool.HasExpiredTx(hash)
+}
+
 func (n *Node) getTxObjFromDataPool(hash []byte) (interface{}, transactionType, bool) {
 	txsPool := n.dataPool.Transactions()
 	txObj, found := txsPool.SearchFirstData(hash)
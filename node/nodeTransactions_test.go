@@ -3,9 +3,11 @@ package node_test
 import (
 	"encoding/hex"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -230,6 +232,43 @@ func TestNode_GetTransactionStatus_ShouldNotFindAndReturnUnknown(t *testing.T) {
 	assert.Equal(t, string(core.TxStatusUnknown), res)
 }
 
+func TestNode_GetTransactionStatus_ShouldFindExpiredFromPoolAndReturnExpired(t *testing.T) {
+	t.Parallel()
+
+	throttler := &mock.ThrottlerStub{
+		CanProcessCalled: func() bool {
+			return true
+		},
+	}
+	dataPool := &mock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &mock.ShardedDataStub{
+				SearchFirstDataCalled: func(_ []byte) (interface{}, bool) {
+					return nil, false
+				},
+				HasExpiredTxCalled: func(_ []byte) bool {
+					return true
+				},
+			}
+		},
+		RewardTransactionsCalled:   getCacherHandler(false, ""),
+		UnsignedTransactionsCalled: getCacherHandler(false, ""),
+	}
+	storer := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return getStorerStub(false)
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithApiTransactionByHashThrottler(throttler),
+		node.WithDataPool(dataPool),
+		node.WithDataStore(storer),
+	)
+	res, err := n.GetTransactionStatus("aaaa")
+	assert.NoError(t, err)
+	assert.Equal(t, string(core.TxStatusExpiredFromPool), res)
+}
+
 func TestNode_GetTransaction_ThrottlerCannotProcessShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -363,6 +402,89 @@ func TestNode_GetTransaction_ShouldFindInTxStorageAndReturn(t *testing.T) {
 	assert.Equal(t, expectedTx.Nonce, tx.Nonce)
 }
 
+func TestNode_GetTransaction_ShouldFindInTxStorageAndAttachReceipt(t *testing.T) {
+	t.Parallel()
+
+	throttler := &mock.ThrottlerStub{
+		CanProcessCalled: func() bool {
+			return true
+		},
+	}
+	dataPool := &mock.PoolsHolderStub{
+		TransactionsCalled:         getCacherHandler(false, ""),
+		RewardTransactionsCalled:   getCacherHandler(false, ""),
+		UnsignedTransactionsCalled: getCacherHandler(false, ""),
+	}
+	rcpt := &receipt.Receipt{
+		GasUsed:      50,
+		GasRefunded:  30,
+		Fee:          big.NewInt(500),
+		DeveloperFee: big.NewInt(15),
+		Status:       string(core.ReceiptStatusSuccess),
+	}
+	receiptBytes, _ := (&mock.MarshalizerFake{}).Marshal(rcpt)
+	storer := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			if unitType != dataRetriever.ReceiptsUnit {
+				return getStorerStub(true)
+			}
+
+			return &mock.StorerStub{
+				SearchFirstCalled: func(_ []byte) ([]byte, error) {
+					return receiptBytes, nil
+				},
+			}
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithApiTransactionByHashThrottler(throttler),
+		node.WithDataPool(dataPool),
+		node.WithDataStore(storer),
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 0),
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+	)
+	expectedReceipt := &transaction.ApiReceipt{
+		GasUsed:      50,
+		GasRefunded:  30,
+		Fee:          "500",
+		DeveloperFee: "15",
+		Status:       string(core.ReceiptStatusSuccess),
+	}
+	tx, err := n.GetTransaction("aaaa")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedReceipt, tx.Receipt)
+}
+
+func TestNode_GetTransaction_ShouldFindInTxStorageWithoutReceipt(t *testing.T) {
+	t.Parallel()
+
+	throttler := &mock.ThrottlerStub{
+		CanProcessCalled: func() bool {
+			return true
+		},
+	}
+	dataPool := &mock.PoolsHolderStub{
+		TransactionsCalled:         getCacherHandler(false, ""),
+		RewardTransactionsCalled:   getCacherHandler(false, ""),
+		UnsignedTransactionsCalled: getCacherHandler(false, ""),
+	}
+	storer := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return getStorerStub(unitType != dataRetriever.ReceiptsUnit)
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithApiTransactionByHashThrottler(throttler),
+		node.WithDataPool(dataPool),
+		node.WithDataStore(storer),
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 0),
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+	)
+	tx, err := n.GetTransaction("aaaa")
+	assert.NoError(t, err)
+	assert.Nil(t, tx.Receipt)
+}
+
 func TestNode_GetTransaction_ShouldFindInRwdTxStorageAndReturn(t *testing.T) {
 	t.Parallel()
 
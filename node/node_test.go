@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/batch"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -438,7 +439,7 @@ func TestCreateTransaction_NilAddrConverterShouldErr(t *testing.T) {
 	txData := "-"
 	signature := "-"
 
-	tx, txHash, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature)
+	tx, txHash, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature, 0, 0, nil, 0, 0)
 
 	assert.Nil(t, tx)
 	assert.Nil(t, txHash)
@@ -470,7 +471,7 @@ func TestCreateTransaction_NilAccountsAdapterShouldErr(t *testing.T) {
 	txData := "-"
 	signature := "-"
 
-	tx, txHash, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature)
+	tx, txHash, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature, 0, 0, nil, 0, 0)
 
 	assert.Nil(t, tx)
 	assert.Nil(t, txHash)
@@ -504,7 +505,7 @@ func TestCreateTransaction_InvalidSignatureShouldErr(t *testing.T) {
 	txData := "-"
 	signature := "-"
 
-	tx, txHash, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature)
+	tx, txHash, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature, 0, 0, nil, 0, 0)
 
 	assert.Nil(t, tx)
 	assert.Nil(t, txHash)
@@ -543,19 +544,77 @@ func TestCreateTransaction_OkValsShouldWork(t *testing.T) {
 	gasLimit := uint64(20)
 	txData := "-"
 	signature := "617eff4f"
+	version := transaction.InitialVersion
 
-	tx, txHash, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature)
+	tx, txHash, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature, version, 0, nil, 0, 0)
 	assert.NotNil(t, tx)
 	assert.Equal(t, expectedHash, txHash)
 	assert.Nil(t, err)
 	assert.Equal(t, nonce, tx.Nonce)
 	assert.Equal(t, value, tx.Value)
 	assert.True(t, bytes.Equal([]byte(receiver), tx.RcvAddr))
+	assert.Equal(t, version, tx.Version)
 
 	err = n.ValidateTransaction(tx)
 	assert.Nil(t, err)
 }
 
+func TestCreateTransaction_WithSignatures(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(getMarshalizer(), testSizeCheckDelta),
+		node.WithVmMarshalizer(getMarshalizer()),
+		node.WithTxSignMarshalizer(getMarshalizer()),
+		node.WithHasher(getHasher()),
+		node.WithAddressPubkeyConverter(
+			&mock.PubkeyConverterStub{
+				DecodeCalled: func(hexAddress string) ([]byte, error) {
+					return []byte(hexAddress), nil
+				},
+			},
+		),
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
+	)
+
+	value := new(big.Int).SetInt64(10)
+	signatures := []string{"617eff4f", "", "627eff4f"}
+
+	tx, _, err := n.CreateTransaction(0, value.String(), "rcv", "snd", 10, 20, "-", "", 0, 0, signatures, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{{0x61, 0x7e, 0xff, 0x4f}, {}, {0x62, 0x7e, 0xff, 0x4f}}, tx.Signatures)
+
+	signatures = []string{"617eff4f", "not hex"}
+	tx, _, err = n.CreateTransaction(0, value.String(), "rcv", "snd", 10, 20, "-", "", 0, 0, signatures, 0, 0)
+	assert.Nil(t, tx)
+	assert.NotNil(t, err)
+}
+
+func TestCreateTransaction_WithTimeLock(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(getMarshalizer(), testSizeCheckDelta),
+		node.WithVmMarshalizer(getMarshalizer()),
+		node.WithTxSignMarshalizer(getMarshalizer()),
+		node.WithHasher(getHasher()),
+		node.WithAddressPubkeyConverter(
+			&mock.PubkeyConverterStub{
+				DecodeCalled: func(hexAddress string) ([]byte, error) {
+					return []byte(hexAddress), nil
+				},
+			},
+		),
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
+	)
+
+	value := new(big.Int).SetInt64(10)
+	tx, _, err := n.CreateTransaction(0, value.String(), "rcv", "snd", 10, 20, "-", "", 1, 0, nil, 100, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(100), tx.NotBeforeRound)
+	assert.Equal(t, uint32(2), tx.NotBeforeEpoch)
+}
+
 func TestSendBulkTransactions_NoTxShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1487,6 +1546,112 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 	assert.Equal(t, accnt, recovAccnt)
 }
 
+func TestNode_GetAccountAtBlockNonceWithNilHistoricalAccountsProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+	)
+
+	recovAccnt, err := n.GetAccountAtBlockNonce(createDummyHexAddress(64), 10)
+
+	assert.Nil(t, recovAccnt)
+	assert.Equal(t, node.ErrNilHistoricalAccountsProvider, err)
+}
+
+func TestNode_GetAccountAtBlockNonceProviderFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithHistoricalAccountsProvider(&mock.HistoricalAccountsProviderStub{
+			GetAccountsAdapterAtBlockNonceCalled: func(_ uint64) (state.AccountsAdapter, data.HeaderHandler, error) {
+				return nil, nil, expectedErr
+			},
+		}),
+	)
+
+	recovAccnt, err := n.GetAccountAtBlockNonce(createDummyHexAddress(64), 10)
+
+	assert.Nil(t, recovAccnt)
+	assert.True(t, errors.Is(err, expectedErr))
+}
+
+func TestNode_ReloadTxPoolShouldUseTheStateOfTheCommittedBlock(t *testing.T) {
+	t.Parallel()
+
+	committedRootHash := []byte("committed root hash")
+	chainHandler := blockchain.NewBlockChain()
+	_ = chainHandler.SetCurrentBlockHeader(&block.Header{RootHash: committedRootHash})
+	historicalAccounts := &mock.AccountsStub{}
+	txPool := &mock.ShardedDataStub{}
+	reloadCalled := false
+	n, _ := node.NewNode(
+		node.WithBlockChain(chainHandler),
+		node.WithDataPool(&mock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return txPool
+			},
+		}),
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
+		node.WithHistoricalAccountsProvider(&mock.HistoricalAccountsProviderStub{
+			GetAccountsAdapterAtRootHashCalled: func(rootHash []byte) (state.AccountsAdapter, error) {
+				assert.Equal(t, committedRootHash, rootHash)
+				return historicalAccounts, nil
+			},
+		}),
+		node.WithTxPoolJournal(&mock.TxPoolJournalStub{
+			ReloadCalled: func(pool dataRetriever.ShardedDataCacherNotifier, accounts state.AccountsAdapter) error {
+				reloadCalled = true
+				assert.True(t, pool == txPool)
+				assert.True(t, accounts == historicalAccounts)
+				return nil
+			},
+		}),
+	)
+
+	n.ReloadTxPool()
+
+	assert.True(t, reloadCalled)
+}
+
+func TestNode_GetAccountAtBlockNonceShouldReturnTheHistoricalAccount(t *testing.T) {
+	t.Parallel()
+
+	accnt, _ := state.NewUserAccount([]byte("1234"))
+	_ = accnt.AddToBalance(big.NewInt(1))
+	accnt.IncreaseNonce(2)
+
+	blockNonce := uint64(10)
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(&mock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+				assert.Fail(t, "live accounts should not be used")
+				return nil, nil
+			},
+		}),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithHistoricalAccountsProvider(&mock.HistoricalAccountsProviderStub{
+			GetAccountsAdapterAtBlockNonceCalled: func(nonce uint64) (state.AccountsAdapter, data.HeaderHandler, error) {
+				assert.Equal(t, blockNonce, nonce)
+				accounts := &mock.AccountsStub{
+					GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+						return accnt, nil
+					},
+				}
+
+				return accounts, &block.Header{Nonce: nonce}, nil
+			},
+		}),
+	)
+
+	recovAccnt, err := n.GetAccountAtBlockNonce(createDummyHexAddress(64), blockNonce)
+
+	assert.Nil(t, err)
+	assert.Equal(t, accnt, recovAccnt)
+}
+
 func TestNode_AppStatusHandlersShouldIncrement(t *testing.T) {
 	t.Parallel()
 
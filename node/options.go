@@ -516,6 +516,28 @@ func WithValidatorsProvider(validatorsProvider process.ValidatorsProvider) Optio
 	}
 }
 
+// WithPeerAccountsExplorer sets up the component which reads the peer accounts at any validator statistics root hash
+func WithPeerAccountsExplorer(peerAccountsExplorer process.PeerAccountsExplorer) Option {
+	return func(n *Node) error {
+		if check.IfNil(peerAccountsExplorer) {
+			return ErrNilPeerAccountsExplorer
+		}
+		n.peerAccountsExplorer = peerAccountsExplorer
+		return nil
+	}
+}
+
+// WithTxPoolJournal sets up the journal used to reload the transactions pool when the node starts
+func WithTxPoolJournal(txPoolJournal dataRetriever.TxPoolJournal) Option {
+	return func(n *Node) error {
+		if check.IfNil(txPoolJournal) {
+			return ErrNilTxPoolJournal
+		}
+		n.txPoolJournal = txPoolJournal
+		return nil
+	}
+}
+
 // WithChainID sets up the chain ID on which the current node is supposed to work on
 func WithChainID(chainID []byte) Option {
 	return func(n *Node) error {
@@ -675,3 +697,30 @@ func WithApiTransactionByHashThrottler(throttler Throttler) Option {
 		return nil
 	}
 }
+
+// WithHistoricalAccountsProvider sets up the component used to query past states of the accounts
+func WithHistoricalAccountsProvider(provider state.HistoricalAccountsProvider) Option {
+	return func(n *Node) error {
+		if check.IfNil(provider) {
+			return ErrNilHistoricalAccountsProvider
+		}
+		n.historicalAccountsProvider = provider
+		return nil
+	}
+}
+
+// WithFullArchive sets up the flag that marks the node as a full archive node
+func WithFullArchive(isFullArchive bool) Option {
+	return func(n *Node) error {
+		n.isFullArchive = isFullArchive
+		return nil
+	}
+}
+
+// WithTxVersionEnableEpoch sets up the epoch starting with which the transactions are allowed to set a version and options
+func WithTxVersionEnableEpoch(txVersionEnableEpoch uint32) Option {
+	return func(n *Node) error {
+		n.txVersionEnableEpoch = txVersionEnableEpoch
+		return nil
+	}
+}
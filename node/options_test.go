@@ -1308,3 +1308,87 @@ func TestWithNodeStopChannel_OkNodeStopChannelShouldWork(t *testing.T) {
 	assert.True(t, node.chanStopNodeProcess == ch)
 	assert.Nil(t, err)
 }
+
+func TestWithHistoricalAccountsProvider_NilProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithHistoricalAccountsProvider(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilHistoricalAccountsProvider, err)
+}
+
+func TestWithHistoricalAccountsProvider_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	provider := &mock.HistoricalAccountsProviderStub{}
+	opt := WithHistoricalAccountsProvider(provider)
+	err := opt(node)
+
+	assert.True(t, node.historicalAccountsProvider == provider)
+	assert.Nil(t, err)
+}
+
+func TestWithPeerAccountsExplorer_NilExplorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithPeerAccountsExplorer(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilPeerAccountsExplorer, err)
+}
+
+func TestWithPeerAccountsExplorer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	explorer := &mock.PeerAccountsExplorerStub{}
+	opt := WithPeerAccountsExplorer(explorer)
+	err := opt(node)
+
+	assert.True(t, node.peerAccountsExplorer == explorer)
+	assert.Nil(t, err)
+}
+
+func TestWithTxPoolJournal_NilJournalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithTxPoolJournal(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilTxPoolJournal, err)
+}
+
+func TestWithTxPoolJournal_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	journal := &mock.TxPoolJournalStub{}
+	opt := WithTxPoolJournal(journal)
+	err := opt(node)
+
+	assert.True(t, node.txPoolJournal == journal)
+	assert.Nil(t, err)
+}
+
+func TestWithFullArchive_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithFullArchive(true)
+	err := opt(node)
+
+	assert.True(t, node.isFullArchive)
+	assert.Nil(t, err)
+}
@@ -29,6 +29,7 @@ type ArgBaseProcessor struct {
 	RequestHandler         process.RequestHandler
 	Core                   serviceContainer.Core
 	BlockChainHook         process.BlockChainHookHandler
+	EpochNotifier          process.EpochNotifier
 	TxCoordinator          process.TransactionCoordinator
 	EpochStartTrigger      process.EpochStartTriggerHandler
 	HeaderValidator        process.HeaderConstructionValidator
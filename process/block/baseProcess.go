@@ -54,6 +54,7 @@ type baseProcessor struct {
 	epochStartTrigger       process.EpochStartTriggerHandler
 	headerValidator         process.HeaderConstructionValidator
 	blockChainHook          process.BlockChainHookHandler
+	epochNotifier           process.EpochNotifier
 	txCoordinator           process.TransactionCoordinator
 	rounder                 consensus.Rounder
 	bootStorer              process.BootStorer
@@ -378,6 +379,9 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if check.IfNil(arguments.BlockChainHook) {
 		return process.ErrNilBlockChainHook
 	}
+	if check.IfNil(arguments.EpochNotifier) {
+		return process.ErrNilEpochNotifier
+	}
 	if check.IfNil(arguments.TxCoordinator) {
 		return process.ErrNilTransactionCoordinator
 	}
@@ -966,6 +970,70 @@ func (bp *baseProcessor) saveBody(body *block.Body) {
 	}
 }
 
+// saveReceipts persists the receipts of the transactions included in the committed block body and returns them
+// mapped by the receipt hashes, so they can be indexed
+func (bp *baseProcessor) saveReceipts(body *block.Body) map[string]data.TransactionHandler {
+	savedReceipts := make(map[string]data.TransactionHandler)
+	receipts := bp.feeHandler.GetReceipts()
+	if len(receipts) == 0 {
+		return savedReceipts
+	}
+
+	for _, miniBlock := range body.MiniBlocks {
+		if miniBlock.Type != block.TxBlock && miniBlock.Type != block.InvalidBlock {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			rcpt, ok := receipts[string(txHash)]
+			if !ok {
+				continue
+			}
+
+			marshalizedReceipt, errNotCritical := bp.marshalizer.Marshal(rcpt)
+			if errNotCritical != nil {
+				log.Warn("saveReceipts.Marshal", "error", errNotCritical.Error())
+				continue
+			}
+
+			errNotCritical = bp.store.Put(dataRetriever.ReceiptsUnit, txHash, marshalizedReceipt)
+			if errNotCritical != nil {
+				log.Warn("saveReceipts.Put -> ReceiptsUnit", "error", errNotCritical.Error())
+			}
+
+			savedReceipts[string(bp.hasher.Compute(string(marshalizedReceipt)))] = rcpt
+		}
+	}
+
+	return savedReceipts
+}
+
+// saveAccountsChangeSet persists the change set of the user accounts committed by the block having the given hash
+func (bp *baseProcessor) saveAccountsChangeSet(headerHash []byte) *state.AccountsChangeSet {
+	provider, ok := bp.accountsDB[state.UserAccountsState].(accountsChangeSetProvider)
+	if !ok {
+		return nil
+	}
+
+	changeSet := provider.LastCommittedChangeSet()
+	if changeSet == nil {
+		return nil
+	}
+
+	marshalizedChangeSet, errNotCritical := bp.marshalizer.Marshal(changeSet)
+	if errNotCritical != nil {
+		log.Warn("saveAccountsChangeSet.Marshal", "error", errNotCritical.Error())
+		return changeSet
+	}
+
+	errNotCritical = bp.store.Put(dataRetriever.AccountsChangeSetUnit, headerHash, marshalizedChangeSet)
+	if errNotCritical != nil {
+		log.Warn("saveAccountsChangeSet.Put -> AccountsChangeSetUnit", "error", errNotCritical.Error())
+	}
+
+	return changeSet
+}
+
 func (bp *baseProcessor) saveShardHeader(header data.HeaderHandler, headerHash []byte, marshalizedHeader []byte) {
 	startTime := time.Now()
 
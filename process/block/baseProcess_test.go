@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -199,6 +200,8 @@ func initStore() *dataRetriever.ChainStorer {
 	store.AddStorer(dataRetriever.BlockHeaderUnit, generateTestUnit())
 	store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit, generateTestUnit())
 	store.AddStorer(dataRetriever.MetaHdrNonceHashDataUnit, generateTestUnit())
+	store.AddStorer(dataRetriever.AccountsChangeSetUnit, generateTestUnit())
+	store.AddStorer(dataRetriever.ReceiptsUnit, generateTestUnit())
 	return store
 }
 
@@ -297,6 +300,7 @@ func CreateMockArguments() blproc.ArgShardProcessor {
 			RequestHandler:    &mock.RequestHandlerStub{},
 			Core:              &mock.ServiceContainerMock{},
 			BlockChainHook:    &mock.BlockChainHookHandlerMock{},
+			EpochNotifier:     &mock.EpochNotifierStub{},
 			TxCoordinator:     &mock.TransactionCoordinatorMock{},
 			EpochStartTrigger: &mock.EpochStartTriggerStub{},
 			HeaderValidator:   headerValidator,
@@ -992,3 +996,123 @@ func TestBlockProcessor_PruneStateOnRollbackPrunesPeerTrieIfSameRootHashButDiffe
 	bp.PruneStateOnRollback(currHeader, prevHeader)
 	assert.Equal(t, 2, pruningCalled)
 }
+
+type accountsWithChangeSetStub struct {
+	*mock.AccountsStub
+	changeSet *state.AccountsChangeSet
+}
+
+func (stub *accountsWithChangeSetStub) LastCommittedChangeSet() *state.AccountsChangeSet {
+	return stub.changeSet
+}
+
+func TestBaseProcessor_SaveAccountsChangeSetShouldPersistTheChangeSet(t *testing.T) {
+	t.Parallel()
+
+	changeSet := &state.AccountsChangeSet{
+		RootHash: []byte("root hash"),
+		Changes: []*state.AccountChange{
+			{
+				Address:      []byte("address"),
+				Nonce:        2,
+				Balance:      big.NewInt(7),
+				BalanceDelta: big.NewInt(-3),
+				DataTrieKeys: [][]byte{[]byte("key")},
+			},
+		},
+	}
+	arguments := CreateMockArguments()
+	arguments.AccountsDB[state.UserAccountsState] = &accountsWithChangeSetStub{
+		AccountsStub: &mock.AccountsStub{},
+		changeSet:    changeSet,
+	}
+	bp, _ := blproc.NewShardProcessor(arguments)
+
+	headerHash := []byte("header hash")
+	savedChangeSet := bp.SaveAccountsChangeSet(headerHash)
+	assert.Equal(t, changeSet, savedChangeSet)
+
+	buff, err := arguments.Store.Get(dataRetriever.AccountsChangeSetUnit, headerHash)
+	assert.Nil(t, err)
+	recoveredChangeSet := &state.AccountsChangeSet{}
+	_ = arguments.Marshalizer.Unmarshal(recoveredChangeSet, buff)
+	assert.Equal(t, changeSet, recoveredChangeSet)
+}
+
+func TestBaseProcessor_SaveAccountsChangeSetWithoutProviderShouldNotPersist(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	bp, _ := blproc.NewShardProcessor(arguments)
+
+	headerHash := []byte("header hash")
+	savedChangeSet := bp.SaveAccountsChangeSet(headerHash)
+	assert.Nil(t, savedChangeSet)
+
+	_, err := arguments.Store.Get(dataRetriever.AccountsChangeSetUnit, headerHash)
+	assert.NotNil(t, err)
+}
+
+func TestBaseProcessor_SaveReceiptsShouldPersistOnlyTheReceiptsOfTheBodyTransactions(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("tx hash")
+	invalidTxHash := []byte("invalid tx hash")
+	scrHash := []byte("scr hash")
+	receipts := map[string]*receipt.Receipt{
+		string(txHash): {
+			TxHash:       txHash,
+			GasUsed:      10,
+			Fee:          big.NewInt(100),
+			DeveloperFee: big.NewInt(0),
+			Status:       string(core.ReceiptStatusSuccess),
+		},
+		string(invalidTxHash): {
+			TxHash:       invalidTxHash,
+			GasUsed:      20,
+			Fee:          big.NewInt(200),
+			DeveloperFee: big.NewInt(0),
+			Status:       string(core.ReceiptStatusFailed),
+		},
+		string(scrHash): {
+			TxHash:       scrHash,
+			Fee:          big.NewInt(0),
+			DeveloperFee: big.NewInt(0),
+			Status:       string(core.ReceiptStatusFailed),
+		},
+		"tx not in body": {
+			Fee:          big.NewInt(0),
+			DeveloperFee: big.NewInt(0),
+			Status:       string(core.ReceiptStatusSuccess),
+		},
+	}
+	arguments := CreateMockArguments()
+	arguments.Hasher = &mock.HasherMock{}
+	arguments.FeeHandler = &mock.FeeAccumulatorStub{
+		GetReceiptsCalled: func() map[string]*receipt.Receipt {
+			return receipts
+		},
+	}
+	bp, _ := blproc.NewShardProcessor(arguments)
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{txHash}, Type: block.TxBlock},
+			{TxHashes: [][]byte{invalidTxHash}, Type: block.InvalidBlock},
+			{TxHashes: [][]byte{scrHash}, Type: block.SmartContractResultBlock},
+		},
+	}
+	savedReceipts := bp.SaveReceipts(body)
+	assert.Equal(t, 2, len(savedReceipts))
+
+	for _, hash := range [][]byte{txHash, invalidTxHash} {
+		buff, err := arguments.Store.Get(dataRetriever.ReceiptsUnit, hash)
+		assert.Nil(t, err)
+		recoveredReceipt := &receipt.Receipt{}
+		_ = arguments.Marshalizer.Unmarshal(recoveredReceipt, buff)
+		assert.Equal(t, receipts[string(hash)], recoveredReceipt)
+	}
+
+	_, err := arguments.Store.Get(dataRetriever.ReceiptsUnit, scrHash)
+	assert.NotNil(t, err)
+}
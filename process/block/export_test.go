@@ -90,6 +90,7 @@ func NewShardProcessorEmptyWith3shards(
 			RequestHandler:    &mock.RequestHandlerStub{},
 			Core:              &mock.ServiceContainerMock{},
 			BlockChainHook:    &mock.BlockChainHookHandlerMock{},
+			EpochNotifier:     &mock.EpochNotifierStub{},
 			TxCoordinator:     &mock.TransactionCoordinatorMock{},
 			EpochStartTrigger: &mock.EpochStartTriggerStub{},
 			HeaderValidator:   hdrValidator,
@@ -342,3 +343,11 @@ func (sp *shardProcessor) CreateBlockBody(shardHdr *block.Header, haveTime func(
 func (sp *shardProcessor) CheckEpochCorrectnessCrossChain() error {
 	return sp.checkEpochCorrectnessCrossChain()
 }
+
+func (bp *baseProcessor) SaveAccountsChangeSet(headerHash []byte) *state.AccountsChangeSet {
+	return bp.saveAccountsChangeSet(headerHash)
+}
+
+func (bp *baseProcessor) SaveReceipts(body *block.Body) map[string]data.TransactionHandler {
+	return bp.saveReceipts(body)
+}
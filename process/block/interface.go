@@ -2,8 +2,13 @@ package block
 
 import (
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 )
 
 type blockProcessor interface {
 	removeStartOfEpochBlockDataFromPools(headerHandler data.HeaderHandler, bodyHandler data.BodyHandler) error
 }
+
+type accountsChangeSetProvider interface {
+	LastCommittedChangeSet() *state.AccountsChangeSet
+}
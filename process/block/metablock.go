@@ -95,6 +95,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		requestHandler:         arguments.RequestHandler,
 		appStatusHandler:       statusHandler.NewNilStatusHandler(),
 		blockChainHook:         arguments.BlockChainHook,
+		epochNotifier:          arguments.EpochNotifier,
 		txCoordinator:          arguments.TxCoordinator,
 		epochStartTrigger:      arguments.EpochStartTrigger,
 		headerValidator:        arguments.HeaderValidator,
@@ -212,6 +213,7 @@ func (mp *metaProcessor) ProcessBlock(
 
 	mp.createBlockStarted()
 	mp.blockChainHook.SetCurrentHeader(headerHandler)
+	mp.epochNotifier.CheckEpoch(headerHandler)
 	mp.epochStartTrigger.Update(header.GetRound(), header.GetNonce())
 
 	err = mp.checkEpochCorrectness(header)
@@ -506,6 +508,7 @@ func (mp *metaProcessor) indexBlock(
 	lastMetaBlock data.HeaderHandler,
 	notarizedHeadersHashes []string,
 	rewardsTxs map[string]data.TransactionHandler,
+	receipts map[string]data.TransactionHandler,
 ) {
 	if mp.core == nil || mp.core.Indexer() == nil {
 		return
@@ -525,6 +528,9 @@ func (mp *metaProcessor) indexBlock(
 	for hash, tx := range rewardsTxs {
 		txPool[hash] = tx
 	}
+	for hash, rcpt := range receipts {
+		txPool[hash] = rcpt
+	}
 
 	publicKeys, err := mp.nodesCoordinator.GetConsensusValidatorsPublicKeys(
 		metaBlock.GetPrevRandSeed(), metaBlock.GetRound(), core.MetachainShardId, metaBlock.GetEpoch(),
@@ -636,6 +642,7 @@ func (mp *metaProcessor) CreateBlock(
 	mp.epochStartTrigger.Update(initialHdr.GetRound(), initialHdr.GetNonce())
 	metaHdr.SetEpoch(mp.epochStartTrigger.Epoch())
 	mp.blockChainHook.SetCurrentHeader(initialHdr)
+	mp.epochNotifier.CheckEpoch(initialHdr)
 
 	var body data.BodyHandler
 	var err error
@@ -1036,6 +1043,12 @@ func (mp *metaProcessor) CommitBlock(
 		return err
 	}
 
+	changeSet := mp.saveAccountsChangeSet(headerHash)
+	receipts := mp.saveReceipts(body)
+	if !check.IfNil(mp.core) {
+		indexAccountsChangeSet(mp.core.Indexer(), header, headerHash, changeSet)
+	}
+
 	mp.validatorStatisticsProcessor.DisplayRatings(header.GetEpoch())
 
 	err = mp.saveLastNotarizedHeader(header)
@@ -1094,7 +1107,7 @@ func (mp *metaProcessor) CommitBlock(
 		mp.core.TPSBenchmark().Update(header)
 	}
 
-	mp.indexBlock(header, body, lastMetaBlock, notarizedHeadersHashes, rewardsTxs)
+	mp.indexBlock(header, body, lastMetaBlock, notarizedHeadersHashes, rewardsTxs, receipts)
 
 	saveMetachainCommitBlockMetrics(mp.appStatusHandler, header, headerHash, mp.nodesCoordinator)
 
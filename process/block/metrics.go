@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -247,6 +248,19 @@ func indexRoundInfo(
 	go indexerHandler.SaveRoundsInfos(roundsInfos)
 }
 
+func indexAccountsChangeSet(
+	indexerHandler indexer.Indexer,
+	header data.HeaderHandler,
+	headerHash []byte,
+	changeSet *state.AccountsChangeSet,
+) {
+	if check.IfNil(indexerHandler) || changeSet == nil {
+		return
+	}
+
+	go indexerHandler.SaveAccountsChangeSet(header, headerHash, changeSet)
+}
+
 func indexValidatorsRating(
 	indexerHandler indexer.Indexer,
 	valStatProc process.ValidatorStatisticsProcessor,
@@ -4,6 +4,10 @@ import (
 	"math/big"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
@@ -17,6 +21,8 @@ type feeData struct {
 type feeHandler struct {
 	mut             sync.RWMutex
 	mapHashFee      map[string]*feeData
+	mapHashReceipt  map[string]*receipt.Receipt
+	mapHashRefund   map[string]uint64
 	accumulatedFees *big.Int
 	developerFees   *big.Int
 }
@@ -27,6 +33,8 @@ func NewFeeAccumulator() (*feeHandler, error) {
 	f.accumulatedFees = big.NewInt(0)
 	f.developerFees = big.NewInt(0)
 	f.mapHashFee = make(map[string]*feeData)
+	f.mapHashReceipt = make(map[string]*receipt.Receipt)
+	f.mapHashRefund = make(map[string]uint64)
 	return f, nil
 }
 
@@ -34,6 +42,8 @@ func NewFeeAccumulator() (*feeHandler, error) {
 func (f *feeHandler) CreateBlockStarted() {
 	f.mut.Lock()
 	f.mapHashFee = make(map[string]*feeData)
+	f.mapHashReceipt = make(map[string]*receipt.Receipt)
+	f.mapHashRefund = make(map[string]uint64)
 	f.accumulatedFees = big.NewInt(0)
 	f.developerFees = big.NewInt(0)
 	f.mut.Unlock()
@@ -90,6 +100,105 @@ func (f *feeHandler) RevertFees(txHashes [][]byte) {
 		f.accumulatedFees.Sub(f.accumulatedFees, fee.cost)
 		delete(f.mapHashFee, string(txHash))
 	}
+
+	for _, txHash := range txHashes {
+		delete(f.mapHashReceipt, string(txHash))
+		delete(f.mapHashRefund, string(txHash))
+	}
+}
+
+// ProcessTransactionGasRefund records the gas refunded to the sender by the smart contract execution of the
+// provided transaction
+func (f *feeHandler) ProcessTransactionGasRefund(txHash []byte, gasRefunded uint64) {
+	f.mut.Lock()
+	f.mapHashRefund[string(txHash)] = gasRefunded
+	f.mut.Unlock()
+}
+
+// ProcessTransactionReceipt creates or updates the receipt of the provided transaction, using the gas refund or,
+// if none was recorded, the fee already accumulated for it. A failed status is never overwritten by a later one.
+func (f *feeHandler) ProcessTransactionReceipt(txHash []byte, tx data.TransactionHandler, status core.ReceiptStatus) {
+	if check.IfNil(tx) {
+		log.Debug("nil tx in ProcessTransactionReceipt", "error", process.ErrNilTransaction.Error())
+		return
+	}
+
+	f.mut.Lock()
+	defer f.mut.Unlock()
+
+	oldReceipt, ok := f.mapHashReceipt[string(txHash)]
+	if ok && oldReceipt.Status == string(core.ReceiptStatusFailed) {
+		status = core.ReceiptStatusFailed
+	}
+
+	rpt := &receipt.Receipt{
+		Value:        big.NewInt(0),
+		SndAddr:      tx.GetSndAddr(),
+		TxHash:       txHash,
+		Fee:          big.NewInt(0),
+		DeveloperFee: big.NewInt(0),
+		Status:       string(status),
+	}
+
+	feeInfo, isFeeRecorded := f.mapHashFee[string(txHash)]
+	if isFeeRecorded {
+		rpt.DeveloperFee.Set(feeInfo.devFee)
+	}
+
+	gasRefunded, isRefundRecorded := f.mapHashRefund[string(txHash)]
+	switch {
+	case status == core.ReceiptStatusPendingInDestination && isGasForwardedToDestination(tx):
+		// the gas is spent or refunded in the destination shard, which creates the receipt with the whole fee
+	case isRefundRecorded:
+		// a smart contract execution knows the gas refunded, including when the execution happens in the
+		// destination shard and the fee for moving the balance was charged in the sender shard
+		rpt.GasRefunded = gasRefunded
+		if gasRefunded > tx.GetGasLimit() {
+			rpt.GasRefunded = tx.GetGasLimit()
+		}
+		rpt.GasUsed = tx.GetGasLimit() - rpt.GasRefunded
+		rpt.Fee.Mul(big.NewInt(0).SetUint64(rpt.GasUsed), big.NewInt(0).SetUint64(tx.GetGasPrice()))
+	case isFeeRecorded && feeInfo.cost.Sign() > 0:
+		// the fee of a move balance is charged only in the sender shard, the destination shard records a zero fee
+		rpt.Fee.Set(feeInfo.cost)
+		rpt.GasUsed, rpt.GasRefunded = computeGasUsedAndRefunded(rpt.Fee, tx)
+	}
+
+	rpt.Value.Mul(big.NewInt(0).SetUint64(rpt.GasRefunded), big.NewInt(0).SetUint64(tx.GetGasPrice()))
+	f.mapHashReceipt[string(txHash)] = rpt
+}
+
+func computeGasUsedAndRefunded(fee *big.Int, tx data.TransactionHandler) (uint64, uint64) {
+	gasLimit := tx.GetGasLimit()
+	if tx.GetGasPrice() == 0 {
+		return gasLimit, 0
+	}
+
+	gasUsed := big.NewInt(0).Div(fee, big.NewInt(0).SetUint64(tx.GetGasPrice())).Uint64()
+	if gasUsed > gasLimit {
+		gasUsed = gasLimit
+	}
+
+	return gasUsed, gasLimit - gasUsed
+}
+
+// isGasForwardedToDestination returns true if the transaction is a cross shard smart contract call, for which the
+// sender shard charges only the fee for moving the balance and forwards the rest of the gas to the destination shard
+func isGasForwardedToDestination(tx data.TransactionHandler) bool {
+	return len(tx.GetData()) > 0 && core.IsSmartContractAddress(tx.GetRcvAddr())
+}
+
+// GetReceipts returns the receipts created since the block creation or processing started
+func (f *feeHandler) GetReceipts() map[string]*receipt.Receipt {
+	f.mut.RLock()
+	defer f.mut.RUnlock()
+
+	receipts := make(map[string]*receipt.Receipt, len(f.mapHashReceipt))
+	for txHash, rcpt := range f.mapHashReceipt {
+		receipts[txHash] = rcpt
+	}
+
+	return receipts
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
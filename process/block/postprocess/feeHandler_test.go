@@ -4,7 +4,10 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process/block/postprocess"
 	"github.com/stretchr/testify/require"
 )
@@ -83,6 +86,158 @@ func TestFeeHandler_RevertFees(t *testing.T) {
 	require.Equal(t, big.NewInt(101), devFees)
 }
 
+func TestFeeHandler_ProcessTransactionReceiptShouldComputeTheFeeBreakdown(t *testing.T) {
+	t.Parallel()
+
+	feeHandler, _ := postprocess.NewFeeAccumulator()
+	txHash := []byte("txhash")
+	tx := &transaction.Transaction{
+		SndAddr:  []byte("sender"),
+		GasPrice: 10,
+		GasLimit: 100,
+	}
+
+	feeHandler.ProcessTransactionFee(big.NewInt(700), big.NewInt(21), txHash)
+	feeHandler.ProcessTransactionReceipt(txHash, tx, core.ReceiptStatusSuccess)
+
+	expectedReceipt := &receipt.Receipt{
+		Value:        big.NewInt(300),
+		SndAddr:      tx.SndAddr,
+		TxHash:       txHash,
+		GasUsed:      70,
+		GasRefunded:  30,
+		Fee:          big.NewInt(700),
+		DeveloperFee: big.NewInt(21),
+		Status:       string(core.ReceiptStatusSuccess),
+	}
+	receipts := feeHandler.GetReceipts()
+	require.Equal(t, 1, len(receipts))
+	require.Equal(t, expectedReceipt, receipts[string(txHash)])
+}
+
+func TestFeeHandler_ProcessTransactionReceiptWithoutFeeShouldOnlySetStatus(t *testing.T) {
+	t.Parallel()
+
+	feeHandler, _ := postprocess.NewFeeAccumulator()
+	txHash := []byte("txhash")
+	tx := &transaction.Transaction{GasPrice: 10, GasLimit: 100}
+
+	feeHandler.ProcessTransactionReceipt(txHash, tx, core.ReceiptStatusSuccess)
+
+	rcpt := feeHandler.GetReceipts()[string(txHash)]
+	require.Equal(t, uint64(0), rcpt.GasUsed)
+	require.Equal(t, uint64(0), rcpt.GasRefunded)
+	require.Equal(t, big.NewInt(0), rcpt.Fee)
+	require.Equal(t, string(core.ReceiptStatusSuccess), rcpt.Status)
+}
+
+func TestFeeHandler_ProcessTransactionReceiptWithZeroFeeShouldOnlySetStatus(t *testing.T) {
+	t.Parallel()
+
+	feeHandler, _ := postprocess.NewFeeAccumulator()
+	txHash := []byte("txhash")
+	tx := &transaction.Transaction{GasPrice: 10, GasLimit: 100}
+
+	// the destination shard of a move balance records a zero fee, as the fee was charged in the sender shard
+	feeHandler.ProcessTransactionFee(big.NewInt(0), big.NewInt(0), txHash)
+	feeHandler.ProcessTransactionReceipt(txHash, tx, core.ReceiptStatusSuccess)
+
+	rcpt := feeHandler.GetReceipts()[string(txHash)]
+	require.Equal(t, uint64(0), rcpt.GasUsed)
+	require.Equal(t, uint64(0), rcpt.GasRefunded)
+	require.Equal(t, big.NewInt(0), rcpt.Value)
+	require.Equal(t, big.NewInt(0), rcpt.Fee)
+	require.Equal(t, string(core.ReceiptStatusSuccess), rcpt.Status)
+}
+
+func TestFeeHandler_ProcessTransactionReceiptWithGasRefundShouldUseTheRefund(t *testing.T) {
+	t.Parallel()
+
+	feeHandler, _ := postprocess.NewFeeAccumulator()
+	txHash := []byte("txhash")
+	tx := &transaction.Transaction{
+		RcvAddr:  make([]byte, 32),
+		Data:     []byte("doSomething"),
+		GasPrice: 10,
+		GasLimit: 100,
+	}
+
+	// the destination shard of a smart contract call records only the fee consumed by the execution
+	feeHandler.ProcessTransactionGasRefund(txHash, 40)
+	feeHandler.ProcessTransactionFee(big.NewInt(500), big.NewInt(50), txHash)
+	feeHandler.ProcessTransactionReceipt(txHash, tx, core.ReceiptStatusSuccess)
+
+	rcpt := feeHandler.GetReceipts()[string(txHash)]
+	require.Equal(t, uint64(60), rcpt.GasUsed)
+	require.Equal(t, uint64(40), rcpt.GasRefunded)
+	require.Equal(t, big.NewInt(400), rcpt.Value)
+	require.Equal(t, big.NewInt(600), rcpt.Fee)
+	require.Equal(t, big.NewInt(50), rcpt.DeveloperFee)
+}
+
+func TestFeeHandler_ProcessTransactionReceiptPendingWithGasForwardedShouldOnlySetStatus(t *testing.T) {
+	t.Parallel()
+
+	feeHandler, _ := postprocess.NewFeeAccumulator()
+	txHash := []byte("txhash")
+	tx := &transaction.Transaction{
+		RcvAddr:  make([]byte, 32),
+		Data:     []byte("doSomething"),
+		GasPrice: 10,
+		GasLimit: 100,
+	}
+
+	feeHandler.ProcessTransactionFee(big.NewInt(200), big.NewInt(0), txHash)
+	feeHandler.ProcessTransactionReceipt(txHash, tx, core.ReceiptStatusPendingInDestination)
+
+	rcpt := feeHandler.GetReceipts()[string(txHash)]
+	require.Equal(t, uint64(0), rcpt.GasUsed)
+	require.Equal(t, uint64(0), rcpt.GasRefunded)
+	require.Equal(t, big.NewInt(0), rcpt.Value)
+	require.Equal(t, big.NewInt(0), rcpt.Fee)
+	require.Equal(t, string(core.ReceiptStatusPendingInDestination), rcpt.Status)
+}
+
+func TestFeeHandler_ProcessTransactionReceiptShouldKeepTheFailedStatus(t *testing.T) {
+	t.Parallel()
+
+	feeHandler, _ := postprocess.NewFeeAccumulator()
+	txHash := []byte("txhash")
+	tx := &transaction.Transaction{GasPrice: 10, GasLimit: 100}
+
+	feeHandler.ProcessTransactionFee(big.NewInt(1000), big.NewInt(0), txHash)
+	feeHandler.ProcessTransactionReceipt(txHash, tx, core.ReceiptStatusFailed)
+	feeHandler.ProcessTransactionReceipt(txHash, tx, core.ReceiptStatusSuccess)
+
+	rcpt := feeHandler.GetReceipts()[string(txHash)]
+	require.Equal(t, string(core.ReceiptStatusFailed), rcpt.Status)
+	require.Equal(t, uint64(100), rcpt.GasUsed)
+	require.Equal(t, uint64(0), rcpt.GasRefunded)
+}
+
+func TestFeeHandler_RevertFeesAndCreateBlockStartedShouldRemoveReceipts(t *testing.T) {
+	t.Parallel()
+
+	feeHandler, _ := postprocess.NewFeeAccumulator()
+	tx := &transaction.Transaction{GasPrice: 10, GasLimit: 100}
+
+	feeHandler.ProcessTransactionReceipt([]byte("txhash1"), tx, core.ReceiptStatusSuccess)
+	feeHandler.ProcessTransactionGasRefund([]byte("txhash2"), 10)
+	feeHandler.ProcessTransactionReceipt([]byte("txhash2"), tx, core.ReceiptStatusSuccess)
+
+	feeHandler.RevertFees([][]byte{[]byte("txhash2")})
+	receipts := feeHandler.GetReceipts()
+	require.Equal(t, 1, len(receipts))
+	require.NotNil(t, receipts["txhash1"])
+
+	// the reverted refund is not used when the transaction is processed again
+	feeHandler.ProcessTransactionReceipt([]byte("txhash2"), tx, core.ReceiptStatusSuccess)
+	require.Equal(t, uint64(0), feeHandler.GetReceipts()["txhash2"].GasRefunded)
+
+	feeHandler.CreateBlockStarted()
+	require.Equal(t, 0, len(feeHandler.GetReceipts()))
+}
+
 func TestFeeHandler_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
@@ -54,3 +54,10 @@ type BalanceComputationHandler interface {
 	AddressHasEnoughBalance(address []byte, value *big.Int) bool
 	IsInterfaceNil() bool
 }
+
+// CurrentBlockInfoProvider defines the functionality of providing the round and epoch of the block in progress
+type CurrentBlockInfoProvider interface {
+	CurrentRound() uint64
+	CurrentEpoch() uint32
+	IsInterfaceNil() bool
+}
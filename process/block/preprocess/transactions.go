@@ -46,9 +46,22 @@ type transactions struct {
 	mutOrderedTxs        sync.RWMutex
 	blockTracker         BlockTracker
 	blockType            block.Type
-	accountsInfo         map[string]*txShardInfo
+	accountsInfo         map[string]*senderLaneInfo
 	mutAccountsInfo      sync.RWMutex
 	emptyAddress         []byte
+	currentBlockInfo     CurrentBlockInfoProvider
+}
+
+// senderLaneInfo holds the shards of the last transaction processed from a nonce lane of a sender
+type senderLaneInfo struct {
+	txShardInfo
+	senderAddress []byte
+	nonceLane     uint32
+}
+
+// timeLockedTxsReleaser defines the pools which hold the time locked transactions until they become executable
+type timeLockedTxsReleaser interface {
+	ReleaseTimeLockedTxs(round uint64, epoch uint32)
 }
 
 // NewTransactionPreprocessor creates a new transaction preprocessor object
@@ -68,6 +81,7 @@ func NewTransactionPreprocessor(
 	pubkeyConverter core.PubkeyConverter,
 	blockSizeComputation BlockSizeComputationHandler,
 	balanceComputation BalanceComputationHandler,
+	currentBlockInfo CurrentBlockInfoProvider,
 ) (*transactions, error) {
 
 	if check.IfNil(hasher) {
@@ -112,6 +126,9 @@ func NewTransactionPreprocessor(
 	if check.IfNil(balanceComputation) {
 		return nil, process.ErrNilBalanceComputationHandler
 	}
+	if check.IfNil(currentBlockInfo) {
+		return nil, process.ErrNilCurrentBlockInfoProvider
+	}
 
 	bpp := basePreProcess{
 		hasher:               hasher,
@@ -133,6 +150,7 @@ func NewTransactionPreprocessor(
 		txProcessor:          txProcessor,
 		blockTracker:         blockTracker,
 		blockType:            blockType,
+		currentBlockInfo:     currentBlockInfo,
 	}
 
 	txs.chRcvAllTxs = make(chan bool)
@@ -141,7 +159,7 @@ func NewTransactionPreprocessor(
 	txs.txsForCurrBlock.txHashAndInfo = make(map[string]*txInfo)
 	txs.orderedTxs = make(map[string][]data.TransactionHandler)
 	txs.orderedTxHashes = make(map[string][][]byte)
-	txs.accountsInfo = make(map[string]*txShardInfo)
+	txs.accountsInfo = make(map[string]*senderLaneInfo)
 
 	txs.emptyAddress = make([]byte, txs.pubkeyConverter.Len())
 
@@ -176,9 +194,17 @@ func (txs *transactions) IsDataPrepared(requestedTxs int, haveTime func() time.D
 	return nil
 }
 
-// RemoveTxBlockFromPools removes transactions and miniblocks from associated pools
+// RemoveTxBlockFromPools removes transactions and miniblocks from associated pools. As this happens when the block is
+// committed, the time locked transactions which became executable in the committed block are released as well
 func (txs *transactions) RemoveTxBlockFromPools(body *block.Body, miniBlockPool storage.Cacher) error {
-	return txs.removeDataFromPools(body, miniBlockPool, txs.txPool, txs.isMiniBlockCorrect)
+	err := txs.removeDataFromPools(body, miniBlockPool, txs.txPool, txs.isMiniBlockCorrect)
+	if err != nil {
+		return err
+	}
+
+	txs.releaseTimeLockedTxs()
+
+	return nil
 }
 
 // RestoreTxBlockIntoPools restores the transactions and miniblocks to associated pools
@@ -433,6 +459,11 @@ func (txs *transactions) processTxsFromMe(
 		return err
 	}
 
+	err = txs.checkTimeLocks(txsFromMe)
+	if err != nil {
+		return err
+	}
+
 	SortTransactionsBySenderAndNonce(txsFromMe)
 
 	isShardStuckFalse := func(uint32) bool {
@@ -493,6 +524,39 @@ func (txs *transactions) processTxsFromMe(
 	return nil
 }
 
+func (txs *transactions) checkTimeLocks(wrappedTxs []*txcache.WrappedTransaction) error {
+	round := txs.currentBlockInfo.CurrentRound()
+	epoch := txs.currentBlockInfo.CurrentEpoch()
+
+	for _, wrappedTx := range wrappedTxs {
+		tx, ok := wrappedTx.Tx.(*transaction.Transaction)
+		if !ok {
+			continue
+		}
+		if !tx.CanBeExecutedAt(round, epoch) {
+			return fmt.Errorf("%w for tx hash %s, round %d, epoch %d",
+				process.ErrTimeLockedTransactionNotExecutable,
+				logger.DisplayByteSlice(wrappedTx.TxHash),
+				round,
+				epoch,
+			)
+		}
+	}
+
+	return nil
+}
+
+// releaseTimeLockedTxs moves the time locked transactions which became executable in the committed block into the
+// caches of the pool, if the pool holds such transactions
+func (txs *transactions) releaseTimeLockedTxs() {
+	releaser, ok := txs.txPool.(timeLockedTxsReleaser)
+	if !ok {
+		return
+	}
+
+	releaser.ReleaseTimeLockedTxs(txs.currentBlockInfo.CurrentRound(), txs.currentBlockInfo.CurrentEpoch())
+}
+
 // SaveTxBlockToStorage saves transactions from body into storage
 func (txs *transactions) SaveTxBlockToStorage(body *block.Body) error {
 	if check.IfNil(body) {
@@ -545,7 +609,7 @@ func (txs *transactions) CreateBlockStarted() {
 	txs.mutOrderedTxs.Unlock()
 
 	txs.mutAccountsInfo.Lock()
-	txs.accountsInfo = make(map[string]*txShardInfo)
+	txs.accountsInfo = make(map[string]*senderLaneInfo)
 	txs.mutAccountsInfo.Unlock()
 }
 
@@ -602,18 +666,24 @@ func (txs *transactions) processAndRemoveBadTransaction(
 
 func (txs *transactions) notifyTransactionProviderIfNeeded() {
 	txs.mutAccountsInfo.RLock()
-	for senderAddress, txShardInfoValue := range txs.accountsInfo {
-		if txShardInfoValue.senderShardID != txs.shardCoordinator.SelfId() {
+	for senderKey, laneInfo := range txs.accountsInfo {
+		if laneInfo.senderShardID != txs.shardCoordinator.SelfId() {
 			continue
 		}
 
-		account, err := txs.getAccountForAddress([]byte(senderAddress))
+		account, err := txs.getAccountForAddress(laneInfo.senderAddress)
 		if err != nil {
 			log.Debug("notifyTransactionProviderIfNeeded.getAccountForAddress", "error", err)
 			continue
 		}
 
-		strCache := process.ShardCacherIdentifier(txShardInfoValue.senderShardID, txShardInfoValue.receiverShardID)
+		laneNonce, err := txs.getNonceForLane(account, laneInfo.nonceLane)
+		if err != nil {
+			log.Debug("notifyTransactionProviderIfNeeded.getNonceForLane", "error", err)
+			continue
+		}
+
+		strCache := process.ShardCacherIdentifier(laneInfo.senderShardID, laneInfo.receiverShardID)
 		txShardPool := txs.txPool.ShardDataStore(strCache)
 		if check.IfNil(txShardPool) {
 			log.Trace("notifyTransactionProviderIfNeeded", "error", process.ErrNilTxDataPool)
@@ -621,11 +691,24 @@ func (txs *transactions) notifyTransactionProviderIfNeeded() {
 		}
 
 		sortedTransactionsProvider := createSortedTransactionsProvider(txShardPool)
-		sortedTransactionsProvider.NotifyAccountNonce([]byte(senderAddress), account.GetNonce())
+		sortedTransactionsProvider.NotifyAccountNonce([]byte(senderKey), laneNonce)
 	}
 	txs.mutAccountsInfo.RUnlock()
 }
 
+func (txs *transactions) getNonceForLane(account state.AccountHandler, lane uint32) (uint64, error) {
+	if lane == 0 {
+		return account.GetNonce(), nil
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return 0, process.ErrWrongTypeAssertion
+	}
+
+	return state.GetNonceForLane(userAccount, lane)
+}
+
 func (txs *transactions) getAccountForAddress(address []byte) (state.AccountHandler, error) {
 	account, err := txs.accounts.GetExistingAccount(address)
 	if err != nil {
@@ -774,6 +857,7 @@ func (txs *transactions) createAndProcessMiniBlocksFromMe(
 	numTxsAdded := 0
 	numTxsBad := 0
 	numTxsSkipped := 0
+	numTxsTimeLocked := 0
 	numTxsFailed := 0
 	numTxsWithInitialBalanceConsumed := 0
 
@@ -788,7 +872,9 @@ func (txs *transactions) createAndProcessMiniBlocksFromMe(
 
 	log.Debug("createAndProcessMiniBlocksFromMe", "totalGasConsumedInSelfShard", totalGasConsumedInSelfShard)
 
-	senderAddressToSkip := []byte("")
+	senderKeyToSkip := []byte("")
+	currentRound := txs.currentBlockInfo.CurrentRound()
+	currentEpoch := txs.currentBlockInfo.CurrentEpoch()
 
 	defer func() {
 		go txs.notifyTransactionProviderIfNeeded()
@@ -841,13 +927,20 @@ func (txs *transactions) createAndProcessMiniBlocksFromMe(
 			continue
 		}
 
-		if len(senderAddressToSkip) > 0 {
-			if bytes.Equal(senderAddressToSkip, tx.GetSndAddr()) {
+		senderKey := core.ComputeNonceLaneSenderKey(tx.GetSndAddr(), tx.NonceLane)
+		if len(senderKeyToSkip) > 0 {
+			if bytes.Equal(senderKeyToSkip, senderKey) {
 				numTxsSkipped++
 				continue
 			}
 		}
 
+		if !tx.CanBeExecutedAt(currentRound, currentEpoch) {
+			senderKeyToSkip = senderKey
+			numTxsTimeLocked++
+			continue
+		}
+
 		txMaxTotalCost := big.NewInt(0)
 		isAddressSet := txs.balanceComputation.IsAddressSet(tx.GetSndAddr())
 		if isAddressSet {
@@ -898,12 +991,16 @@ func (txs *transactions) createAndProcessMiniBlocksFromMe(
 		totalTimeUsedForProcesss += elapsedTime
 
 		txs.mutAccountsInfo.Lock()
-		txs.accountsInfo[string(tx.GetSndAddr())] = &txShardInfo{senderShardID: senderShardID, receiverShardID: receiverShardID}
+		txs.accountsInfo[string(senderKey)] = &senderLaneInfo{
+			txShardInfo:   txShardInfo{senderShardID: senderShardID, receiverShardID: receiverShardID},
+			senderAddress: tx.GetSndAddr(),
+			nonceLane:     tx.NonceLane,
+		}
 		txs.mutAccountsInfo.Unlock()
 
 		if err != nil && !errors.Is(err, process.ErrFailedTransaction) {
 			if errors.Is(err, process.ErrHigherNonceInTransaction) {
-				senderAddressToSkip = tx.GetSndAddr()
+				senderKeyToSkip = senderKey
 			}
 
 			numTxsBad++
@@ -926,7 +1023,7 @@ func (txs *transactions) createAndProcessMiniBlocksFromMe(
 			continue
 		}
 
-		senderAddressToSkip = []byte("")
+		senderKeyToSkip = []byte("")
 
 		gasRefunded := txs.gasHandler.GasRefunded(txHash)
 		mapGasConsumedByMiniBlockInReceiverShard[receiverShardID] -= gasRefunded
@@ -987,6 +1084,7 @@ func (txs *transactions) createAndProcessMiniBlocksFromMe(
 		"num txs bad", numTxsBad,
 		"num txs failed", numTxsFailed,
 		"num txs skipped", numTxsSkipped,
+		"num txs time locked", numTxsTimeLocked,
 		"num txs with initial balance consumed", numTxsWithInitialBalanceConsumed,
 		"used time for computeGasConsumed", totalTimeUsedForComputeGasConsumed,
 		"used time for processAndRemoveBadTransaction", totalTimeUsedForProcesss)
@@ -1156,18 +1254,23 @@ func (txs *transactions) IsInterfaceNil() bool {
 	return txs == nil
 }
 
-// SortTransactionsBySenderAndNonce sorts the provided transactions and hashes simultaneously
+// SortTransactionsBySenderAndNonce sorts the provided transactions and hashes simultaneously. The transactions are
+// ordered by their sender and nonce lane, then by nonce and, since a sender can have transactions with the same nonce
+// on different lanes, by hash, so the proposer and the validators compute the same order
 func SortTransactionsBySenderAndNonce(transactions []*txcache.WrappedTransaction) {
 	sorter := func(i, j int) bool {
-		txI := transactions[i].Tx
-		txJ := transactions[j].Tx
+		delta := bytes.Compare(transactions[i].SenderKey(), transactions[j].SenderKey())
+		if delta != 0 {
+			return delta < 0
+		}
 
-		delta := bytes.Compare(txI.GetSndAddr(), txJ.GetSndAddr())
-		if delta == 0 {
-			delta = int(txI.GetNonce()) - int(txJ.GetNonce())
+		nonceI := transactions[i].Tx.GetNonce()
+		nonceJ := transactions[j].Tx.GetNonce()
+		if nonceI != nonceJ {
+			return nonceI < nonceJ
 		}
 
-		return delta < 0
+		return bytes.Compare(transactions[i].TxHash, transactions[j].TxHash) < 0
 	}
 
 	sort.Slice(transactions, sorter)
@@ -3,6 +3,7 @@ package preprocess
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -25,6 +26,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const MaxGasLimitPerBlock = uint64(100000)
@@ -192,6 +194,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilPool(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -219,6 +222,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilStore(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -246,6 +250,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilHasher(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -273,6 +278,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilMarsalizer(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -300,6 +306,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilTxProce(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -327,6 +334,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilShardCoord(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -354,6 +362,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilAccounts(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -380,6 +389,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilRequestFunc(t *testing.T)
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -407,6 +417,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilFeeHandler(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -434,6 +445,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilGasHandler(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -461,6 +473,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilBlockTracker(t *testing.T)
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -488,6 +501,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilPubkeyConverter(t *testing
 		nil,
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -515,6 +529,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilBlockSizeComputationHandle
 		createMockPubkeyConverter(),
 		nil,
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -542,12 +557,41 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilBalanceComputationHandler(
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		nil,
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	assert.Nil(t, txs)
 	assert.Equal(t, process.ErrNilBalanceComputationHandler, err)
 }
 
+func TestTxsPreprocessor_NewTransactionPreprocessorNilCurrentBlockInfoProvider(t *testing.T) {
+	t.Parallel()
+
+	tdp := initDataPool()
+	requestTransaction := func(shardID uint32, txHashes [][]byte) {}
+	txs, err := NewTransactionPreprocessor(
+		tdp.Transactions(),
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		requestTransaction,
+		feeHandlerMock(),
+		&mock.GasHandlerMock{},
+		&mock.BlockTrackerMock{},
+		block.TxBlock,
+		createMockPubkeyConverter(),
+		&mock.BlockSizeComputationStub{},
+		&mock.BalanceComputationStub{},
+		nil,
+	)
+
+	assert.Nil(t, txs)
+	assert.Equal(t, process.ErrNilCurrentBlockInfoProvider, err)
+}
+
 func TestTxsPreprocessor_NewTransactionPreprocessorOkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -569,6 +613,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorOkValsShouldWork(t *testing.T
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	assert.Nil(t, err)
@@ -793,6 +838,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddAll(t *testi
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	assert.NotNil(t, txs)
 
@@ -863,6 +909,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddAllAsNoSCCal
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	assert.NotNil(t, txs)
 
@@ -943,6 +990,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddOnly5asSCCal
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	assert.NotNil(t, txs)
 
@@ -1036,6 +1084,35 @@ func ExampleSortTransactionsBySenderAndNonce() {
 	// 3 ffff b
 }
 
+func TestSortTransactionsBySenderAndNonce_EqualNoncesOnDifferentLanesShouldHaveTheSameOrder(t *testing.T) {
+	t.Parallel()
+
+	createTxs := func() []*txcache.WrappedTransaction {
+		return []*txcache.WrappedTransaction{
+			{Tx: &transaction.Transaction{Nonce: 1, SndAddr: []byte("aaaa"), NonceLane: 2}, TxHash: []byte("x")},
+			{Tx: &transaction.Transaction{Nonce: 1, SndAddr: []byte("aaaa"), NonceLane: 1}, TxHash: []byte("y")},
+			{Tx: &transaction.Transaction{Nonce: 1, SndAddr: []byte("aaaa")}, TxHash: []byte("z")},
+			{Tx: &transaction.Transaction{Nonce: 0, SndAddr: []byte("aaaa"), NonceLane: 1}, TxHash: []byte("t")},
+		}
+	}
+	expectedHashes := [][]byte{[]byte("z"), []byte("t"), []byte("y"), []byte("x")}
+
+	txs := createTxs()
+	SortTransactionsBySenderAndNonce(txs)
+	for i, tx := range txs {
+		assert.Equal(t, expectedHashes[i], tx.TxHash)
+	}
+
+	reversedTxs := createTxs()
+	for i, j := 0, len(reversedTxs)-1; i < j; i, j = i+1, j-1 {
+		reversedTxs[i], reversedTxs[j] = reversedTxs[j], reversedTxs[i]
+	}
+	SortTransactionsBySenderAndNonce(reversedTxs)
+	for i, tx := range reversedTxs {
+		assert.Equal(t, expectedHashes[i], tx.TxHash)
+	}
+}
+
 func BenchmarkSortTransactionsByNonceAndSender_WhenReversedNonces(b *testing.B) {
 	numTx := 100000
 	txs := make([]*txcache.WrappedTransaction, numTx)
@@ -1055,7 +1132,200 @@ func BenchmarkSortTransactionsByNonceAndSender_WhenReversedNonces(b *testing.B)
 	}
 }
 
+func createPreprocessorForTimeLockTests(
+	txPool dataRetriever.ShardedDataCacherNotifier,
+	currentRound uint64,
+) *transactions {
+	requestTransaction := func(shardID uint32, txHashes [][]byte) {}
+	preprocessor, _ := NewTransactionPreprocessor(
+		txPool,
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{
+			ProcessTransactionCalled: func(transaction *transaction.Transaction) error {
+				return nil
+			},
+		},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		requestTransaction,
+		feeHandlerMock(),
+		&mock.GasHandlerMock{
+			ComputeGasConsumedByTxCalled: func(txSenderShardId uint32, txReceiverShardId uint32, txHandler data.TransactionHandler) (uint64, uint64, error) {
+				return 0, 0, nil
+			},
+			SetGasConsumedCalled: func(gasConsumed uint64, hash []byte) {},
+			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
+			TotalGasConsumedCalled: func() uint64 {
+				return 0
+			},
+			GasRefundedCalled: func(hash []byte) uint64 {
+				return 0
+			},
+		},
+		&mock.BlockTrackerMock{},
+		block.TxBlock,
+		createMockPubkeyConverter(),
+		&mock.BlockSizeComputationStub{},
+		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{
+			CurrentRoundCalled: func() uint64 {
+				return currentRound
+			},
+		},
+	)
+
+	return preprocessor
+}
+
+func TestTransactions_CreateAndProcessMiniBlocksFromMeShouldSkipTimeLockedTxs(t *testing.T) {
+	t.Parallel()
+
+	txPool, _ := createTxPool()
+	txs := createPreprocessorForTimeLockTests(txPool, 5)
+
+	sortedTxs := []*txcache.WrappedTransaction{
+		{
+			Tx:     &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 0, NotBeforeRound: 10},
+			TxHash: []byte("hash-alice-0"),
+		},
+		{
+			Tx:     &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 1},
+			TxHash: []byte("hash-alice-1"),
+		},
+		{
+			Tx:     &transaction.Transaction{SndAddr: []byte("bob"), Nonce: 0, NotBeforeRound: 5},
+			TxHash: []byte("hash-bob-0"),
+		},
+	}
+
+	miniBlocks, err := txs.createAndProcessMiniBlocksFromMe(haveTimeTrue, isShardStuckFalse, isMaxBlockSizeReachedFalse, sortedTxs)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(miniBlocks))
+	assert.Equal(t, [][]byte{[]byte("hash-bob-0")}, miniBlocks[0].TxHashes)
+}
+
+func TestTransactions_RemoveTxBlockFromPoolsShouldReleaseTimeLockedTxs(t *testing.T) {
+	t.Parallel()
+
+	txPool, _ := createTxPool()
+	tx := &transaction.Transaction{SndAddr: []byte("alice"), NotBeforeRound: 10}
+	txPool.AddData([]byte("hash-alice-0"), tx, tx.Size(), process.ShardCacherIdentifier(0, 0))
+
+	// the block in progress does not release the time locked transactions, as it might not get committed
+	txs := createPreprocessorForTimeLockTests(txPool, 10)
+	miniBlocks, err := txs.CreateAndProcessMiniBlocks(haveTimeTrue)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(miniBlocks))
+
+	err = txs.RemoveTxBlockFromPools(&block.Body{}, &mock.CacherStub{})
+	require.Nil(t, err)
+
+	txs = createPreprocessorForTimeLockTests(txPool, 11)
+	miniBlocks, err = txs.CreateAndProcessMiniBlocks(haveTimeTrue)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(miniBlocks))
+	assert.Equal(t, [][]byte{[]byte("hash-alice-0")}, miniBlocks[0].TxHashes)
+}
+
+func TestTransactions_ProcessBlockTransactionsFromMeWithTimeLockedTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txPool, _ := createTxPool()
+	txs := createPreprocessorForTimeLockTests(txPool, 5)
+
+	tx := &transaction.Transaction{SndAddr: []byte("alice"), NotBeforeRound: 10}
+	txHash := []byte("hash-alice-0")
+	txs.AddTxForCurrentBlock(txHash, tx, 0, 0)
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes:        [][]byte{txHash},
+				SenderShardID:   0,
+				ReceiverShardID: 0,
+				Type:            block.TxBlock,
+			},
+		},
+	}
+
+	err := txs.ProcessBlockTransactions(body, haveTimeTrue)
+	assert.True(t, errors.Is(err, process.ErrTimeLockedTransactionNotExecutable))
+}
+
+func createTxsWithGasPrices() []*txcache.WrappedTransaction {
+	return []*txcache.WrappedTransaction{
+		{Tx: &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 0, GasPrice: 100}, TxHash: []byte("hash-alice-0")},
+		{Tx: &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 1, GasPrice: 300}, TxHash: []byte("hash-alice-1")},
+		{Tx: &transaction.Transaction{SndAddr: []byte("bob"), Nonce: 0, GasPrice: 200}, TxHash: []byte("hash-bob-0")},
+		{Tx: &transaction.Transaction{SndAddr: []byte("bob"), Nonce: 1, GasPrice: 50}, TxHash: []byte("hash-bob-1")},
+		{Tx: &transaction.Transaction{SndAddr: []byte("carol"), Nonce: 0, GasPrice: 200}, TxHash: []byte("hash-carol-0")},
+	}
+}
+
+func TestTransactions_FeeMarketProposerAndScoreValidatorShouldAgreeOnTheOrder(t *testing.T) {
+	t.Parallel()
+
+	proposerTxPool, _ := createTxPoolWithSelectionStrategy(txcache.FeeMarketSelection)
+	strCache := process.ShardCacherIdentifier(0, 0)
+	for _, wrappedTx := range createTxsWithGasPrices() {
+		proposerTxPool.AddData(wrappedTx.TxHash, wrappedTx.Tx, wrappedTx.Tx.Size(), strCache)
+	}
+
+	proposer := createPreprocessorForTimeLockTests(proposerTxPool, 0)
+	miniBlocks, err := proposer.CreateAndProcessMiniBlocks(haveTimeTrue)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(miniBlocks))
+
+	// the selection strategy only decides which transactions are selected, the processing order is always the
+	// sender and nonce order
+	expectedOrder := [][]byte{
+		[]byte("hash-alice-0"),
+		[]byte("hash-alice-1"),
+		[]byte("hash-bob-0"),
+		[]byte("hash-bob-1"),
+		[]byte("hash-carol-0"),
+	}
+	assert.Equal(t, expectedOrder, miniBlocks[0].TxHashes)
+
+	validatorTxPool, _ := createTxPoolWithSelectionStrategy(txcache.ScoreSelection)
+	validator := createPreprocessorForTimeLockTests(validatorTxPool, 0)
+	for _, wrappedTx := range createTxsWithGasPrices() {
+		validator.AddTxForCurrentBlock(wrappedTx.TxHash, wrappedTx.Tx.(*transaction.Transaction), 0, 0)
+	}
+	err = validator.ProcessBlockTransactions(&block.Body{MiniBlocks: miniBlocks}, haveTimeTrue)
+	assert.Nil(t, err)
+}
+
+func TestTransactions_ScoreSelectionShouldSortBySenderAndNonce(t *testing.T) {
+	t.Parallel()
+
+	txPool, _ := createTxPool()
+	strCache := process.ShardCacherIdentifier(0, 0)
+	for _, wrappedTx := range createTxsWithGasPrices() {
+		txPool.AddData(wrappedTx.TxHash, wrappedTx.Tx, wrappedTx.Tx.Size(), strCache)
+	}
+
+	txs := createPreprocessorForTimeLockTests(txPool, 0)
+	miniBlocks, err := txs.CreateAndProcessMiniBlocks(haveTimeTrue)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(miniBlocks))
+
+	expectedOrder := [][]byte{
+		[]byte("hash-alice-0"),
+		[]byte("hash-alice-1"),
+		[]byte("hash-bob-0"),
+		[]byte("hash-bob-1"),
+		[]byte("hash-carol-0"),
+	}
+	assert.Equal(t, expectedOrder, miniBlocks[0].TxHashes)
+}
+
 func createTxPool() (dataRetriever.ShardedDataCacherNotifier, error) {
+	return createTxPoolWithSelectionStrategy("")
+}
+
+func createTxPoolWithSelectionStrategy(selectionStrategy txcache.SelectionStrategy) (dataRetriever.ShardedDataCacherNotifier, error) {
 	return txpool.NewShardedTxPool(
 		txpool.ArgShardedTxPool{
 			Config: storageUnit.CacheConfig{
@@ -1064,6 +1334,7 @@ func createTxPool() (dataRetriever.ShardedDataCacherNotifier, error) {
 				SizeInBytes:          1000000000,
 				SizeInBytesPerSender: 10000000,
 				Shards:               16,
+				SelectionStrategy:    string(selectionStrategy),
 			},
 			MinGasPrice:    200000000000,
 			NumberOfShards: 1,
@@ -1089,6 +1360,7 @@ func createGoodPreprocessor(dataPool dataRetriever.PoolsHolder) *transactions {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	return preprocessor
@@ -1134,6 +1406,7 @@ func TestTransactionPreprocessor_ProcessTxsToMeShouldUseCorrectSenderAndReceiver
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 
 	tx := transaction.Transaction{SndAddr: []byte("2"), RcvAddr: []byte("0")}
@@ -65,6 +65,7 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		requestHandler:         arguments.RequestHandler,
 		appStatusHandler:       statusHandler.NewNilStatusHandler(),
 		blockChainHook:         arguments.BlockChainHook,
+		epochNotifier:          arguments.EpochNotifier,
 		txCoordinator:          arguments.TxCoordinator,
 		rounder:                arguments.Rounder,
 		epochStartTrigger:      arguments.EpochStartTrigger,
@@ -158,6 +159,7 @@ func (sp *shardProcessor) ProcessBlock(
 
 	sp.createBlockStarted()
 	sp.blockChainHook.SetCurrentHeader(headerHandler)
+	sp.epochNotifier.CheckEpoch(headerHandler)
 
 	sp.txCoordinator.RequestBlockTransactions(body)
 	requestedMetaHdrs, requestedFinalityAttestingMetaHdrs := sp.requestMetaHeaders(header)
@@ -501,6 +503,7 @@ func (sp *shardProcessor) indexBlockIfNeeded(
 	body data.BodyHandler,
 	header data.HeaderHandler,
 	lastBlockHeader data.HeaderHandler,
+	receipts map[string]data.TransactionHandler,
 ) {
 	if check.IfNil(sp.core) || check.IfNil(sp.core.Indexer()) {
 		return
@@ -530,6 +533,9 @@ func (sp *shardProcessor) indexBlockIfNeeded(
 	for hash, tx := range receiptPool {
 		txPool[hash] = tx
 	}
+	for hash, rcpt := range receipts {
+		txPool[hash] = rcpt
+	}
 
 	shardId := sp.shardCoordinator.SelfId()
 
@@ -680,6 +686,7 @@ func (sp *shardProcessor) CreateBlock(
 
 	shardHdr.SetEpoch(sp.epochStartTrigger.MetaEpoch())
 	sp.blockChainHook.SetCurrentHeader(shardHdr)
+	sp.epochNotifier.CheckEpoch(shardHdr)
 	body, err := sp.createBlockBody(shardHdr, haveTime)
 	if err != nil {
 		return nil, nil, err
@@ -809,6 +816,12 @@ func (sp *shardProcessor) CommitBlock(
 		return err
 	}
 
+	changeSet := sp.saveAccountsChangeSet(headerHash)
+	receipts := sp.saveReceipts(body)
+	if !check.IfNil(sp.core) {
+		indexAccountsChangeSet(sp.core.Indexer(), header, headerHash, changeSet)
+	}
+
 	log.Info("shard block has been committed successfully",
 		"epoch", header.Epoch,
 		"round", header.Round,
@@ -849,7 +862,7 @@ func (sp *shardProcessor) CommitBlock(
 	}
 
 	sp.blockChain.SetCurrentBlockHeaderHash(headerHash)
-	sp.indexBlockIfNeeded(bodyHandler, headerHandler, lastBlockHeader)
+	sp.indexBlockIfNeeded(bodyHandler, headerHandler, lastBlockHeader, receipts)
 
 	lastCrossNotarizedHeader, _, err := sp.blockTracker.GetLastCrossNotarizedHeader(core.MetachainShardId)
 	if err != nil {
@@ -508,6 +508,7 @@ func TestShardProcessor_ProcessBlockWithInvalidTransactionShouldErr(t *testing.T
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	container, _ := factory.Create()
 
@@ -730,6 +731,7 @@ func TestShardProcessor_ProcessBlockWithErrOnProcessBlockTransactionsCallShouldR
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	container, _ := factory.Create()
 
@@ -2278,6 +2280,7 @@ func TestShardProcessor_MarshalizedDataToBroadcastShouldWork(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	container, _ := factory.Create()
 
@@ -2391,6 +2394,7 @@ func TestShardProcessor_MarshalizedDataMarshalWithoutSuccess(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	container, _ := factory.Create()
 
@@ -2808,6 +2812,7 @@ func TestShardProcessor_CreateMiniBlocksShouldWorkWithIntraShardTxs(t *testing.T
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	container, _ := factory.Create()
 
@@ -2992,6 +2997,7 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	container, _ := factory.Create()
 
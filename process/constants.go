@@ -30,6 +30,8 @@ const (
 	BuiltInFunctionCall
 	// RewardTx defines ID of a reward transaction
 	RewardTx
+	// RelayedTx defines ID of a transaction which carries a user transaction, whose gas is paid by the relayer
+	RelayedTx
 	// InvalidTransaction defines unknown transaction type
 	InvalidTransaction
 )
@@ -107,3 +109,11 @@ const MaxRoundsToKeepUnprocessedMiniBlocks = 100
 
 // MaxRoundsToKeepUnprocessedTransactions defines the maximum number of rounds for which unprocessed transactions are kept in pool
 const MaxRoundsToKeepUnprocessedTransactions = 100
+
+// MaxTimeLockRoundsAhead defines the maximum number of rounds, counted from the current one, a transaction can be time
+// locked for
+const MaxTimeLockRoundsAhead = 14400
+
+// MaxTimeLockEpochsAhead defines the maximum number of epochs, counted from the current one, a transaction can be time
+// locked for
+const MaxTimeLockEpochsAhead = 1
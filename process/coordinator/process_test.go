@@ -536,6 +536,7 @@ func createPreProcessorContainer() process.PreProcessorsContainer {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -626,6 +627,7 @@ func createPreProcessorContainerWithDataPool(
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -957,6 +959,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessCrossShardTransactions(t *tes
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -1046,6 +1049,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessCrossShardTransactionsNilPreP
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -1156,6 +1160,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessTransactionsFromMeNothingToPr
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -1806,6 +1811,7 @@ func TestTransactionCoordinator_ProcessBlockTransactionProcessTxError(t *testing
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -1942,6 +1948,7 @@ func TestTransactionCoordinator_RequestMiniblocks(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -2084,6 +2091,7 @@ func TestShardProcessor_ProcessMiniBlockCompleteWithOkTxsShouldExecuteThemAndNot
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -2220,6 +2228,7 @@ func TestShardProcessor_ProcessMiniBlockCompleteWithErrorWhileProcessShouldCallR
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.BlockChainHookHandlerMock{},
 	)
 	container, _ := preFactory.Create()
 
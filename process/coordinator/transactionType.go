@@ -4,6 +4,7 @@ import (
 	"bytes"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -15,16 +16,21 @@ var _ process.TxTypeHandler = (*txTypeHandler)(nil)
 type txTypeHandler struct {
 	pubkeyConv       core.PubkeyConverter
 	shardCoordinator sharding.Coordinator
-	builtInFuncNames map[string]struct{}
+	builtInFuncs     process.BuiltInFunctionContainer
 	argumentParser   process.ArgumentsParser
+
+	relayedTxEnableEpoch uint32
+	flagRelayedTx        atomic.Flag
 }
 
 // ArgNewTxTypeHandler defines the arguments needed to create a new tx type handler
 type ArgNewTxTypeHandler struct {
 	PubkeyConverter  core.PubkeyConverter
 	ShardCoordinator sharding.Coordinator
-	BuiltInFuncNames map[string]struct{}
-	ArgumentParser   process.ArgumentsParser
+	BuiltInFunctions     process.BuiltInFunctionContainer
+	ArgumentParser       process.ArgumentsParser
+	EpochNotifier        process.EpochNotifier
+	RelayedTxEnableEpoch uint32
 }
 
 // NewTxTypeHandler creates a transaction type handler
@@ -40,17 +46,23 @@ func NewTxTypeHandler(
 	if check.IfNil(args.ArgumentParser) {
 		return nil, process.ErrNilArgumentParser
 	}
-	if args.BuiltInFuncNames == nil {
+	if check.IfNil(args.BuiltInFunctions) {
 		return nil, process.ErrNilBuiltInFunction
 	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
 
 	tc := &txTypeHandler{
-		pubkeyConv:       args.PubkeyConverter,
-		shardCoordinator: args.ShardCoordinator,
-		argumentParser:   args.ArgumentParser,
-		builtInFuncNames: args.BuiltInFuncNames,
+		pubkeyConv:           args.PubkeyConverter,
+		shardCoordinator:     args.ShardCoordinator,
+		argumentParser:       args.ArgumentParser,
+		builtInFuncs:         args.BuiltInFunctions,
+		relayedTxEnableEpoch: args.RelayedTxEnableEpoch,
 	}
 
+	args.EpochNotifier.RegisterNotifyHandler(tc)
+
 	return tc, nil
 }
 
@@ -73,6 +85,10 @@ func (tth *txTypeHandler) ComputeTransactionType(tx data.TransactionHandler) pro
 		return process.MoveBalance
 	}
 
+	if tth.isRelayedTransaction(tx.GetData()) {
+		return process.RelayedTx
+	}
+
 	isDestInSelfShard, err := tth.isDestAddressInSelfShard(tx.GetRcvAddr())
 	if err != nil {
 		return process.InvalidTransaction
@@ -95,7 +111,7 @@ func (tth *txTypeHandler) ComputeTransactionType(tx data.TransactionHandler) pro
 }
 
 func (tth *txTypeHandler) isBuiltInFunctionCall(txData []byte) bool {
-	if len(tth.builtInFuncNames) == 0 {
+	if tth.builtInFuncs.Len() == 0 {
 		return false
 	}
 	if len(txData) == 0 {
@@ -112,8 +128,36 @@ func (tth *txTypeHandler) isBuiltInFunctionCall(txData []byte) bool {
 		return false
 	}
 
-	_, ok := tth.builtInFuncNames[function]
-	return ok
+	builtInFunc, err := tth.builtInFuncs.Get(function)
+	if err != nil {
+		return false
+	}
+
+	return builtInFunc.IsActive()
+}
+
+func (tth *txTypeHandler) isRelayedTransaction(txData []byte) bool {
+	if !tth.flagRelayedTx.IsSet() {
+		return false
+	}
+
+	err := tth.argumentParser.ParseData(string(txData))
+	if err != nil {
+		return false
+	}
+
+	function, err := tth.argumentParser.GetFunction()
+	if err != nil {
+		return false
+	}
+
+	return function == core.RelayedTransaction
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (tth *txTypeHandler) EpochConfirmed(epoch uint32) {
+	tth.flagRelayedTx.Toggle(epoch >= tth.relayedTxEnableEpoch)
+	log.Debug("txTypeHandler: relayed transactions", "enabled", tth.flagRelayedTx.IsSet())
 }
 
 func (tth *txTypeHandler) isDestAddressEmpty(tx data.TransactionHandler) bool {
@@ -4,19 +4,23 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
 )
 
 func createMockArguments() ArgNewTxTypeHandler {
 	return ArgNewTxTypeHandler{
-		PubkeyConverter:  createMockPubkeyConverter(),
-		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(3),
-		BuiltInFuncNames: make(map[string]struct{}),
-		ArgumentParser:   vmcommon.NewAtArgumentParser(),
+		PubkeyConverter:      createMockPubkeyConverter(),
+		ShardCoordinator:     mock.NewMultiShardsCoordinatorMock(3),
+		BuiltInFunctions:     builtInFunctions.NewBuiltInFunctionContainer(),
+		ArgumentParser:       vmcommon.NewAtArgumentParser(),
+		EpochNotifier:        &mock.EpochNotifierStub{},
+		RelayedTxEnableEpoch: 0,
 	}
 }
 
@@ -61,13 +65,24 @@ func TestNewTxTypeHandler_NilBuiltInFuncs(t *testing.T) {
 	t.Parallel()
 
 	arg := createMockArguments()
-	arg.BuiltInFuncNames = nil
+	arg.BuiltInFunctions = nil
 	tth, err := NewTxTypeHandler(arg)
 
 	assert.Nil(t, tth)
 	assert.Equal(t, process.ErrNilBuiltInFunction, err)
 }
 
+func TestNewTxTypeHandler_NilEpochNotifier(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.EpochNotifier = nil
+	tth, err := NewTxTypeHandler(arg)
+
+	assert.Nil(t, tth)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
 func TestNewTxTypeHandler_ValsOk(t *testing.T) {
 	t.Parallel()
 
@@ -213,7 +228,7 @@ func TestTxTypeHandler_ComputeTransactionTypeBuiltInFunc(t *testing.T) {
 		},
 	}
 	builtIn := "builtIn"
-	arg.BuiltInFuncNames[builtIn] = struct{}{}
+	_ = arg.BuiltInFunctions.Add(builtIn, &mock.BuiltInFunctionStub{})
 	tth, err := NewTxTypeHandler(arg)
 
 	assert.NotNil(t, tth)
@@ -222,3 +237,83 @@ func TestTxTypeHandler_ComputeTransactionTypeBuiltInFunc(t *testing.T) {
 	txType := tth.ComputeTransactionType(tx)
 	assert.Equal(t, process.BuiltInFunctionCall, txType)
 }
+
+func TestTxTypeHandler_ComputeTransactionTypeInactiveBuiltInFuncShouldBeMoveBalance(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("000")
+	tx.RcvAddr = []byte("001")
+	tx.Data = []byte("builtIn")
+	tx.Value = big.NewInt(45)
+
+	arg := createMockArguments()
+	arg.PubkeyConverter = &mock.PubkeyConverterStub{
+		LenCalled: func() int {
+			return len(tx.RcvAddr)
+		},
+	}
+	builtIn := "builtIn"
+	_ = arg.BuiltInFunctions.Add(builtIn, &mock.BuiltInFunctionStub{
+		IsActiveCalled: func() bool {
+			return false
+		},
+	})
+	tth, _ := NewTxTypeHandler(arg)
+
+	txType := tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.MoveBalance, txType)
+}
+
+func TestTxTypeHandler_ComputeTransactionTypeRelayedTx(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("000")
+	tx.RcvAddr = []byte("001")
+	tx.Data = []byte(core.RelayedTransaction + "@0102")
+	tx.Value = big.NewInt(45)
+
+	arg := createMockArguments()
+	arg.PubkeyConverter = &mock.PubkeyConverterStub{
+		LenCalled: func() int {
+			return len(tx.RcvAddr)
+		},
+	}
+	tth, err := NewTxTypeHandler(arg)
+
+	assert.NotNil(t, tth)
+	assert.Nil(t, err)
+
+	txType := tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.RelayedTx, txType)
+}
+
+func TestTxTypeHandler_ComputeTransactionTypeRelayedTxBeforeActivationShouldNotBeRelayed(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("000")
+	tx.RcvAddr = []byte("001")
+	tx.Data = []byte(core.RelayedTransaction + "@0102")
+	tx.Value = big.NewInt(45)
+
+	arg := createMockArguments()
+	arg.PubkeyConverter = &mock.PubkeyConverterStub{
+		LenCalled: func() int {
+			return len(tx.RcvAddr)
+		},
+	}
+	arg.RelayedTxEnableEpoch = 1
+	tth, _ := NewTxTypeHandler(arg)
+
+	txType := tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.MoveBalance, txType)
+
+	tth.EpochConfirmed(1)
+	txType = tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.RelayedTx, txType)
+}
@@ -12,6 +12,11 @@ import (
 
 var _ process.TxValidator = (*txValidator)(nil)
 
+// nonceLaneHandler defines the intercepted transactions which can be ordered on a secondary nonce lane of their sender
+type nonceLaneHandler interface {
+	NonceLane() uint32
+}
+
 // txValidator represents a tx handler validator that doesn't check the validity of provided txHandler
 type txValidator struct {
 	accounts             state.AccountsAdapter
@@ -80,7 +85,11 @@ func (txv *txValidator) CheckTxValidity(interceptedTx process.TxValidatorHandler
 		)
 	}
 
-	accountNonce := accountHandler.GetNonce()
+	accountNonce, err := txv.getNonceForLane(accountHandler, interceptedTx)
+	if err != nil {
+		return err
+	}
+
 	txNonce := interceptedTx.Nonce()
 	lowerNonceInTx := txNonce < accountNonce
 	veryHighNonceInTx := txNonce > accountNonce+uint64(txv.maxNonceDeltaAllowed)
@@ -115,6 +124,25 @@ func (txv *txValidator) CheckTxValidity(interceptedTx process.TxValidatorHandler
 	return nil
 }
 
+// getNonceForLane returns the nonce the intercepted transaction is compared against: the account nonce for the default
+// nonce lane or the nonce saved in the data trie of the account for the secondary ones
+func (txv *txValidator) getNonceForLane(accountHandler state.AccountHandler, interceptedTx process.TxValidatorHandler) (uint64, error) {
+	txWithLane, ok := interceptedTx.(nonceLaneHandler)
+	if !ok || txWithLane.NonceLane() == 0 {
+		return accountHandler.GetNonce(), nil
+	}
+
+	account, ok := accountHandler.(state.UserAccountHandler)
+	if !ok {
+		return 0, fmt.Errorf("%w, account is not of type *state.Account, address: %s",
+			process.ErrWrongTypeAssertion,
+			txv.pubkeyConverter.Encode(interceptedTx.SenderAddress()),
+		)
+	}
+
+	return state.GetNonceForLane(account, txWithLane.NonceLane())
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (txv *txValidator) IsInterfaceNil() bool {
 	return txv == nil
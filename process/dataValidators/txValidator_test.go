@@ -371,6 +371,37 @@ func TestTxValidator_CheckTxValidityTxIsOkShouldReturnTrue(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestTxValidator_CheckTxValidityTxOnNonceLaneShouldCheckTheLaneNonce(t *testing.T) {
+	t.Parallel()
+
+	accountNonce := uint64(100)
+	accountBalance := big.NewInt(10)
+	adb := getAccAdapter(accountNonce, accountBalance)
+	shardCoordinator := createMockCoordinator("_", 0)
+	maxNonceDeltaAllowed := 100
+	txValidator, _ := dataValidators.NewTxValidator(
+		adb,
+		shardCoordinator,
+		&mock.WhiteListHandlerStub{},
+		mock.NewPubkeyConverterMock(32),
+		maxNonceDeltaAllowed,
+	)
+
+	addressMock := []byte("address")
+	currentShard := uint32(0)
+	txValidatorHandler := getTxValidatorHandler(currentShard, currentShard, 0, addressMock, big.NewInt(0))
+	txValidatorHandler.(*mock.TxValidatorHandlerStub).NonceLaneCalled = func() uint32 {
+		return 2
+	}
+
+	result := txValidator.CheckTxValidity(txValidatorHandler)
+	assert.Nil(t, result)
+
+	txValidatorHandler = getTxValidatorHandler(currentShard, currentShard, 0, addressMock, big.NewInt(0))
+	result = txValidator.CheckTxValidity(txValidatorHandler)
+	assert.True(t, errors.Is(result, process.ErrWrongTransaction))
+}
+
 //------- IsInterfaceNil
 
 func TestTxValidator_IsInterfaceNil(t *testing.T) {
@@ -12,6 +12,7 @@ import (
 var _ process.RewardsHandler = (*EconomicsData)(nil)
 var _ process.ValidatorSettingsHandler = (*EconomicsData)(nil)
 var _ process.FeeHandler = (*EconomicsData)(nil)
+var _ process.StorageRentHandler = (*EconomicsData)(nil)
 
 // EconomicsData will store information about economics
 type EconomicsData struct {
@@ -37,6 +38,7 @@ type EconomicsData struct {
 	numRoundsWithoutBleed    uint64
 	bleedPercentagePerRound  float64
 	maximumPercentageToBleed float64
+	storageRentPerByte       *big.Int
 }
 
 // NewEconomicsData will create and object with information about economics parameters
@@ -78,6 +80,7 @@ func NewEconomicsData(economics *config.EconomicsConfig) (*EconomicsData, error)
 		bleedPercentagePerRound:  data.bleedPercentagePerRound,
 		maximumPercentageToBleed: data.maximumPercentageToBleed,
 		unJailPrice:              data.unJailPrice,
+		storageRentPerByte:       data.storageRentPerByte,
 	}, nil
 }
 
@@ -169,6 +172,11 @@ func convertValues(economics *config.EconomicsConfig) (*EconomicsData, error) {
 		return nil, process.ErrInvalidUnJailPrice
 	}
 
+	storageRentPerByte, err := convertStorageRentPerByte(economics.FeeSettings.StorageRentPerByte)
+	if err != nil {
+		return nil, err
+	}
+
 	return &EconomicsData{
 		minGasPrice:              minGasPrice,
 		minGasLimit:              minGasLimit,
@@ -186,9 +194,24 @@ func convertValues(economics *config.EconomicsConfig) (*EconomicsData, error) {
 		bleedPercentagePerRound:  bleedPercentagePerRound,
 		maximumPercentageToBleed: maximumPercentageToBleed,
 		unJailPrice:              unJailPrice,
+		storageRentPerByte:       storageRentPerByte,
 	}, nil
 }
 
+// convertStorageRentPerByte parses the storage rent per byte, which is optional, a missing value meaning no rent
+func convertStorageRentPerByte(storageRentPerByte string) (*big.Int, error) {
+	if len(storageRentPerByte) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	rent, ok := big.NewInt(0).SetString(storageRentPerByte, 10)
+	if !ok || rent.Sign() < 0 {
+		return nil, process.ErrInvalidStorageRentPerByte
+	}
+
+	return rent, nil
+}
+
 func checkValues(economics *config.EconomicsConfig) error {
 	if isPercentageInvalid(economics.RewardsSettings.LeaderPercentage) ||
 		isPercentageInvalid(economics.RewardsSettings.DeveloperPercentage) ||
@@ -358,6 +381,11 @@ func (ed *EconomicsData) StakeEnableNonce() uint64 {
 	return ed.stakeEnableNonce
 }
 
+// ComputeStorageRent returns the rent owed by an account for the given number of bytes stored in its data trie
+func (ed *EconomicsData) ComputeStorageRent(storedBytes uint64) *big.Int {
+	return big.NewInt(0).Mul(ed.storageRentPerByte, big.NewInt(0).SetUint64(storedBytes))
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ed *EconomicsData) IsInterfaceNil() bool {
 	return ed == nil
@@ -131,6 +131,19 @@ func TestNewEconomicsData_InvalidLeaderPercentageShouldErr(t *testing.T) {
 
 }
 
+func TestNewEconomicsData_InvalidStorageRentPerByteShouldErr(t *testing.T) {
+	t.Parallel()
+
+	badStorageRents := []string{"-1", "-100000000000000000000", "badValue", "1.5"}
+	for _, storageRentPerByte := range badStorageRents {
+		economicsConfig := createDummyEconomicsConfig()
+		economicsConfig.FeeSettings.StorageRentPerByte = storageRentPerByte
+
+		_, err := economics.NewEconomicsData(economicsConfig)
+		assert.Equal(t, process.ErrInvalidStorageRentPerByte, err)
+	}
+}
+
 func TestNewEconomicsData_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -296,3 +309,16 @@ func TestEconomicsData_TxWithWithMoreGasPriceLimitShouldWork(t *testing.T) {
 
 	assert.Nil(t, err)
 }
+
+func TestEconomicsData_ComputeStorageRent(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	economicsData, _ := economics.NewEconomicsData(economicsConfig)
+	assert.Equal(t, big.NewInt(0), economicsData.ComputeStorageRent(1000))
+
+	economicsConfig.FeeSettings.StorageRentPerByte = "15"
+	economicsData, _ = economics.NewEconomicsData(economicsConfig)
+	assert.Equal(t, big.NewInt(0), economicsData.ComputeStorageRent(0))
+	assert.Equal(t, big.NewInt(15000), economicsData.ComputeStorageRent(1000))
+}
@@ -470,6 +470,9 @@ var ErrInvalidMaxGasLimitPerBlock = errors.New("invalid max gas limit per block"
 // ErrInvalidGasPerDataByte signals that an invalid gas per data byte has been read from config file
 var ErrInvalidGasPerDataByte = errors.New("invalid gas per data byte")
 
+// ErrInvalidStorageRentPerByte signals that an invalid storage rent per byte has been read from config file
+var ErrInvalidStorageRentPerByte = errors.New("invalid storage rent per byte")
+
 // ErrMaxGasLimitPerMiniBlockInSenderShardIsReached signals that max gas limit per mini block in sender shard has been reached
 var ErrMaxGasLimitPerMiniBlockInSenderShardIsReached = errors.New("max gas limit per mini block in sender shard is reached")
 
@@ -793,3 +796,93 @@ var ErrShardIsStuck = errors.New("shard is stuck")
 
 // ErrNilInterceptorContainer signals that nil interceptor container has been provided
 var ErrNilInterceptorContainer = errors.New("nil interceptor container")
+
+// ErrHistoricalQueriesNotSupported signals that the queries on past states are not supported by the component
+var ErrHistoricalQueriesNotSupported = errors.New("historical queries not supported")
+
+// ErrInvalidMaxConcurrentHistoricalQueries signals that an invalid maximum number of concurrent historical queries
+// has been provided
+var ErrInvalidMaxConcurrentHistoricalQueries = errors.New("invalid max concurrent historical queries")
+
+// ErrInvalidRelayedTxData signals that the data field of a relayed transaction is not valid
+var ErrInvalidRelayedTxData = errors.New("invalid relayed transaction data")
+
+// ErrRecursiveRelayedTxIsNotAllowed signals that a relayed transaction carries another relayed transaction
+var ErrRecursiveRelayedTxIsNotAllowed = errors.New("recursive relayed transaction is not allowed")
+
+// ErrRelayedTxBeneficiaryDoesNotMatchReceiver signals that the sender of the user transaction is not the receiver of the relayed transaction
+var ErrRelayedTxBeneficiaryDoesNotMatchReceiver = errors.New("invalid address in relayed transaction")
+
+// ErrRelayedTxValueDoesNotMatchUserTxValue signals that the value of the relayed transaction differs from the value of the user transaction
+var ErrRelayedTxValueDoesNotMatchUserTxValue = errors.New("relayed transaction value does not match the user transaction value")
+
+// ErrRelayedTxGasPriceMismatch signals that the gas price of the relayed transaction differs from the gas price of the user transaction
+var ErrRelayedTxGasPriceMismatch = errors.New("relayed transaction gas price does not match the user transaction gas price")
+
+// ErrRelayedTxGasLimitTooLow signals that the gas limit of the relayed transaction does not cover the gas limit of the user transaction
+var ErrRelayedTxGasLimitTooLow = errors.New("relayed transaction gas limit is too low")
+
+// ErrInvalidTransactionVersion signals that the transaction version is not supported
+var ErrInvalidTransactionVersion = errors.New("invalid transaction version")
+
+// ErrInvalidTransactionOptions signals that the transaction options are not supported
+var ErrInvalidTransactionOptions = errors.New("invalid transaction options")
+
+// ErrAccountIsAlreadyMultisig signals that the account is already a multisig account
+var ErrAccountIsAlreadyMultisig = errors.New("account is already a multisig account")
+
+// ErrAccountIsNotMultisig signals that the account is not a multisig account
+var ErrAccountIsNotMultisig = errors.New("account is not a multisig account")
+
+// ErrInvalidMultisigThreshold signals that the threshold of a multisig account is either zero or greater than the number of signers
+var ErrInvalidMultisigThreshold = errors.New("invalid multisig threshold")
+
+// ErrDuplicatedMultisigSigner signals that a multisig account signer was provided more than once
+var ErrDuplicatedMultisigSigner = errors.New("duplicated multisig signer")
+
+// ErrNotEnoughMultisigSignatures signals that a transaction sent by a multisig account does not carry enough valid signatures
+var ErrNotEnoughMultisigSignatures = errors.New("not enough valid multisig signatures")
+
+// ErrMultisigAccountNeedsMultipleSignatures signals that a transaction sent by a multisig account carries a single signature
+var ErrMultisigAccountNeedsMultipleSignatures = errors.New("transactions sent by multisig accounts need multiple signatures")
+
+// ErrCrossShardRelayedTxWithMultipleSignatures signals that a relayed transaction carries a user transaction signed
+// by multiple signers, although the relayer is not in the shard of the user
+var ErrCrossShardRelayedTxWithMultipleSignatures = errors.New("cross shard relayed transaction with multiple signatures")
+
+// ErrBothSignatureAndSignaturesSet signals that a transaction carries both a single signature and multiple signatures
+var ErrBothSignatureAndSignaturesSet = errors.New("both the signature and the signatures fields are set")
+
+// ErrNilMultisigAccountsHandler signals that a nil multisig accounts handler has been provided
+var ErrNilMultisigAccountsHandler = errors.New("nil multisig accounts handler")
+
+// ErrInvalidMultisigSignerLength signals that a multisig account signer does not have the length of an address
+var ErrInvalidMultisigSignerLength = errors.New("invalid multisig signer length")
+
+// ErrNonCanonicalMultisigSignatures signals that the signatures of a transaction sent by a multisig account are not
+// given one per signer, in the order of the signers, with no signature repeated
+var ErrNonCanonicalMultisigSignatures = errors.New("non canonical multisig signatures")
+
+// ErrNilCurrentBlockInfoProvider signals that a nil current block info provider has been provided
+var ErrNilCurrentBlockInfoProvider = errors.New("nil current block info provider")
+
+// ErrTimeLockedTransactionNotExecutable signals that a time locked transaction was included before its round or epoch
+var ErrTimeLockedTransactionNotExecutable = errors.New("time locked transaction is not executable yet")
+
+// ErrInvalidNonceLane signals that the nonce lane of a transaction is not supported
+var ErrInvalidNonceLane = errors.New("invalid nonce lane")
+
+// ErrNonceLaneNotAllowed signals that a transaction sent on a secondary nonce lane is not a move balance transaction
+var ErrNonceLaneNotAllowed = errors.New("secondary nonce lanes are allowed only for move balance transactions")
+
+// ErrBuiltInFunctionIsNotActive signals that a built-in function was called before its activation epoch
+var ErrBuiltInFunctionIsNotActive = errors.New("built-in function is not active")
+
+// ErrNilEpochNotifier signals that a nil epoch notifier has been provided
+var ErrNilEpochNotifier = errors.New("nil epoch notifier")
+
+// ErrTimeLockNotAllowed signals that a transaction is time locked, although its version does not allow it
+var ErrTimeLockNotAllowed = errors.New("time lock not allowed")
+
+// ErrTimeLockTooFarInTheFuture signals that a transaction is time locked for a round or an epoch too far in the future
+var ErrTimeLockTooFarInTheFuture = errors.New("time lock too far in the future")
@@ -3,6 +3,7 @@ package interceptorscontainer
 import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -15,6 +16,7 @@ import (
 // ShardInterceptorsContainerFactoryArgs holds the arguments needed for ShardInterceptorsContainerFactory
 type ShardInterceptorsContainerFactoryArgs struct {
 	Accounts                state.AccountsAdapter
+	BlockChain              data.ChainHandler
 	ShardCoordinator        sharding.Coordinator
 	NodesCoordinator        sharding.NodesCoordinator
 	Messenger               process.TopicHandler
@@ -41,6 +43,7 @@ type ShardInterceptorsContainerFactoryArgs struct {
 	WhiteListerVerifiedTxs  process.WhiteListHandler
 	AntifloodHandler        process.P2PAntifloodHandler
 	NonceConverter          typeConverters.Uint64ByteSliceConverter
+	TxVersionEnableEpoch    uint32
 }
 
 // MetaInterceptorsContainerFactoryArgs holds the arguments needed for MetaInterceptorsContainerFactory
@@ -55,6 +58,7 @@ type MetaInterceptorsContainerFactoryArgs struct {
 	MultiSigner             crypto.MultiSigner
 	DataPool                dataRetriever.PoolsHolder
 	Accounts                state.AccountsAdapter
+	BlockChain              data.ChainHandler
 	AddressPubkeyConverter  core.PubkeyConverter
 	SingleSigner            crypto.SingleSigner
 	BlockSingleSigner       crypto.SingleSigner
@@ -72,4 +76,5 @@ type MetaInterceptorsContainerFactoryArgs struct {
 	WhiteListerVerifiedTxs  process.WhiteListHandler
 	AntifloodHandler        process.P2PAntifloodHandler
 	NonceConverter          typeConverters.Uint64ByteSliceConverter
+	TxVersionEnableEpoch    uint32
 }
@@ -12,6 +12,7 @@ import (
 	processInterceptors "github.com/ElrondNetwork/elrond-go/process/interceptors"
 	interceptorFactory "github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 )
 
 var _ process.InterceptorsContainerFactory = (*metaInterceptorsContainerFactory)(nil)
@@ -77,6 +78,11 @@ func NewMetaInterceptorsContainerFactory(
 		return nil, process.ErrNilValidityAttester
 	}
 
+	multisigAccounts, err := builtInFunctions.NewMultisigAccountsReader(args.Accounts, args.BlockChain, args.ProtoMarshalizer)
+	if err != nil {
+		return nil, err
+	}
+
 	argInterceptorFactory := &interceptorFactory.ArgInterceptedDataFactory{
 		ProtoMarshalizer:        args.ProtoMarshalizer,
 		TxSignMarshalizer:       args.TxSignMarshalizer,
@@ -96,6 +102,9 @@ func NewMetaInterceptorsContainerFactory(
 		EpochStartTrigger:       args.EpochStartTrigger,
 		NonceConverter:          args.NonceConverter,
 		WhiteListerVerifiedTxs:  args.WhiteListerVerifiedTxs,
+		TxVersionEnableEpoch:    args.TxVersionEnableEpoch,
+		MultisigAccounts:        multisigAccounts,
+		BlockChain:              args.BlockChain,
 	}
 
 	container := containers.NewInterceptorsContainer()
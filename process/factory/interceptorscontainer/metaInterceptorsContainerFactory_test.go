@@ -464,6 +464,7 @@ func getArgumentsMeta() interceptorscontainer.MetaInterceptorsContainerFactoryAr
 		MultiSigner:             mock.NewMultiSigner(),
 		DataPool:                createMetaDataPools(),
 		Accounts:                &mock.AccountsStub{},
+		BlockChain:              &mock.BlockChainMock{},
 		AddressPubkeyConverter:  mock.NewPubkeyConverterMock(32),
 		SingleSigner:            &mock.SignerMock{},
 		BlockSingleSigner:       &mock.SignerMock{},
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/containers"
 	interceptorFactory "github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 )
 
 var _ process.InterceptorsContainerFactory = (*shardInterceptorsContainerFactory)(nil)
@@ -77,6 +78,11 @@ func NewShardInterceptorsContainerFactory(
 		return nil, process.ErrNilEpochStartTrigger
 	}
 
+	multisigAccounts, err := builtInFunctions.NewMultisigAccountsReader(args.Accounts, args.BlockChain, args.ProtoMarshalizer)
+	if err != nil {
+		return nil, err
+	}
+
 	argInterceptorFactory := &interceptorFactory.ArgInterceptedDataFactory{
 		ProtoMarshalizer:        args.ProtoMarshalizer,
 		TxSignMarshalizer:       args.TxSignMarshalizer,
@@ -96,6 +102,9 @@ func NewShardInterceptorsContainerFactory(
 		EpochStartTrigger:       args.EpochStartTrigger,
 		NonceConverter:          args.NonceConverter,
 		WhiteListerVerifiedTxs:  args.WhiteListerVerifiedTxs,
+		TxVersionEnableEpoch:    args.TxVersionEnableEpoch,
+		MultisigAccounts:        multisigAccounts,
+		BlockChain:              args.BlockChain,
 	}
 
 	container := containers.NewInterceptorsContainer()
@@ -505,6 +505,7 @@ func TestShardInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 func getArgumentsShard() interceptorscontainer.ShardInterceptorsContainerFactoryArgs {
 	return interceptorscontainer.ShardInterceptorsContainerFactoryArgs{
 		Accounts:                &mock.AccountsStub{},
+		BlockChain:              &mock.BlockChainMock{},
 		ShardCoordinator:        mock.NewOneShardCoordinatorMock(),
 		NodesCoordinator:        mock.NewNodesCoordinatorMock(),
 		Messenger:               &mock.TopicHandlerStub{},
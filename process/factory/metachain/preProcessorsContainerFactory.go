@@ -32,6 +32,7 @@ type preProcessorsContainerFactory struct {
 	pubkeyConverter      core.PubkeyConverter
 	blockSizeComputation preprocess.BlockSizeComputationHandler
 	balanceComputation   preprocess.BalanceComputationHandler
+	currentBlockInfo     preprocess.CurrentBlockInfoProvider
 }
 
 // NewPreProcessorsContainerFactory is responsible for creating a new preProcessors factory object
@@ -51,6 +52,7 @@ func NewPreProcessorsContainerFactory(
 	pubkeyConverter core.PubkeyConverter,
 	blockSizeComputation preprocess.BlockSizeComputationHandler,
 	balanceComputation preprocess.BalanceComputationHandler,
+	currentBlockInfo preprocess.CurrentBlockInfoProvider,
 ) (*preProcessorsContainerFactory, error) {
 
 	if check.IfNil(shardCoordinator) {
@@ -98,6 +100,9 @@ func NewPreProcessorsContainerFactory(
 	if check.IfNil(balanceComputation) {
 		return nil, process.ErrNilBalanceComputationHandler
 	}
+	if check.IfNil(currentBlockInfo) {
+		return nil, process.ErrNilCurrentBlockInfoProvider
+	}
 
 	return &preProcessorsContainerFactory{
 		shardCoordinator:     shardCoordinator,
@@ -115,6 +120,7 @@ func NewPreProcessorsContainerFactory(
 		pubkeyConverter:      pubkeyConverter,
 		blockSizeComputation: blockSizeComputation,
 		balanceComputation:   balanceComputation,
+		currentBlockInfo:     currentBlockInfo,
 	}, nil
 }
 
@@ -162,6 +168,7 @@ func (ppcm *preProcessorsContainerFactory) createTxPreProcessor() (process.PrePr
 		ppcm.pubkeyConverter,
 		ppcm.blockSizeComputation,
 		ppcm.balanceComputation,
+		ppcm.currentBlockInfo,
 	)
 
 	return txPreprocessor, err
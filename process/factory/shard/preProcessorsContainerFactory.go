@@ -34,6 +34,7 @@ type preProcessorsContainerFactory struct {
 	blockTracker         preprocess.BlockTracker
 	blockSizeComputation preprocess.BlockSizeComputationHandler
 	balanceComputation   preprocess.BalanceComputationHandler
+	currentBlockInfo     preprocess.CurrentBlockInfoProvider
 }
 
 // NewPreProcessorsContainerFactory is responsible for creating a new preProcessors factory object
@@ -55,6 +56,7 @@ func NewPreProcessorsContainerFactory(
 	blockTracker preprocess.BlockTracker,
 	blockSizeComputation preprocess.BlockSizeComputationHandler,
 	balanceComputation preprocess.BalanceComputationHandler,
+	currentBlockInfo preprocess.CurrentBlockInfoProvider,
 ) (*preProcessorsContainerFactory, error) {
 
 	if check.IfNil(shardCoordinator) {
@@ -108,6 +110,9 @@ func NewPreProcessorsContainerFactory(
 	if check.IfNil(balanceComputation) {
 		return nil, process.ErrNilBalanceComputationHandler
 	}
+	if check.IfNil(currentBlockInfo) {
+		return nil, process.ErrNilCurrentBlockInfoProvider
+	}
 
 	return &preProcessorsContainerFactory{
 		shardCoordinator:     shardCoordinator,
@@ -127,6 +132,7 @@ func NewPreProcessorsContainerFactory(
 		blockTracker:         blockTracker,
 		blockSizeComputation: blockSizeComputation,
 		balanceComputation:   balanceComputation,
+		currentBlockInfo:     currentBlockInfo,
 	}, nil
 }
 
@@ -194,6 +200,7 @@ func (ppcm *preProcessorsContainerFactory) createTxPreProcessor() (process.PrePr
 		ppcm.pubkeyConverter,
 		ppcm.blockSizeComputation,
 		ppcm.balanceComputation,
+		ppcm.currentBlockInfo,
 	)
 
 	return txPreprocessor, err
@@ -3,6 +3,7 @@ package factory
 import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
@@ -31,4 +32,7 @@ type ArgInterceptedDataFactory struct {
 	ValidityAttester        process.ValidityAttester
 	EpochStartTrigger       process.EpochStartTriggerHandler
 	NonceConverter          typeConverters.Uint64ByteSliceConverter
+	TxVersionEnableEpoch    uint32
+	MultisigAccounts        process.MultisigAccountsHandler
+	BlockChain              data.ChainHandler
 }
@@ -70,6 +70,8 @@ func createMockArgument() *ArgInterceptedDataFactory {
 		EpochStartTrigger:       &mock.EpochStartTriggerStub{},
 		NonceConverter:          mock.NewNonceHashConverterMock(),
 		WhiteListerVerifiedTxs:  &mock.WhiteListHandlerStub{},
+		MultisigAccounts:        &mock.MultisigAccountsHandlerStub{},
+		BlockChain:              &mock.BlockChainMock{},
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -23,6 +24,10 @@ type interceptedTxDataFactory struct {
 	shardCoordinator       sharding.Coordinator
 	feeHandler             process.FeeHandler
 	whiteListerVerifiedTxs process.WhiteListHandler
+	epochStartTrigger      process.EpochStartTriggerHandler
+	txVersionEnableEpoch   uint32
+	multisigAccounts       process.MultisigAccountsHandler
+	blockChain             data.ChainHandler
 }
 
 // NewInterceptedTxDataFactory creates an instance of interceptedTxDataFactory
@@ -57,6 +62,15 @@ func NewInterceptedTxDataFactory(argument *ArgInterceptedDataFactory) (*intercep
 	if check.IfNil(argument.WhiteListerVerifiedTxs) {
 		return nil, process.ErrNilWhiteListHandler
 	}
+	if check.IfNil(argument.EpochStartTrigger) {
+		return nil, process.ErrNilEpochStartTrigger
+	}
+	if check.IfNil(argument.MultisigAccounts) {
+		return nil, process.ErrNilMultisigAccountsHandler
+	}
+	if check.IfNil(argument.BlockChain) {
+		return nil, process.ErrNilBlockChain
+	}
 
 	return &interceptedTxDataFactory{
 		protoMarshalizer:       argument.ProtoMarshalizer,
@@ -68,11 +82,23 @@ func NewInterceptedTxDataFactory(argument *ArgInterceptedDataFactory) (*intercep
 		shardCoordinator:       argument.ShardCoordinator,
 		feeHandler:             argument.FeeHandler,
 		whiteListerVerifiedTxs: argument.WhiteListerVerifiedTxs,
+		epochStartTrigger:      argument.EpochStartTrigger,
+		txVersionEnableEpoch:   argument.TxVersionEnableEpoch,
+		multisigAccounts:       argument.MultisigAccounts,
+		blockChain:             argument.BlockChain,
 	}, nil
 }
 
 // Create creates instances of InterceptedData by unmarshalling provided buffer
 func (itdf *interceptedTxDataFactory) Create(buff []byte) (process.InterceptedData, error) {
+	isTxVersionEnabled := itdf.epochStartTrigger.Epoch() >= itdf.txVersionEnableEpoch
+
+	currentRound := uint64(0)
+	currentHeader := itdf.blockChain.GetCurrentBlockHeader()
+	if !check.IfNil(currentHeader) {
+		currentRound = currentHeader.GetRound()
+	}
+
 	return transaction.NewInterceptedTransaction(
 		buff,
 		itdf.protoMarshalizer,
@@ -84,6 +110,10 @@ func (itdf *interceptedTxDataFactory) Create(buff []byte) (process.InterceptedDa
 		itdf.shardCoordinator,
 		itdf.feeHandler,
 		itdf.whiteListerVerifiedTxs,
+		isTxVersionEnabled,
+		itdf.multisigAccounts,
+		currentRound+process.MaxTimeLockRoundsAhead,
+		itdf.epochStartTrigger.Epoch()+process.MaxTimeLockEpochsAhead,
 	)
 }
 
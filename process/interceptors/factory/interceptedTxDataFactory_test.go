@@ -108,6 +108,39 @@ func TestNewInterceptedTxDataFactory_NilEconomicsFeeHandlerShouldErr(t *testing.
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
+func TestNewInterceptedTxDataFactory_NilEpochStartTriggerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.EpochStartTrigger = nil
+
+	imh, err := NewInterceptedTxDataFactory(arg)
+	assert.Nil(t, imh)
+	assert.Equal(t, process.ErrNilEpochStartTrigger, err)
+}
+
+func TestNewInterceptedTxDataFactory_NilMultisigAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.MultisigAccounts = nil
+
+	imh, err := NewInterceptedTxDataFactory(arg)
+	assert.Nil(t, imh)
+	assert.Equal(t, process.ErrNilMultisigAccountsHandler, err)
+}
+
+func TestNewInterceptedTxDataFactory_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.BlockChain = nil
+
+	imh, err := NewInterceptedTxDataFactory(arg)
+	assert.Nil(t, imh)
+	assert.Equal(t, process.ErrNilBlockChain, err)
+}
+
 func TestInterceptedTxDataFactory_ShouldWorkAndCreate(t *testing.T) {
 	t.Parallel()
 
@@ -129,3 +162,36 @@ func TestInterceptedTxDataFactory_ShouldWorkAndCreate(t *testing.T) {
 	_, ok := interceptedData.(*transaction.InterceptedTransaction)
 	assert.True(t, ok)
 }
+
+func TestInterceptedTxDataFactory_CreateShouldEnableTxVersionStartingWithTheEnableEpoch(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(0)
+	arg := createMockArgument()
+	arg.TxVersionEnableEpoch = 1
+	arg.EpochStartTrigger = &mock.EpochStartTriggerStub{
+		EpochCalled: func() uint32 {
+			return epoch
+		},
+	}
+	imh, _ := NewInterceptedTxDataFactory(arg)
+
+	marshalizer := &mock.MarshalizerMock{}
+	tx := &dataTransaction.Transaction{
+		Value:     big.NewInt(0),
+		RcvAddr:   []byte("receiver"),
+		SndAddr:   []byte("sender"),
+		Signature: []byte("signature"),
+		Version:   dataTransaction.VersionWithOptions,
+	}
+	txBuff, _ := marshalizer.Marshal(tx)
+
+	interceptedData, err := imh.Create(txBuff)
+	assert.Nil(t, err)
+	assert.Equal(t, process.ErrInvalidTransactionVersion, interceptedData.CheckValidity())
+
+	epoch = 1
+	interceptedData, err = imh.Create(txBuff)
+	assert.Nil(t, err)
+	assert.NotEqual(t, process.ErrInvalidTransactionVersion, interceptedData.CheckValidity())
+}
@@ -1,12 +1,14 @@
 package process
 
 import (
+	"context"
 	"math/big"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -175,6 +177,9 @@ type TransactionFeeHandler interface {
 	GetAccumulatedFees() *big.Int
 	GetDeveloperFees() *big.Int
 	ProcessTransactionFee(cost *big.Int, devFee *big.Int, txHash []byte)
+	ProcessTransactionGasRefund(txHash []byte, gasRefunded uint64)
+	ProcessTransactionReceipt(txHash []byte, tx data.TransactionHandler, status core.ReceiptStatus)
+	GetReceipts() map[string]*receipt.Receipt
 	RevertFees(txHashes [][]byte)
 	IsInterfaceNil() bool
 }
@@ -255,6 +260,14 @@ type ValidatorsProvider interface {
 	IsInterfaceNil() bool
 }
 
+// PeerAccountsExplorer reads the full data of the peer accounts at any validator statistics root hash
+type PeerAccountsExplorer interface {
+	GetPeerAccounts(ctx context.Context, rootHash []byte) (*state.PeerAccountsApiResponse, error)
+	ComparePeerAccounts(ctx context.Context, fromRootHash []byte, toRootHash []byte) (*state.PeerAccountsComparisonApiResponse, error)
+	GetEpochStartValidatorStatsRootHash(epoch uint32) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
 // Checker provides functionality to checks the integrity and validity of a data structure
 type Checker interface {
 	// IntegrityAndValidity does both validity and integrity checks on the data structure
@@ -383,6 +396,12 @@ type VirtualMachinesContainer interface {
 	IsInterfaceNil() bool
 }
 
+// QueryVMContainerCreator defines the functionality to create the virtual machines used to query a given state
+type QueryVMContainerCreator interface {
+	CreateVMContainer(accounts state.AccountsAdapter) (VirtualMachinesContainer, error)
+	IsInterfaceNil() bool
+}
+
 // VirtualMachinesContainerFactory defines the functionality to create a virtual machine container
 type VirtualMachinesContainerFactory interface {
 	Create() (VirtualMachinesContainer, error)
@@ -429,6 +448,8 @@ type BlockChainHookHandler interface {
 	SetCurrentHeader(hdr data.HeaderHandler)
 	GetBuiltInFunctions() BuiltInFunctionContainer
 	NewAddress(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error)
+	CurrentRound() uint64
+	CurrentEpoch() uint32
 }
 
 // Interceptor defines what a data interceptor should do
@@ -544,6 +565,12 @@ type FeeHandler interface {
 	IsInterfaceNil() bool
 }
 
+// StorageRentHandler computes the rent owed by an account for the bytes stored in its data trie
+type StorageRentHandler interface {
+	ComputeStorageRent(storedBytes uint64) *big.Int
+	IsInterfaceNil() bool
+}
+
 // TransactionWithFeeHandler represents a transaction structure that has economics variables defined
 type TransactionWithFeeHandler interface {
 	GetGasLimit() uint64
@@ -600,9 +627,10 @@ type NetworkConnectionWatcher interface {
 
 // SCQuery represents a prepared query for executing a function of the smart contract
 type SCQuery struct {
-	ScAddress []byte
-	FuncName  string
-	Arguments [][]byte
+	ScAddress  []byte
+	FuncName   string
+	Arguments  [][]byte
+	BlockNonce *uint64
 }
 
 // GasHandler is able to perform some gas calculation
@@ -768,6 +796,7 @@ type MiniBlockProvider interface {
 // BuiltinFunction defines the methods for the built-in protocol smart contract functions
 type BuiltinFunction interface {
 	ProcessBuiltinFunction(acntSnd, acntDst state.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error)
+	IsActive() bool
 	IsInterfaceNil() bool
 }
 
@@ -892,3 +921,17 @@ type NodesCoordinator interface {
 	GetAllLeavingValidatorsPublicKeys(epoch uint32) (map[uint32][][]byte, error)
 	IsInterfaceNil() bool
 }
+
+// MultisigAccountsHandler defines the behavior of a component able to provide the multisig settings of an account
+type MultisigAccountsHandler interface {
+	GetMultisigSigners(address []byte) ([][]byte, uint32, error)
+	IsInterfaceNil() bool
+}
+
+// EpochNotifier can notify the subscribed components when the epoch of the block in progress changes
+type EpochNotifier interface {
+	RegisterNotifyHandler(handler core.EpochSubscriberHandler)
+	CurrentEpoch() uint32
+	CheckEpoch(header data.HeaderHandler)
+	IsInterfaceNil() bool
+}
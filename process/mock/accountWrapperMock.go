@@ -82,6 +82,11 @@ func (awm *AccountWrapMock) SetOwnerAddress([]byte) {
 
 }
 
+// GetStoredBytes -
+func (awm *AccountWrapMock) GetStoredBytes() uint64 {
+	return 0
+}
+
 // GetOwnerAddress -
 func (awm *AccountWrapMock) GetOwnerAddress() []byte {
 	return nil
@@ -7,6 +7,7 @@ import (
 
 type BuiltInFunctionStub struct {
 	ProcessBuiltinFunctionCalled func(acntSnd, acntDst state.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error)
+	IsActiveCalled               func() bool
 }
 
 // ProcessBuiltinFunction -
@@ -17,6 +18,14 @@ func (b *BuiltInFunctionStub) ProcessBuiltinFunction(acntSnd, acntDst state.User
 	return &vmcommon.VMOutput{}, nil
 }
 
+// IsActive -
+func (b *BuiltInFunctionStub) IsActive() bool {
+	if b.IsActiveCalled != nil {
+		return b.IsActiveCalled()
+	}
+	return true
+}
+
 // IsInterfaceNil -
 func (b *BuiltInFunctionStub) IsInterfaceNil() bool {
 	return b == nil
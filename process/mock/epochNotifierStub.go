@@ -0,0 +1,44 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// EpochNotifierStub -
+type EpochNotifierStub struct {
+	CurrentEpochCalled          func() uint32
+	CheckEpochCalled            func(header data.HeaderHandler)
+	RegisterNotifyHandlerCalled func(handler core.EpochSubscriberHandler)
+}
+
+// RegisterNotifyHandler -
+func (ens *EpochNotifierStub) RegisterNotifyHandler(handler core.EpochSubscriberHandler) {
+	if ens.RegisterNotifyHandlerCalled != nil {
+		ens.RegisterNotifyHandlerCalled(handler)
+		return
+	}
+
+	handler.EpochConfirmed(ens.CurrentEpoch())
+}
+
+// CurrentEpoch -
+func (ens *EpochNotifierStub) CurrentEpoch() uint32 {
+	if ens.CurrentEpochCalled != nil {
+		return ens.CurrentEpochCalled()
+	}
+
+	return 0
+}
+
+// CheckEpoch -
+func (ens *EpochNotifierStub) CheckEpoch(header data.HeaderHandler) {
+	if ens.CheckEpochCalled != nil {
+		ens.CheckEpochCalled(header)
+	}
+}
+
+// IsInterfaceNil -
+func (ens *EpochNotifierStub) IsInterfaceNil() bool {
+	return ens == nil
+}
@@ -1,14 +1,23 @@
 package mock
 
-import "math/big"
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+)
 
 // FeeAccumulatorStub is a stub which implements TransactionFeeHandler interface
 type FeeAccumulatorStub struct {
-	CreateBlockStartedCalled    func()
-	GetAccumulatedFeesCalled    func() *big.Int
-	GetDeveloperFeesCalled      func() *big.Int
-	ProcessTransactionFeeCalled func(cost *big.Int, devFee *big.Int, hash []byte)
-	RevertFeesCalled            func(txHashes [][]byte)
+	CreateBlockStartedCalled          func()
+	GetAccumulatedFeesCalled          func() *big.Int
+	GetDeveloperFeesCalled            func() *big.Int
+	ProcessTransactionFeeCalled       func(cost *big.Int, devFee *big.Int, hash []byte)
+	ProcessTransactionGasRefundCalled func(txHash []byte, gasRefunded uint64)
+	ProcessTransactionReceiptCalled   func(txHash []byte, tx data.TransactionHandler, status core.ReceiptStatus)
+	GetReceiptsCalled                 func() map[string]*receipt.Receipt
+	RevertFeesCalled                  func(txHashes [][]byte)
 }
 
 // RevertFees -
@@ -48,6 +57,28 @@ func (f *FeeAccumulatorStub) ProcessTransactionFee(cost *big.Int, devFee *big.In
 	}
 }
 
+// ProcessTransactionGasRefund -
+func (f *FeeAccumulatorStub) ProcessTransactionGasRefund(txHash []byte, gasRefunded uint64) {
+	if f.ProcessTransactionGasRefundCalled != nil {
+		f.ProcessTransactionGasRefundCalled(txHash, gasRefunded)
+	}
+}
+
+// ProcessTransactionReceipt -
+func (f *FeeAccumulatorStub) ProcessTransactionReceipt(txHash []byte, tx data.TransactionHandler, status core.ReceiptStatus) {
+	if f.ProcessTransactionReceiptCalled != nil {
+		f.ProcessTransactionReceiptCalled(txHash, tx, status)
+	}
+}
+
+// GetReceipts -
+func (f *FeeAccumulatorStub) GetReceipts() map[string]*receipt.Receipt {
+	if f.GetReceiptsCalled != nil {
+		return f.GetReceiptsCalled()
+	}
+	return make(map[string]*receipt.Receipt)
+}
+
 // IsInterfaceNil -
 func (f *FeeAccumulatorStub) IsInterfaceNil() bool {
 	return f == nil
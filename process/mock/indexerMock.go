@@ -4,12 +4,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
 // IndexerMock is a mock implementation fot the Indexer interface
 type IndexerMock struct {
-	SaveBlockCalled func(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler)
+	SaveBlockCalled             func(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler)
+	SaveAccountsChangeSetCalled func(header data.HeaderHandler, headerHash []byte, changeSet *state.AccountsChangeSet)
 }
 
 // SaveBlock -
@@ -41,6 +43,13 @@ func (im *IndexerMock) UpdateTPS(_ statistics.TPSBenchmark) {
 func (im *IndexerMock) SaveRoundsInfos(_ []indexer.RoundInfo) {
 }
 
+// SaveAccountsChangeSet -
+func (im *IndexerMock) SaveAccountsChangeSet(header data.HeaderHandler, headerHash []byte, changeSet *state.AccountsChangeSet) {
+	if im.SaveAccountsChangeSetCalled != nil {
+		im.SaveAccountsChangeSetCalled(header, headerHash, changeSet)
+	}
+}
+
 // SaveValidatorsPubKeys -
 func (im *IndexerMock) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) {
 	panic("implement me")
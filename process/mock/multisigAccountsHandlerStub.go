@@ -0,0 +1,20 @@
+package mock
+
+// MultisigAccountsHandlerStub -
+type MultisigAccountsHandlerStub struct {
+	GetMultisigSignersCalled func(address []byte) ([][]byte, uint32, error)
+}
+
+// GetMultisigSigners -
+func (mahs *MultisigAccountsHandlerStub) GetMultisigSigners(address []byte) ([][]byte, uint32, error) {
+	if mahs.GetMultisigSignersCalled != nil {
+		return mahs.GetMultisigSignersCalled(address)
+	}
+
+	return nil, 0, nil
+}
+
+// IsInterfaceNil -
+func (mahs *MultisigAccountsHandlerStub) IsInterfaceNil() bool {
+	return mahs == nil
+}
@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// QueryVMContainerCreatorStub -
+type QueryVMContainerCreatorStub struct {
+	CreateVMContainerCalled func(accounts state.AccountsAdapter) (process.VirtualMachinesContainer, error)
+}
+
+// CreateVMContainer -
+func (qvccs *QueryVMContainerCreatorStub) CreateVMContainer(accounts state.AccountsAdapter) (process.VirtualMachinesContainer, error) {
+	if qvccs.CreateVMContainerCalled != nil {
+		return qvccs.CreateVMContainerCalled(accounts)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (qvccs *QueryVMContainerCreatorStub) IsInterfaceNil() bool {
+	return qvccs == nil
+}
@@ -11,6 +11,7 @@ type TxValidatorHandlerStub struct {
 	NonceCalled           func() uint64
 	SenderAddressCalled   func() []byte
 	FeeCalled             func() *big.Int
+	NonceLaneCalled       func() uint32
 }
 
 // SenderShardId -
@@ -37,3 +38,12 @@ func (tvhs *TxValidatorHandlerStub) SenderAddress() []byte {
 func (tvhs *TxValidatorHandlerStub) Fee() *big.Int {
 	return tvhs.FeeCalled()
 }
+
+// NonceLane -
+func (tvhs *TxValidatorHandlerStub) NonceLane() uint32 {
+	if tvhs.NonceLaneCalled != nil {
+		return tvhs.NonceLaneCalled()
+	}
+
+	return 0
+}
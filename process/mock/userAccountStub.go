@@ -66,6 +66,11 @@ func (u *UserAccountStub) SetOwnerAddress([]byte) {
 
 }
 
+// GetStoredBytes -
+func (u *UserAccountStub) GetStoredBytes() uint64 {
+	return 0
+}
+
 // GetOwnerAddress -
 func (u *UserAccountStub) GetOwnerAddress() []byte {
 	return nil
@@ -11,6 +11,7 @@ type VMContainerMock struct {
 	RemoveCalled      func(key []byte)
 	LenCalled         func() int
 	KeysCalled        func() [][]byte
+	CloseCalled       func() error
 }
 
 // Get -
@@ -71,7 +72,10 @@ func (v *VMContainerMock) Keys() [][]byte {
 
 // Close -
 func (v *VMContainerMock) Close() error {
-	return nil
+	if v.CloseCalled == nil {
+		return nil
+	}
+	return v.CloseCalled()
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -0,0 +1,238 @@
+package peer
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.PeerAccountsExplorer = (*peerAccountsExplorer)(nil)
+
+const maxPeerAccountsEntries = 10000
+
+var errPeerAccountsLimitReached = errors.New("peer accounts limit reached")
+
+// ArgPeerAccountsExplorer holds the arguments needed to create a peer accounts explorer
+type ArgPeerAccountsExplorer struct {
+	PeerAdapter              state.AccountsAdapter
+	Marshalizer              marshal.Marshalizer
+	Store                    dataRetriever.StorageService
+	ValidatorPubKeyConverter core.PubkeyConverter
+	AddressPubKeyConverter   core.PubkeyConverter
+}
+
+// peerAccountsExplorer reads the full data of the peer accounts from the peer accounts trie having any validator
+// statistics root hash, so that the state of the validators can be compared between two meta blocks, as the ones
+// starting two epochs. The old root hashes can be read only while the peer accounts trie is not pruned
+type peerAccountsExplorer struct {
+	peerAdapter              state.AccountsAdapter
+	marshalizer              marshal.Marshalizer
+	store                    dataRetriever.StorageService
+	validatorPubKeyConverter core.PubkeyConverter
+	addressPubKeyConverter   core.PubkeyConverter
+}
+
+// NewPeerAccountsExplorer creates a new peer accounts explorer
+func NewPeerAccountsExplorer(args ArgPeerAccountsExplorer) (*peerAccountsExplorer, error) {
+	if check.IfNil(args.PeerAdapter) {
+		return nil, process.ErrNilPeerAccountsAdapter
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Store) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.ValidatorPubKeyConverter) {
+		return nil, fmt.Errorf("%w for validators", process.ErrNilPubkeyConverter)
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return nil, fmt.Errorf("%w for addresses", process.ErrNilPubkeyConverter)
+	}
+
+	return &peerAccountsExplorer{
+		peerAdapter:              args.PeerAdapter,
+		marshalizer:              args.Marshalizer,
+		store:                    args.Store,
+		validatorPubKeyConverter: args.ValidatorPubKeyConverter,
+		addressPubKeyConverter:   args.AddressPubKeyConverter,
+	}, nil
+}
+
+// GetPeerAccounts returns the peer accounts from the peer accounts trie having the given root hash, at most
+// maxPeerAccountsEntries of them. The iteration stops when the provided context is done
+func (pae *peerAccountsExplorer) GetPeerAccounts(ctx context.Context, rootHash []byte) (*state.PeerAccountsApiResponse, error) {
+	response := &state.PeerAccountsApiResponse{
+		RootHash:     hex.EncodeToString(rootHash),
+		PeerAccounts: make(map[string]*state.PeerAccountApiResponse),
+	}
+
+	err := pae.peerAdapter.IterateAllLeaves(ctx, rootHash, func(key []byte, value []byte) error {
+		if len(response.PeerAccounts) == maxPeerAccountsEntries {
+			response.Truncated = true
+			return errPeerAccountsLimitReached
+		}
+
+		peerAccount, err := pae.createPeerAccountApiResponse(key, value)
+		if err != nil {
+			return err
+		}
+
+		response.PeerAccounts[peerAccount.BLSPublicKey] = peerAccount
+		return nil
+	})
+	if err != nil && err != errPeerAccountsLimitReached {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ComparePeerAccounts returns the peer accounts that differ between the peer accounts tries having the given root
+// hashes, at most maxPeerAccountsEntries of them. Only the subtrees which differ are loaded, so the unchanged peer
+// accounts are not read at all. The comparison stops when the provided context is done
+func (pae *peerAccountsExplorer) ComparePeerAccounts(
+	ctx context.Context,
+	fromRootHash []byte,
+	toRootHash []byte,
+) (*state.PeerAccountsComparisonApiResponse, error) {
+	fromTrie, err := pae.peerAdapter.GetTrie(fromRootHash)
+	if err != nil {
+		return nil, err
+	}
+	toTrie, err := pae.peerAdapter.GetTrie(toRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &state.PeerAccountsComparisonApiResponse{
+		FromRootHash: hex.EncodeToString(fromRootHash),
+		ToRootHash:   hex.EncodeToString(toRootHash),
+		Changes:      make(map[string]*state.PeerAccountChangeApiResponse),
+	}
+	err = trie.GetTrieDiff(fromTrie, toTrie, func(diff trie.LeafDiff) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if len(response.Changes) == maxPeerAccountsEntries {
+			response.Truncated = true
+			return errPeerAccountsLimitReached
+		}
+
+		change, errCreate := pae.createPeerAccountChangeApiResponse(diff)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		response.Changes[pae.validatorPubKeyConverter.Encode(diff.Key)] = change
+		return nil
+	})
+	if err != nil && err != errPeerAccountsLimitReached {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetEpochStartValidatorStatsRootHash returns the validator statistics root hash of the meta block which started
+// the given epoch
+func (pae *peerAccountsExplorer) GetEpochStartValidatorStatsRootHash(epoch uint32) ([]byte, error) {
+	epochStartIdentifier := core.EpochStartIdentifier(epoch)
+	metaBlock, err := process.GetMetaHeaderFromStorage([]byte(epochStartIdentifier), pae.marshalizer, pae.store)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the start of epoch %d meta block: %w", epoch, err)
+	}
+
+	return metaBlock.GetValidatorStatsRootHash(), nil
+}
+
+func (pae *peerAccountsExplorer) createPeerAccountChangeApiResponse(
+	diff trie.LeafDiff,
+) (*state.PeerAccountChangeApiResponse, error) {
+	change := &state.PeerAccountChangeApiResponse{}
+
+	var err error
+	fromRating, fromTempRating := int64(0), int64(0)
+	if len(diff.OldValue) > 0 {
+		change.From, err = pae.createPeerAccountApiResponse(diff.Key, diff.OldValue)
+		if err != nil {
+			return nil, err
+		}
+		fromRating, fromTempRating = int64(change.From.Rating), int64(change.From.TempRating)
+	}
+
+	toRating, toTempRating := int64(0), int64(0)
+	if len(diff.NewValue) > 0 {
+		change.To, err = pae.createPeerAccountApiResponse(diff.Key, diff.NewValue)
+		if err != nil {
+			return nil, err
+		}
+		toRating, toTempRating = int64(change.To.Rating), int64(change.To.TempRating)
+	}
+
+	change.RatingDelta = toRating - fromRating
+	change.TempRatingDelta = toTempRating - fromTempRating
+
+	return change, nil
+}
+
+func (pae *peerAccountsExplorer) createPeerAccountApiResponse(
+	blsKey []byte,
+	buff []byte,
+) (*state.PeerAccountApiResponse, error) {
+	peerAccount := &state.PeerAccountData{}
+	err := pae.marshalizer.Unmarshal(peerAccount, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardAddress := ""
+	if len(peerAccount.RewardAddress) > 0 {
+		rewardAddress = pae.addressPubKeyConverter.Encode(peerAccount.RewardAddress)
+	}
+	accumulatedFees := big.NewInt(0)
+	if peerAccount.AccumulatedFees != nil {
+		accumulatedFees = peerAccount.AccumulatedFees
+	}
+
+	return &state.PeerAccountApiResponse{
+		BLSPublicKey:               pae.validatorPubKeyConverter.Encode(blsKey),
+		RewardAddress:              rewardAddress,
+		ShardId:                    peerAccount.ShardId,
+		List:                       peerAccount.List,
+		IndexInList:                peerAccount.IndexInList,
+		Rating:                     peerAccount.Rating,
+		TempRating:                 peerAccount.TempRating,
+		ValidatorSuccessRate:       createSignRateApiResponse(peerAccount.ValidatorSuccessRate),
+		LeaderSuccessRate:          createSignRateApiResponse(peerAccount.LeaderSuccessRate),
+		TotalValidatorSuccessRate:  createSignRateApiResponse(peerAccount.TotalValidatorSuccessRate),
+		TotalLeaderSuccessRate:     createSignRateApiResponse(peerAccount.TotalLeaderSuccessRate),
+		AccumulatedFees:            accumulatedFees.String(),
+		NumSelectedInSuccessBlocks: peerAccount.NumSelectedInSuccessBlocks,
+		ConsecutiveProposerMisses:  peerAccount.ConsecutiveProposerMisses,
+		Nonce:                      peerAccount.Nonce,
+		UnStakedEpoch:              peerAccount.UnStakedEpoch,
+	}, nil
+}
+
+func createSignRateApiResponse(signRate state.SignRate) state.SignRateApiResponse {
+	return state.SignRateApiResponse{
+		NumSuccess: signRate.NumSuccess,
+		NumFailure: signRate.NumFailure,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pae *peerAccountsExplorer) IsInterfaceNil() bool {
+	return pae == nil
+}
@@ -0,0 +1,285 @@
+package peer
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPeerAccountsDBWithMemoryTrie() *state.PeerAccountsDB {
+	marshalizer := &mock.MarshalizerMock{}
+	hasher := &mock.HasherMock{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hasher, 5)
+	peerAdapter, _ := state.NewPeerAccountsDB(tr, hasher, marshalizer, factory.NewPeerAccountCreator())
+
+	return peerAdapter
+}
+
+func createDefaultPeerAccountsExplorerArgs() ArgPeerAccountsExplorer {
+	return ArgPeerAccountsExplorer{
+		PeerAdapter:              createPeerAccountsDBWithMemoryTrie(),
+		Marshalizer:              &mock.MarshalizerMock{},
+		Store:                    &mock.ChainStorerMock{},
+		ValidatorPubKeyConverter: mock.NewPubkeyConverterMock(96),
+		AddressPubKeyConverter:   mock.NewPubkeyConverterMock(32),
+	}
+}
+
+func savePeerAccount(t *testing.T, peerAdapter state.AccountsAdapter, blsKey []byte, rating uint32, list string) {
+	acc, err := peerAdapter.LoadAccount(blsKey)
+	require.Nil(t, err)
+
+	peerAcc := acc.(state.PeerAccountHandler)
+	_ = peerAcc.SetBLSPublicKey(blsKey)
+	_ = peerAcc.SetRewardAddress([]byte("reward address"))
+	peerAcc.SetRating(rating)
+	peerAcc.SetTempRating(rating + 1)
+	peerAcc.SetListAndIndex(1, list, 7)
+	peerAcc.IncreaseValidatorSuccessRate(3)
+	peerAcc.DecreaseLeaderSuccessRate(2)
+	peerAcc.AddToAccumulatedFees(big.NewInt(100))
+
+	require.Nil(t, peerAdapter.SaveAccount(peerAcc))
+}
+
+func TestNewPeerAccountsExplorer_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultPeerAccountsExplorerArgs()
+	args.PeerAdapter = nil
+	pae, err := NewPeerAccountsExplorer(args)
+	assert.True(t, check.IfNil(pae))
+	assert.Equal(t, process.ErrNilPeerAccountsAdapter, err)
+
+	args = createDefaultPeerAccountsExplorerArgs()
+	args.Marshalizer = nil
+	pae, err = NewPeerAccountsExplorer(args)
+	assert.True(t, check.IfNil(pae))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	args = createDefaultPeerAccountsExplorerArgs()
+	args.Store = nil
+	pae, err = NewPeerAccountsExplorer(args)
+	assert.True(t, check.IfNil(pae))
+	assert.Equal(t, process.ErrNilStorage, err)
+
+	args = createDefaultPeerAccountsExplorerArgs()
+	args.ValidatorPubKeyConverter = nil
+	pae, err = NewPeerAccountsExplorer(args)
+	assert.True(t, check.IfNil(pae))
+	assert.True(t, errors.Is(err, process.ErrNilPubkeyConverter))
+
+	args = createDefaultPeerAccountsExplorerArgs()
+	args.AddressPubKeyConverter = nil
+	pae, err = NewPeerAccountsExplorer(args)
+	assert.True(t, check.IfNil(pae))
+	assert.True(t, errors.Is(err, process.ErrNilPubkeyConverter))
+}
+
+func TestNewPeerAccountsExplorer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pae, err := NewPeerAccountsExplorer(createDefaultPeerAccountsExplorerArgs())
+	assert.False(t, check.IfNil(pae))
+	assert.Nil(t, err)
+}
+
+func TestPeerAccountsExplorer_GetPeerAccountsShouldReturnTheFullData(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultPeerAccountsExplorerArgs()
+	blsKey1, blsKey2 := []byte("bls key 1"), []byte("bls key 2")
+	savePeerAccount(t, args.PeerAdapter, blsKey1, 50, string(core.EligibleList))
+	savePeerAccount(t, args.PeerAdapter, blsKey2, 60, string(core.WaitingList))
+	rootHash, err := args.PeerAdapter.Commit()
+	require.Nil(t, err)
+
+	pae, _ := NewPeerAccountsExplorer(args)
+	response, err := pae.GetPeerAccounts(context.Background(), rootHash)
+	require.Nil(t, err)
+
+	assert.Equal(t, hex.EncodeToString(rootHash), response.RootHash)
+	require.Equal(t, 2, len(response.PeerAccounts))
+	peerAccount := response.PeerAccounts[hex.EncodeToString(blsKey1)]
+	require.NotNil(t, peerAccount)
+	expectedPeerAccount := &state.PeerAccountApiResponse{
+		BLSPublicKey:              hex.EncodeToString(blsKey1),
+		RewardAddress:             hex.EncodeToString([]byte("reward address")),
+		ShardId:                   1,
+		List:                      string(core.EligibleList),
+		IndexInList:               7,
+		Rating:                    50,
+		TempRating:                51,
+		ValidatorSuccessRate:      state.SignRateApiResponse{NumSuccess: 3},
+		LeaderSuccessRate:         state.SignRateApiResponse{NumFailure: 2},
+		TotalValidatorSuccessRate: state.SignRateApiResponse{},
+		TotalLeaderSuccessRate:    state.SignRateApiResponse{},
+		AccumulatedFees:           "100",
+		UnStakedEpoch:             core.DefaultUnstakedEpoch,
+	}
+	assert.Equal(t, expectedPeerAccount, peerAccount)
+	assert.Equal(t, uint32(60), response.PeerAccounts[hex.EncodeToString(blsKey2)].Rating)
+}
+
+func TestPeerAccountsExplorer_GetPeerAccountsMissingRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pae, _ := NewPeerAccountsExplorer(createDefaultPeerAccountsExplorerArgs())
+	response, err := pae.GetPeerAccounts(context.Background(), []byte("missing root hash"))
+	assert.Nil(t, response)
+	assert.NotNil(t, err)
+}
+
+func TestPeerAccountsExplorer_ComparePeerAccountsShouldReturnOnlyTheChangedAccounts(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultPeerAccountsExplorerArgs()
+	unchangedKey, changedKey, removedKey, addedKey := []byte("unchanged"), []byte("changed"), []byte("removed"), []byte("added")
+	savePeerAccount(t, args.PeerAdapter, unchangedKey, 50, string(core.EligibleList))
+	savePeerAccount(t, args.PeerAdapter, changedKey, 50, string(core.EligibleList))
+	savePeerAccount(t, args.PeerAdapter, removedKey, 50, string(core.EligibleList))
+	fromRootHash, err := args.PeerAdapter.Commit()
+	require.Nil(t, err)
+
+	acc, _ := args.PeerAdapter.LoadAccount(changedKey)
+	peerAcc := acc.(state.PeerAccountHandler)
+	peerAcc.SetRating(42)
+	peerAcc.SetTempRating(40)
+	peerAcc.SetListAndIndex(1, string(core.JailedList), 0)
+	_ = args.PeerAdapter.SaveAccount(peerAcc)
+	_ = args.PeerAdapter.RemoveAccount(removedKey)
+	savePeerAccount(t, args.PeerAdapter, addedKey, 10, string(core.NewList))
+	toRootHash, err := args.PeerAdapter.Commit()
+	require.Nil(t, err)
+
+	pae, _ := NewPeerAccountsExplorer(args)
+	comparison, err := pae.ComparePeerAccounts(context.Background(), fromRootHash, toRootHash)
+	require.Nil(t, err)
+
+	assert.Equal(t, hex.EncodeToString(fromRootHash), comparison.FromRootHash)
+	assert.Equal(t, hex.EncodeToString(toRootHash), comparison.ToRootHash)
+	require.Equal(t, 3, len(comparison.Changes))
+	assert.Nil(t, comparison.Changes[hex.EncodeToString(unchangedKey)])
+
+	changed := comparison.Changes[hex.EncodeToString(changedKey)]
+	require.NotNil(t, changed)
+	assert.Equal(t, string(core.EligibleList), changed.From.List)
+	assert.Equal(t, string(core.JailedList), changed.To.List)
+	assert.Equal(t, int64(-8), changed.RatingDelta)
+	assert.Equal(t, int64(-11), changed.TempRatingDelta)
+
+	removed := comparison.Changes[hex.EncodeToString(removedKey)]
+	require.NotNil(t, removed)
+	assert.NotNil(t, removed.From)
+	assert.Nil(t, removed.To)
+	assert.Equal(t, int64(-50), removed.RatingDelta)
+
+	added := comparison.Changes[hex.EncodeToString(addedKey)]
+	require.NotNil(t, added)
+	assert.Nil(t, added.From)
+	assert.NotNil(t, added.To)
+	assert.Equal(t, int64(10), added.RatingDelta)
+	assert.Equal(t, int64(11), added.TempRatingDelta)
+}
+
+func TestPeerAccountsExplorer_ComparePeerAccountsMissingRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultPeerAccountsExplorerArgs()
+	savePeerAccount(t, args.PeerAdapter, []byte("bls key"), 50, string(core.EligibleList))
+	rootHash, _ := args.PeerAdapter.Commit()
+
+	pae, _ := NewPeerAccountsExplorer(args)
+	comparison, err := pae.ComparePeerAccounts(context.Background(), rootHash, []byte("missing root hash"))
+	assert.Nil(t, comparison)
+	assert.NotNil(t, err)
+
+	comparison, err = pae.ComparePeerAccounts(context.Background(), []byte("missing root hash"), rootHash)
+	assert.Nil(t, comparison)
+	assert.NotNil(t, err)
+}
+
+func TestPeerAccountsExplorer_GetEpochStartValidatorStatsRootHash(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultPeerAccountsExplorerArgs()
+	validatorStatsRootHash := []byte("validator stats root hash")
+	epochStartBuff, _ := args.Marshalizer.Marshal(&block.MetaBlock{Epoch: 3, ValidatorStatsRootHash: validatorStatsRootHash})
+	args.Store = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			assert.Equal(t, dataRetriever.MetaBlockUnit, unitType)
+			return &mock.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					if string(key) == core.EpochStartIdentifier(3) {
+						return epochStartBuff, nil
+					}
+					return nil, errors.New("not found")
+				},
+			}
+		},
+	}
+	pae, _ := NewPeerAccountsExplorer(args)
+
+	rootHash, err := pae.GetEpochStartValidatorStatsRootHash(3)
+	assert.Nil(t, err)
+	assert.Equal(t, validatorStatsRootHash, rootHash)
+
+	rootHash, err = pae.GetEpochStartValidatorStatsRootHash(4)
+	assert.Nil(t, rootHash)
+	assert.True(t, errors.Is(err, process.ErrMissingHeader))
+}
+
+func TestPeerAccountsExplorer_ComparePeerAccountsCancelledContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultPeerAccountsExplorerArgs()
+	savePeerAccount(t, args.PeerAdapter, []byte("bls key 1"), 50, string(core.EligibleList))
+	fromRootHash, err := args.PeerAdapter.Commit()
+	require.Nil(t, err)
+	savePeerAccount(t, args.PeerAdapter, []byte("bls key 1"), 60, string(core.EligibleList))
+	toRootHash, err := args.PeerAdapter.Commit()
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	pae, _ := NewPeerAccountsExplorer(args)
+	comparison, err := pae.ComparePeerAccounts(ctx, fromRootHash, toRootHash)
+	assert.Nil(t, comparison)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestPeerAccountsExplorer_GetPeerAccountsShouldTruncateTheResponse(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultPeerAccountsExplorerArgs()
+	for i := 0; i <= maxPeerAccountsEntries; i++ {
+		savePeerAccount(t, args.PeerAdapter, []byte(fmt.Sprintf("bls key %d", i)), 50, string(core.EligibleList))
+	}
+	rootHash, err := args.PeerAdapter.Commit()
+	require.Nil(t, err)
+
+	pae, _ := NewPeerAccountsExplorer(args)
+	response, err := pae.GetPeerAccounts(context.Background(), rootHash)
+	require.Nil(t, err)
+	assert.True(t, response.Truncated)
+	assert.Equal(t, maxPeerAccountsEntries, len(response.PeerAccounts))
+}
@@ -1,12 +1,11 @@
 package peer
 
 import (
-	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math"
 	"math/big"
-	"sort"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
@@ -391,36 +390,6 @@ func (vs *validatorStatistics) RootHash() ([]byte, error) {
 	return vs.peerAdapter.RootHash()
 }
 
-func (vs *validatorStatistics) getValidatorDataFromLeaves(
-	leaves map[string][]byte,
-) (map[uint32][]*state.ValidatorInfo, error) {
-
-	validators := make(map[uint32][]*state.ValidatorInfo, vs.shardCoordinator.NumberOfShards()+1)
-	for i := uint32(0); i < vs.shardCoordinator.NumberOfShards(); i++ {
-		validators[i] = make([]*state.ValidatorInfo, 0)
-	}
-	validators[core.MetachainShardId] = make([]*state.ValidatorInfo, 0)
-
-	sliceLeaves := vs.convertMapToSortedSlice(leaves)
-
-	sort.Slice(sliceLeaves, func(i, j int) bool {
-		return bytes.Compare(sliceLeaves[i], sliceLeaves[j]) < 0
-	})
-
-	for _, pa := range sliceLeaves {
-		peerAccount, err := vs.unmarshalPeer(pa)
-		if err != nil {
-			return nil, err
-		}
-
-		currentShardId := peerAccount.GetShardId()
-		validatorInfoData := vs.peerAccountToValidatorInfo(peerAccount)
-		validators[currentShardId] = append(validators[currentShardId], validatorInfoData)
-	}
-
-	return validators, nil
-}
-
 func getActualList(peerAccount state.PeerAccountHandler) string {
 	savedList := peerAccount.GetList()
 	if peerAccount.GetUnStakedEpoch() == core.DefaultUnstakedEpoch {
@@ -472,18 +441,8 @@ func (vs *validatorStatistics) unmarshalPeer(pa []byte) (state.PeerAccountHandle
 	return peerAccount, nil
 }
 
-func (vs *validatorStatistics) convertMapToSortedSlice(leaves map[string][]byte) [][]byte {
-	newLeaves := make([][]byte, len(leaves))
-	i := 0
-	for _, pa := range leaves {
-		newLeaves[i] = pa
-		i++
-	}
-
-	return newLeaves
-}
-
-// GetValidatorInfoForRootHash returns all the peer accounts from the trie with the given rootHash
+// GetValidatorInfoForRootHash returns all the peer accounts from the trie with the given rootHash, in the order of
+// the trie keys
 func (vs *validatorStatistics) GetValidatorInfoForRootHash(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error) {
 	sw := core.NewStopWatch()
 	sw.Start("GetValidatorInfoForRootHash")
@@ -492,17 +451,28 @@ func (vs *validatorStatistics) GetValidatorInfoForRootHash(rootHash []byte) (map
 		log.Debug("GetValidatorInfoForRootHash", sw.GetMeasurements()...)
 	}()
 
-	allLeaves, err := vs.peerAdapter.GetAllLeaves(rootHash)
-	if err != nil {
-		return nil, err
+	vInfos := make(map[uint32][]*state.ValidatorInfo, vs.shardCoordinator.NumberOfShards()+1)
+	for i := uint32(0); i < vs.shardCoordinator.NumberOfShards(); i++ {
+		vInfos[i] = make([]*state.ValidatorInfo, 0)
 	}
+	vInfos[core.MetachainShardId] = make([]*state.ValidatorInfo, 0)
+
+	err := vs.peerAdapter.IterateAllLeaves(context.Background(), rootHash, func(_ []byte, value []byte) error {
+		peerAccount, errUnmarshal := vs.unmarshalPeer(value)
+		if errUnmarshal != nil {
+			return errUnmarshal
+		}
 
-	vInfos, err := vs.getValidatorDataFromLeaves(allLeaves)
+		currentShardId := peerAccount.GetShardId()
+		vInfos[currentShardId] = append(vInfos[currentShardId], vs.peerAccountToValidatorInfo(peerAccount))
+
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return vInfos, err
+	return vInfos, nil
 }
 
 // ProcessRatingsEndOfEpoch makes end of epoch process on the rating
@@ -2,6 +2,7 @@ package peer_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -1786,8 +1787,8 @@ func TestValidatorStatistics_RootHashWithErrShouldReturnNil(t *testing.T) {
 	arguments := createMockArguments()
 
 	peerAdapter := getAccountsMock()
-	peerAdapter.GetAllLeavesCalled = func(rootHash []byte) (m map[string][]byte, err error) {
-		return nil, expectedErr
+	peerAdapter.IterateAllLeavesCalled = func(_ context.Context, rootHash []byte, handler func(key []byte, value []byte) error) error {
+		return expectedErr
 	}
 	arguments.PeerAdapter = peerAdapter
 
@@ -1814,11 +1815,11 @@ func TestValidatorStatistics_ResetValidatorStatisticsAtNewEpoch(t *testing.T) {
 	validatorInfoMap[string(addrBytes0)] = marshalizedPa0
 
 	peerAdapter := getAccountsMock()
-	peerAdapter.GetAllLeavesCalled = func(rootHash []byte) (m map[string][]byte, err error) {
+	peerAdapter.IterateAllLeavesCalled = func(_ context.Context, rootHash []byte, handler func(key []byte, value []byte) error) error {
 		if bytes.Equal(rootHash, hash) {
-			return validatorInfoMap, nil
+			return iterateLeaves(validatorInfoMap, handler)
 		}
-		return nil, expectedErr
+		return expectedErr
 	}
 	peerAdapter.LoadAccountCalled = func(address []byte) (handler state.AccountHandler, err error) {
 		if bytes.Equal(pa0.GetBLSPublicKey(), address) {
@@ -1870,11 +1871,11 @@ func TestValidatorStatistics_Process(t *testing.T) {
 	validatorInfoMap[string(addrBytesMeta)] = marshalizedPaMeta
 
 	peerAdapter := getAccountsMock()
-	peerAdapter.GetAllLeavesCalled = func(rootHash []byte) (m map[string][]byte, err error) {
+	peerAdapter.IterateAllLeavesCalled = func(_ context.Context, rootHash []byte, handler func(key []byte, value []byte) error) error {
 		if bytes.Equal(rootHash, hash) {
-			return validatorInfoMap, nil
+			return iterateLeaves(validatorInfoMap, handler)
 		}
-		return nil, expectedErr
+		return expectedErr
 	}
 	peerAdapter.LoadAccountCalled = func(address []byte) (handler state.AccountHandler, err error) {
 		if bytes.Equal(pa0.GetBLSPublicKey(), address) {
@@ -1916,11 +1917,11 @@ func TestValidatorStatistics_GetValidatorInfoForRootHash(t *testing.T) {
 	validatorInfoMap[string(addrBytesMeta)] = marshalizedPaMeta
 
 	peerAdapter := getAccountsMock()
-	peerAdapter.GetAllLeavesCalled = func(rootHash []byte) (m map[string][]byte, err error) {
+	peerAdapter.IterateAllLeavesCalled = func(_ context.Context, rootHash []byte, handler func(key []byte, value []byte) error) error {
 		if bytes.Equal(rootHash, hash) {
-			return validatorInfoMap, nil
+			return iterateLeaves(validatorInfoMap, handler)
 		}
-		return nil, expectedErr
+		return expectedErr
 	}
 	arguments.PeerAdapter = peerAdapter
 
@@ -2366,8 +2367,8 @@ func updateArgumentsWithNeeded(arguments peer.ArgValidatorStatisticsProcessor) {
 	validatorInfoMap[string(addrBytes0)] = marshalizedPa0
 	validatorInfoMap[string(addrBytesMeta)] = marshalizedPaMeta
 	peerAdapter := getAccountsMock()
-	peerAdapter.GetAllLeavesCalled = func(rootHash []byte) (m map[string][]byte, err error) {
-		return validatorInfoMap, nil
+	peerAdapter.IterateAllLeavesCalled = func(_ context.Context, rootHash []byte, handler func(key []byte, value []byte) error) error {
+		return iterateLeaves(validatorInfoMap, handler)
 	}
 	peerAdapter.LoadAccountCalled = func(address []byte) (handler state.AccountHandler, err error) {
 		return pa0, nil
@@ -2375,6 +2376,17 @@ func updateArgumentsWithNeeded(arguments peer.ArgValidatorStatisticsProcessor) {
 	arguments.PeerAdapter = peerAdapter
 }
 
+func iterateLeaves(leaves map[string][]byte, handler func(key []byte, value []byte) error) error {
+	for key, value := range leaves {
+		err := handler([]byte(key), value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func createUpdateTestArgs(consensusGroup map[string][]sharding.Validator) peer.ArgValidatorStatisticsProcessor {
 	peerAccountsMap := make(map[string]state.PeerAccountHandler)
 	arguments := createMockArguments()
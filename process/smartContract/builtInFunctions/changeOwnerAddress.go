@@ -58,6 +58,11 @@ func (c *changeOwnerAddress) ProcessBuiltinFunction(
 	return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
 }
 
+// IsActive returns true as the function is active since genesis
+func (c *changeOwnerAddress) IsActive() bool {
+	return true
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (c *changeOwnerAddress) IsInterfaceNil() bool {
 	return c == nil
@@ -74,6 +74,11 @@ func (c *claimDeveloperRewards) ProcessBuiltinFunction(
 	return vmOutput, nil
 }
 
+// IsActive returns true as the function is active since genesis
+func (c *claimDeveloperRewards) IsActive() bool {
+	return true
+}
+
 // IsInterfaceNil returns true if underlying object is nil
 func (c *claimDeveloperRewards) IsInterfaceNil() bool {
 	return c == nil
@@ -140,6 +140,11 @@ func (e *esdtTransfer) getESDTDataFromKey(userAcnt state.UserAccountHandler, key
 	return esdtData, nil
 }
 
+// IsActive returns true as the function is active since genesis
+func (e *esdtTransfer) IsActive() bool {
+	return true
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *esdtTransfer) IsInterfaceNil() bool {
 	return e == nil
@@ -13,10 +13,12 @@ var log = logger.GetOrCreate("process/smartContract/builtInFunctions")
 
 // ArgsCreateBuiltInFunctionContainer -
 type ArgsCreateBuiltInFunctionContainer struct {
-	GasMap               map[string]map[string]uint64
-	MapDNSAddresses      map[string]struct{}
-	EnableUserNameChange bool
-	Marshalizer          marshal.Marshalizer
+	GasMap                      map[string]map[string]uint64
+	MapDNSAddresses             map[string]struct{}
+	EnableUserNameChange        bool
+	Marshalizer                 marshal.Marshalizer
+	EpochNotifier               process.EpochNotifier
+	MultisigAccountsEnableEpoch uint32
 }
 
 // CreateBuiltInFunctionContainer will create the list of built-in functions
@@ -67,6 +69,36 @@ func CreateBuiltInFunctionContainer(args ArgsCreateBuiltInFunctionContainer) (pr
 		return nil, err
 	}
 
+	newFunc, err = NewSetMultisigAccountFunc(
+		gasConfig.BaseOperationCost,
+		gasConfig.BuiltInCost.SetMultisigAccount,
+		args.Marshalizer,
+		args.MultisigAccountsEnableEpoch,
+		args.EpochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionSetMultisigAccount, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewChangeMultisigSignersFunc(
+		gasConfig.BaseOperationCost,
+		gasConfig.BuiltInCost.ChangeMultisigSigners,
+		args.Marshalizer,
+		args.MultisigAccountsEnableEpoch,
+		args.EpochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionChangeMultisigSigners, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
 	return container, nil
 }
 
@@ -14,10 +14,12 @@ func createMockArguments() ArgsCreateBuiltInFunctionContainer {
 	fillGasMapInternal(gasMap, 1)
 
 	args := ArgsCreateBuiltInFunctionContainer{
-		GasMap:               gasMap,
-		MapDNSAddresses:      make(map[string]struct{}),
-		EnableUserNameChange: false,
-		Marshalizer:          &mock.MarshalizerMock{},
+		GasMap:                      gasMap,
+		MapDNSAddresses:             make(map[string]struct{}),
+		EnableUserNameChange:        false,
+		Marshalizer:                 &mock.MarshalizerMock{},
+		EpochNotifier:               &mock.EpochNotifierStub{},
+		MultisigAccountsEnableEpoch: 0,
 	}
 
 	return args
@@ -48,6 +50,8 @@ func fillGasMapBuiltInCosts(value uint64) map[string]uint64 {
 	gasMap["SaveUserName"] = value
 	gasMap["SaveKeyValue"] = value
 	gasMap["ESDTTransfer"] = value
+	gasMap["SetMultisigAccount"] = value
+	gasMap["ChangeMultisigSigners"] = value
 
 	return gasMap
 }
@@ -70,5 +74,5 @@ func TestCreateBuiltInFunctionContainer_Errors(t *testing.T) {
 	args = createMockArguments()
 	container, err = CreateBuiltInFunctionContainer(args)
 	assert.Nil(t, err)
-	assert.Equal(t, container.Len(), 5)
+	assert.Equal(t, container.Len(), 7)
 }
@@ -91,6 +91,11 @@ func (k *saveKeyValueStorage) ProcessBuiltinFunction(
 	return vmOutput, nil
 }
 
+// IsActive returns true as the function is active since genesis
+func (k *saveKeyValueStorage) IsActive() bool {
+	return true
+}
+
 // IsInterfaceNil return true if underlying object in nil
 func (k *saveKeyValueStorage) IsInterfaceNil() bool {
 	return k == nil
@@ -0,0 +1,228 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. multisig.proto
+package builtInFunctions
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const multisigKeyIdentifier = "multisig"
+
+var _ process.BuiltinFunction = (*multisigAccount)(nil)
+
+type multisigAccount struct {
+	gasConfig        BaseOperationCost
+	funcGasCost      uint64
+	marshalizer      marshal.Marshalizer
+	keyPrefix        []byte
+	isSignersChanger bool
+	enableEpoch      uint32
+	flagEnabled      atomic.Flag
+}
+
+// NewSetMultisigAccountFunc returns the built-in function which turns the caller account into a multisig account
+func NewSetMultisigAccountFunc(
+	gasConfig BaseOperationCost,
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*multisigAccount, error) {
+	return newMultisigAccountFunc(gasConfig, funcGasCost, marshalizer, enableEpoch, epochNotifier, false)
+}
+
+// NewChangeMultisigSignersFunc returns the built-in function which replaces the signers and the threshold of the
+// caller multisig account
+func NewChangeMultisigSignersFunc(
+	gasConfig BaseOperationCost,
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*multisigAccount, error) {
+	return newMultisigAccountFunc(gasConfig, funcGasCost, marshalizer, enableEpoch, epochNotifier, true)
+}
+
+func newMultisigAccountFunc(
+	gasConfig BaseOperationCost,
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+	isSignersChanger bool,
+) (*multisigAccount, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	m := &multisigAccount{
+		gasConfig:        gasConfig,
+		funcGasCost:      funcGasCost,
+		marshalizer:      marshalizer,
+		keyPrefix:        multisigKey(),
+		isSignersChanger: isSignersChanger,
+		enableEpoch:      enableEpoch,
+	}
+	epochNotifier.RegisterNotifyHandler(m)
+
+	return m, nil
+}
+
+// ProcessBuiltinFunction saves the signers and the threshold, received as "<threshold>@<signer 1>@...@<signer N>",
+// in the data trie of the caller account
+func (m *multisigAccount) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	input *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if !m.flagEnabled.IsSet() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	if input == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if len(input.Arguments) < 2 {
+		return nil, process.ErrInvalidArguments
+	}
+	if input.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilSCDestAccount
+	}
+	if !bytes.Equal(input.CallerAddr, input.RecipientAddr) {
+		return nil, fmt.Errorf("%w not the owner of the account", process.ErrOperationNotPermitted)
+	}
+	if core.IsSmartContractAddress(input.CallerAddr) {
+		return nil, fmt.Errorf("%w multisig builtin functions not allowed for smart contracts", process.ErrOperationNotPermitted)
+	}
+
+	multisigData, err := createMultisigAccountData(input.Arguments[0], input.Arguments[1:], len(input.CallerAddr))
+	if err != nil {
+		return nil, err
+	}
+
+	oldMultisigData, err := getMultisigAccountData(acntDst, m.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	isMultisig := oldMultisigData != nil
+	if isMultisig && !m.isSignersChanger {
+		return nil, process.ErrAccountIsAlreadyMultisig
+	}
+	if !isMultisig && m.isSignersChanger {
+		return nil, process.ErrAccountIsNotMultisig
+	}
+
+	marshalizedData, err := m.marshalizer.Marshal(multisigData)
+	if err != nil {
+		return nil, err
+	}
+
+	length := uint64(len(marshalizedData))
+	useGas := m.funcGasCost + length*(m.gasConfig.DataCopyPerByte+m.gasConfig.PersistPerByte+m.gasConfig.StorePerByte)
+	if input.GasProvided < useGas {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	acntDst.DataTrieTracker().SaveKeyValue(m.keyPrefix, marshalizedData)
+
+	log.Trace("multisig account set", "address", input.CallerAddr, "num signers", len(multisigData.Signers),
+		"threshold", multisigData.Threshold)
+
+	return &vmcommon.VMOutput{GasRemaining: input.GasProvided - useGas}, nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (m *multisigAccount) EpochConfirmed(epoch uint32) {
+	m.flagEnabled.Toggle(epoch >= m.enableEpoch)
+	log.Debug("multisig account built-in function", "signers changer", m.isSignersChanger,
+		"enabled", m.flagEnabled.IsSet())
+}
+
+// IsActive returns true if the multisig accounts are enabled in the current epoch
+func (m *multisigAccount) IsActive() bool {
+	return m.flagEnabled.IsSet()
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (m *multisigAccount) IsInterfaceNil() bool {
+	return m == nil
+}
+
+func createMultisigAccountData(thresholdBytes []byte, signers [][]byte, addressLength int) (*MultisigAccountData, error) {
+	threshold := big.NewInt(0).SetBytes(thresholdBytes)
+	if !threshold.IsUint64() || threshold.Uint64() > math.MaxUint32 {
+		return nil, process.ErrInvalidMultisigThreshold
+	}
+	if threshold.Uint64() == 0 || threshold.Uint64() > uint64(len(signers)) {
+		return nil, process.ErrInvalidMultisigThreshold
+	}
+
+	uniqueSigners := make(map[string]struct{}, len(signers))
+	for _, signer := range signers {
+		if len(signer) != addressLength {
+			return nil, process.ErrInvalidMultisigSignerLength
+		}
+
+		_, exists := uniqueSigners[string(signer)]
+		if exists {
+			return nil, process.ErrDuplicatedMultisigSigner
+		}
+		uniqueSigners[string(signer)] = struct{}{}
+	}
+
+	return &MultisigAccountData{
+		Signers:   signers,
+		Threshold: uint32(threshold.Uint64()),
+	}, nil
+}
+
+// IsMultisigAccount returns true if the provided account holds multisig settings in its data trie
+func IsMultisigAccount(account state.UserAccountHandler, marshalizer marshal.Marshalizer) (bool, error) {
+	multisigData, err := getMultisigAccountData(account, marshalizer)
+	if err != nil {
+		return false, err
+	}
+
+	return multisigData != nil, nil
+}
+
+// getMultisigAccountData returns the multisig data saved in the data trie of the account or nil if the account is a
+// regular, single key, account
+func getMultisigAccountData(account state.UserAccountHandler, marshalizer marshal.Marshalizer) (*MultisigAccountData, error) {
+	marshalizedData, err := account.DataTrieTracker().RetrieveValue(multisigKey())
+	if err == state.ErrNilTrie {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(marshalizedData) == 0 {
+		return nil, nil
+	}
+
+	multisigData := &MultisigAccountData{}
+	err = marshalizer.Unmarshal(multisigData, marshalizedData)
+	if err != nil {
+		return nil, err
+	}
+
+	return multisigData, nil
+}
+
+func multisigKey() []byte {
+	return []byte(core.ElrondProtectedKeyPrefix + multisigKeyIdentifier)
+}
@@ -0,0 +1,166 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMultisigVmInput(addr []byte, threshold byte, signers ...[]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  addr,
+			GasProvided: 1000,
+			CallValue:   big.NewInt(0),
+			Arguments:   append([][]byte{{threshold}}, signers...),
+		},
+		RecipientAddr: addr,
+	}
+}
+
+func TestNewSetMultisigAccountFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewSetMultisigAccountFunc(BaseOperationCost{}, 10, nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, m)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	m, err = NewChangeMultisigSignersFunc(BaseOperationCost{}, 10, nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, m)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewSetMultisigAccountFunc_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewSetMultisigAccountFunc(BaseOperationCost{}, 10, &mock.MarshalizerMock{}, 0, nil)
+	assert.Nil(t, m)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestSetMultisigAccount_ProcessBuiltinFunctionBeforeActivationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	m, _ := NewSetMultisigAccountFunc(BaseOperationCost{}, 10, &mock.MarshalizerMock{}, 1, &mock.EpochNotifierStub{})
+	assert.False(t, m.IsActive())
+
+	addr := []byte("multisig")
+	acc, _ := state.NewUserAccount(addr)
+	input := createMultisigVmInput(addr, 1, []byte("signer01"))
+	_, err := m.ProcessBuiltinFunction(nil, acc, input)
+	assert.Equal(t, process.ErrBuiltInFunctionIsNotActive, err)
+
+	m.EpochConfirmed(1)
+	assert.True(t, m.IsActive())
+	_, err = m.ProcessBuiltinFunction(nil, acc, input)
+	assert.Nil(t, err)
+}
+
+func TestSetMultisigAccount_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	m, _ := NewSetMultisigAccountFunc(BaseOperationCost{}, 10, &mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{})
+	addr := []byte("multisig")
+	acc, _ := state.NewUserAccount(addr)
+
+	_, err := m.ProcessBuiltinFunction(nil, acc, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createMultisigVmInput(addr, 1)
+	_, err = m.ProcessBuiltinFunction(nil, acc, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createMultisigVmInput(addr, 1, []byte("signer01"))
+	input.CallValue = big.NewInt(1)
+	_, err = m.ProcessBuiltinFunction(nil, acc, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input = createMultisigVmInput(addr, 1, []byte("signer01"))
+	_, err = m.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilSCDestAccount, err)
+
+	input.CallerAddr = []byte("other001")
+	_, err = m.ProcessBuiltinFunction(nil, acc, input)
+	assert.True(t, errors.Is(err, process.ErrOperationNotPermitted))
+
+	input = createMultisigVmInput(addr, 0, []byte("signer01"))
+	_, err = m.ProcessBuiltinFunction(nil, acc, input)
+	assert.Equal(t, process.ErrInvalidMultisigThreshold, err)
+
+	input = createMultisigVmInput(addr, 2, []byte("signer01"))
+	_, err = m.ProcessBuiltinFunction(nil, acc, input)
+	assert.Equal(t, process.ErrInvalidMultisigThreshold, err)
+
+	input = createMultisigVmInput(addr, 1, []byte("signer01"), []byte("signer01"))
+	_, err = m.ProcessBuiltinFunction(nil, acc, input)
+	assert.Equal(t, process.ErrDuplicatedMultisigSigner, err)
+
+	input = createMultisigVmInput(addr, 1, []byte("signer"))
+	_, err = m.ProcessBuiltinFunction(nil, acc, input)
+	assert.Equal(t, process.ErrInvalidMultisigSignerLength, err)
+
+	input = createMultisigVmInput(addr, 1, []byte("signer01"))
+	input.GasProvided = 1
+	_, err = m.ProcessBuiltinFunction(nil, acc, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+}
+
+func TestSetMultisigAccount_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	gasConfig := BaseOperationCost{StorePerByte: 1}
+	m, _ := NewSetMultisigAccountFunc(gasConfig, 10, marshalizer, 0, &mock.EpochNotifierStub{})
+	addr := []byte("multisig")
+	acc, _ := state.NewUserAccount(addr)
+	signers := [][]byte{[]byte("signer01"), []byte("signer02")}
+
+	input := createMultisigVmInput(addr, 2, signers...)
+	vmOutput, err := m.ProcessBuiltinFunction(nil, acc, input)
+	require.Nil(t, err)
+
+	multisigData, err := getMultisigAccountData(acc, marshalizer)
+	require.Nil(t, err)
+	assert.Equal(t, signers, multisigData.Signers)
+	assert.Equal(t, uint32(2), multisigData.Threshold)
+
+	marshalizedData, _ := marshalizer.Marshal(multisigData)
+	expectedGasUsed := 10 + uint64(len(marshalizedData))
+	assert.Equal(t, input.GasProvided-expectedGasUsed, vmOutput.GasRemaining)
+
+	_, err = m.ProcessBuiltinFunction(nil, acc, input)
+	assert.Equal(t, process.ErrAccountIsAlreadyMultisig, err)
+}
+
+func TestChangeMultisigSigners_ProcessBuiltinFunction(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	setFunc, _ := NewSetMultisigAccountFunc(BaseOperationCost{}, 10, marshalizer, 0, &mock.EpochNotifierStub{})
+	changeFunc, _ := NewChangeMultisigSignersFunc(BaseOperationCost{}, 10, marshalizer, 0, &mock.EpochNotifierStub{})
+	addr := []byte("multisig")
+	acc, _ := state.NewUserAccount(addr)
+
+	input := createMultisigVmInput(addr, 1, []byte("signer01"))
+	_, err := changeFunc.ProcessBuiltinFunction(nil, acc, input)
+	require.Equal(t, process.ErrAccountIsNotMultisig, err)
+
+	_, err = setFunc.ProcessBuiltinFunction(nil, acc, input)
+	require.Nil(t, err)
+
+	newSigners := [][]byte{[]byte("signer02"), []byte("signer03"), []byte("signer04")}
+	input = createMultisigVmInput(addr, 2, newSigners...)
+	_, err = changeFunc.ProcessBuiltinFunction(nil, acc, input)
+	require.Nil(t, err)
+
+	multisigData, _ := getMultisigAccountData(acc, marshalizer)
+	assert.Equal(t, newSigners, multisigData.Signers)
+	assert.Equal(t, uint32(2), multisigData.Threshold)
+}
@@ -0,0 +1,121 @@
+package builtInFunctions
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.MultisigAccountsHandler = (*multisigAccountsReader)(nil)
+
+type multisigAccountsReader struct {
+	accounts    state.AccountsAdapter
+	blockChain  data.ChainHandler
+	marshalizer marshal.Marshalizer
+}
+
+// NewMultisigAccountsReader creates a component able to read the multisig settings of the accounts from the state
+// committed with the current block
+func NewMultisigAccountsReader(
+	accounts state.AccountsAdapter,
+	blockChain data.ChainHandler,
+	marshalizer marshal.Marshalizer,
+) (*multisigAccountsReader, error) {
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(blockChain) {
+		return nil, process.ErrNilBlockChain
+	}
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	return &multisigAccountsReader{
+		accounts:    accounts,
+		blockChain:  blockChain,
+		marshalizer: marshalizer,
+	}, nil
+}
+
+// GetMultisigSigners returns the signers and the threshold of the provided address. Regular accounts and accounts
+// not found in the trie return empty signers and threshold 0. The settings are read from the committed state, as the
+// accounts adapter is concurrently changed by the block being processed
+func (mar *multisigAccountsReader) GetMultisigSigners(address []byte) ([][]byte, uint32, error) {
+	rootHash := mar.getCommittedRootHash()
+	if len(rootHash) == 0 {
+		return nil, 0, nil
+	}
+
+	account, err := mar.getCommittedAccount(rootHash, address)
+	if err != nil {
+		return nil, 0, err
+	}
+	if check.IfNil(account) {
+		return nil, 0, nil
+	}
+
+	multisigData, err := getMultisigAccountData(account, mar.marshalizer)
+	if err != nil {
+		return nil, 0, err
+	}
+	if multisigData == nil {
+		return nil, 0, nil
+	}
+
+	return multisigData.Signers, multisigData.Threshold, nil
+}
+
+func (mar *multisigAccountsReader) getCommittedRootHash() []byte {
+	header := mar.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		header = mar.blockChain.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return nil
+	}
+
+	return header.GetRootHash()
+}
+
+func (mar *multisigAccountsReader) getCommittedAccount(rootHash []byte, address []byte) (state.UserAccountHandler, error) {
+	mainTrie, err := mar.accounts.GetTrie(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	accountBytes, err := mainTrie.Get(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(accountBytes) == 0 {
+		return nil, nil
+	}
+
+	account, err := state.NewUserAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	err = mar.marshalizer.Unmarshal(account, accountBytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(account.GetRootHash()) == 0 {
+		return account, nil
+	}
+
+	dataTrie, err := mar.accounts.GetTrie(account.GetRootHash())
+	if err != nil {
+		return nil, err
+	}
+	account.SetDataTrie(dataTrie)
+
+	return account, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mar *multisigAccountsReader) IsInterfaceNil() bool {
+	return mar == nil
+}
@@ -0,0 +1,134 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMultisigAccountsReader_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mar, err := NewMultisigAccountsReader(nil, &mock.BlockChainMock{}, &mock.MarshalizerMock{})
+	assert.True(t, check.IfNil(mar))
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	mar, err = NewMultisigAccountsReader(&mock.AccountsStub{}, nil, &mock.MarshalizerMock{})
+	assert.True(t, check.IfNil(mar))
+	assert.Equal(t, process.ErrNilBlockChain, err)
+
+	mar, err = NewMultisigAccountsReader(&mock.AccountsStub{}, &mock.BlockChainMock{}, nil)
+	assert.True(t, check.IfNil(mar))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestMultisigAccountsReader_GetMultisigSignersWithoutCommittedBlockShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (data.Trie, error) {
+			assert.Fail(t, "should have not read the trie")
+			return nil, nil
+		},
+	}
+	mar, _ := NewMultisigAccountsReader(accounts, &mock.BlockChainMock{}, &mock.MarshalizerMock{})
+
+	readSigners, threshold, err := mar.GetMultisigSigners([]byte("address"))
+	assert.Nil(t, err)
+	assert.Nil(t, readSigners)
+	assert.Equal(t, uint32(0), threshold)
+}
+
+func TestMultisigAccountsReader_GetMultisigSigners(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	committedRootHash := []byte("committed root hash")
+	dataTrieRootHash := []byte("data trie root hash")
+	multisigAddr := []byte("multisig")
+	regularAddr := []byte("regular")
+	uncommittedAddr := []byte("uncommitted")
+	failingAddr := []byte("failing")
+	errExpected := errors.New("expected error")
+
+	signers := [][]byte{[]byte("signer01"), []byte("signer02")}
+	multisigAcc, _ := state.NewUserAccount(multisigAddr)
+	setFunc, _ := NewSetMultisigAccountFunc(BaseOperationCost{}, 0, marshalizer, 0, &mock.EpochNotifierStub{})
+	_, err := setFunc.ProcessBuiltinFunction(nil, multisigAcc, createMultisigVmInput(multisigAddr, 1, signers...))
+	assert.Nil(t, err)
+	committedMultisigData := multisigAcc.DataTrieTracker().DirtyData()[string(multisigKey())]
+	multisigAcc.SetRootHash(dataTrieRootHash)
+	multisigAccBytes, _ := marshalizer.Marshal(multisigAcc)
+
+	regularAcc, _ := state.NewUserAccount(regularAddr)
+	regularAccBytes, _ := marshalizer.Marshal(regularAcc)
+
+	mainTrie := &mock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			switch string(key) {
+			case string(multisigAddr):
+				return multisigAccBytes, nil
+			case string(regularAddr):
+				return regularAccBytes, nil
+			case string(failingAddr):
+				return nil, errExpected
+			default:
+				return nil, nil
+			}
+		},
+	}
+	dataTrie := &mock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			if string(key) == string(multisigKey()) {
+				return committedMultisigData, nil
+			}
+			return nil, nil
+		},
+	}
+	accounts := &mock.AccountsStub{
+		GetTrieCalled: func(rootHash []byte) (data.Trie, error) {
+			switch string(rootHash) {
+			case string(committedRootHash):
+				return mainTrie, nil
+			case string(dataTrieRootHash):
+				return dataTrie, nil
+			default:
+				return nil, errExpected
+			}
+		},
+		GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+			assert.Fail(t, "should have not read the live state")
+			return nil, nil
+		},
+	}
+	blockChain := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{RootHash: committedRootHash}
+		},
+	}
+	mar, _ := NewMultisigAccountsReader(accounts, blockChain, marshalizer)
+
+	readSigners, threshold, err := mar.GetMultisigSigners(multisigAddr)
+	assert.Nil(t, err)
+	assert.Equal(t, signers, readSigners)
+	assert.Equal(t, uint32(1), threshold)
+
+	readSigners, threshold, err = mar.GetMultisigSigners(regularAddr)
+	assert.Nil(t, err)
+	assert.Nil(t, readSigners)
+	assert.Equal(t, uint32(0), threshold)
+
+	readSigners, _, err = mar.GetMultisigSigners(uncommittedAddr)
+	assert.Nil(t, err)
+	assert.Nil(t, readSigners)
+
+	_, _, err = mar.GetMultisigSigners(failingAddr)
+	assert.Equal(t, errExpected, err)
+}
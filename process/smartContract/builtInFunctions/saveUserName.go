@@ -77,6 +77,11 @@ func (s *saveUserName) ProcessBuiltinFunction(
 	return &vmcommon.VMOutput{GasRemaining: vmInput.GasProvided - s.gasCost}, nil
 }
 
+// IsActive returns true as the function is active since genesis
+func (s *saveUserName) IsActive() bool {
+	return true
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (s *saveUserName) IsInterfaceNil() bool {
 	return s == nil
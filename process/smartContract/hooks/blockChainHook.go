@@ -1,6 +1,7 @@
 package hooks
 
 import (
+	"context"
 	"encoding/binary"
 	"math/big"
 	"sync"
@@ -473,7 +474,21 @@ func (bh *BlockChainHookImpl) GetAllState(address []byte) (map[string][]byte, er
 		return nil, process.ErrWrongTypeAssertion
 	}
 
-	return dstAccount.DataTrie().GetAllLeaves()
+	allState := make(map[string][]byte)
+	dataTrie := dstAccount.DataTrie()
+	if check.IfNil(dataTrie) {
+		return allState, nil
+	}
+
+	err = dataTrie.IterateAllLeaves(context.Background(), func(key []byte, value []byte) error {
+		allState[string(key)] = value
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allState, nil
 }
 
 func hashFromAddressAndNonce(creatorAddress []byte, creatorNonce uint64) []byte {
@@ -284,6 +284,7 @@ func (sc *scProcessor) ExecuteSmartContractTransaction(
 
 	acntDst.AddToDeveloperReward(newDeveloperReward)
 	sc.txFeeHandler.ProcessTransactionFee(consumedFee, newDeveloperReward, txHash)
+	sc.txFeeHandler.ProcessTransactionReceipt(txHash, tx, core.ReceiptStatusSuccess)
 
 	err = sc.accounts.SaveAccount(acntDst)
 	if err != nil {
@@ -375,11 +376,9 @@ func (sc *scProcessor) resolveBuiltInFunctions(
 		acntSnd,
 		vmcommon.DirectCall,
 	)
-	if !check.IfNil(acntSnd) {
-		err = acntSnd.AddToBalance(scrForSender.Value)
-		if err != nil {
-			return true, err
-		}
+	err = sc.refundSender(tx, acntSnd, scrForSender.Value, vmcommon.DirectCall)
+	if err != nil {
+		return true, err
 	}
 
 	scrResults = append(scrResults, scrForSender)
@@ -396,7 +395,9 @@ func (sc *scProcessor) resolveBuiltInFunctions(
 	}
 
 	sc.gasHandler.SetGasRefunded(vmOutput.GasRemaining, txHash)
+	sc.txFeeHandler.ProcessTransactionGasRefund(txHash, vmOutput.GasRemaining)
 	sc.txFeeHandler.ProcessTransactionFee(consumedFee, big.NewInt(0), txHash)
+	sc.txFeeHandler.ProcessTransactionReceipt(txHash, tx, core.ReceiptStatusSuccess)
 
 	return true, sc.saveAccounts(acntSnd, acntDst)
 }
@@ -421,17 +422,11 @@ func (sc *scProcessor) ProcessIfError(
 		return err
 	}
 
-	if !check.IfNil(acntSnd) {
-		err = acntSnd.AddToBalance(tx.GetValue())
-		if err != nil {
-			return err
-		}
-
-		err = sc.accounts.SaveAccount(acntSnd)
-		if err != nil {
-			log.Debug("error saving account")
-		}
-	} else {
+	err = sc.refundSender(tx, acntSnd, tx.GetValue(), vmcommon.DirectCall)
+	if err != nil {
+		log.Debug("error refunding the sender", "error", err.Error())
+	}
+	if check.IfNil(acntSnd) {
 		moveBalanceCost := sc.economicsFee.ComputeFee(tx)
 		consumedFee.Sub(consumedFee, moveBalanceCost)
 	}
@@ -441,7 +436,10 @@ func (sc *scProcessor) ProcessIfError(
 		return err
 	}
 
+	// the whole gas is consumed when the execution fails
+	sc.txFeeHandler.ProcessTransactionGasRefund(txHash, 0)
 	sc.txFeeHandler.ProcessTransactionFee(consumedFee, big.NewInt(0), txHash)
+	sc.txFeeHandler.ProcessTransactionReceipt(txHash, tx, core.ReceiptStatusFailed)
 
 	return nil
 }
@@ -553,6 +551,7 @@ func (sc *scProcessor) DeploySmartContract(
 	}
 
 	sc.txFeeHandler.ProcessTransactionFee(consumedFee, big.NewInt(0), txHash)
+	sc.txFeeHandler.ProcessTransactionReceipt(txHash, tx, core.ReceiptStatusSuccess)
 	sc.printScDeployed(vmOutput, tx)
 
 	return nil
@@ -687,16 +686,9 @@ func (sc *scProcessor) processVMOutput(
 
 	scrTxs = append(scrTxs, scrForSender)
 
-	if !check.IfNil(acntSnd) {
-		err = acntSnd.AddToBalance(scrForSender.Value)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		err = sc.accounts.SaveAccount(acntSnd)
-		if err != nil {
-			return nil, nil, err
-		}
+	err = sc.refundSender(tx, acntSnd, scrForSender.Value, callType)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	err = sc.deleteAccounts(vmOutput.DeletedAccounts)
@@ -705,6 +697,7 @@ func (sc *scProcessor) processVMOutput(
 	}
 
 	sc.gasHandler.SetGasRefunded(vmOutput.GasRemaining, txHash)
+	sc.txFeeHandler.ProcessTransactionGasRefund(txHash, vmOutput.GasRemaining)
 
 	return scrTxs, consumedFee, nil
 }
@@ -752,12 +745,8 @@ func (sc *scProcessor) createSCRsWhenError(
 	returnCode string,
 	returnMessage []byte,
 ) ([]data.TransactionHandler, error) {
-	rcvAddress := tx.GetSndAddr()
-
 	callType := determineCallType(tx)
-	if callType == vmcommon.AsynchronousCallBack {
-		rcvAddress = tx.GetRcvAddr()
-	}
+	rcvAddress := getRefundReceiver(tx, callType)
 
 	scr := &smartContractResult.SmartContractResult{
 		Nonce:         tx.GetNonce(),
@@ -854,10 +843,7 @@ func (sc *scProcessor) createSCRForSender(
 	refundErd.Mul(big.NewInt(0).SetUint64(gasRemaining), big.NewInt(0).SetUint64(tx.GetGasPrice()))
 	consumedFee.Sub(consumedFee, refundErd)
 
-	rcvAddress := tx.GetSndAddr()
-	if callType == vmcommon.AsynchronousCallBack {
-		rcvAddress = tx.GetRcvAddr()
-	}
+	rcvAddress := getRefundReceiver(tx, callType)
 
 	scTx := &smartContractResult.SmartContractResult{}
 	scTx.Value = big.NewInt(0).Add(refundErd, storageFreeRefund)
@@ -889,6 +875,64 @@ func (sc *scProcessor) createSCRForSender(
 	return scTx, consumedFee
 }
 
+// getRefundReceiver returns the address which receives the unused gas and the returned value of the transaction.
+// The user transactions carried by relayed transactions refund the relayer, as the relayer paid for them
+func getRefundReceiver(tx data.TransactionHandler, callType vmcommon.CallType) []byte {
+	if callType == vmcommon.AsynchronousCallBack {
+		return tx.GetRcvAddr()
+	}
+
+	relayerAddr := getRelayerAddress(tx, callType)
+	if len(relayerAddr) > 0 {
+		return relayerAddr
+	}
+
+	return tx.GetSndAddr()
+}
+
+func getRelayerAddress(tx data.TransactionHandler, callType vmcommon.CallType) []byte {
+	if callType != vmcommon.DirectCall {
+		return nil
+	}
+
+	scr, isSCR := tx.(*smartContractResult.SmartContractResult)
+	if !isSCR {
+		return nil
+	}
+
+	return scr.RelayerAddr
+}
+
+// refundSender credits the refund receiver with the provided value, if the receiver belongs to this shard. A relayer
+// from another shard gets the value when the smart contract result created for it reaches its shard
+func (sc *scProcessor) refundSender(
+	tx data.TransactionHandler,
+	acntSnd state.UserAccountHandler,
+	value *big.Int,
+	callType vmcommon.CallType,
+) error {
+	acntRefund := acntSnd
+	relayerAddr := getRelayerAddress(tx, callType)
+	isRelayerRefunded := len(relayerAddr) > 0 && !bytes.Equal(relayerAddr, tx.GetSndAddr())
+	if isRelayerRefunded {
+		var err error
+		acntRefund, err = sc.getAccountFromAddress(relayerAddr)
+		if err != nil {
+			return err
+		}
+	}
+	if check.IfNil(acntRefund) {
+		return nil
+	}
+
+	err := acntRefund.AddToBalance(value)
+	if err != nil {
+		return err
+	}
+
+	return sc.accounts.SaveAccount(acntRefund)
+}
+
 // save account changes in state from vmOutput - protected by VM - every output can be treated as is.
 func (sc *scProcessor) processSCOutputAccounts(
 	outputAccounts []*vmcommon.OutputAccount,
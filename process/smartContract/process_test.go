@@ -1246,6 +1246,71 @@ func TestScProcessor_RefundGasToSenderAccNotInShard(t *testing.T) {
 	require.Equal(t, 0, consumed.Cmp(big.NewInt(0).SetUint64(tx.GasPrice*tx.GasLimit)))
 }
 
+func TestScProcessor_CreateSCRForSenderWithRelayerShouldRefundTheRelayer(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:       1,
+		SndAddr:     []byte("SRC"),
+		RcvAddr:     []byte("DST"),
+		Value:       big.NewInt(45),
+		GasPrice:    10,
+		GasLimit:    10,
+		RelayerAddr: []byte("RELAYER"),
+	}
+	refundScr, _ := sc.createSCRForSender(
+		big.NewInt(0),
+		5,
+		vmcommon.Ok,
+		nil,
+		"",
+		scr,
+		[]byte("txHash"),
+		nil,
+		vmcommon.DirectCall,
+	)
+
+	require.NotNil(t, refundScr)
+	require.Equal(t, scr.RelayerAddr, refundScr.RcvAddr)
+	require.Equal(t, big.NewInt(50), refundScr.Value)
+}
+
+func TestScProcessor_RefundSenderWithRelayerShouldCreditTheRelayer(t *testing.T) {
+	t.Parallel()
+
+	relayer, _ := state.NewUserAccount([]byte("RELAYER"))
+	sender, _ := state.NewUserAccount([]byte("SRC"))
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.AccountsDB = &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return relayer, nil
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			return nil
+		},
+	}
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(5)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		return 0
+	}
+	arguments.Coordinator = shardCoordinator
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	scr := &smartContractResult.SmartContractResult{
+		SndAddr:     []byte("SRC"),
+		RcvAddr:     []byte("DST"),
+		RelayerAddr: []byte("RELAYER"),
+	}
+	err := sc.refundSender(scr, sender, big.NewInt(50), vmcommon.DirectCall)
+
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(50), relayer.GetBalance())
+	require.Equal(t, big.NewInt(0), sender.GetBalance())
+}
+
 func TestScProcessor_RefundGasToSender(t *testing.T) {
 	t.Parallel()
 
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -16,26 +17,50 @@ var _ process.SCQueryService = (*SCQueryService)(nil)
 
 // SCQueryService can execute Get functions over SC to fetch stored values
 type SCQueryService struct {
-	vmContainer  process.VirtualMachinesContainer
-	economicsFee process.FeeHandler
-	mutRunSc     sync.Mutex
+	vmContainer                process.VirtualMachinesContainer
+	economicsFee               process.FeeHandler
+	queryVMContainerCreator    process.QueryVMContainerCreator
+	historicalAccountsProvider state.HistoricalAccountsProvider
+	isHistoricalQueriesEnabled bool
+	historicalQueriesSlots     chan struct{}
+	mutRunSc                   sync.Mutex
+}
+
+// ArgsNewSCQueryService defines the arguments needed for the sc query service. The queries on past states are served
+// only by full archive nodes which provide the query VM container creator and the historical accounts provider, and
+// at most MaxConcurrentHistoricalQueries of them are run at the same time
+type ArgsNewSCQueryService struct {
+	VmContainer                    process.VirtualMachinesContainer
+	EconomicsFee                   process.FeeHandler
+	QueryVMContainerCreator        process.QueryVMContainerCreator
+	HistoricalAccountsProvider     state.HistoricalAccountsProvider
+	IsFullArchive                  bool
+	MaxConcurrentHistoricalQueries uint32
 }
 
 // NewSCQueryService returns a new instance of SCQueryService
-func NewSCQueryService(
-	vmContainer process.VirtualMachinesContainer,
-	economicsFee process.FeeHandler,
-) (*SCQueryService, error) {
-	if check.IfNil(vmContainer) {
+func NewSCQueryService(args ArgsNewSCQueryService) (*SCQueryService, error) {
+	if check.IfNil(args.VmContainer) {
 		return nil, process.ErrNoVM
 	}
-	if check.IfNil(economicsFee) {
+	if check.IfNil(args.EconomicsFee) {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
 
+	isHistoricalQueriesEnabled := args.IsFullArchive &&
+		!check.IfNil(args.QueryVMContainerCreator) &&
+		!check.IfNil(args.HistoricalAccountsProvider)
+	if isHistoricalQueriesEnabled && args.MaxConcurrentHistoricalQueries == 0 {
+		return nil, process.ErrInvalidMaxConcurrentHistoricalQueries
+	}
+
 	return &SCQueryService{
-		vmContainer:  vmContainer,
-		economicsFee: economicsFee,
+		vmContainer:                args.VmContainer,
+		economicsFee:               args.EconomicsFee,
+		queryVMContainerCreator:    args.QueryVMContainerCreator,
+		historicalAccountsProvider: args.HistoricalAccountsProvider,
+		isHistoricalQueriesEnabled: isHistoricalQueriesEnabled,
+		historicalQueriesSlots:     make(chan struct{}, args.MaxConcurrentHistoricalQueries),
 	}, nil
 }
 
@@ -48,14 +73,58 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 		return nil, process.ErrEmptyFunctionName
 	}
 
+	if query.BlockNonce != nil {
+		return service.executeScCallAtBlockNonce(query, *query.BlockNonce)
+	}
+
 	service.mutRunSc.Lock()
 	defer service.mutRunSc.Unlock()
 
-	return service.executeScCall(query, 0)
+	return service.executeScCall(service.vmContainer, query, 0)
+}
+
+// executeScCallAtBlockNonce runs the query on the state committed by the block having the given nonce. The call
+// is executed by virtual machines created only for this query, so the live ones are not touched. The query is
+// rejected if all the historical query slots are taken
+func (service *SCQueryService) executeScCallAtBlockNonce(query *process.SCQuery, blockNonce uint64) (*vmcommon.VMOutput, error) {
+	if !service.isHistoricalQueriesEnabled {
+		return nil, process.ErrHistoricalQueriesNotSupported
+	}
+
+	select {
+	case service.historicalQueriesSlots <- struct{}{}:
+		defer func() {
+			<-service.historicalQueriesSlots
+		}()
+	default:
+		return nil, process.ErrSystemBusy
+	}
+
+	accounts, _, err := service.historicalAccountsProvider.GetAccountsAdapterAtBlockNonce(blockNonce)
+	if err != nil {
+		return nil, fmt.Errorf("could not recreate the state at block nonce %d: %w", blockNonce, err)
+	}
+
+	vmContainer, err := service.queryVMContainerCreator.CreateVMContainer(accounts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		errClose := vmContainer.Close()
+		if errClose != nil {
+			log.Debug("SCQueryService: could not close the historical query virtual machines", "error", errClose)
+		}
+	}()
+
+	return service.executeScCall(vmContainer, query, 0)
 }
 
-func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, error) {
-	vm, err := findVMByScAddress(service.vmContainer, query.ScAddress)
+func (service *SCQueryService) executeScCall(
+	vmContainer process.VirtualMachinesContainer,
+	query *process.SCQuery,
+	gasPrice uint64,
+) (*vmcommon.VMOutput, error) {
+	vm, err := findVMByScAddress(vmContainer, query.ScAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +198,7 @@ func (service *SCQueryService) ComputeScCallGasLimit(tx *transaction.Transaction
 	service.mutRunSc.Lock()
 	defer service.mutRunSc.Unlock()
 
-	vmOutput, err := service.executeScCall(query, 1)
+	vmOutput, err := service.executeScCall(service.vmContainer, query, 1)
 	if err != nil {
 		return 0, err
 	}
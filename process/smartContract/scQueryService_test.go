@@ -8,6 +8,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -21,7 +24,10 @@ const DummyScAddress = "00000000000000000500fabd9501b7e5353de57a4e319857c2fb9908
 func TestNewSCQueryService_NilVmShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(nil, &mock.FeeHandlerStub{})
+	target, err := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer:  nil,
+		EconomicsFee: &mock.FeeHandlerStub{},
+	})
 
 	assert.Nil(t, target)
 	assert.Equal(t, process.ErrNoVM, err)
@@ -30,7 +36,10 @@ func TestNewSCQueryService_NilVmShouldErr(t *testing.T) {
 func TestNewSCQueryService_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(&mock.VMContainerMock{}, nil)
+	target, err := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer:  &mock.VMContainerMock{},
+		EconomicsFee: nil,
+	})
 
 	assert.Nil(t, target)
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
@@ -39,7 +48,10 @@ func TestNewSCQueryService_NilFeeHandlerShouldErr(t *testing.T) {
 func TestNewSCQueryService_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{})
+	target, err := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer:  &mock.VMContainerMock{},
+		EconomicsFee: &mock.FeeHandlerStub{},
+	})
 
 	assert.NotNil(t, target)
 	assert.Nil(t, err)
@@ -49,7 +61,10 @@ func TestNewSCQueryService_ShouldWork(t *testing.T) {
 func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{})
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer:  &mock.VMContainerMock{},
+		EconomicsFee: &mock.FeeHandlerStub{},
+	})
 
 	query := process.SCQuery{
 		ScAddress: nil,
@@ -66,7 +81,10 @@ func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 func TestExecuteQuery_EmptyFunctionShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{})
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer:  &mock.VMContainerMock{},
+		EconomicsFee: &mock.FeeHandlerStub{},
+	})
 
 	query := process.SCQuery{
 		ScAddress: []byte{0},
@@ -80,6 +98,210 @@ func TestExecuteQuery_EmptyFunctionShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrEmptyFunctionName, err)
 }
 
+func TestExecuteQuery_AtBlockNonceWithoutHistoricalComponentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer:  &mock.VMContainerMock{},
+		EconomicsFee: &mock.FeeHandlerStub{},
+	})
+
+	blockNonce := uint64(10)
+	query := process.SCQuery{
+		ScAddress:  []byte(DummyScAddress),
+		FuncName:   "function",
+		BlockNonce: &blockNonce,
+	}
+
+	output, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, output)
+	assert.Equal(t, process.ErrHistoricalQueriesNotSupported, err)
+}
+
+func TestExecuteQuery_AtBlockNonceShouldRunOnDedicatedVMsOverHistoricalAccounts(t *testing.T) {
+	t.Parallel()
+
+	historicalAccounts := &mock.AccountsStub{}
+	blockNonce := uint64(10)
+	historicalAccountsProvider := &mock.HistoricalAccountsProviderStub{
+		GetAccountsAdapterAtBlockNonceCalled: func(nonce uint64) (state.AccountsAdapter, data.HeaderHandler, error) {
+			assert.Equal(t, blockNonce, nonce)
+			return historicalAccounts, &block.Header{Nonce: nonce}, nil
+		},
+	}
+
+	liveVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			assert.Fail(t, "the live virtual machine should not have been called")
+			return nil, nil
+		},
+	}
+	historicalRunWasCalled := false
+	historicalVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			historicalRunWasCalled = true
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+			}, nil
+		},
+	}
+	historicalContainerWasClosed := false
+	queryVMContainerCreator := &mock.QueryVMContainerCreatorStub{
+		CreateVMContainerCalled: func(accounts state.AccountsAdapter) (process.VirtualMachinesContainer, error) {
+			assert.True(t, accounts == historicalAccounts)
+			return &mock.VMContainerMock{
+				GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+					return historicalVM, nil
+				},
+				CloseCalled: func() error {
+					historicalContainerWasClosed = true
+					return nil
+				},
+			}, nil
+		},
+	}
+
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return liveVM, nil
+			},
+		},
+		EconomicsFee: &mock.FeeHandlerStub{
+			MaxGasLimitPerBlockCalled: func() uint64 {
+				return uint64(math.MaxUint64)
+			},
+		},
+		QueryVMContainerCreator:        queryVMContainerCreator,
+		HistoricalAccountsProvider:     historicalAccountsProvider,
+		IsFullArchive:                  true,
+		MaxConcurrentHistoricalQueries: 1,
+	})
+
+	query := process.SCQuery{
+		ScAddress:  []byte(DummyScAddress),
+		FuncName:   "function",
+		BlockNonce: &blockNonce,
+	}
+
+	_, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, err)
+	assert.True(t, historicalRunWasCalled)
+	assert.True(t, historicalContainerWasClosed)
+}
+
+func createHistoricalQueriesArgs(historicalVM vmcommon.VMExecutionHandler) ArgsNewSCQueryService {
+	return ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{},
+		EconomicsFee: &mock.FeeHandlerStub{
+			MaxGasLimitPerBlockCalled: func() uint64 {
+				return uint64(math.MaxUint64)
+			},
+		},
+		QueryVMContainerCreator: &mock.QueryVMContainerCreatorStub{
+			CreateVMContainerCalled: func(accounts state.AccountsAdapter) (process.VirtualMachinesContainer, error) {
+				return &mock.VMContainerMock{
+					GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+						return historicalVM, nil
+					},
+				}, nil
+			},
+		},
+		HistoricalAccountsProvider: &mock.HistoricalAccountsProviderStub{
+			GetAccountsAdapterAtBlockNonceCalled: func(nonce uint64) (state.AccountsAdapter, data.HeaderHandler, error) {
+				return &mock.AccountsStub{}, &block.Header{Nonce: nonce}, nil
+			},
+		},
+		IsFullArchive:                  true,
+		MaxConcurrentHistoricalQueries: 1,
+	}
+}
+
+func TestNewSCQueryService_HistoricalQueriesWithoutSlotsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHistoricalQueriesArgs(&mock.VMExecutionHandlerStub{})
+	args.MaxConcurrentHistoricalQueries = 0
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrInvalidMaxConcurrentHistoricalQueries, err)
+}
+
+func TestExecuteQuery_AtBlockNonceOnNodeWhichIsNotFullArchiveShouldErr(t *testing.T) {
+	t.Parallel()
+
+	historicalVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			assert.Fail(t, "the historical virtual machine should not have been called")
+			return nil, nil
+		},
+	}
+	args := createHistoricalQueriesArgs(historicalVM)
+	args.IsFullArchive = false
+	args.MaxConcurrentHistoricalQueries = 0
+	target, _ := NewSCQueryService(args)
+
+	blockNonce := uint64(10)
+	query := process.SCQuery{
+		ScAddress:  []byte(DummyScAddress),
+		FuncName:   "function",
+		BlockNonce: &blockNonce,
+	}
+
+	output, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, output)
+	assert.Equal(t, process.ErrHistoricalQueriesNotSupported, err)
+}
+
+func TestExecuteQuery_AtBlockNonceWhenAllSlotsAreTakenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	runStarted := make(chan struct{})
+	releaseRun := make(chan struct{})
+	historicalVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			runStarted <- struct{}{}
+			<-releaseRun
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+			}, nil
+		},
+	}
+	target, _ := NewSCQueryService(createHistoricalQueriesArgs(historicalVM))
+
+	blockNonce := uint64(10)
+	query := process.SCQuery{
+		ScAddress:  []byte(DummyScAddress),
+		FuncName:   "function",
+		BlockNonce: &blockNonce,
+	}
+
+	errFirstQuery := make(chan error)
+	go func() {
+		_, err := target.ExecuteQuery(&query)
+		errFirstQuery <- err
+	}()
+	<-runStarted
+
+	output, err := target.ExecuteQuery(&query)
+	assert.Nil(t, output)
+	assert.Equal(t, process.ErrSystemBusy, err)
+
+	releaseRun <- struct{}{}
+	assert.Nil(t, <-errFirstQuery)
+
+	go func() {
+		<-runStarted
+		releaseRun <- struct{}{}
+	}()
+	_, err = target.ExecuteQuery(&query)
+	assert.Nil(t, err)
+}
+
 func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 	t.Parallel()
 
@@ -102,18 +324,18 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-	)
+	})
 
 	dataArgs := make([][]byte, len(args))
 	for i, arg := range args {
@@ -143,18 +365,18 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-	)
+	})
 
 	query := process.SCQuery{
 		ScAddress: []byte(DummyScAddress),
@@ -179,18 +401,18 @@ func TestExecuteQuery_WhenNotOkCodeShouldErr(t *testing.T) {
 			}, nil
 		},
 	}
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-	)
+	})
 
 	query := process.SCQuery{
 		ScAddress: []byte(DummyScAddress),
@@ -226,18 +448,18 @@ func TestExecuteQuery_ShouldCallRunScSequentially(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-	)
+	})
 
 	noOfGoRoutines := 50
 	wg := sync.WaitGroup{}
@@ -271,18 +493,18 @@ func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-	)
+	})
 
 	tx := &transaction.Transaction{
 		RcvAddr: []byte(DummyScAddress),
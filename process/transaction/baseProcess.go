@@ -117,10 +117,14 @@ func (txProc *baseTxProcessor) checkTxValues(tx *transaction.Transaction, acntSn
 		return nil
 	}
 
-	if acntSnd.GetNonce() < tx.Nonce {
+	laneNonce, err := state.GetNonceForLane(acntSnd, tx.NonceLane)
+	if err != nil {
+		return err
+	}
+	if laneNonce < tx.Nonce {
 		return process.ErrHigherNonceInTransaction
 	}
-	if acntSnd.GetNonce() > tx.Nonce {
+	if laneNonce > tx.Nonce {
 		return process.ErrLowerNonceInTransaction
 	}
 
@@ -36,6 +36,10 @@ type InterceptedTransaction struct {
 	isForCurrentShard      bool
 	feeHandler             process.FeeHandler
 	whiteListerVerifiedTxs process.WhiteListHandler
+	isTxVersionEnabled     bool
+	multisigAccounts       process.MultisigAccountsHandler
+	maxNotBeforeRound      uint64
+	maxNotBeforeEpoch      uint32
 }
 
 // NewInterceptedTransaction returns a new instance of InterceptedTransaction
@@ -50,6 +54,10 @@ func NewInterceptedTransaction(
 	coordinator sharding.Coordinator,
 	feeHandler process.FeeHandler,
 	whiteListerVerifiedTxs process.WhiteListHandler,
+	isTxVersionEnabled bool,
+	multisigAccounts process.MultisigAccountsHandler,
+	maxNotBeforeRound uint64,
+	maxNotBeforeEpoch uint32,
 ) (*InterceptedTransaction, error) {
 
 	if txBuff == nil {
@@ -82,6 +90,9 @@ func NewInterceptedTransaction(
 	if check.IfNil(whiteListerVerifiedTxs) {
 		return nil, process.ErrNilWhiteListHandler
 	}
+	if check.IfNil(multisigAccounts) {
+		return nil, process.ErrNilMultisigAccountsHandler
+	}
 
 	tx, err := createTx(protoMarshalizer, txBuff)
 	if err != nil {
@@ -99,6 +110,10 @@ func NewInterceptedTransaction(
 		coordinator:            coordinator,
 		feeHandler:             feeHandler,
 		whiteListerVerifiedTxs: whiteListerVerifiedTxs,
+		isTxVersionEnabled:     isTxVersionEnabled,
+		multisigAccounts:       multisigAccounts,
+		maxNotBeforeRound:      maxNotBeforeRound,
+		maxNotBeforeEpoch:      maxNotBeforeEpoch,
 	}
 
 	err = inTx.processFields(txBuff)
@@ -128,15 +143,83 @@ func (inTx *InterceptedTransaction) CheckValidity() error {
 
 	whiteListedVerified := inTx.whiteListerVerifiedTxs.IsWhiteListed(inTx)
 	if !whiteListedVerified {
-		err = inTx.verifySig()
+		err = inTx.verifySig(inTx.tx)
+		if err != nil {
+			return err
+		}
+
+		userTx, err := inTx.verifyIfRelayedTx()
 		if err != nil {
 			return err
 		}
+
+		// the multisig signatures of a sender from another shard are only checked in the sender shard, so the
+		// transaction must not be marked as verified here
+		if inTx.isSigVerifiedInSenderShard(inTx.tx) || inTx.isSigVerifiedInSenderShard(userTx) {
+			return nil
+		}
+
+		inTx.whiteListerVerifiedTxs.Add([][]byte{inTx.Hash()})
 	}
 
 	return nil
 }
 
+// verifyIfRelayedTx checks the user transaction carried by a relayed transaction, including its signature, and
+// returns it. A nil user transaction is returned for the transactions that are not relayed
+func (inTx *InterceptedTransaction) verifyIfRelayedTx() (*transaction.Transaction, error) {
+	if !isRelayedTxData(inTx.tx.Data) {
+		return nil, nil
+	}
+
+	userTx, err := getUserTxFromRelayedTxData(inTx.protoMarshalizer, inTx.tx.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkRelayedTx(inTx.tx, userTx, inTx.feeHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	err = inTx.integrityOfTx(userTx)
+	if err != nil {
+		return nil, err
+	}
+
+	isUserInRelayerShard := inTx.coordinator.ComputeId(userTx.SndAddr) == inTx.coordinator.ComputeId(inTx.tx.SndAddr)
+	if isUserInRelayerShard {
+		err = inTx.verifySig(userTx)
+		if err != nil {
+			return nil, err
+		}
+
+		return userTx, nil
+	}
+
+	// the multisig settings of the user can only be read in its own shard, so the relayer shard would include a
+	// transaction that the user shard rejects. Only the single signature of the user is accepted and both shards
+	// check it in the same way, the multisig settings being checked when the user transaction is processed
+	if len(userTx.Signatures) > 0 {
+		return nil, process.ErrCrossShardRelayedTxWithMultipleSignatures
+	}
+
+	err = inTx.verifySingleSigOfTx(userTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return userTx, nil
+}
+
+func (inTx *InterceptedTransaction) isSigVerifiedInSenderShard(tx *transaction.Transaction) bool {
+	if tx == nil || len(tx.Signatures) == 0 {
+		return false
+	}
+
+	return inTx.coordinator.ComputeId(tx.SndAddr) != inTx.coordinator.SelfId()
+}
+
 func (inTx *InterceptedTransaction) processFields(txBuff []byte) error {
 	inTx.hash = inTx.hasher.Compute(string(txBuff))
 
@@ -156,43 +239,214 @@ func (inTx *InterceptedTransaction) processFields(txBuff []byte) error {
 
 // integrity checks for not nil fields and negative value
 func (inTx *InterceptedTransaction) integrity() error {
-	if inTx.tx.Signature == nil {
+	return inTx.integrityOfTx(inTx.tx)
+}
+
+func (inTx *InterceptedTransaction) integrityOfTx(tx *transaction.Transaction) error {
+	if tx.Signature == nil && len(tx.Signatures) == 0 {
 		return process.ErrNilSignature
 	}
-	if inTx.tx.RcvAddr == nil {
+	if len(tx.Signature) > 0 && len(tx.Signatures) > 0 {
+		return process.ErrBothSignatureAndSignaturesSet
+	}
+	if tx.RcvAddr == nil {
 		return process.ErrNilRcvAddr
 	}
-	if inTx.tx.SndAddr == nil {
+	if tx.SndAddr == nil {
 		return process.ErrNilSndAddr
 	}
-	if inTx.tx.Value == nil {
+	if tx.Value == nil {
 		return process.ErrNilValue
 	}
-	if inTx.tx.Value.Sign() < 0 {
+	if tx.Value.Sign() < 0 {
 		return process.ErrNegativeValue
 	}
 
-	return inTx.feeHandler.CheckValidityTxValues(inTx.tx)
+	err := inTx.checkTxVersion(tx)
+	if err != nil {
+		return err
+	}
+
+	err = inTx.checkTimeLock(tx)
+	if err != nil {
+		return err
+	}
+
+	err = inTx.checkNonceLane(tx)
+	if err != nil {
+		return err
+	}
+
+	return inTx.feeHandler.CheckValidityTxValues(tx)
 }
 
-// verifySig checks if the tx is correctly signed
-func (inTx *InterceptedTransaction) verifySig() error {
-	buffCopiedTx, err := inTx.tx.GetDataForSigning(inTx.pubkeyConv, inTx.signMarshalizer)
+// checkTxVersion verifies the version, the options and the time lock of the transaction. Before the versioning is
+// enabled, only the transactions created in the initial version are accepted
+func (inTx *InterceptedTransaction) checkTxVersion(tx *transaction.Transaction) error {
+	if !inTx.isTxVersionEnabled {
+		if tx.Version > transaction.InitialVersion {
+			return process.ErrInvalidTransactionVersion
+		}
+		if tx.Options != 0 {
+			return process.ErrInvalidTransactionOptions
+		}
+		if tx.IsTimeLocked() {
+			return process.ErrTimeLockNotAllowed
+		}
+
+		return nil
+	}
+
+	if tx.Version > transaction.VersionWithOptions {
+		return process.ErrInvalidTransactionVersion
+	}
+	if tx.IsTimeLocked() && tx.Version < transaction.VersionWithOptions {
+		return process.ErrTimeLockNotAllowed
+	}
+	if tx.Options == 0 {
+		return nil
+	}
+	if tx.Version < transaction.VersionWithOptions || tx.Options&^transaction.MaskAllOptions != 0 {
+		return process.ErrInvalidTransactionOptions
+	}
+
+	return nil
+}
+
+// checkTimeLock verifies that the transaction is not time locked farther in the future than the transactions pool is
+// willing to hold it
+func (inTx *InterceptedTransaction) checkTimeLock(tx *transaction.Transaction) error {
+	if tx.NotBeforeRound > inTx.maxNotBeforeRound || tx.NotBeforeEpoch > inTx.maxNotBeforeEpoch {
+		return process.ErrTimeLockTooFarInTheFuture
+	}
+
+	return nil
+}
+
+// checkNonceLane verifies the nonce lane of the transaction. The secondary lanes are accepted only for the move
+// balance transactions created with a version supporting the options, since the VM relies on the account nonce
+func (inTx *InterceptedTransaction) checkNonceLane(tx *transaction.Transaction) error {
+	if !tx.UsesNonceLane() {
+		return nil
+	}
+	if !inTx.isTxVersionEnabled || tx.Version < transaction.VersionWithOptions {
+		return process.ErrInvalidTransactionVersion
+	}
+	if tx.NonceLane >= core.MaxNonceLanes {
+		return process.ErrInvalidNonceLane
+	}
+	if core.IsSmartContractAddress(tx.RcvAddr) {
+		return process.ErrNonceLaneNotAllowed
+	}
+
+	return nil
+}
+
+// verifySig checks if the tx is correctly signed, either over its serialized form or over the hash of it, as
+// requested by the transaction options. Transactions sent by multisig accounts are checked against the signers
+// of the account
+func (inTx *InterceptedTransaction) verifySig(tx *transaction.Transaction) error {
+	buffCopiedTx, err := inTx.getDataForSigning(tx)
 	if err != nil {
 		return err
 	}
 
-	senderPubKey, err := inTx.keyGen.PublicKeyFromByteArray(inTx.tx.SndAddr)
+	isSenderInSelfShard := inTx.coordinator.ComputeId(tx.SndAddr) == inTx.coordinator.SelfId()
+	if !isSenderInSelfShard {
+		// the multisig settings of the sender can only be read in its own shard, where the transaction gets
+		// fully verified before being processed
+		if len(tx.Signatures) > 0 {
+			return nil
+		}
+
+		return inTx.verifySingleSig(tx.SndAddr, buffCopiedTx, tx.Signature)
+	}
+
+	signers, threshold, err := inTx.multisigAccounts.GetMultisigSigners(tx.SndAddr)
+	if err != nil {
+		return err
+	}
+	if len(signers) == 0 {
+		if len(tx.Signatures) > 0 {
+			return process.ErrAccountIsNotMultisig
+		}
+
+		return inTx.verifySingleSig(tx.SndAddr, buffCopiedTx, tx.Signature)
+	}
+	if len(tx.Signatures) == 0 {
+		return process.ErrMultisigAccountNeedsMultipleSignatures
+	}
+
+	return inTx.verifyMultisig(signers, threshold, buffCopiedTx, tx.Signatures)
+}
+
+func (inTx *InterceptedTransaction) verifySingleSigOfTx(tx *transaction.Transaction) error {
+	buffCopiedTx, err := inTx.getDataForSigning(tx)
 	if err != nil {
 		return err
 	}
 
-	err = inTx.singleSigner.Verify(senderPubKey, buffCopiedTx, inTx.tx.Signature)
+	return inTx.verifySingleSig(tx.SndAddr, buffCopiedTx, tx.Signature)
+}
+
+func (inTx *InterceptedTransaction) getDataForSigning(tx *transaction.Transaction) ([]byte, error) {
+	buffCopiedTx, err := tx.GetDataForSigning(inTx.pubkeyConv, inTx.signMarshalizer)
+	if err != nil {
+		return nil, err
+	}
+	if tx.HasOptionSignedWithHash() {
+		return inTx.hasher.Compute(string(buffCopiedTx)), nil
+	}
+
+	return buffCopiedTx, nil
+}
+
+func (inTx *InterceptedTransaction) verifySingleSig(pubKey []byte, msg []byte, signature []byte) error {
+	senderPubKey, err := inTx.keyGen.PublicKeyFromByteArray(pubKey)
 	if err != nil {
 		return err
 	}
 
-	inTx.whiteListerVerifiedTxs.Add([][]byte{inTx.Hash()})
+	return inTx.singleSigner.Verify(senderPubKey, msg, signature)
+}
+
+// verifyMultisig checks that at least threshold signers provided a valid signature. The signatures are given in
+// the canonical form: one for each signer, the signature on position i belonging to the signer on position i, an
+// empty signature meaning that the signer did not sign the transaction
+func (inTx *InterceptedTransaction) verifyMultisig(
+	signers [][]byte,
+	threshold uint32,
+	msg []byte,
+	signatures [][]byte,
+) error {
+	if len(signatures) != len(signers) {
+		return process.ErrNonCanonicalMultisigSignatures
+	}
+
+	numValidSignatures := uint32(0)
+	uniqueSignatures := make(map[string]struct{}, len(signatures))
+	for i, signature := range signatures {
+		if len(signature) == 0 {
+			continue
+		}
+
+		_, exists := uniqueSignatures[string(signature)]
+		if exists {
+			return process.ErrNonCanonicalMultisigSignatures
+		}
+		uniqueSignatures[string(signature)] = struct{}{}
+
+		err := inTx.verifySingleSig(signers[i], msg, signature)
+		if err != nil {
+			return err
+		}
+
+		numValidSignatures++
+	}
+
+	if numValidSignatures < threshold {
+		return process.ErrNotEnoughMultisigSignatures
+	}
 
 	return nil
 }
@@ -232,6 +486,11 @@ func (inTx *InterceptedTransaction) SenderAddress() []byte {
 	return inTx.tx.SndAddr
 }
 
+// NonceLane returns the nonce lane of the transaction
+func (inTx *InterceptedTransaction) NonceLane() uint32 {
+	return inTx.tx.NonceLane
+}
+
 // Fee returns the estimated cost of the transaction
 func (inTx *InterceptedTransaction) Fee() *big.Int {
 	return inTx.feeHandler.ComputeFee(inTx.tx)
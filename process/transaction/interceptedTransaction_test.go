@@ -2,10 +2,13 @@ package transaction_test
 
 import (
 	"bytes"
+	"encoding/hex"
 	"errors"
+	"math"
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	dataTransaction "github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -62,6 +65,24 @@ func createFreeTxFeeHandler() *mock.FeeHandlerStub {
 }
 
 func createInterceptedTxFromPlainTx(tx *dataTransaction.Transaction, txFeeHandler process.FeeHandler) (*transaction.InterceptedTransaction, error) {
+	return createInterceptedTxFromPlainTxWithTxVersion(tx, txFeeHandler, true)
+}
+
+func createInterceptedTxFromPlainTxWithTxVersion(
+	tx *dataTransaction.Transaction,
+	txFeeHandler process.FeeHandler,
+	isTxVersionEnabled bool,
+) (*transaction.InterceptedTransaction, error) {
+	return createInterceptedTxFromPlainTxWithTimeLockHorizon(tx, txFeeHandler, isTxVersionEnabled, math.MaxUint64, math.MaxUint32)
+}
+
+func createInterceptedTxFromPlainTxWithTimeLockHorizon(
+	tx *dataTransaction.Transaction,
+	txFeeHandler process.FeeHandler,
+	isTxVersionEnabled bool,
+	maxNotBeforeRound uint64,
+	maxNotBeforeEpoch uint32,
+) (*transaction.InterceptedTransaction, error) {
 	marshalizer := &mock.MarshalizerMock{}
 	txBuff, err := marshalizer.Marshal(tx)
 	if err != nil {
@@ -92,6 +113,10 @@ func createInterceptedTxFromPlainTx(tx *dataTransaction.Transaction, txFeeHandle
 		shardCoordinator,
 		txFeeHandler,
 		&mock.WhiteListHandlerStub{},
+		isTxVersionEnabled,
+		&mock.MultisigAccountsHandlerStub{},
+		maxNotBeforeRound,
+		maxNotBeforeEpoch,
 	)
 }
 
@@ -111,6 +136,10 @@ func TestNewInterceptedTransaction_NilBufferShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		true,
+		&mock.MultisigAccountsHandlerStub{},
+		math.MaxUint64,
+		math.MaxUint32,
 	)
 
 	assert.Nil(t, txi)
@@ -131,6 +160,10 @@ func TestNewInterceptedTransaction_NilMarshalizerShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		true,
+		&mock.MultisigAccountsHandlerStub{},
+		math.MaxUint64,
+		math.MaxUint32,
 	)
 
 	assert.Nil(t, txi)
@@ -151,6 +184,10 @@ func TestNewInterceptedTransaction_NilSignMarshalizerShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		true,
+		&mock.MultisigAccountsHandlerStub{},
+		math.MaxUint64,
+		math.MaxUint32,
 	)
 
 	assert.Nil(t, txi)
@@ -171,6 +208,10 @@ func TestNewInterceptedTransaction_NilHasherShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		true,
+		&mock.MultisigAccountsHandlerStub{},
+		math.MaxUint64,
+		math.MaxUint32,
 	)
 
 	assert.Nil(t, txi)
@@ -191,6 +232,10 @@ func TestNewInterceptedTransaction_NilKeyGenShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		true,
+		&mock.MultisigAccountsHandlerStub{},
+		math.MaxUint64,
+		math.MaxUint32,
 	)
 
 	assert.Nil(t, txi)
@@ -211,6 +256,10 @@ func TestNewInterceptedTransaction_NilSignerShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		true,
+		&mock.MultisigAccountsHandlerStub{},
+		math.MaxUint64,
+		math.MaxUint32,
 	)
 
 	assert.Nil(t, txi)
@@ -231,6 +280,10 @@ func TestNewInterceptedTransaction_NilPubkeyConverterShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		true,
+		&mock.MultisigAccountsHandlerStub{},
+		math.MaxUint64,
+		math.MaxUint32,
 	)
 
 	assert.Nil(t, txi)
@@ -251,6 +304,10 @@ func TestNewInterceptedTransaction_NilCoordinatorShouldErr(t *testing.T) {
 		nil,
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		true,
+		&mock.MultisigAccountsHandlerStub{},
+		math.MaxUint64,
+		math.MaxUint32,
 	)
 
 	assert.Nil(t, txi)
@@ -271,6 +328,10 @@ func TestNewInterceptedTransaction_NilFeeHandlerShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		nil,
 		&mock.WhiteListHandlerStub{},
+		true,
+		&mock.MultisigAccountsHandlerStub{},
+		math.MaxUint64,
+		math.MaxUint32,
 	)
 
 	assert.Nil(t, txi)
@@ -291,12 +352,40 @@ func TestNewInterceptedTransaction_NilWhiteListerVerifiedTxsShouldErr(t *testing
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		nil,
+		true,
+		&mock.MultisigAccountsHandlerStub{},
+		math.MaxUint64,
+		math.MaxUint32,
 	)
 
 	assert.Nil(t, txi)
 	assert.Equal(t, process.ErrNilWhiteListHandler, err)
 }
 
+func TestNewInterceptedTransaction_NilMultisigAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txi, err := transaction.NewInterceptedTransaction(
+		make([]byte, 0),
+		&mock.MarshalizerMock{},
+		&mock.MarshalizerMock{},
+		mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		createMockPubkeyConverter(),
+		mock.NewOneShardCoordinatorMock(),
+		&mock.FeeHandlerStub{},
+		&mock.WhiteListHandlerStub{},
+		true,
+		nil,
+		math.MaxUint64,
+		math.MaxUint32,
+	)
+
+	assert.Nil(t, txi)
+	assert.Equal(t, process.ErrNilMultisigAccountsHandler, err)
+}
+
 func TestNewInterceptedTransaction_UnmarshalingTxFailsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -317,6 +406,10 @@ func TestNewInterceptedTransaction_UnmarshalingTxFailsShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		true,
+		&mock.MultisigAccountsHandlerStub{},
+		math.MaxUint64,
+		math.MaxUint32,
 	)
 
 	assert.Nil(t, txi)
@@ -602,6 +695,10 @@ func TestInterceptedTransaction_ScTxDeployRecvShardIdShouldBeSendersShardId(t *t
 		shardCoordinator,
 		createFreeTxFeeHandler(),
 		&mock.WhiteListHandlerStub{},
+		true,
+		&mock.MultisigAccountsHandlerStub{},
+		math.MaxUint64,
+		math.MaxUint32,
 	)
 
 	assert.Nil(t, err)
@@ -744,6 +841,10 @@ func TestInterceptedTransaction_CheckValiditySecondTimeDoesNotVerifySig(t *testi
 		shardCoordinator,
 		createFreeTxFeeHandler(),
 		whiteListerVerifiedTxs,
+		true,
+		&mock.MultisigAccountsHandlerStub{},
+		math.MaxUint64,
+		math.MaxUint32,
 	)
 	require.Nil(t, err)
 
@@ -762,6 +863,596 @@ func TestInterceptedTransaction_CheckValiditySecondTimeDoesNotVerifySig(t *testi
 
 //------- IsInterfaceNil
 
+func createVersionedTxForInterceptor(version uint32, options uint32) *dataTransaction.Transaction {
+	return &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      []byte("data"),
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+		Version:   version,
+		Options:   options,
+	}
+}
+
+func TestInterceptedTransaction_CheckValidityTxVersionNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	tx := createVersionedTxForInterceptor(dataTransaction.InitialVersion, 0)
+	txi, _ := createInterceptedTxFromPlainTxWithTxVersion(tx, createFreeTxFeeHandler(), false)
+	assert.Nil(t, txi.CheckValidity())
+
+	tx = createVersionedTxForInterceptor(dataTransaction.VersionWithOptions, 0)
+	txi, _ = createInterceptedTxFromPlainTxWithTxVersion(tx, createFreeTxFeeHandler(), false)
+	assert.Equal(t, process.ErrInvalidTransactionVersion, txi.CheckValidity())
+
+	tx = createVersionedTxForInterceptor(dataTransaction.InitialVersion, dataTransaction.MaskSignedWithHash)
+	txi, _ = createInterceptedTxFromPlainTxWithTxVersion(tx, createFreeTxFeeHandler(), false)
+	assert.Equal(t, process.ErrInvalidTransactionOptions, txi.CheckValidity())
+}
+
+func TestInterceptedTransaction_CheckValidityTxVersionEnabled(t *testing.T) {
+	t.Parallel()
+
+	tx := createVersionedTxForInterceptor(0, 0)
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+	assert.Nil(t, txi.CheckValidity())
+
+	tx = createVersionedTxForInterceptor(dataTransaction.VersionWithOptions, 0)
+	txi, _ = createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+	assert.Nil(t, txi.CheckValidity())
+
+	tx = createVersionedTxForInterceptor(dataTransaction.VersionWithOptions+1, 0)
+	txi, _ = createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+	assert.Equal(t, process.ErrInvalidTransactionVersion, txi.CheckValidity())
+
+	tx = createVersionedTxForInterceptor(dataTransaction.InitialVersion, dataTransaction.MaskSignedWithHash)
+	txi, _ = createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+	assert.Equal(t, process.ErrInvalidTransactionOptions, txi.CheckValidity())
+
+	tx = createVersionedTxForInterceptor(dataTransaction.VersionWithOptions, dataTransaction.MaskAllOptions<<1)
+	txi, _ = createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+	assert.Equal(t, process.ErrInvalidTransactionOptions, txi.CheckValidity())
+}
+
+func TestInterceptedTransaction_CheckValidityTimeLockedTx(t *testing.T) {
+	t.Parallel()
+
+	tx := createVersionedTxForInterceptor(dataTransaction.InitialVersion, 0)
+	tx.NotBeforeRound = 10
+	txi, _ := createInterceptedTxFromPlainTxWithTxVersion(tx, createFreeTxFeeHandler(), false)
+	assert.Equal(t, process.ErrTimeLockNotAllowed, txi.CheckValidity())
+
+	txi, _ = createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+	assert.Equal(t, process.ErrTimeLockNotAllowed, txi.CheckValidity())
+
+	tx = createVersionedTxForInterceptor(dataTransaction.VersionWithOptions, 0)
+	tx.NotBeforeEpoch = 2
+	txi, _ = createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+	assert.Nil(t, txi.CheckValidity())
+}
+
+func TestInterceptedTransaction_CheckValidityTimeLockTooFarInTheFuture(t *testing.T) {
+	t.Parallel()
+
+	tx := createVersionedTxForInterceptor(dataTransaction.VersionWithOptions, 0)
+	tx.NotBeforeRound = 101
+	txi, _ := createInterceptedTxFromPlainTxWithTimeLockHorizon(tx, createFreeTxFeeHandler(), true, 100, 2)
+	assert.Equal(t, process.ErrTimeLockTooFarInTheFuture, txi.CheckValidity())
+
+	tx.NotBeforeRound = 0
+	tx.NotBeforeEpoch = 3
+	txi, _ = createInterceptedTxFromPlainTxWithTimeLockHorizon(tx, createFreeTxFeeHandler(), true, 100, 2)
+	assert.Equal(t, process.ErrTimeLockTooFarInTheFuture, txi.CheckValidity())
+
+	tx.NotBeforeRound = 100
+	tx.NotBeforeEpoch = 2
+	txi, _ = createInterceptedTxFromPlainTxWithTimeLockHorizon(tx, createFreeTxFeeHandler(), true, 100, 2)
+	assert.Nil(t, txi.CheckValidity())
+}
+
+func TestInterceptedTransaction_CheckValidityNonceLane(t *testing.T) {
+	t.Parallel()
+
+	tx := createVersionedTxForInterceptor(dataTransaction.InitialVersion, 0)
+	tx.NonceLane = 1
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+	assert.Equal(t, process.ErrInvalidTransactionVersion, txi.CheckValidity())
+
+	tx = createVersionedTxForInterceptor(dataTransaction.VersionWithOptions, 0)
+	tx.NonceLane = 1
+	txi, _ = createInterceptedTxFromPlainTxWithTxVersion(tx, createFreeTxFeeHandler(), false)
+	assert.Equal(t, process.ErrInvalidTransactionVersion, txi.CheckValidity())
+
+	txi, _ = createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+	assert.Nil(t, txi.CheckValidity())
+	assert.Equal(t, uint32(1), txi.NonceLane())
+
+	tx.NonceLane = core.MaxNonceLanes
+	txi, _ = createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+	assert.Equal(t, process.ErrInvalidNonceLane, txi.CheckValidity())
+
+	tx.NonceLane = 1
+	tx.RcvAddr = make([]byte, 32)
+	txi, _ = createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+	assert.Equal(t, process.ErrNonceLaneNotAllowed, txi.CheckValidity())
+}
+
+func TestInterceptedTransaction_CheckValiditySignedWithHashShouldVerifyTheHash(t *testing.T) {
+	t.Parallel()
+
+	tx := createVersionedTxForInterceptor(dataTransaction.VersionWithOptions, dataTransaction.MaskSignedWithHash)
+	marshalizer := &mock.MarshalizerMock{}
+	txBuff, _ := marshalizer.Marshal(tx)
+
+	pubkeyConverter := &mock.PubkeyConverterStub{}
+	dataForSigning, _ := tx.GetDataForSigning(pubkeyConverter, marshalizer)
+	hasher := mock.HasherMock{}
+	expectedSignedMessage := hasher.Compute(string(dataForSigning))
+
+	var signedMessage []byte
+	signer := &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			signedMessage = msg
+			return nil
+		},
+	}
+
+	txi, _ := transaction.NewInterceptedTransaction(
+		txBuff,
+		marshalizer,
+		marshalizer,
+		hasher,
+		createKeyGenMock(),
+		signer,
+		pubkeyConverter,
+		mock.NewMultipleShardsCoordinatorMock(),
+		createFreeTxFeeHandler(),
+		&mock.WhiteListHandlerStub{},
+		true,
+		&mock.MultisigAccountsHandlerStub{},
+		math.MaxUint64,
+		math.MaxUint32,
+	)
+
+	err := txi.CheckValidity()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSignedMessage, signedMessage)
+}
+
+func createRelayedTxForInterceptor(userTx *dataTransaction.Transaction) *dataTransaction.Transaction {
+	userTxBuff, _ := (&mock.MarshalizerMock{}).Marshal(userTx)
+
+	return &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(0).Set(userTx.Value),
+		Data:      []byte(core.RelayedTransaction + "@" + hex.EncodeToString(userTxBuff)),
+		GasLimit:  userTx.GasLimit,
+		GasPrice:  userTx.GasPrice,
+		RcvAddr:   userTx.SndAddr,
+		SndAddr:   []byte("relayer"),
+		Signature: sigOk,
+	}
+}
+
+func createUserTxForInterceptor() *dataTransaction.Transaction {
+	return &dataTransaction.Transaction{
+		Nonce:     2,
+		Value:     big.NewInt(2),
+		Data:      []byte("data"),
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+	}
+}
+
+func TestInterceptedTransaction_CheckValidityRelayedTxOkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tx := createRelayedTxForInterceptor(createUserTxForInterceptor())
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Nil(t, err)
+}
+
+func TestInterceptedTransaction_CheckValidityRelayedTxInvalidUserSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	userTx := createUserTxForInterceptor()
+	userTx.Signature = []byte("wrong signature")
+	tx := createRelayedTxForInterceptor(userTx)
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, errSignerMockVerifySigFails, err)
+}
+
+func TestInterceptedTransaction_CheckValidityRelayedTxValueMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := createRelayedTxForInterceptor(createUserTxForInterceptor())
+	tx.Value = big.NewInt(3)
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrRelayedTxValueDoesNotMatchUserTxValue, err)
+}
+
+func TestInterceptedTransaction_CheckValidityRecursiveRelayedTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	userTx := createRelayedTxForInterceptor(createUserTxForInterceptor())
+	userTx.SndAddr = senderAddress
+	tx := createRelayedTxForInterceptor(userTx)
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrRecursiveRelayedTxIsNotAllowed, err)
+}
+
+func createMultisigTxForInterceptor(signatures [][]byte) *dataTransaction.Transaction {
+	return &dataTransaction.Transaction{
+		Nonce:      1,
+		Value:      big.NewInt(2),
+		Data:       []byte("data"),
+		GasLimit:   3,
+		GasPrice:   4,
+		RcvAddr:    recvAddress,
+		SndAddr:    senderAddress,
+		Signatures: signatures,
+	}
+}
+
+// createMultisigSigner accepts all the signatures built from sigOk, so the signers of a multisig account can
+// provide distinct valid signatures
+func createMultisigSigner() crypto.SingleSigner {
+	return &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			if !bytes.HasPrefix(sig, sigOk) {
+				return errSignerMockVerifySigFails
+			}
+			return nil
+		},
+	}
+}
+
+func createInterceptedTxWithMultisigAccounts(
+	tx *dataTransaction.Transaction,
+	signers [][]byte,
+	threshold uint32,
+) *transaction.InterceptedTransaction {
+	return createInterceptedTxWithMultisigAccountsAndWhiteLister(tx, signers, threshold, &mock.WhiteListHandlerStub{})
+}
+
+func createInterceptedTxWithMultisigAccountsAndWhiteLister(
+	tx *dataTransaction.Transaction,
+	signers [][]byte,
+	threshold uint32,
+	whiteListerVerifiedTxs process.WhiteListHandler,
+) *transaction.InterceptedTransaction {
+	marshalizer := &mock.MarshalizerMock{}
+	txBuff, _ := marshalizer.Marshal(tx)
+
+	multisigAccounts := &mock.MultisigAccountsHandlerStub{
+		GetMultisigSignersCalled: func(address []byte) ([][]byte, uint32, error) {
+			if bytes.Equal(address, senderAddress) {
+				return signers, threshold, nil
+			}
+
+			return nil, 0, nil
+		},
+	}
+
+	txi, _ := transaction.NewInterceptedTransaction(
+		txBuff,
+		marshalizer,
+		marshalizer,
+		mock.HasherMock{},
+		createKeyGenMock(),
+		createMultisigSigner(),
+		&mock.PubkeyConverterStub{},
+		mock.NewOneShardCoordinatorMock(),
+		createFreeTxFeeHandler(),
+		whiteListerVerifiedTxs,
+		true,
+		multisigAccounts,
+		math.MaxUint64,
+		math.MaxUint32,
+	)
+
+	return txi
+}
+
+func createMultisigSignatures() [][]byte {
+	return [][]byte{
+		append([]byte{}, append(sigOk, '1')...),
+		append([]byte{}, append(sigOk, '2')...),
+		append([]byte{}, append(sigOk, '3')...),
+	}
+}
+
+func createMultisigSigners() [][]byte {
+	return [][]byte{[]byte("signer1"), []byte("signer2"), []byte("signer3")}
+}
+
+func TestInterceptedTransaction_CheckValidityBothSignatureAndSignaturesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := createMultisigTxForInterceptor(createMultisigSignatures())
+	tx.Signature = sigOk
+	txi := createInterceptedTxWithMultisigAccounts(tx, createMultisigSigners(), 2)
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrBothSignatureAndSignaturesSet, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultisigEnoughSignaturesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sigs := createMultisigSignatures()
+	tx := createMultisigTxForInterceptor([][]byte{sigs[0], nil, sigs[2]})
+	whiteListed := false
+	whiteLister := &mock.WhiteListHandlerStub{
+		AddCalled: func(keys [][]byte) {
+			whiteListed = true
+		},
+	}
+	txi := createInterceptedTxWithMultisigAccountsAndWhiteLister(tx, createMultisigSigners(), 2, whiteLister)
+
+	err := txi.CheckValidity()
+
+	assert.Nil(t, err)
+	assert.True(t, whiteListed)
+}
+
+func TestInterceptedTransaction_CheckValidityMultisigNotEnoughSignaturesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sigs := createMultisigSignatures()
+	tx := createMultisigTxForInterceptor([][]byte{nil, sigs[1], nil})
+	txi := createInterceptedTxWithMultisigAccounts(tx, createMultisigSigners(), 2)
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrNotEnoughMultisigSignatures, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultisigInvalidSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sigs := createMultisigSignatures()
+	tx := createMultisigTxForInterceptor([][]byte{sigs[0], []byte("wrong signature"), sigs[2]})
+	txi := createInterceptedTxWithMultisigAccounts(tx, createMultisigSigners(), 2)
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, errSignerMockVerifySigFails, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultisigTooManySignaturesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sigs := createMultisigSignatures()
+	tx := createMultisigTxForInterceptor(append(sigs, append(sigOk, '4')))
+	txi := createInterceptedTxWithMultisigAccounts(tx, createMultisigSigners(), 2)
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrNonCanonicalMultisigSignatures, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultisigTooFewSignaturesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sigs := createMultisigSignatures()
+	tx := createMultisigTxForInterceptor(sigs[:2])
+	txi := createInterceptedTxWithMultisigAccounts(tx, createMultisigSigners(), 2)
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrNonCanonicalMultisigSignatures, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultisigDuplicatedSignaturesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sigs := createMultisigSignatures()
+	tx := createMultisigTxForInterceptor([][]byte{sigs[0], sigs[0], nil})
+	txi := createInterceptedTxWithMultisigAccounts(tx, createMultisigSigners(), 2)
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrNonCanonicalMultisigSignatures, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultisigAccountWithSingleSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := createMultisigTxForInterceptor(nil)
+	tx.Signature = sigOk
+	txi := createInterceptedTxWithMultisigAccounts(tx, createMultisigSigners(), 2)
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrMultisigAccountNeedsMultipleSignatures, err)
+}
+
+func TestInterceptedTransaction_CheckValidityRegularAccountWithSignaturesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sigs := createMultisigSignatures()
+	tx := createMultisigTxForInterceptor(sigs[:2])
+	txi := createInterceptedTxWithMultisigAccounts(tx, nil, 0)
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrAccountIsNotMultisig, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultisigSenderInOtherShardShouldNotVerifyNorWhiteList(t *testing.T) {
+	t.Parallel()
+
+	tx := createMultisigTxForInterceptor([][]byte{[]byte("wrong signature")})
+	marshalizer := &mock.MarshalizerMock{}
+	txBuff, _ := marshalizer.Marshal(tx)
+	shardCoordinator := mock.NewMultipleShardsCoordinatorMock()
+	shardCoordinator.CurrentShard = 6
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, senderAddress) {
+			return senderShard
+		}
+
+		return shardCoordinator.CurrentShard
+	}
+	whiteLister := &mock.WhiteListHandlerStub{
+		AddCalled: func(keys [][]byte) {
+			assert.Fail(t, "should have not white listed the transaction")
+		},
+	}
+	txi, _ := transaction.NewInterceptedTransaction(
+		txBuff,
+		marshalizer,
+		marshalizer,
+		mock.HasherMock{},
+		createKeyGenMock(),
+		createDummySigner(),
+		&mock.PubkeyConverterStub{},
+		shardCoordinator,
+		createFreeTxFeeHandler(),
+		whiteLister,
+		true,
+		&mock.MultisigAccountsHandlerStub{},
+		math.MaxUint64,
+		math.MaxUint32,
+	)
+
+	err := txi.CheckValidity()
+
+	assert.Nil(t, err)
+}
+
+func createCrossShardRelayedInterceptedTx(
+	tx *dataTransaction.Transaction,
+	selfShard uint32,
+	multisigAccounts process.MultisigAccountsHandler,
+	whiteListerVerifiedTxs process.WhiteListHandler,
+) *transaction.InterceptedTransaction {
+	marshalizer := &mock.MarshalizerMock{}
+	txBuff, _ := marshalizer.Marshal(tx)
+	shardCoordinator := mock.NewMultipleShardsCoordinatorMock()
+	shardCoordinator.CurrentShard = selfShard
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, senderAddress) {
+			return 1
+		}
+
+		return 0
+	}
+
+	txi, _ := transaction.NewInterceptedTransaction(
+		txBuff,
+		marshalizer,
+		marshalizer,
+		mock.HasherMock{},
+		createKeyGenMock(),
+		createMultisigSigner(),
+		&mock.PubkeyConverterStub{},
+		shardCoordinator,
+		createFreeTxFeeHandler(),
+		whiteListerVerifiedTxs,
+		true,
+		multisigAccounts,
+		math.MaxUint64,
+		math.MaxUint32,
+	)
+
+	return txi
+}
+
+func TestInterceptedTransaction_CheckValidityCrossShardRelayedTxWithMultisigUserShouldErr(t *testing.T) {
+	t.Parallel()
+
+	userTx := createMultisigTxForInterceptor(createMultisigSignatures())
+	tx := createRelayedTxForInterceptor(userTx)
+	multisigAccounts := &mock.MultisigAccountsHandlerStub{
+		GetMultisigSignersCalled: func(address []byte) ([][]byte, uint32, error) {
+			if bytes.Equal(address, senderAddress) {
+				return createMultisigSigners(), 2, nil
+			}
+
+			return nil, 0, nil
+		},
+	}
+
+	relayerShard, userShard := uint32(0), uint32(1)
+	for _, selfShard := range []uint32{relayerShard, userShard} {
+		txi := createCrossShardRelayedInterceptedTx(tx, selfShard, multisigAccounts, &mock.WhiteListHandlerStub{})
+
+		err := txi.CheckValidity()
+
+		assert.Equal(t, process.ErrCrossShardRelayedTxWithMultipleSignatures, err)
+	}
+}
+
+func TestInterceptedTransaction_CheckValidityCrossShardRelayedTxShouldNotReadTheUserMultisigSettings(t *testing.T) {
+	t.Parallel()
+
+	tx := createRelayedTxForInterceptor(createUserTxForInterceptor())
+	multisigAccounts := &mock.MultisigAccountsHandlerStub{
+		GetMultisigSignersCalled: func(address []byte) ([][]byte, uint32, error) {
+			if bytes.Equal(address, senderAddress) {
+				assert.Fail(t, "should have not read the multisig settings of the user")
+			}
+
+			return nil, 0, nil
+		},
+	}
+
+	relayerShard, userShard := uint32(0), uint32(1)
+	for _, selfShard := range []uint32{relayerShard, userShard} {
+		whiteListed := false
+		whiteLister := &mock.WhiteListHandlerStub{
+			AddCalled: func(keys [][]byte) {
+				whiteListed = true
+			},
+		}
+		txi := createCrossShardRelayedInterceptedTx(tx, selfShard, multisigAccounts, whiteLister)
+
+		err := txi.CheckValidity()
+
+		assert.Nil(t, err)
+		assert.True(t, whiteListed)
+	}
+}
+
+func TestInterceptedTransaction_CheckValidityCrossShardRelayedTxInvalidUserSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	userTx := createUserTxForInterceptor()
+	userTx.Signature = []byte("wrong signature")
+	tx := createRelayedTxForInterceptor(userTx)
+
+	relayerShard, userShard := uint32(0), uint32(1)
+	for _, selfShard := range []uint32{relayerShard, userShard} {
+		txi := createCrossShardRelayedInterceptedTx(tx, selfShard, &mock.MultisigAccountsHandlerStub{}, &mock.WhiteListHandlerStub{})
+
+		err := txi.CheckValidity()
+
+		assert.Equal(t, errSignerMockVerifySigFails, err)
+	}
+}
+
 func TestInterceptedTransaction_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
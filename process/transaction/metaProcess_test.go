@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	txproc "github.com/ElrondNetwork/elrond-go/process/transaction"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
@@ -426,10 +427,12 @@ func TestMetaTxProcessor_ProcessTransactionScTxShouldNotBeCalledWhenAdrDstIsNotI
 	}
 
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  createMockPubkeyConverter(),
-		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: make(map[string]struct{}),
-		ArgumentParser:   vmcommon.NewAtArgumentParser(),
+		PubkeyConverter:      createMockPubkeyConverter(),
+		ShardCoordinator:     shardCoordinator,
+		BuiltInFunctions:     builtInFunctions.NewBuiltInFunctionContainer(),
+		ArgumentParser:       vmcommon.NewAtArgumentParser(),
+		EpochNotifier:        &mock.EpochNotifierStub{},
+		RelayedTxEnableEpoch: 0,
 	}
 	computeType, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 
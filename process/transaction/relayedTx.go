@@ -0,0 +1,404 @@
+package transaction
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+)
+
+const relayedTxDataSeparator = "@"
+
+// isRelayedTxData returns true if the data field has the form "relayedTx@<hex encoded, marshalized user transaction>"
+func isRelayedTxData(txData []byte) bool {
+	return strings.HasPrefix(string(txData), core.RelayedTransaction+relayedTxDataSeparator)
+}
+
+// getUserTxFromRelayedTxData extracts the user transaction carried by the data field of a relayed transaction
+func getUserTxFromRelayedTxData(marshalizer marshal.Marshalizer, txData []byte) (*transaction.Transaction, error) {
+	tokens := strings.Split(string(txData), relayedTxDataSeparator)
+	if len(tokens) != 2 || tokens[0] != core.RelayedTransaction {
+		return nil, process.ErrInvalidRelayedTxData
+	}
+
+	userTxBuff, err := hex.DecodeString(tokens[1])
+	if err != nil {
+		return nil, process.ErrInvalidRelayedTxData
+	}
+
+	userTx := &transaction.Transaction{}
+	err = marshalizer.Unmarshal(userTx, userTxBuff)
+	if err != nil {
+		return nil, process.ErrInvalidRelayedTxData
+	}
+
+	return userTx, nil
+}
+
+// checkRelayedTx verifies that the relayed transaction is able to carry the user transaction: the user has to be the
+// receiver of the relayed transaction, the values and the gas prices have to match, while the gas limit of the relayed
+// transaction has to cover its own move balance cost and the gas limit of the user transaction
+func checkRelayedTx(relayedTx *transaction.Transaction, userTx *transaction.Transaction, feeHandler process.FeeHandler) error {
+	if isRelayedTxData(userTx.Data) {
+		return process.ErrRecursiveRelayedTxIsNotAllowed
+	}
+	if relayedTx.UsesNonceLane() || userTx.UsesNonceLane() {
+		return process.ErrNonceLaneNotAllowed
+	}
+	if !bytes.Equal(userTx.SndAddr, relayedTx.RcvAddr) {
+		return process.ErrRelayedTxBeneficiaryDoesNotMatchReceiver
+	}
+	if userTx.Value == nil || relayedTx.Value == nil || userTx.Value.Cmp(relayedTx.Value) != 0 {
+		return process.ErrRelayedTxValueDoesNotMatchUserTxValue
+	}
+	if userTx.GasPrice != relayedTx.GasPrice {
+		return process.ErrRelayedTxGasPriceMismatch
+	}
+
+	relayerGasLimit := feeHandler.ComputeGasLimit(relayedTx)
+	if relayedTx.GasLimit < relayerGasLimit || relayedTx.GasLimit-relayerGasLimit < userTx.GasLimit {
+		return process.ErrRelayedTxGasLimitTooLow
+	}
+
+	return nil
+}
+
+// processRelayedTx charges the relayer, in its own shard, for the value and the gas of the relayed transaction.
+// In the shard of the user, the user is credited with the value and the gas of the user transaction, which is then
+// executed as if it was sent by the user
+func (txProc *txProcessor) processRelayedTx(
+	tx *transaction.Transaction,
+	txHash []byte,
+	acntRelayer, acntUser state.UserAccountHandler,
+) error {
+	userTx, err := getUserTxFromRelayedTxData(txProc.marshalizer, tx.Data)
+	if err == nil {
+		err = checkRelayedTx(tx, userTx, txProc.economicsFee)
+	}
+	if err != nil {
+		return txProc.executingFailedTransaction(tx, txHash, acntRelayer, err)
+	}
+
+	userTxFee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(userTx.GasPrice), big.NewInt(0).SetUint64(userTx.GasLimit))
+	userCredit := big.NewInt(0).Add(tx.Value, userTxFee)
+
+	if !check.IfNil(acntRelayer) {
+		isUserInSelfShard := !check.IfNil(acntUser)
+		if isUserInSelfShard {
+			err = checkUserTxNonce(userTx, acntUser)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = txProc.chargeRelayer(tx, txHash, acntRelayer, userCredit)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the user transaction is executed in the shard of the user
+	if check.IfNil(acntUser) {
+		return nil
+	}
+
+	err = acntUser.AddToBalance(userCredit)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.accounts.SaveAccount(acntUser)
+	if err != nil {
+		return err
+	}
+
+	return txProc.processUserTx(tx, txHash, userTx, userCredit)
+}
+
+func checkUserTxNonce(userTx *transaction.Transaction, acntUser state.UserAccountHandler) error {
+	if acntUser.GetNonce() < userTx.Nonce {
+		return process.ErrHigherNonceInTransaction
+	}
+	if acntUser.GetNonce() > userTx.Nonce {
+		return process.ErrLowerNonceInTransaction
+	}
+
+	return nil
+}
+
+func (txProc *txProcessor) chargeRelayer(
+	tx *transaction.Transaction,
+	txHash []byte,
+	acntRelayer state.UserAccountHandler,
+	userCredit *big.Int,
+) error {
+	relayerFee := txProc.economicsFee.ComputeFee(tx)
+	cost := big.NewInt(0).Add(relayerFee, userCredit)
+
+	err := acntRelayer.SubFromBalance(cost)
+	if err != nil {
+		return err
+	}
+
+	acntRelayer.IncreaseNonce(1)
+	err = txProc.accounts.SaveAccount(acntRelayer)
+	if err != nil {
+		return err
+	}
+
+	txProc.txFeeHandler.ProcessTransactionFee(relayerFee, big.NewInt(0), txHash)
+
+	return nil
+}
+
+func (txProc *txProcessor) processUserTx(
+	relayedTx *transaction.Transaction,
+	relayedTxHash []byte,
+	userTx *transaction.Transaction,
+	userCredit *big.Int,
+) error {
+	acntSnd, acntDst, err := txProc.getAccounts(userTx.SndAddr, userTx.RcvAddr)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.checkTxValues(userTx, acntSnd, acntDst)
+	if err != nil {
+		return txProc.refundRelayer(relayedTx, relayedTxHash, acntSnd, userCredit, err)
+	}
+
+	err = txProc.checkUserTxSignaturesKind(userTx, acntSnd)
+	if err != nil {
+		return txProc.refundRelayer(relayedTx, relayedTxHash, acntSnd, userCredit, err)
+	}
+
+	txType := txProc.txTypeHandler.ComputeTransactionType(userTx)
+	isUserTxCrossShard := txProc.shardCoordinator.ComputeId(userTx.RcvAddr) != txProc.shardCoordinator.SelfId()
+	if isUserTxCrossShard && txType != process.SCDeployment {
+		return txProc.processUserTxCrossShard(relayedTx, relayedTxHash, userTx, acntSnd, userCredit)
+	}
+
+	switch txType {
+	case process.MoveBalance:
+		userTxHash, errHash := core.CalculateHash(txProc.marshalizer, txProc.hasher, userTx)
+		if errHash != nil {
+			return errHash
+		}
+
+		err = txProc.processMoveBalance(userTx, userTxHash, userTx.SndAddr, userTx.RcvAddr)
+		if err != nil {
+			return err
+		}
+
+		return txProc.returnUnusedGasToRelayer(relayedTx, relayedTxHash, userTx, 0)
+	case process.SCDeployment, process.SCInvoking, process.BuiltInFunctionCall:
+		// the user transaction is executed as a smart contract result carrying the relayer, so that the unused gas
+		// and the returned value go back to the relayer
+		scr := createSCRFromUserTx(relayedTx, relayedTxHash, userTx, userTx.GasLimit)
+		err = txProc.scrForwarder.AddIntermediateTransactions([]data.TransactionHandler{scr})
+		if err != nil {
+			return err
+		}
+
+		if txType == process.SCDeployment {
+			return txProc.scProcessor.DeploySmartContract(scr, acntSnd)
+		}
+
+		return txProc.scProcessor.ExecuteSmartContractTransaction(scr, acntSnd, acntDst)
+	}
+
+	return txProc.refundRelayer(relayedTx, relayedTxHash, acntSnd, userCredit, process.ErrWrongTransaction)
+}
+
+// checkUserTxSignaturesKind verifies that the user transaction is signed by multiple signers only if the user is a
+// multisig account. The interceptors of the relayer shard can not read the multisig settings of a user from another
+// shard, so a mismatch is turned into a refund to the relayer instead of rejecting an already notarized transaction
+func (txProc *txProcessor) checkUserTxSignaturesKind(userTx *transaction.Transaction, acntUser state.UserAccountHandler) error {
+	isMultisigAccount, err := builtInFunctions.IsMultisigAccount(acntUser, txProc.marshalizer)
+	if err != nil {
+		return err
+	}
+
+	hasMultipleSignatures := len(userTx.Signatures) > 0
+	if isMultisigAccount && !hasMultipleSignatures {
+		return process.ErrMultisigAccountNeedsMultipleSignatures
+	}
+	if !isMultisigAccount && hasMultipleSignatures {
+		return process.ErrAccountIsNotMultisig
+	}
+
+	return nil
+}
+
+// createSCRFromUserTx creates the smart contract result which executes the user transaction on behalf of the user,
+// providing the given gas limit. Any refund of the result goes to the relayer
+func createSCRFromUserTx(
+	relayedTx *transaction.Transaction,
+	relayedTxHash []byte,
+	userTx *transaction.Transaction,
+	gasLimit uint64,
+) *smartContractResult.SmartContractResult {
+	return &smartContractResult.SmartContractResult{
+		Nonce:          userTx.Nonce,
+		Value:          big.NewInt(0).Set(userTx.Value),
+		RcvAddr:        userTx.RcvAddr,
+		SndAddr:        userTx.SndAddr,
+		Data:           userTx.Data,
+		GasLimit:       gasLimit,
+		GasPrice:       userTx.GasPrice,
+		PrevTxHash:     relayedTxHash,
+		OriginalTxHash: relayedTxHash,
+		RelayerAddr:    relayedTx.SndAddr,
+	}
+}
+
+// processUserTxCrossShard charges the user and forwards the user transaction to the shard of its receiver, as a
+// smart contract result carrying the value, the data and the gas left after the move balance cost
+func (txProc *txProcessor) processUserTxCrossShard(
+	relayedTx *transaction.Transaction,
+	relayedTxHash []byte,
+	userTx *transaction.Transaction,
+	acntUser state.UserAccountHandler,
+	userCredit *big.Int,
+) error {
+	isToMetachain := txProc.shardCoordinator.ComputeId(userTx.RcvAddr) == core.MetachainShardId
+	if isToMetachain && len(userTx.Data) == 0 {
+		return txProc.refundRelayer(relayedTx, relayedTxHash, acntUser, userCredit, process.ErrInvalidMetaTransaction)
+	}
+
+	gasForReceiverShard := uint64(0)
+	if len(userTx.Data) > 0 {
+		gasForReceiverShard = userTx.GasLimit - txProc.economicsFee.ComputeGasLimit(userTx)
+	}
+
+	moveBalanceFee := txProc.economicsFee.ComputeFee(userTx)
+	cost := big.NewInt(0).Mul(big.NewInt(0).SetUint64(userTx.GasPrice), big.NewInt(0).SetUint64(gasForReceiverShard))
+	cost.Add(cost, moveBalanceFee)
+	cost.Add(cost, userTx.Value)
+
+	err := acntUser.SubFromBalance(cost)
+	if err != nil {
+		return err
+	}
+
+	acntUser.IncreaseNonce(1)
+	err = txProc.accounts.SaveAccount(acntUser)
+	if err != nil {
+		return err
+	}
+
+	scr := createSCRFromUserTx(relayedTx, relayedTxHash, userTx, gasForReceiverShard)
+	err = txProc.scrForwarder.AddIntermediateTransactions([]data.TransactionHandler{scr})
+	if err != nil {
+		return err
+	}
+
+	txProc.txFeeHandler.ProcessTransactionFee(moveBalanceFee, big.NewInt(0), relayedTxHash)
+
+	return txProc.returnUnusedGasToRelayer(relayedTx, relayedTxHash, userTx, gasForReceiverShard)
+}
+
+// returnUnusedGasToRelayer gives back to the relayer the gas of the user transaction which was neither consumed by
+// the move balance cost nor forwarded to the shard of the receiver
+func (txProc *txProcessor) returnUnusedGasToRelayer(
+	relayedTx *transaction.Transaction,
+	relayedTxHash []byte,
+	userTx *transaction.Transaction,
+	gasForwarded uint64,
+) error {
+	gasPrice := big.NewInt(0).SetUint64(userTx.GasPrice)
+	unusedValue := big.NewInt(0).Mul(gasPrice, big.NewInt(0).SetUint64(userTx.GasLimit-gasForwarded))
+	unusedValue.Sub(unusedValue, txProc.economicsFee.ComputeFee(userTx))
+	if unusedValue.Sign() <= 0 {
+		return nil
+	}
+
+	acntUser, err := txProc.getAccountFromAddress(userTx.SndAddr)
+	if err != nil {
+		return err
+	}
+
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:          relayedTx.Nonce,
+		Value:          unusedValue,
+		RcvAddr:        relayedTx.SndAddr,
+		SndAddr:        relayedTx.RcvAddr,
+		PrevTxHash:     relayedTxHash,
+		OriginalTxHash: relayedTxHash,
+		GasPrice:       userTx.GasPrice,
+	}
+
+	return txProc.giveBackToRelayer(relayedTx, acntUser, scr)
+}
+
+// refundRelayer gives back to the relayer the value and the gas of a user transaction which could not be executed.
+// The relayer keeps paying for the relayed transaction itself
+func (txProc *txProcessor) refundRelayer(
+	relayedTx *transaction.Transaction,
+	relayedTxHash []byte,
+	acntUser state.UserAccountHandler,
+	userCredit *big.Int,
+	txErr error,
+) error {
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:          relayedTx.Nonce,
+		Value:          big.NewInt(0).Set(userCredit),
+		RcvAddr:        relayedTx.SndAddr,
+		SndAddr:        relayedTx.RcvAddr,
+		Data:           []byte("@" + hex.EncodeToString([]byte(txErr.Error())) + "@" + hex.EncodeToString(relayedTxHash)),
+		PrevTxHash:     relayedTxHash,
+		OriginalTxHash: relayedTxHash,
+		ReturnMessage:  []byte(txErr.Error()),
+	}
+
+	return txProc.giveBackToRelayer(relayedTx, acntUser, scr)
+}
+
+// giveBackToRelayer moves the value of the provided smart contract result from the user to the relayer
+func (txProc *txProcessor) giveBackToRelayer(
+	relayedTx *transaction.Transaction,
+	acntUser state.UserAccountHandler,
+	scr *smartContractResult.SmartContractResult,
+) error {
+	if !check.IfNil(acntUser) {
+		err := acntUser.SubFromBalance(scr.Value)
+		if err != nil {
+			return err
+		}
+
+		err = txProc.accounts.SaveAccount(acntUser)
+		if err != nil {
+			return err
+		}
+	}
+
+	acntRelayer, err := txProc.getAccountFromAddress(relayedTx.SndAddr)
+	if err != nil {
+		return err
+	}
+
+	// a relayer from another shard is refunded when the smart contract result reaches its shard
+	if !check.IfNil(acntRelayer) {
+		err = acntRelayer.AddToBalance(scr.Value)
+		if err != nil {
+			return err
+		}
+
+		err = txProc.accounts.SaveAccount(acntRelayer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return txProc.scrForwarder.AddIntermediateTransactions([]data.TransactionHandler{scr})
+}
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"math/big"
 
+	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/receipt"
@@ -16,15 +18,20 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
+var log = logger.GetOrCreate("process/transaction")
+
 var _ process.TransactionProcessor = (*txProcessor)(nil)
 
 // txProcessor implements TransactionProcessor interface and can modify account states according to a transaction
 type txProcessor struct {
 	*baseTxProcessor
-	txFeeHandler     process.TransactionFeeHandler
-	txTypeHandler    process.TxTypeHandler
-	receiptForwarder process.IntermediateTransactionHandler
-	badTxForwarder   process.IntermediateTransactionHandler
+	txFeeHandler          process.TransactionFeeHandler
+	txTypeHandler         process.TxTypeHandler
+	receiptForwarder      process.IntermediateTransactionHandler
+	badTxForwarder        process.IntermediateTransactionHandler
+	scrForwarder          process.IntermediateTransactionHandler
+	nonceLanesEnableEpoch uint32
+	flagNonceLanes        atomic.Flag
 }
 
 // NewTxProcessor creates a new txProcessor engine
@@ -40,6 +47,9 @@ func NewTxProcessor(
 	economicsFee process.FeeHandler,
 	receiptForwarder process.IntermediateTransactionHandler,
 	badTxForwarder process.IntermediateTransactionHandler,
+	scrForwarder process.IntermediateTransactionHandler,
+	epochNotifier process.EpochNotifier,
+	nonceLanesEnableEpoch uint32,
 ) (*txProcessor, error) {
 
 	if check.IfNil(accounts) {
@@ -75,6 +85,12 @@ func NewTxProcessor(
 	if check.IfNil(badTxForwarder) {
 		return nil, process.ErrNilBadTxHandler
 	}
+	if check.IfNil(scrForwarder) {
+		return nil, process.ErrNilIntermediateTransactionHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
 
 	baseTxProcess := &baseTxProcessor{
 		accounts:         accounts,
@@ -86,13 +102,18 @@ func NewTxProcessor(
 		scProcessor:      scProcessor,
 	}
 
-	return &txProcessor{
-		baseTxProcessor:  baseTxProcess,
-		txFeeHandler:     txFeeHandler,
-		txTypeHandler:    txTypeHandler,
-		receiptForwarder: receiptForwarder,
-		badTxForwarder:   badTxForwarder,
-	}, nil
+	txProc := &txProcessor{
+		baseTxProcessor:       baseTxProcess,
+		txFeeHandler:          txFeeHandler,
+		txTypeHandler:         txTypeHandler,
+		receiptForwarder:      receiptForwarder,
+		badTxForwarder:        badTxForwarder,
+		scrForwarder:          scrForwarder,
+		nonceLanesEnableEpoch: nonceLanesEnableEpoch,
+	}
+	epochNotifier.RegisterNotifyHandler(txProc)
+
+	return txProc, nil
 }
 
 // ProcessTransaction modifies the account states in respect with the transaction data
@@ -101,6 +122,18 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction) error
 		return process.ErrNilTransaction
 	}
 
+	txHash, err := core.CalculateHash(txProc.marshalizer, txProc.hasher, tx)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.processTransaction(tx, txHash)
+	txProc.createTransactionReceipt(txHash, tx, err)
+
+	return err
+}
+
+func (txProc *txProcessor) processTransaction(tx *transaction.Transaction, txHash []byte) error {
 	acntSnd, acntDst, err := txProc.getAccounts(tx.SndAddr, tx.RcvAddr)
 	if err != nil {
 		return err
@@ -113,10 +146,16 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction) error
 		txProc.pubkeyConv,
 	)
 
+	txType := txProc.txTypeHandler.ComputeTransactionType(tx)
+	err = txProc.checkNonceLane(tx, txType)
+	if err != nil {
+		return err
+	}
+
 	err = txProc.checkTxValues(tx, acntSnd, acntDst)
 	if err != nil {
 		if errors.Is(err, process.ErrInsufficientFunds) {
-			receiptErr := txProc.executingFailedTransaction(tx, acntSnd, err)
+			receiptErr := txProc.executingFailedTransaction(tx, txHash, acntSnd, err)
 			if receiptErr != nil {
 				return receiptErr
 			}
@@ -132,23 +171,71 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction) error
 		return err
 	}
 
-	txType := txProc.txTypeHandler.ComputeTransactionType(tx)
 	switch txType {
 	case process.MoveBalance:
-		return txProc.processMoveBalance(tx, tx.SndAddr, tx.RcvAddr)
+		return txProc.processMoveBalance(tx, txHash, tx.SndAddr, tx.RcvAddr)
 	case process.SCDeployment:
 		return txProc.processSCDeployment(tx, tx.SndAddr)
 	case process.SCInvoking:
 		return txProc.processSCInvoking(tx, tx.SndAddr, tx.RcvAddr)
 	case process.BuiltInFunctionCall:
 		return txProc.processSCInvoking(tx, tx.SndAddr, tx.RcvAddr)
+	case process.RelayedTx:
+		return txProc.processRelayedTx(tx, txHash, acntSnd, acntDst)
 	}
 
 	return process.ErrWrongTransaction
 }
 
+// checkNonceLane verifies that the secondary nonce lanes are used only after their activation epoch, only with a
+// valid lane index and only by move balance transactions
+func (txProc *txProcessor) checkNonceLane(tx *transaction.Transaction, txType process.TransactionType) error {
+	if !tx.UsesNonceLane() {
+		return nil
+	}
+	if !txProc.flagNonceLanes.IsSet() {
+		return process.ErrNonceLaneNotAllowed
+	}
+	if tx.NonceLane >= core.MaxNonceLanes {
+		return process.ErrInvalidNonceLane
+	}
+	if txType != process.MoveBalance {
+		return process.ErrNonceLaneNotAllowed
+	}
+
+	return nil
+}
+
+// createTransactionReceipt records the processing status of the transaction in its receipt. Transactions which were
+// not processed at all (the block containing them will be rejected) do not get a receipt.
+func (txProc *txProcessor) createTransactionReceipt(txHash []byte, tx *transaction.Transaction, processingErr error) {
+	var status core.ReceiptStatus
+	switch {
+	case processingErr == nil:
+		status = txProc.computeSuccessfulReceiptStatus(tx)
+	case errors.Is(processingErr, process.ErrFailedTransaction):
+		status = core.ReceiptStatusFailed
+	default:
+		return
+	}
+
+	txProc.txFeeHandler.ProcessTransactionReceipt(txHash, tx, status)
+}
+
+func (txProc *txProcessor) computeSuccessfulReceiptStatus(tx *transaction.Transaction) core.ReceiptStatus {
+	selfShardID := txProc.shardCoordinator.SelfId()
+	isCrossShardFromMe := txProc.shardCoordinator.ComputeId(tx.SndAddr) == selfShardID &&
+		txProc.shardCoordinator.ComputeId(tx.RcvAddr) != selfShardID
+	if isCrossShardFromMe {
+		return core.ReceiptStatusPendingInDestination
+	}
+
+	return core.ReceiptStatusSuccess
+}
+
 func (txProc *txProcessor) executingFailedTransaction(
 	tx *transaction.Transaction,
+	txHash []byte,
 	acntSnd state.UserAccountHandler,
 	txError error,
 ) error {
@@ -162,13 +249,12 @@ func (txProc *txProcessor) executingFailedTransaction(
 		return err
 	}
 
-	acntSnd.IncreaseNonce(1)
-	err = txProc.badTxForwarder.AddIntermediateTransactions([]data.TransactionHandler{tx})
+	err = state.IncreaseNonceForLane(acntSnd, tx.NonceLane)
 	if err != nil {
 		return err
 	}
 
-	txHash, err := core.CalculateHash(txProc.marshalizer, txProc.hasher, tx)
+	err = txProc.badTxForwarder.AddIntermediateTransactions([]data.TransactionHandler{tx})
 	if err != nil {
 		return err
 	}
@@ -258,6 +344,7 @@ func (txProc *txProcessor) processTxFee(
 
 func (txProc *txProcessor) checkIfValidTxToMetaChain(
 	tx *transaction.Transaction,
+	txHash []byte,
 	acntSnd state.UserAccountHandler,
 	adrDst []byte,
 ) error {
@@ -269,7 +356,7 @@ func (txProc *txProcessor) checkIfValidTxToMetaChain(
 
 	// it is not allowed to send transactions to metachain if those are not of type smart contract
 	if len(tx.GetData()) == 0 {
-		return txProc.executingFailedTransaction(tx, acntSnd, process.ErrInvalidMetaTransaction)
+		return txProc.executingFailedTransaction(tx, txHash, acntSnd, process.ErrInvalidMetaTransaction)
 	}
 
 	return nil
@@ -277,6 +364,7 @@ func (txProc *txProcessor) checkIfValidTxToMetaChain(
 
 func (txProc *txProcessor) processMoveBalance(
 	tx *transaction.Transaction,
+	txHash []byte,
 	adrSrc, adrDst []byte,
 ) error {
 
@@ -287,7 +375,7 @@ func (txProc *txProcessor) processMoveBalance(
 		return err
 	}
 
-	err = txProc.checkIfValidTxToMetaChain(tx, acntSrc, adrDst)
+	err = txProc.checkIfValidTxToMetaChain(tx, txHash, acntSrc, adrDst)
 	if err != nil {
 		return err
 	}
@@ -304,12 +392,10 @@ func (txProc *txProcessor) processMoveBalance(
 
 	// is sender address in node shard
 	if acntSrc != nil {
-		acntSrc.IncreaseNonce(1)
-	}
-
-	txHash, err := core.CalculateHash(txProc.marshalizer, txProc.hasher, tx)
-	if err != nil {
-		return err
+		err = state.IncreaseNonceForLane(acntSrc, tx.NonceLane)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = txProc.createReceiptWithReturnedGas(txHash, tx, acntSrc)
@@ -393,6 +479,12 @@ func (txProc *txProcessor) moveBalances(
 	return nil
 }
 
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (txProc *txProcessor) EpochConfirmed(epoch uint32) {
+	txProc.flagNonceLanes.Toggle(epoch >= txProc.nonceLanesEnableEpoch)
+	log.Debug("txProcessor: nonce lanes", "enabled", txProc.flagNonceLanes.IsSet())
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (txProc *txProcessor) IsInterfaceNil() bool {
 	return txProc == nil
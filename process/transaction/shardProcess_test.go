@@ -8,12 +8,15 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	txproc "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/vm/factory"
@@ -72,6 +75,9 @@ func createTxProcessor() txproc.TxProcessor {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	return txProc
@@ -94,6 +100,9 @@ func TestNewTxProcessor_NilAccountsShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilAccountsAdapter, err)
@@ -115,6 +124,9 @@ func TestNewTxProcessor_NilHasherShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilHasher, err)
@@ -136,6 +148,9 @@ func TestNewTxProcessor_NilPubkeyConverterMockShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilPubkeyConverter, err)
@@ -157,6 +172,9 @@ func TestNewTxProcessor_NilMarshalizerMockShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilMarshalizer, err)
@@ -178,6 +196,9 @@ func TestNewTxProcessor_NilShardCoordinatorMockShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
@@ -199,6 +220,9 @@ func TestNewTxProcessor_NilSCProcessorShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilSmartContractProcessor, err)
@@ -220,6 +244,9 @@ func TestNewTxProcessor_NilTxFeeHandlerShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilUnsignedTxHandler, err)
@@ -241,6 +268,9 @@ func TestNewTxProcessor_OkValsShouldWork(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, err)
@@ -266,6 +296,9 @@ func TestTxProcessor_GetAccountsShouldErrNilAddressContainer(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	adr1 := []byte{65}
@@ -295,6 +328,9 @@ func TestTxProcessor_GetAccountsMalfunctionAccountsShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	adr1 := []byte{65}
@@ -341,6 +377,9 @@ func TestTxProcessor_GetAccountsOkValsSrcShouldWork(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
@@ -396,6 +435,9 @@ func TestTxProcessor_GetAccountsOkValsDsthouldWork(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
@@ -436,6 +478,9 @@ func TestTxProcessor_GetAccountsOkValsShouldWork(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	a1, a2, err := execTx.GetAccounts(adr1, adr2)
@@ -467,6 +512,9 @@ func TestTxProcessor_GetSameAccountShouldWork(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	a1, a2, err := execTx.GetAccounts(adr1, adr1)
@@ -646,6 +694,9 @@ func TestTxProcessor_ProcessTransactionMalfunctionAccountsShouldErr(t *testing.T
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	tx := transaction.Transaction{}
@@ -687,6 +738,9 @@ func TestTxProcessor_ProcessCheckNotPassShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx)
@@ -746,6 +800,9 @@ func testProcessCheck(t *testing.T, nonce uint64, value *big.Int) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx)
@@ -787,6 +844,9 @@ func TestTxProcessor_ProcessMoveBalancesShouldWork(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx)
@@ -832,6 +892,9 @@ func TestTxProcessor_ProcessOkValsShouldWork(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx)
@@ -842,6 +905,242 @@ func TestTxProcessor_ProcessOkValsShouldWork(t *testing.T) {
 	assert.Equal(t, 2, saveAccountCalled)
 }
 
+func createTxProcessorForReceiptTests(
+	t *testing.T,
+	tx *transaction.Transaction,
+	shardCoordinator sharding.Coordinator,
+	receiptStatuses map[string]core.ReceiptStatus,
+) process.TransactionProcessor {
+	acntSrc, err := state.NewUserAccount(tx.SndAddr)
+	assert.Nil(t, err)
+	acntSrc.Balance = big.NewInt(100)
+	acntDst, err := state.NewUserAccount(tx.RcvAddr)
+	assert.Nil(t, err)
+
+	adb := createAccountStub(tx.SndAddr, tx.RcvAddr, acntSrc, acntDst)
+	adb.SaveAccountCalled = func(account state.AccountHandler) error {
+		return nil
+	}
+
+	txFeeHandler := &mock.FeeAccumulatorStub{
+		ProcessTransactionReceiptCalled: func(txHash []byte, tx data.TransactionHandler, status core.ReceiptStatus) {
+			receiptStatuses[string(txHash)] = status
+		},
+	}
+
+	execTx, _ := txproc.NewTxProcessor(
+		adb,
+		mock.HasherMock{},
+		createMockPubkeyConverter(),
+		&mock.MarshalizerMock{},
+		shardCoordinator,
+		&mock.SCProcessorMock{},
+		txFeeHandler,
+		&mock.TxTypeHandlerMock{},
+		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
+	)
+
+	return execTx
+}
+
+func TestTxProcessor_ProcessTransactionShouldCreateSuccessfulReceipt(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		SndAddr: []byte("SRC"),
+		RcvAddr: []byte("DST"),
+		Value:   big.NewInt(10),
+	}
+	receiptStatuses := make(map[string]core.ReceiptStatus)
+	execTx := createTxProcessorForReceiptTests(t, tx, mock.NewOneShardCoordinatorMock(), receiptStatuses)
+
+	err := execTx.ProcessTransaction(tx)
+	assert.Nil(t, err)
+
+	txHash, _ := core.CalculateHash(&mock.MarshalizerMock{}, mock.HasherMock{}, tx)
+	assert.Equal(t, core.ReceiptStatusSuccess, receiptStatuses[string(txHash)])
+}
+
+func TestTxProcessor_ProcessTransactionCrossShardShouldCreatePendingReceipt(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		SndAddr: []byte("SRC"),
+		RcvAddr: []byte("DST"),
+		Value:   big.NewInt(10),
+	}
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, tx.RcvAddr) {
+			return 1
+		}
+
+		return 0
+	}
+	receiptStatuses := make(map[string]core.ReceiptStatus)
+	execTx := createTxProcessorForReceiptTests(t, tx, shardCoordinator, receiptStatuses)
+
+	err := execTx.ProcessTransaction(tx)
+	assert.Nil(t, err)
+
+	txHash, _ := core.CalculateHash(&mock.MarshalizerMock{}, mock.HasherMock{}, tx)
+	assert.Equal(t, core.ReceiptStatusPendingInDestination, receiptStatuses[string(txHash)])
+}
+
+func TestTxProcessor_ProcessTransactionWithInsufficientFundsShouldCreateFailedReceipt(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		SndAddr: []byte("SRC"),
+		RcvAddr: []byte("DST"),
+		Value:   big.NewInt(1000),
+	}
+	receiptStatuses := make(map[string]core.ReceiptStatus)
+	execTx := createTxProcessorForReceiptTests(t, tx, mock.NewOneShardCoordinatorMock(), receiptStatuses)
+
+	err := execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+
+	txHash, _ := core.CalculateHash(&mock.MarshalizerMock{}, mock.HasherMock{}, tx)
+	assert.Equal(t, core.ReceiptStatusFailed, receiptStatuses[string(txHash)])
+}
+
+func TestTxProcessor_ProcessTransactionWithWrongNonceShouldNotCreateReceipt(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		Nonce:   5,
+		SndAddr: []byte("SRC"),
+		RcvAddr: []byte("DST"),
+		Value:   big.NewInt(10),
+	}
+	receiptStatuses := make(map[string]core.ReceiptStatus)
+	execTx := createTxProcessorForReceiptTests(t, tx, mock.NewOneShardCoordinatorMock(), receiptStatuses)
+
+	err := execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrHigherNonceInTransaction, err)
+	assert.Equal(t, 0, len(receiptStatuses))
+}
+
+func createTxProcessorForNonceLaneTests(
+	t *testing.T,
+	tx *transaction.Transaction,
+	txTypeHandler process.TxTypeHandler,
+	nonceLanesEnableEpoch uint32,
+) (process.TransactionProcessor, state.UserAccountHandler) {
+	acntSrc, err := state.NewUserAccount(tx.SndAddr)
+	assert.Nil(t, err)
+	acntSrc.Balance = big.NewInt(100)
+	acntSrc.Nonce = 7
+	acntDst, err := state.NewUserAccount(tx.RcvAddr)
+	assert.Nil(t, err)
+
+	adb := createAccountStub(tx.SndAddr, tx.RcvAddr, acntSrc, acntDst)
+	adb.SaveAccountCalled = func(account state.AccountHandler) error {
+		return nil
+	}
+
+	execTx, _ := txproc.NewTxProcessor(
+		adb,
+		mock.HasherMock{},
+		createMockPubkeyConverter(),
+		&mock.MarshalizerMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.FeeAccumulatorStub{},
+		txTypeHandler,
+		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		nonceLanesEnableEpoch,
+	)
+
+	return execTx, acntSrc
+}
+
+func TestTxProcessor_ProcessTransactionOnNonceLaneShouldIncreaseTheLaneNonce(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		Nonce:     0,
+		NonceLane: 3,
+		SndAddr:   []byte("SRC"),
+		RcvAddr:   []byte("DST"),
+		Value:     big.NewInt(10),
+	}
+	execTx, acntSrc := createTxProcessorForNonceLaneTests(t, tx, &mock.TxTypeHandlerMock{}, 0)
+
+	err := execTx.ProcessTransaction(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(7), acntSrc.GetNonce())
+	laneNonce, _ := state.GetNonceForLane(acntSrc, 3)
+	assert.Equal(t, uint64(1), laneNonce)
+
+	err = execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrLowerNonceInTransaction, err)
+}
+
+func TestTxProcessor_ProcessTransactionOnNonceLaneShouldErrIfNotMoveBalance(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		NonceLane: 3,
+		SndAddr:   []byte("SRC"),
+		RcvAddr:   []byte("DST"),
+		Value:     big.NewInt(10),
+	}
+	txTypeHandler := &mock.TxTypeHandlerMock{
+		ComputeTransactionTypeCalled: func(tx data.TransactionHandler) process.TransactionType {
+			return process.SCInvoking
+		},
+	}
+	execTx, acntSrc := createTxProcessorForNonceLaneTests(t, tx, txTypeHandler, 0)
+
+	err := execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrNonceLaneNotAllowed, err)
+	laneNonce, _ := state.GetNonceForLane(acntSrc, 3)
+	assert.Equal(t, uint64(0), laneNonce)
+}
+
+func TestTxProcessor_ProcessTransactionOnNonceLaneBeforeActivationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		NonceLane: 3,
+		SndAddr:   []byte("SRC"),
+		RcvAddr:   []byte("DST"),
+		Value:     big.NewInt(10),
+	}
+	execTx, acntSrc := createTxProcessorForNonceLaneTests(t, tx, &mock.TxTypeHandlerMock{}, 1)
+
+	err := execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrNonceLaneNotAllowed, err)
+	laneNonce, _ := state.GetNonceForLane(acntSrc, 3)
+	assert.Equal(t, uint64(0), laneNonce)
+}
+
+func TestTxProcessor_ProcessTransactionOnInvalidNonceLaneShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		NonceLane: core.MaxNonceLanes,
+		SndAddr:   []byte("SRC"),
+		RcvAddr:   []byte("DST"),
+		Value:     big.NewInt(10),
+	}
+	execTx, _ := createTxProcessorForNonceLaneTests(t, tx, &mock.TxTypeHandlerMock{}, 0)
+
+	err := execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrInvalidNonceLane, err)
+}
+
 func TestTxProcessor_MoveBalanceWithFeesShouldWork(t *testing.T) {
 	saveAccountCalled := 0
 
@@ -890,6 +1189,9 @@ func TestTxProcessor_MoveBalanceWithFeesShouldWork(t *testing.T) {
 		feeHandler,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx)
@@ -951,6 +1253,9 @@ func TestTxProcessor_ProcessTransactionScTxShouldWork(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx)
@@ -1005,6 +1310,9 @@ func TestTxProcessor_ProcessTransactionScTxShouldReturnErrWhenExecutionFails(t *
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx)
@@ -1054,10 +1362,12 @@ func TestTxProcessor_ProcessTransactionScTxShouldNotBeCalledWhenAdrDstIsNotInNod
 	}
 
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  mock.NewPubkeyConverterMock(32),
-		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: make(map[string]struct{}),
-		ArgumentParser:   vmcommon.NewAtArgumentParser(),
+		PubkeyConverter:      mock.NewPubkeyConverterMock(32),
+		ShardCoordinator:     shardCoordinator,
+		BuiltInFunctions:     builtInFunctions.NewBuiltInFunctionContainer(),
+		ArgumentParser:       vmcommon.NewAtArgumentParser(),
+		EpochNotifier:        &mock.EpochNotifierStub{},
+		RelayedTxEnableEpoch: 0,
 	}
 	computeType, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 
@@ -1073,6 +1383,9 @@ func TestTxProcessor_ProcessTransactionScTxShouldNotBeCalledWhenAdrDstIsNotInNod
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx)
@@ -1102,6 +1415,9 @@ func TestTxProcessor_ProcessTxFeeIntraShard(t *testing.T) {
 		},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	tx := &transaction.Transaction{
 		RcvAddr:  []byte("aaa"),
@@ -1142,6 +1458,9 @@ func TestTxProcessor_ProcessTxFeeCrossShardMoveBalance(t *testing.T) {
 		},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	tx := &transaction.Transaction{
 		RcvAddr:  []byte("aaa"),
@@ -1204,6 +1523,9 @@ func TestTxProcessor_ProcessTxFeeCrossShardSCCall(t *testing.T) {
 		},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	scAddress, _ := hex.DecodeString("000000000000000000005fed9c659422cd8429ce92f8973bba2a9fb51e0eb3a1")
@@ -1261,8 +1583,359 @@ func TestTxProcessor_ProcessTransactionShouldReturnErrForInvalidMetaTx(t *testin
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx)
 	assert.Equal(t, err, process.ErrFailedTransaction)
 }
+
+func TestNewTxProcessor_NilScrForwarderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txProc, err := txproc.NewTxProcessor(
+		&mock.AccountsStub{},
+		mock.HasherMock{},
+		createMockPubkeyConverter(),
+		&mock.MarshalizerMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.FeeAccumulatorStub{},
+		&mock.TxTypeHandlerMock{},
+		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		nil,
+		&mock.EpochNotifierStub{},
+		0,
+	)
+
+	assert.Equal(t, process.ErrNilIntermediateTransactionHandler, err)
+	assert.Nil(t, txProc)
+}
+
+func TestNewTxProcessor_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txProc, err := txproc.NewTxProcessor(
+		&mock.AccountsStub{},
+		mock.HasherMock{},
+		createMockPubkeyConverter(),
+		&mock.MarshalizerMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.FeeAccumulatorStub{},
+		&mock.TxTypeHandlerMock{},
+		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		nil,
+		0,
+	)
+
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+	assert.Nil(t, txProc)
+}
+
+//------- relayed transactions
+
+func createRelayedTxToTest(userTx *transaction.Transaction) *transaction.Transaction {
+	userTxBuff, _ := (&mock.MarshalizerMock{}).Marshal(userTx)
+
+	return &transaction.Transaction{
+		Nonce:    7,
+		SndAddr:  []byte("relayer"),
+		RcvAddr:  userTx.SndAddr,
+		Value:    big.NewInt(0).Set(userTx.Value),
+		GasPrice: userTx.GasPrice,
+		GasLimit: userTx.GasLimit + 10,
+		Data:     []byte(core.RelayedTransaction + "@" + hex.EncodeToString(userTxBuff)),
+	}
+}
+
+func createUserTxToTest() *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:    3,
+		SndAddr:  []byte("user"),
+		RcvAddr:  []byte("receiver"),
+		Value:    big.NewInt(5),
+		GasPrice: 1,
+		GasLimit: 20,
+	}
+}
+
+func createUserAccountToTest(address string, nonce uint64, balance int64) state.UserAccountHandler {
+	account, _ := state.NewUserAccount([]byte(address))
+	account.Nonce = nonce
+	account.Balance = big.NewInt(balance)
+
+	return account
+}
+
+func createRelayedTxProcessorToTest(
+	selfShardID uint32,
+	accounts map[string]state.UserAccountHandler,
+	shardOfAddress map[string]uint32,
+	scrForwarder process.IntermediateTransactionHandler,
+	scProcessor process.SmartContractProcessor,
+) process.TransactionProcessor {
+	adb := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, ok := accounts[string(address)]
+			if !ok {
+				return nil, errors.New("failure")
+			}
+			return account, nil
+		},
+	}
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(3)
+	shardCoordinator.CurrentShard = selfShardID
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		return shardOfAddress[string(address)]
+	}
+	feeHandler := &mock.FeeHandlerStub{
+		CheckValidityTxValuesCalled: func(tx process.TransactionWithFeeHandler) error {
+			return nil
+		},
+		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+			return 10
+		},
+		ComputeFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+			return big.NewInt(int64(10 * tx.GetGasPrice()))
+		},
+	}
+	txTypeHandler := &mock.TxTypeHandlerMock{
+		ComputeTransactionTypeCalled: func(tx data.TransactionHandler) process.TransactionType {
+			if bytes.HasPrefix(tx.GetData(), []byte(core.RelayedTransaction)) {
+				return process.RelayedTx
+			}
+			if len(tx.GetData()) > 0 {
+				return process.SCInvoking
+			}
+			return process.MoveBalance
+		},
+	}
+
+	execTx, _ := txproc.NewTxProcessor(
+		adb,
+		mock.HasherMock{},
+		createMockPubkeyConverter(),
+		&mock.MarshalizerMock{},
+		shardCoordinator,
+		scProcessor,
+		&mock.FeeAccumulatorStub{},
+		txTypeHandler,
+		feeHandler,
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		scrForwarder,
+		&mock.EpochNotifierStub{},
+		0,
+	)
+
+	return execTx
+}
+
+func TestTxProcessor_ProcessRelayedTransactionIntraShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	relayer := createUserAccountToTest("relayer", 7, 100)
+	user := createUserAccountToTest("user", 3, 0)
+	receiver := createUserAccountToTest("receiver", 0, 0)
+	accounts := map[string]state.UserAccountHandler{"relayer": relayer, "user": user, "receiver": receiver}
+	execTx := createRelayedTxProcessorToTest(0, accounts, map[string]uint32{}, &mock.IntermediateTransactionHandlerMock{}, &mock.SCProcessorMock{})
+
+	err := execTx.ProcessTransaction(createRelayedTxToTest(createUserTxToTest()))
+	assert.Nil(t, err)
+
+	// the relayer pays its own fee (10), the value (5) and the gas of the user transaction (20) and gets back
+	// the unused gas of the user transaction (10)
+	assert.Equal(t, big.NewInt(75), relayer.GetBalance())
+	assert.Equal(t, uint64(8), relayer.GetNonce())
+	// the user pays the fee of the user transaction (10) out of the credited gas
+	assert.Equal(t, big.NewInt(0), user.GetBalance())
+	assert.Equal(t, uint64(4), user.GetNonce())
+	assert.Equal(t, big.NewInt(5), receiver.GetBalance())
+}
+
+func TestTxProcessor_ProcessRelayedTransactionIntraShardSCCallShouldExecuteAsResultWithRelayer(t *testing.T) {
+	t.Parallel()
+
+	relayer := createUserAccountToTest("relayer", 7, 100)
+	user := createUserAccountToTest("user", 3, 0)
+	receiver := createUserAccountToTest("receiver", 0, 0)
+	accounts := map[string]state.UserAccountHandler{"relayer": relayer, "user": user, "receiver": receiver}
+	var forwardedTxs []data.TransactionHandler
+	scrForwarder := &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			forwardedTxs = append(forwardedTxs, txs...)
+			return nil
+		},
+	}
+	var executedTx data.TransactionHandler
+	scProcessor := &mock.SCProcessorMock{
+		ExecuteSmartContractTransactionCalled: func(tx data.TransactionHandler, acntSrc, acntDst state.UserAccountHandler) error {
+			executedTx = tx
+			return nil
+		},
+	}
+	execTx := createRelayedTxProcessorToTest(0, accounts, map[string]uint32{}, scrForwarder, scProcessor)
+
+	userTx := createUserTxToTest()
+	userTx.Data = []byte("function")
+	err := execTx.ProcessTransaction(createRelayedTxToTest(userTx))
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, len(forwardedTxs))
+	assert.Equal(t, forwardedTxs[0], executedTx)
+	scr := executedTx.(*smartContractResult.SmartContractResult)
+	assert.Equal(t, []byte("user"), scr.SndAddr)
+	assert.Equal(t, []byte("receiver"), scr.RcvAddr)
+	assert.Equal(t, []byte("function"), scr.Data)
+	assert.Equal(t, userTx.GasLimit, scr.GasLimit)
+	assert.Equal(t, []byte("relayer"), scr.RelayerAddr)
+}
+
+func TestTxProcessor_ProcessRelayedTransactionWithWrongUserNonceIntraShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	relayer := createUserAccountToTest("relayer", 7, 100)
+	user := createUserAccountToTest("user", 4, 0)
+	accounts := map[string]state.UserAccountHandler{"relayer": relayer, "user": user}
+	execTx := createRelayedTxProcessorToTest(0, accounts, map[string]uint32{}, &mock.IntermediateTransactionHandlerMock{}, &mock.SCProcessorMock{})
+
+	err := execTx.ProcessTransaction(createRelayedTxToTest(createUserTxToTest()))
+	assert.Equal(t, process.ErrLowerNonceInTransaction, err)
+	assert.Equal(t, big.NewInt(100), relayer.GetBalance())
+}
+
+func TestTxProcessor_ProcessRelayedTransactionInvalidShouldChargeTheRelayer(t *testing.T) {
+	t.Parallel()
+
+	relayer := createUserAccountToTest("relayer", 7, 100)
+	user := createUserAccountToTest("user", 3, 0)
+	accounts := map[string]state.UserAccountHandler{"relayer": relayer, "user": user}
+	execTx := createRelayedTxProcessorToTest(0, accounts, map[string]uint32{}, &mock.IntermediateTransactionHandlerMock{}, &mock.SCProcessorMock{})
+
+	userTx := createUserTxToTest()
+	relayedTx := createRelayedTxToTest(userTx)
+	relayedTx.GasLimit = userTx.GasLimit
+
+	err := execTx.ProcessTransaction(relayedTx)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, big.NewInt(90), relayer.GetBalance())
+	assert.Equal(t, uint64(8), relayer.GetNonce())
+	assert.Equal(t, big.NewInt(0), user.GetBalance())
+}
+
+func TestTxProcessor_ProcessRelayedTransactionCrossShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	shardOfAddress := map[string]uint32{"relayer": 0, "user": 1, "receiver": 2}
+	relayedTx := createRelayedTxToTest(createUserTxToTest())
+
+	// in the shard of the relayer, only the relayer is charged
+	relayer := createUserAccountToTest("relayer", 7, 100)
+	execTx := createRelayedTxProcessorToTest(0, map[string]state.UserAccountHandler{"relayer": relayer}, shardOfAddress, &mock.IntermediateTransactionHandlerMock{}, &mock.SCProcessorMock{})
+	err := execTx.ProcessTransaction(relayedTx)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(65), relayer.GetBalance())
+	assert.Equal(t, uint64(8), relayer.GetNonce())
+
+	// in the shard of the user, the user transaction is forwarded to the shard of the receiver
+	user := createUserAccountToTest("user", 3, 0)
+	var forwardedTxs []data.TransactionHandler
+	scrForwarder := &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			forwardedTxs = append(forwardedTxs, txs...)
+			return nil
+		},
+	}
+	execTx = createRelayedTxProcessorToTest(1, map[string]state.UserAccountHandler{"user": user}, shardOfAddress, scrForwarder, &mock.SCProcessorMock{})
+	err = execTx.ProcessTransaction(relayedTx)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), user.GetBalance())
+	assert.Equal(t, uint64(4), user.GetNonce())
+
+	assert.Equal(t, 2, len(forwardedTxs))
+	scr := forwardedTxs[0].(*smartContractResult.SmartContractResult)
+	assert.Equal(t, []byte("user"), scr.SndAddr)
+	assert.Equal(t, []byte("receiver"), scr.RcvAddr)
+	assert.Equal(t, big.NewInt(5), scr.Value)
+	assert.Equal(t, []byte("relayer"), scr.RelayerAddr)
+
+	// the unused gas of the user transaction goes back to the relayer
+	refundScr := forwardedTxs[1].(*smartContractResult.SmartContractResult)
+	assert.Equal(t, []byte("relayer"), refundScr.RcvAddr)
+	assert.Equal(t, big.NewInt(10), refundScr.Value)
+}
+
+func TestTxProcessor_ProcessRelayedTransactionCrossShardShouldRefundTheRelayerIfUserTxFails(t *testing.T) {
+	t.Parallel()
+
+	shardOfAddress := map[string]uint32{"relayer": 0, "user": 1, "receiver": 1}
+	relayedTx := createRelayedTxToTest(createUserTxToTest())
+
+	user := createUserAccountToTest("user", 4, 0)
+	receiver := createUserAccountToTest("receiver", 0, 0)
+	var forwardedTxs []data.TransactionHandler
+	scrForwarder := &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			forwardedTxs = append(forwardedTxs, txs...)
+			return nil
+		},
+	}
+	accounts := map[string]state.UserAccountHandler{"user": user, "receiver": receiver}
+	execTx := createRelayedTxProcessorToTest(1, accounts, shardOfAddress, scrForwarder, &mock.SCProcessorMock{})
+
+	err := execTx.ProcessTransaction(relayedTx)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), user.GetBalance())
+	assert.Equal(t, uint64(4), user.GetNonce())
+	assert.Equal(t, big.NewInt(0), receiver.GetBalance())
+
+	assert.Equal(t, 1, len(forwardedTxs))
+	scr := forwardedTxs[0].(*smartContractResult.SmartContractResult)
+	assert.Equal(t, []byte("relayer"), scr.RcvAddr)
+	assert.Equal(t, big.NewInt(25), scr.Value)
+}
+
+func TestTxProcessor_ProcessRelayedTransactionCrossShardMultisigUserWithSingleSignatureShouldRefundTheRelayer(t *testing.T) {
+	t.Parallel()
+
+	shardOfAddress := map[string]uint32{"relayer": 0, "user": 1, "receiver": 1}
+	relayedTx := createRelayedTxToTest(createUserTxToTest())
+
+	user := createUserAccountToTest("user", 3, 0)
+	multisigData := &builtInFunctions.MultisigAccountData{
+		Signers:   [][]byte{[]byte("signer1"), []byte("signer2")},
+		Threshold: 2,
+	}
+	multisigDataBuff, _ := (&mock.MarshalizerMock{}).Marshal(multisigData)
+	user.DataTrieTracker().SaveKeyValue([]byte(core.ElrondProtectedKeyPrefix+"multisig"), multisigDataBuff)
+	receiver := createUserAccountToTest("receiver", 0, 0)
+	var forwardedTxs []data.TransactionHandler
+	scrForwarder := &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			forwardedTxs = append(forwardedTxs, txs...)
+			return nil
+		},
+	}
+	accounts := map[string]state.UserAccountHandler{"user": user, "receiver": receiver}
+	execTx := createRelayedTxProcessorToTest(1, accounts, shardOfAddress, scrForwarder, &mock.SCProcessorMock{})
+
+	err := execTx.ProcessTransaction(relayedTx)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), user.GetBalance())
+	assert.Equal(t, uint64(3), user.GetNonce())
+	assert.Equal(t, big.NewInt(0), receiver.GetBalance())
+
+	assert.Equal(t, 1, len(forwardedTxs))
+	scr := forwardedTxs[0].(*smartContractResult.SmartContractResult)
+	assert.Equal(t, []byte("relayer"), scr.RcvAddr)
+	assert.Equal(t, big.NewInt(25), scr.Value)
+	assert.Equal(t, []byte(process.ErrMultisigAccountNeedsMultipleSignatures.Error()), scr.ReturnMessage)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -12,6 +13,7 @@ type transactionCostEstimator struct {
 	txTypeHandler      process.TxTypeHandler
 	feeHandler         process.FeeHandler
 	query              external.SCQueryService
+	marshalizer        marshal.Marshalizer
 	storePerByteCost   uint64
 	compilePerByteCost uint64
 }
@@ -21,6 +23,7 @@ func NewTransactionCostEstimator(
 	txTypeHandler process.TxTypeHandler,
 	feeHandler process.FeeHandler,
 	query external.SCQueryService,
+	marshalizer marshal.Marshalizer,
 	gasSchedule map[string]map[string]uint64,
 ) (*transactionCostEstimator, error) {
 	if check.IfNil(txTypeHandler) {
@@ -32,6 +35,9 @@ func NewTransactionCostEstimator(
 	if check.IfNil(query) {
 		return nil, external.ErrNilSCQueryService
 	}
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
 
 	compileCost, storeCost := getOperationCost(gasSchedule)
 
@@ -39,6 +45,7 @@ func NewTransactionCostEstimator(
 		txTypeHandler:      txTypeHandler,
 		feeHandler:         feeHandler,
 		query:              query,
+		marshalizer:        marshalizer,
 		storePerByteCost:   compileCost,
 		compilePerByteCost: storeCost,
 	}, nil
@@ -77,6 +84,8 @@ func (tce *transactionCostEstimator) ComputeTransactionGasLimit(tx *transaction.
 		return tce.computeScCallGasLimit(tx)
 	case process.BuiltInFunctionCall:
 		return tce.computeScCallGasLimit(tx)
+	case process.RelayedTx:
+		return tce.computeRelayedTxGasLimit(tx)
 	default:
 		return 0, process.ErrWrongTransaction
 	}
@@ -99,6 +108,22 @@ func (tce *transactionCostEstimator) computeScCallGasLimit(tx *transaction.Trans
 	return baseCost + scCallGasLimit, nil
 }
 
+// computeRelayedTxGasLimit adds the gas limit of the carried user transaction to the move balance cost of the relayed transaction
+func (tce *transactionCostEstimator) computeRelayedTxGasLimit(tx *transaction.Transaction) (uint64, error) {
+	userTx, err := getUserTxFromRelayedTxData(tce.marshalizer, tx.Data)
+	if err != nil {
+		return 0, err
+	}
+
+	userTxGasLimit, err := tce.ComputeTransactionGasLimit(userTx)
+	if err != nil {
+		return 0, err
+	}
+
+	baseCost := tce.feeHandler.ComputeGasLimit(tx)
+	return baseCost + userTxGasLimit, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tce *transactionCostEstimator) IsInterfaceNil() bool {
 	return tce == nil
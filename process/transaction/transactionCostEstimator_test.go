@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/hex"
 	"math/big"
 	"testing"
 
@@ -31,7 +32,7 @@ func TestTransactionCostEstimator_NilTxTypeHandler(t *testing.T) {
 	t.Parallel()
 
 	gasSchedule := createGasMap(1)
-	tce, err := NewTransactionCostEstimator(nil, &mock.FeeHandlerStub{}, &mock.ScQueryStub{}, gasSchedule)
+	tce, err := NewTransactionCostEstimator(nil, &mock.FeeHandlerStub{}, &mock.ScQueryStub{}, &mock.MarshalizerMock{}, gasSchedule)
 
 	require.Nil(t, tce)
 	require.Equal(t, process.ErrNilTxTypeHandler, err)
@@ -41,7 +42,7 @@ func TestTransactionCostEstimator_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
 	gasSchedule := createGasMap(1)
-	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, nil, &mock.ScQueryStub{}, gasSchedule)
+	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, nil, &mock.ScQueryStub{}, &mock.MarshalizerMock{}, gasSchedule)
 
 	require.Nil(t, tce)
 	require.Equal(t, process.ErrNilEconomicsFeeHandler, err)
@@ -51,17 +52,27 @@ func TestTransactionCostEstimator_NilQueryServiceShouldErr(t *testing.T) {
 	t.Parallel()
 
 	gasSchedule := createGasMap(1)
-	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, nil, gasSchedule)
+	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, nil, &mock.MarshalizerMock{}, gasSchedule)
 
 	require.Nil(t, tce)
 	require.Equal(t, external.ErrNilSCQueryService, err)
 }
 
+func TestTransactionCostEstimator_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gasSchedule := createGasMap(1)
+	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, &mock.ScQueryStub{}, nil, gasSchedule)
+
+	require.Nil(t, tce)
+	require.Equal(t, process.ErrNilMarshalizer, err)
+}
+
 func TestTransactionCostEstimator_Ok(t *testing.T) {
 	t.Parallel()
 
 	gasSchedule := createGasMap(1)
-	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, &mock.ScQueryStub{}, gasSchedule)
+	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, &mock.ScQueryStub{}, &mock.MarshalizerMock{}, gasSchedule)
 
 	require.Nil(t, err)
 	require.False(t, check.IfNil(tce))
@@ -80,7 +91,7 @@ func TestComputeTransactionGasLimit_MoveBalance(t *testing.T) {
 		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
 			return consumedGasUnits
 		},
-	}, &mock.ScQueryStub{}, gasSchedule)
+	}, &mock.ScQueryStub{}, &mock.MarshalizerMock{}, gasSchedule)
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -101,7 +112,7 @@ func TestComputeTransactionGasLimit_SmartContractDeploy(t *testing.T) {
 		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
 			return gasLimitBaseTx
 		},
-	}, &mock.ScQueryStub{}, gasSchedule)
+	}, &mock.ScQueryStub{}, &mock.MarshalizerMock{}, gasSchedule)
 
 	tx := &transaction.Transaction{
 		Data: []byte("data"),
@@ -129,10 +140,47 @@ func TestComputeTransactionGasLimit_SmartContractCall(t *testing.T) {
 		ComputeScCallGasLimitHandler: func(tx *transaction.Transaction) (u uint64, err error) {
 			return consumedGasUnits.Uint64(), nil
 		},
-	}, gasSchedule)
+	}, &mock.MarshalizerMock{}, gasSchedule)
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
 	require.Nil(t, err)
 	require.Equal(t, consumedGasUnits.Uint64()+gasLimitBaseTx, cost)
 }
+
+func TestComputeTransactionGasLimit_RelayedTransaction(t *testing.T) {
+	t.Parallel()
+
+	gasSchedule := createGasMap(1)
+	gasLimitBaseTx := uint64(500)
+	consumedGasUnits := uint64(1000)
+	marshalizer := &mock.MarshalizerMock{}
+	tce, _ := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{
+		ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (transactionType process.TransactionType) {
+			if isRelayedTxData(tx.GetData()) {
+				return process.RelayedTx
+			}
+			return process.SCInvoking
+		},
+	}, &mock.FeeHandlerStub{
+		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+			return gasLimitBaseTx
+		},
+	}, &mock.ScQueryStub{
+		ComputeScCallGasLimitHandler: func(tx *transaction.Transaction) (u uint64, err error) {
+			return consumedGasUnits, nil
+		},
+	}, marshalizer, gasSchedule)
+
+	userTxBuff, _ := marshalizer.Marshal(&transaction.Transaction{Data: []byte("doSomething")})
+	tx := &transaction.Transaction{
+		Data: []byte(core.RelayedTransaction + "@" + hex.EncodeToString(userTxBuff)),
+	}
+	cost, err := tce.ComputeTransactionGasLimit(tx)
+	require.Nil(t, err)
+	require.Equal(t, gasLimitBaseTx+consumedGasUnits+gasLimitBaseTx, cost)
+
+	tx.Data = []byte(core.RelayedTransaction + "@invalid")
+	_, err = tce.ComputeTransactionGasLimit(tx)
+	require.Equal(t, process.ErrInvalidRelayedTxData, err)
+}
@@ -0,0 +1,493 @@
+package adaptivecache
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.Cacher = (*adaptiveCache)(nil)
+
+var log = logger.GetOrCreate("storage/adaptivecache")
+
+// windowPercent is the percent of the cache's capacity reserved for the newly added items
+const windowPercent = 1
+
+// CacheStatistics holds the usage statistics of an adaptive cache
+type CacheStatistics struct {
+	Name           string
+	NumHits        uint64
+	NumMisses      uint64
+	NumEvictions   uint64
+	NumItems       int
+	SizeInBytes    int64
+	MaxSizeInBytes int64
+}
+
+// HitRatio returns the ratio of the get operations which found the key
+func (cs CacheStatistics) HitRatio() float64 {
+	total := cs.NumHits + cs.NumMisses
+	if total == 0 {
+		return 0
+	}
+
+	return float64(cs.NumHits) / float64(total)
+}
+
+// ArgAdaptiveCache holds the arguments needed for creating an adaptive cache
+type ArgAdaptiveCache struct {
+	Name           string
+	MaxNumItems    int
+	MaxSizeInBytes int64
+	Budget         *MemoryBudget
+}
+
+type cacheEntry struct {
+	key         string
+	value       interface{}
+	sizeInBytes int64
+	inWindow    bool
+}
+
+// adaptiveCache is a W-TinyLFU cache: the new items are added in a small LRU window and, when evicted from it, they
+// compete with the least recently used item of the main LRU segment. The one accessed more frequently, as estimated
+// by a count-min sketch, is kept. The capacity in bytes is managed by the shared memory budget
+type adaptiveCache struct {
+	mut            sync.Mutex
+	name           string
+	maxNumItems    int
+	maxSizeInBytes int64
+	budget         *MemoryBudget
+	window         *list.List
+	main           *list.List
+	items          map[string]*list.Element
+	windowSize     int64
+	mainSize       int64
+	sketch         *frequencySketch
+	isClosed       bool
+
+	numHits      uint64
+	numMisses    uint64
+	numEvictions uint64
+
+	mutAddedDataHandlers sync.RWMutex
+	mapDataHandlers      map[string]func(key []byte, value interface{})
+	mapEvictionHandlers  map[string]func(key []byte, value interface{})
+}
+
+// NewAdaptiveCache creates a new adaptive cache which shares the provided memory budget
+func NewAdaptiveCache(arg ArgAdaptiveCache) (*adaptiveCache, error) {
+	if arg.Budget == nil {
+		return nil, ErrNilMemoryBudget
+	}
+	if arg.MaxNumItems < 1 {
+		return nil, ErrInvalidCacheCapacity
+	}
+	if arg.MaxSizeInBytes < 1 {
+		return nil, ErrInvalidCacheSizeInBytes
+	}
+
+	ac := &adaptiveCache{
+		name:                arg.Name,
+		maxNumItems:         arg.MaxNumItems,
+		maxSizeInBytes:      arg.MaxSizeInBytes,
+		budget:              arg.Budget,
+		window:              list.New(),
+		main:                list.New(),
+		items:               make(map[string]*list.Element),
+		sketch:              newFrequencySketch(arg.MaxNumItems),
+		mapDataHandlers:     make(map[string]func(key []byte, value interface{})),
+		mapEvictionHandlers: make(map[string]func(key []byte, value interface{})),
+	}
+	arg.Budget.register(ac)
+
+	return ac, nil
+}
+
+// SetName sets the name used when reporting the cache's statistics
+func (ac *adaptiveCache) SetName(name string) {
+	ac.mut.Lock()
+	ac.name = name
+	ac.mut.Unlock()
+}
+
+// Clear is used to completely clear the cache.
+func (ac *adaptiveCache) Clear() {
+	ac.mut.Lock()
+	defer ac.mut.Unlock()
+
+	ac.budget.addUsed(-(ac.windowSize + ac.mainSize))
+	ac.window.Init()
+	ac.main.Init()
+	ac.items = make(map[string]*list.Element)
+	ac.windowSize = 0
+	ac.mainSize = 0
+	ac.sketch.clear()
+}
+
+// Put adds a value to the cache. Returns true if an eviction occurred.
+func (ac *adaptiveCache) Put(key []byte, value interface{}, sizeInBytes int) (evicted bool) {
+	ac.mut.Lock()
+	if ac.isClosed {
+		ac.mut.Unlock()
+		return false
+	}
+	ac.sketch.increment(string(key))
+	ac.putUnprotected(string(key), value, int64(sizeInBytes))
+	evictedEntries := ac.evictUnprotected()
+	ac.mut.Unlock()
+
+	ac.callAddedDataHandlers(key, value)
+	ac.callEvictionHandlers(evictedEntries)
+
+	return len(evictedEntries) > 0
+}
+
+func (ac *adaptiveCache) putUnprotected(key string, value interface{}, sizeInBytes int64) {
+	element, ok := ac.items[key]
+	if ok {
+		entry := element.Value.(*cacheEntry)
+		ac.addSize(entry, sizeInBytes-entry.sizeInBytes)
+		entry.value = value
+		entry.sizeInBytes = sizeInBytes
+		ac.listOf(entry).MoveToFront(element)
+		return
+	}
+
+	entry := &cacheEntry{
+		key:         key,
+		value:       value,
+		sizeInBytes: sizeInBytes,
+		inWindow:    true,
+	}
+	ac.items[key] = ac.window.PushFront(entry)
+	ac.addSize(entry, sizeInBytes)
+}
+
+// evictUnprotected moves the items overflowing the window in the main segment, if they are accessed more frequently
+// than the main segment's victims, then removes the items exceeding the cache's capacity
+func (ac *adaptiveCache) evictUnprotected() []*cacheEntry {
+	evicted := make([]*cacheEntry, 0)
+	capacity := ac.capacityInBytes()
+	windowCapacity := capacity * windowPercent / 100
+	windowMaxNumItems := ac.maxNumItems * windowPercent / 100
+	if windowMaxNumItems < 1 {
+		windowMaxNumItems = 1
+	}
+
+	for ac.window.Len() > windowMaxNumItems || (ac.windowSize > windowCapacity && ac.window.Len() > 1) {
+		candidate := ac.window.Back().Value.(*cacheEntry)
+		ac.moveToMain(candidate)
+
+		for ac.isOverCapacity(capacity) && ac.main.Len() > 1 {
+			victim := ac.main.Back().Value.(*cacheEntry)
+			if victim == candidate {
+				victim = ac.main.Back().Prev().Value.(*cacheEntry)
+			}
+
+			if ac.sketch.estimate(candidate.key) > ac.sketch.estimate(victim.key) {
+				ac.removeEntry(victim)
+				evicted = append(evicted, victim)
+				continue
+			}
+
+			ac.removeEntry(candidate)
+			evicted = append(evicted, candidate)
+			break
+		}
+	}
+
+	return append(evicted, ac.evictToSize(capacity)...)
+}
+
+func (ac *adaptiveCache) evictToSize(sizeInBytes int64) []*cacheEntry {
+	evicted := make([]*cacheEntry, 0)
+	for ac.isOverCapacity(sizeInBytes) {
+		element := ac.main.Back()
+		if element == nil {
+			element = ac.window.Back()
+		}
+		if element == nil {
+			break
+		}
+
+		entry := element.Value.(*cacheEntry)
+		ac.removeEntry(entry)
+		evicted = append(evicted, entry)
+	}
+
+	return evicted
+}
+
+func (ac *adaptiveCache) isOverCapacity(sizeInBytes int64) bool {
+	return ac.window.Len()+ac.main.Len() > ac.maxNumItems || ac.windowSize+ac.mainSize > sizeInBytes
+}
+
+// capacityInBytes returns the size the cache can grow up to. When the memory budget is exceeded, the cache is
+// limited to its share of the budget
+func (ac *adaptiveCache) capacityInBytes() int64 {
+	if ac.budget.isExceeded() {
+		return ac.budget.shareFor(ac.maxSizeInBytes)
+	}
+
+	return ac.budget.capacityFor(ac.maxSizeInBytes)
+}
+
+func (ac *adaptiveCache) moveToMain(entry *cacheEntry) {
+	element := ac.items[entry.key]
+	ac.window.Remove(element)
+	ac.windowSize -= entry.sizeInBytes
+
+	entry.inWindow = false
+	ac.items[entry.key] = ac.main.PushFront(entry)
+	ac.mainSize += entry.sizeInBytes
+}
+
+func (ac *adaptiveCache) removeEntry(entry *cacheEntry) {
+	element, ok := ac.items[entry.key]
+	if !ok {
+		return
+	}
+
+	ac.listOf(entry).Remove(element)
+	delete(ac.items, entry.key)
+	ac.addSize(entry, -entry.sizeInBytes)
+	atomic.AddUint64(&ac.numEvictions, 1)
+}
+
+func (ac *adaptiveCache) listOf(entry *cacheEntry) *list.List {
+	if entry.inWindow {
+		return ac.window
+	}
+
+	return ac.main
+}
+
+func (ac *adaptiveCache) addSize(entry *cacheEntry, delta int64) {
+	if entry.inWindow {
+		ac.windowSize += delta
+	} else {
+		ac.mainSize += delta
+	}
+	ac.budget.addUsed(delta)
+}
+
+// shrink evicts the items exceeding the cache's current capacity
+func (ac *adaptiveCache) shrink() {
+	ac.mut.Lock()
+	evictedEntries := ac.evictToSize(ac.capacityInBytes())
+	ac.mut.Unlock()
+
+	ac.callEvictionHandlers(evictedEntries)
+}
+
+// Get looks up a key's value from the cache.
+func (ac *adaptiveCache) Get(key []byte) (value interface{}, ok bool) {
+	ac.mut.Lock()
+	defer ac.mut.Unlock()
+
+	ac.sketch.increment(string(key))
+	element, ok := ac.items[string(key)]
+	if !ok {
+		atomic.AddUint64(&ac.numMisses, 1)
+		return nil, false
+	}
+
+	atomic.AddUint64(&ac.numHits, 1)
+	entry := element.Value.(*cacheEntry)
+	ac.listOf(entry).MoveToFront(element)
+
+	return entry.value, true
+}
+
+// Has checks if a key is in the cache, without updating the
+// recent-ness or deleting it for being stale.
+func (ac *adaptiveCache) Has(key []byte) bool {
+	ac.mut.Lock()
+	defer ac.mut.Unlock()
+
+	_, ok := ac.items[string(key)]
+
+	return ok
+}
+
+// Peek returns the key value (or undefined if not found) without updating
+// the "recently used"-ness of the key.
+func (ac *adaptiveCache) Peek(key []byte) (value interface{}, ok bool) {
+	ac.mut.Lock()
+	defer ac.mut.Unlock()
+
+	element, ok := ac.items[string(key)]
+	if !ok {
+		return nil, false
+	}
+
+	return element.Value.(*cacheEntry).value, true
+}
+
+// HasOrAdd checks if a key is in the cache  without updating the
+// recent-ness or deleting it for being stale,  and if not, adds the value.
+// Returns whether found and whether an eviction occurred.
+func (ac *adaptiveCache) HasOrAdd(key []byte, value interface{}, sizeInBytes int) (found, evicted bool) {
+	ac.mut.Lock()
+	_, found = ac.items[string(key)]
+	if found {
+		ac.mut.Unlock()
+		return true, false
+	}
+	if ac.isClosed {
+		ac.mut.Unlock()
+		return false, false
+	}
+
+	ac.sketch.increment(string(key))
+	ac.putUnprotected(string(key), value, int64(sizeInBytes))
+	evictedEntries := ac.evictUnprotected()
+	ac.mut.Unlock()
+
+	ac.callAddedDataHandlers(key, value)
+	ac.callEvictionHandlers(evictedEntries)
+
+	return false, len(evictedEntries) > 0
+}
+
+// Remove removes the provided key from the cache.
+func (ac *adaptiveCache) Remove(key []byte) {
+	ac.mut.Lock()
+	defer ac.mut.Unlock()
+
+	element, ok := ac.items[string(key)]
+	if !ok {
+		return
+	}
+
+	entry := element.Value.(*cacheEntry)
+	ac.listOf(entry).Remove(element)
+	delete(ac.items, entry.key)
+	ac.addSize(entry, -entry.sizeInBytes)
+}
+
+// Keys returns a slice of the keys in the cache, from oldest to newest.
+func (ac *adaptiveCache) Keys() [][]byte {
+	ac.mut.Lock()
+	defer ac.mut.Unlock()
+
+	keys := make([][]byte, 0, len(ac.items))
+	for element := ac.main.Back(); element != nil; element = element.Prev() {
+		keys = append(keys, []byte(element.Value.(*cacheEntry).key))
+	}
+	for element := ac.window.Back(); element != nil; element = element.Prev() {
+		keys = append(keys, []byte(element.Value.(*cacheEntry).key))
+	}
+
+	return keys
+}
+
+// Len returns the number of items in the cache.
+func (ac *adaptiveCache) Len() int {
+	ac.mut.Lock()
+	defer ac.mut.Unlock()
+
+	return len(ac.items)
+}
+
+// MaxSize returns the maximum number of items which can be stored in cache.
+func (ac *adaptiveCache) MaxSize() int {
+	return ac.maxNumItems
+}
+
+// RegisterHandler registers a new handler to be called when a new data is added
+func (ac *adaptiveCache) RegisterHandler(handler func(key []byte, value interface{}), id string) {
+	if handler == nil {
+		log.Error("attempt to register a nil handler to a cacher object")
+		return
+	}
+
+	ac.mutAddedDataHandlers.Lock()
+	ac.mapDataHandlers[id] = handler
+	ac.mutAddedDataHandlers.Unlock()
+}
+
+// UnRegisterHandler removes the handler from the list
+func (ac *adaptiveCache) UnRegisterHandler(id string) {
+	ac.mutAddedDataHandlers.Lock()
+	delete(ac.mapDataHandlers, id)
+	delete(ac.mapEvictionHandlers, id)
+	ac.mutAddedDataHandlers.Unlock()
+}
+
+// RegisterEvictionHandler registers a new handler to be called when an item is evicted from the cache
+func (ac *adaptiveCache) RegisterEvictionHandler(handler func(key []byte, value interface{}), id string) {
+	if handler == nil {
+		log.Error("attempt to register a nil eviction handler to a cacher object")
+		return
+	}
+
+	ac.mutAddedDataHandlers.Lock()
+	ac.mapEvictionHandlers[id] = handler
+	ac.mutAddedDataHandlers.Unlock()
+}
+
+func (ac *adaptiveCache) callAddedDataHandlers(key []byte, value interface{}) {
+	ac.mutAddedDataHandlers.RLock()
+	for _, handler := range ac.mapDataHandlers {
+		go handler(key, value)
+	}
+	ac.mutAddedDataHandlers.RUnlock()
+}
+
+func (ac *adaptiveCache) callEvictionHandlers(entries []*cacheEntry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	ac.mutAddedDataHandlers.RLock()
+	defer ac.mutAddedDataHandlers.RUnlock()
+
+	for _, handler := range ac.mapEvictionHandlers {
+		for _, entry := range entries {
+			go handler([]byte(entry.key), entry.value)
+		}
+	}
+}
+
+// Statistics returns the usage statistics of the cache
+func (ac *adaptiveCache) Statistics() CacheStatistics {
+	ac.mut.Lock()
+	defer ac.mut.Unlock()
+
+	return CacheStatistics{
+		Name:           ac.name,
+		NumHits:        atomic.LoadUint64(&ac.numHits),
+		NumMisses:      atomic.LoadUint64(&ac.numMisses),
+		NumEvictions:   atomic.LoadUint64(&ac.numEvictions),
+		NumItems:       len(ac.items),
+		SizeInBytes:    ac.windowSize + ac.mainSize,
+		MaxSizeInBytes: ac.capacityInBytes(),
+	}
+}
+
+// Close releases the memory used by the cache and removes it from its memory budget. The items added afterwards
+// are not kept
+func (ac *adaptiveCache) Close() error {
+	ac.mut.Lock()
+	if ac.isClosed {
+		ac.mut.Unlock()
+		return nil
+	}
+	ac.isClosed = true
+	ac.mut.Unlock()
+
+	ac.Clear()
+	ac.budget.unregister(ac)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ac *adaptiveCache) IsInterfaceNil() bool {
+	return ac == nil
+}
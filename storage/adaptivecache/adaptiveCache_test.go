@@ -0,0 +1,150 @@
+package adaptivecache_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/storage/adaptivecache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createCache(t *testing.T, maxNumItems int, maxSizeInBytes int64, budget *adaptivecache.MemoryBudget) adaptivecache.Cacher {
+	cache, err := adaptivecache.NewAdaptiveCache(adaptivecache.ArgAdaptiveCache{
+		Name:           "test",
+		MaxNumItems:    maxNumItems,
+		MaxSizeInBytes: maxSizeInBytes,
+		Budget:         budget,
+	})
+	require.Nil(t, err)
+
+	return cache
+}
+
+func TestNewAdaptiveCache_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cache, err := adaptivecache.NewAdaptiveCache(adaptivecache.ArgAdaptiveCache{MaxNumItems: 1, MaxSizeInBytes: 1})
+	assert.Nil(t, cache)
+	assert.Equal(t, adaptivecache.ErrNilMemoryBudget, err)
+
+	cache, err = adaptivecache.NewAdaptiveCache(adaptivecache.ArgAdaptiveCache{MaxSizeInBytes: 1, Budget: adaptivecache.NewMemoryBudget()})
+	assert.Nil(t, cache)
+	assert.Equal(t, adaptivecache.ErrInvalidCacheCapacity, err)
+
+	cache, err = adaptivecache.NewAdaptiveCache(adaptivecache.ArgAdaptiveCache{MaxNumItems: 1, Budget: adaptivecache.NewMemoryBudget()})
+	assert.Nil(t, cache)
+	assert.Equal(t, adaptivecache.ErrInvalidCacheSizeInBytes, err)
+}
+
+func TestAdaptiveCache_PutGetRemoveShouldWork(t *testing.T) {
+	t.Parallel()
+
+	budget := adaptivecache.NewMemoryBudget()
+	cache := createCache(t, 100, 10000, budget)
+
+	evicted := cache.Put([]byte("key1"), "value1", 10)
+	assert.False(t, evicted)
+	_ = cache.Put([]byte("key2"), "value2", 20)
+
+	value, ok := cache.Get([]byte("key1"))
+	assert.True(t, ok)
+	assert.Equal(t, "value1", value)
+	_, ok = cache.Get([]byte("missing"))
+	assert.False(t, ok)
+
+	assert.True(t, cache.Has([]byte("key2")))
+	value, ok = cache.Peek([]byte("key2"))
+	assert.True(t, ok)
+	assert.Equal(t, "value2", value)
+	assert.Equal(t, 2, cache.Len())
+	assert.Equal(t, 100, cache.MaxSize())
+	assert.Equal(t, uint64(30), budget.UsedSizeInBytes())
+
+	found, _ := cache.HasOrAdd([]byte("key1"), "other", 10)
+	assert.True(t, found)
+	found, _ = cache.HasOrAdd([]byte("key3"), "value3", 5)
+	assert.False(t, found)
+	assert.Equal(t, 3, len(cache.Keys()))
+
+	cache.Remove([]byte("key2"))
+	assert.False(t, cache.Has([]byte("key2")))
+	assert.Equal(t, uint64(15), budget.UsedSizeInBytes())
+
+	statistics := cache.Statistics()
+	assert.Equal(t, "test", statistics.Name)
+	assert.Equal(t, uint64(1), statistics.NumHits)
+	assert.Equal(t, uint64(1), statistics.NumMisses)
+	assert.Equal(t, 0.5, statistics.HitRatio())
+
+	cache.Clear()
+	assert.Equal(t, 0, cache.Len())
+	assert.Equal(t, uint64(0), budget.UsedSizeInBytes())
+}
+
+func TestAdaptiveCache_ShouldNotExceedTheCapacity(t *testing.T) {
+	t.Parallel()
+
+	cache := createCache(t, 10, 100, adaptivecache.NewMemoryBudget())
+	for i := 0; i < 50; i++ {
+		_ = cache.Put([]byte(fmt.Sprintf("key%d", i)), i, 5)
+		assert.True(t, cache.Len() <= 10)
+	}
+
+	cacheBySize := createCache(t, 100, 100, adaptivecache.NewMemoryBudget())
+	for i := 0; i < 50; i++ {
+		_ = cacheBySize.Put([]byte(fmt.Sprintf("key%d", i)), i, 30)
+		assert.True(t, cacheBySize.Statistics().SizeInBytes <= 100)
+	}
+}
+
+func TestAdaptiveCache_FrequentlyAccessedItemsShouldSurviveAScan(t *testing.T) {
+	t.Parallel()
+
+	cache := createCache(t, 100, 100000, adaptivecache.NewMemoryBudget())
+	hotKey := []byte("hot")
+	_ = cache.Put(hotKey, "hot value", 1)
+	for i := 0; i < 10; i++ {
+		_, _ = cache.Get(hotKey)
+	}
+
+	for i := 0; i < 1000; i++ {
+		_ = cache.Put([]byte(fmt.Sprintf("scan%d", i)), i, 1)
+	}
+
+	assert.True(t, cache.Has(hotKey))
+}
+
+func TestAdaptiveCache_HandlersShouldBeCalled(t *testing.T) {
+	t.Parallel()
+
+	cache := createCache(t, 1, 100, adaptivecache.NewMemoryBudget())
+
+	wg := sync.WaitGroup{}
+	wg.Add(3)
+	cache.RegisterHandler(func(key []byte, value interface{}) {
+		wg.Done()
+	}, "added")
+	cache.RegisterEvictionHandler(func(key []byte, value interface{}) {
+		assert.Equal(t, []byte("key1"), key)
+		wg.Done()
+	}, "evicted")
+
+	_ = cache.Put([]byte("key1"), 1, 1)
+	evicted := cache.Put([]byte("key2"), 2, 1)
+	assert.True(t, evicted)
+
+	chDone := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(chDone)
+	}()
+
+	select {
+	case <-chDone:
+	case <-time.After(time.Second):
+		assert.Fail(t, "handlers were not called")
+	}
+}
@@ -0,0 +1,24 @@
+package adaptivecache
+
+import "errors"
+
+// ErrNilMemoryBudget signals that a nil memory budget has been provided
+var ErrNilMemoryBudget = errors.New("nil memory budget")
+
+// ErrInvalidCacheCapacity signals that an invalid cache capacity has been provided
+var ErrInvalidCacheCapacity = errors.New("invalid cache capacity")
+
+// ErrInvalidCacheSizeInBytes signals that an invalid cache size in bytes has been provided
+var ErrInvalidCacheSizeInBytes = errors.New("invalid cache size in bytes")
+
+// ErrNilMemoryStatistics signals that a nil memory statistics handler has been provided
+var ErrNilMemoryStatistics = errors.New("nil memory statistics handler")
+
+// ErrInvalidMemoryUsagePercent signals that an invalid memory usage percent has been provided
+var ErrInvalidMemoryUsagePercent = errors.New("invalid memory usage percent")
+
+// ErrInvalidCheckInterval signals that an invalid check interval has been provided
+var ErrInvalidCheckInterval = errors.New("invalid check interval")
+
+// ErrMonitoringAlreadyStarted signals that the memory monitoring was already started
+var ErrMonitoringAlreadyStarted = errors.New("memory monitoring already started")
@@ -0,0 +1,9 @@
+package adaptivecache
+
+func (mb *MemoryBudget) UpdateScale(memPercentUsage uint64, highMemoryUsagePercent uint64) {
+	mb.updateScale(memPercentUsage, highMemoryUsagePercent)
+}
+
+func (mb *MemoryBudget) ShrinkCaches() {
+	mb.shrinkCaches()
+}
@@ -0,0 +1,94 @@
+package adaptivecache
+
+import (
+	"hash/fnv"
+)
+
+const sketchDepth = 4
+const maxCounterValue = 15
+const minSketchWidth = 16
+const resetMultiplier = 10
+
+// frequencySketch is a count-min sketch which estimates how often a key was accessed. The counters are halved
+// periodically, so the old accesses count less than the recent ones. It is not concurrent safe
+type frequencySketch struct {
+	counters       [sketchDepth][]uint8
+	mask           uint64
+	numIncrements  uint64
+	resetThreshold uint64
+}
+
+func newFrequencySketch(numItems int) *frequencySketch {
+	width := uint64(minSketchWidth)
+	for width < uint64(numItems) {
+		width <<= 1
+	}
+
+	fs := &frequencySketch{
+		mask:           width - 1,
+		resetThreshold: width * resetMultiplier,
+	}
+	for i := range fs.counters {
+		fs.counters[i] = make([]uint8, width)
+	}
+
+	return fs
+}
+
+func (fs *frequencySketch) indexes(key string) [sketchDepth]uint64 {
+	hasher := fnv.New64a()
+	_, _ = hasher.Write([]byte(key))
+	hash := hasher.Sum64()
+
+	low := hash & 0xFFFFFFFF
+	high := (hash >> 32) | 1
+
+	var indexes [sketchDepth]uint64
+	for i := range indexes {
+		indexes[i] = (low + uint64(i)*high) & fs.mask
+	}
+
+	return indexes
+}
+
+func (fs *frequencySketch) increment(key string) {
+	for row, index := range fs.indexes(key) {
+		if fs.counters[row][index] < maxCounterValue {
+			fs.counters[row][index]++
+		}
+	}
+
+	fs.numIncrements++
+	if fs.numIncrements >= fs.resetThreshold {
+		fs.reset()
+	}
+}
+
+func (fs *frequencySketch) estimate(key string) uint8 {
+	estimate := uint8(maxCounterValue)
+	for row, index := range fs.indexes(key) {
+		if fs.counters[row][index] < estimate {
+			estimate = fs.counters[row][index]
+		}
+	}
+
+	return estimate
+}
+
+func (fs *frequencySketch) reset() {
+	for row := range fs.counters {
+		for i := range fs.counters[row] {
+			fs.counters[row][i] >>= 1
+		}
+	}
+	fs.numIncrements /= 2
+}
+
+func (fs *frequencySketch) clear() {
+	for row := range fs.counters {
+		for i := range fs.counters[row] {
+			fs.counters[row][i] = 0
+		}
+	}
+	fs.numIncrements = 0
+}
@@ -0,0 +1,17 @@
+package adaptivecache
+
+import "github.com/ElrondNetwork/elrond-go/storage"
+
+// MemoryStatisticsHandler defines the component able to report the memory usage of the node
+type MemoryStatisticsHandler interface {
+	ComputeStatistics()
+	MemPercentUsage() uint64
+}
+
+// Cacher defines the adaptive cache's behavior besides storage.Cacher
+type Cacher interface {
+	storage.Cacher
+	RegisterEvictionHandler(handler func(key []byte, value interface{}), id string)
+	Statistics() CacheStatistics
+	Close() error
+}
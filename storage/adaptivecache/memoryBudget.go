@@ -0,0 +1,228 @@
+package adaptivecache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+// scaleUnit represents a scale factor of 100%
+const scaleUnit = 1000000
+
+// minScale is the smallest scale factor (5%) the caches' capacities can be reduced to under memory pressure
+const minScale = scaleUnit / 20
+
+// memoryPressureHysteresisPercent is subtracted from the high memory usage threshold when deciding to grow back
+const memoryPressureHysteresisPercent = 10
+
+const minCheckInterval = time.Second
+
+// ArgsMemoryMonitoring holds the arguments needed for monitoring the memory pressure
+type ArgsMemoryMonitoring struct {
+	MemoryStatistics       MemoryStatisticsHandler
+	HighMemoryUsagePercent uint64
+	CheckInterval          time.Duration
+}
+
+// MemoryBudget holds the total memory, in bytes, which can be used by the adaptive caches. A cache can grow up to
+// its own configured size as long as the budget is not exceeded. Otherwise, the caches holding more than their
+// share of the budget are shrunk. All the capacities are scaled down when memory pressure is reported
+type MemoryBudget struct {
+	totalSizeInBytes int64
+	usedSizeInBytes  int64
+	sumOfMaxSizes    int64
+	scale            int64
+
+	mutCaches  sync.RWMutex
+	caches     map[*adaptiveCache]struct{}
+	cancelFunc func()
+}
+
+// NewMemoryBudget creates a memory budget without a global limit and without memory pressure monitoring
+func NewMemoryBudget() *MemoryBudget {
+	return &MemoryBudget{
+		scale:  scaleUnit,
+		caches: make(map[*adaptiveCache]struct{}),
+	}
+}
+
+// SetTotalSizeInBytes sets the global limit of the caches' memory. A value of 0 means no global limit
+func (mb *MemoryBudget) SetTotalSizeInBytes(totalSizeInBytes uint64) {
+	atomic.StoreInt64(&mb.totalSizeInBytes, int64(totalSizeInBytes))
+	mb.shrinkCaches()
+}
+
+// TotalSizeInBytes returns the global limit of the caches' memory, 0 meaning no limit
+func (mb *MemoryBudget) TotalSizeInBytes() uint64 {
+	return uint64(atomic.LoadInt64(&mb.totalSizeInBytes))
+}
+
+// UsedSizeInBytes returns the memory, in bytes, used by all the adaptive caches
+func (mb *MemoryBudget) UsedSizeInBytes() uint64 {
+	return uint64(atomic.LoadInt64(&mb.usedSizeInBytes))
+}
+
+// ScalePercent returns the current scale factor applied on the caches' capacities
+func (mb *MemoryBudget) ScalePercent() float64 {
+	return float64(atomic.LoadInt64(&mb.scale)) * 100 / scaleUnit
+}
+
+// StartMonitoring starts checking the memory usage reported by the provided statistics handler. The caches'
+// capacities are halved each time the memory usage exceeds the threshold and grow back slowly afterwards
+func (mb *MemoryBudget) StartMonitoring(args ArgsMemoryMonitoring) error {
+	if check.IfNilReflect(args.MemoryStatistics) {
+		return ErrNilMemoryStatistics
+	}
+	if args.HighMemoryUsagePercent == 0 || args.HighMemoryUsagePercent > 100 {
+		return ErrInvalidMemoryUsagePercent
+	}
+	if args.CheckInterval < minCheckInterval {
+		return ErrInvalidCheckInterval
+	}
+
+	mb.mutCaches.Lock()
+	defer mb.mutCaches.Unlock()
+
+	if mb.cancelFunc != nil {
+		return ErrMonitoringAlreadyStarted
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	mb.cancelFunc = cancelFunc
+	go mb.monitorMemory(ctx, args)
+
+	return nil
+}
+
+func (mb *MemoryBudget) monitorMemory(ctx context.Context, args ArgsMemoryMonitoring) {
+	for {
+		args.MemoryStatistics.ComputeStatistics()
+		mb.updateScale(args.MemoryStatistics.MemPercentUsage(), args.HighMemoryUsagePercent)
+
+		select {
+		case <-ctx.Done():
+			log.Debug("adaptive caches memory monitoring closing")
+			return
+		case <-time.After(args.CheckInterval):
+		}
+	}
+}
+
+func (mb *MemoryBudget) updateScale(memPercentUsage uint64, highMemoryUsagePercent uint64) {
+	scale := atomic.LoadInt64(&mb.scale)
+	if memPercentUsage >= highMemoryUsagePercent {
+		newScale := scale / 2
+		if newScale < minScale {
+			newScale = minScale
+		}
+		if newScale == scale {
+			return
+		}
+
+		atomic.StoreInt64(&mb.scale, newScale)
+		log.Debug("memory pressure, shrinking the adaptive caches",
+			"memory usage percent", memPercentUsage, "scale percent", mb.ScalePercent())
+		mb.shrinkCaches()
+		return
+	}
+
+	isBelowThreshold := memPercentUsage+memoryPressureHysteresisPercent < highMemoryUsagePercent
+	if isBelowThreshold && scale < scaleUnit {
+		newScale := scale + scale/4
+		if newScale > scaleUnit {
+			newScale = scaleUnit
+		}
+		atomic.StoreInt64(&mb.scale, newScale)
+	}
+}
+
+func (mb *MemoryBudget) register(cache *adaptiveCache) {
+	mb.mutCaches.Lock()
+	mb.caches[cache] = struct{}{}
+	mb.mutCaches.Unlock()
+
+	atomic.AddInt64(&mb.sumOfMaxSizes, cache.maxSizeInBytes)
+}
+
+func (mb *MemoryBudget) unregister(cache *adaptiveCache) {
+	mb.mutCaches.Lock()
+	_, ok := mb.caches[cache]
+	delete(mb.caches, cache)
+	mb.mutCaches.Unlock()
+
+	if ok {
+		atomic.AddInt64(&mb.sumOfMaxSizes, -cache.maxSizeInBytes)
+	}
+}
+
+func (mb *MemoryBudget) addUsed(delta int64) {
+	atomic.AddInt64(&mb.usedSizeInBytes, delta)
+}
+
+func (mb *MemoryBudget) isExceeded() bool {
+	total := atomic.LoadInt64(&mb.totalSizeInBytes)
+	if total == 0 {
+		return false
+	}
+
+	return atomic.LoadInt64(&mb.usedSizeInBytes) > total*atomic.LoadInt64(&mb.scale)/scaleUnit
+}
+
+// capacityFor returns the size, in bytes, a cache can grow up to
+func (mb *MemoryBudget) capacityFor(maxSizeInBytes int64) int64 {
+	return maxSizeInBytes * atomic.LoadInt64(&mb.scale) / scaleUnit
+}
+
+// shareFor returns the size, in bytes, a cache is entitled to when the budget is exceeded
+func (mb *MemoryBudget) shareFor(maxSizeInBytes int64) int64 {
+	share := mb.capacityFor(maxSizeInBytes)
+	total := atomic.LoadInt64(&mb.totalSizeInBytes)
+	sumOfMaxSizes := atomic.LoadInt64(&mb.sumOfMaxSizes)
+	if total == 0 || sumOfMaxSizes <= total {
+		return share
+	}
+
+	return share * total / sumOfMaxSizes
+}
+
+func (mb *MemoryBudget) shrinkCaches() {
+	mb.mutCaches.RLock()
+	caches := make([]*adaptiveCache, 0, len(mb.caches))
+	for cache := range mb.caches {
+		caches = append(caches, cache)
+	}
+	mb.mutCaches.RUnlock()
+
+	for _, cache := range caches {
+		cache.shrink()
+	}
+}
+
+// CachesStatistics returns the statistics of all the adaptive caches using this budget
+func (mb *MemoryBudget) CachesStatistics() []CacheStatistics {
+	mb.mutCaches.RLock()
+	defer mb.mutCaches.RUnlock()
+
+	statistics := make([]CacheStatistics, 0, len(mb.caches))
+	for cache := range mb.caches {
+		statistics = append(statistics, cache.Statistics())
+	}
+
+	return statistics
+}
+
+// Close stops the memory pressure monitoring
+func (mb *MemoryBudget) Close() error {
+	mb.mutCaches.Lock()
+	defer mb.mutCaches.Unlock()
+
+	if mb.cancelFunc != nil {
+		mb.cancelFunc()
+		mb.cancelFunc = nil
+	}
+
+	return nil
+}
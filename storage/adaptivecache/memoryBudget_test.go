@@ -0,0 +1,118 @@
+package adaptivecache_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/storage/adaptivecache"
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryStatisticsStub struct {
+	memPercentUsage uint64
+}
+
+func (mss *memoryStatisticsStub) ComputeStatistics() {
+}
+
+func (mss *memoryStatisticsStub) MemPercentUsage() uint64 {
+	return mss.memPercentUsage
+}
+
+func fillCache(cache adaptivecache.Cacher, prefix string, numItems int, sizeInBytes int) {
+	for i := 0; i < numItems; i++ {
+		_ = cache.Put([]byte(fmt.Sprintf("%s%d", prefix, i)), i, sizeInBytes)
+	}
+}
+
+func TestMemoryBudget_StartMonitoringInvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	budget := adaptivecache.NewMemoryBudget()
+	args := adaptivecache.ArgsMemoryMonitoring{
+		MemoryStatistics:       &memoryStatisticsStub{},
+		HighMemoryUsagePercent: 80,
+		CheckInterval:          time.Second,
+	}
+
+	argsCopy := args
+	argsCopy.MemoryStatistics = nil
+	assert.Equal(t, adaptivecache.ErrNilMemoryStatistics, budget.StartMonitoring(argsCopy))
+
+	argsCopy = args
+	argsCopy.HighMemoryUsagePercent = 101
+	assert.Equal(t, adaptivecache.ErrInvalidMemoryUsagePercent, budget.StartMonitoring(argsCopy))
+
+	argsCopy = args
+	argsCopy.CheckInterval = time.Millisecond
+	assert.Equal(t, adaptivecache.ErrInvalidCheckInterval, budget.StartMonitoring(argsCopy))
+
+	assert.Nil(t, budget.StartMonitoring(args))
+	assert.Equal(t, adaptivecache.ErrMonitoringAlreadyStarted, budget.StartMonitoring(args))
+	assert.Nil(t, budget.Close())
+}
+
+func TestMemoryBudget_ExceededBudgetShouldShrinkTheCachesToTheirShare(t *testing.T) {
+	t.Parallel()
+
+	budget := adaptivecache.NewMemoryBudget()
+	budget.SetTotalSizeInBytes(1000)
+	cacheA := createCache(t, 1000, 1000, budget)
+	cacheB := createCache(t, 1000, 1000, budget)
+
+	fillCache(cacheA, "a", 100, 10)
+	assert.Equal(t, int64(1000), cacheA.Statistics().SizeInBytes)
+
+	fillCache(cacheB, "b", 30, 10)
+	assert.Equal(t, int64(300), cacheB.Statistics().SizeInBytes)
+
+	budget.ShrinkCaches()
+	assert.True(t, cacheA.Statistics().SizeInBytes <= 500)
+	assert.True(t, budget.UsedSizeInBytes() <= 1000)
+}
+
+func TestMemoryBudget_MemoryPressureShouldScaleTheCaches(t *testing.T) {
+	t.Parallel()
+
+	budget := adaptivecache.NewMemoryBudget()
+	cache := createCache(t, 1000, 1000, budget)
+	fillCache(cache, "key", 100, 10)
+
+	budget.UpdateScale(90, 80)
+	assert.Equal(t, float64(50), budget.ScalePercent())
+	assert.True(t, cache.Statistics().SizeInBytes <= 500)
+
+	budget.UpdateScale(75, 80)
+	assert.Equal(t, float64(50), budget.ScalePercent(), "should not grow back inside the hysteresis interval")
+
+	for i := 0; i < 10; i++ {
+		budget.UpdateScale(10, 80)
+	}
+	assert.Equal(t, float64(100), budget.ScalePercent())
+}
+
+func TestMemoryBudget_ClosedCacheShouldReleaseItsShare(t *testing.T) {
+	t.Parallel()
+
+	budget := adaptivecache.NewMemoryBudget()
+	budget.SetTotalSizeInBytes(1000)
+	cacheA := createCache(t, 1000, 1000, budget)
+	cacheB := createCache(t, 1000, 1000, budget)
+
+	fillCache(cacheA, "a", 100, 10)
+	fillCache(cacheB, "b", 30, 10)
+	budget.ShrinkCaches()
+	assert.True(t, cacheA.Statistics().SizeInBytes <= 500)
+
+	err := cacheB.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(budget.CachesStatistics()))
+	assert.Equal(t, uint64(cacheA.Statistics().SizeInBytes), budget.UsedSizeInBytes())
+
+	_ = cacheB.Put([]byte("b"), 0, 10)
+	assert.Equal(t, 0, cacheB.Len())
+
+	fillCache(cacheA, "a", 100, 10)
+	assert.Equal(t, int64(1000), cacheA.Statistics().SizeInBytes)
+}
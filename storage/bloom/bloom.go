@@ -14,8 +14,8 @@ package bloom
 import (
 	"encoding/binary"
 	"errors"
-
 	"sync"
+	"sync/atomic"
 
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
@@ -27,15 +27,20 @@ import (
 var _ storage.BloomFilter = (*Bloom)(nil)
 
 const (
-	bitsInByte = 8
+	bitsInByte                = 8
+	fingerprintBytesPerHasher = 4
+	fingerprintSeed           = "bloom filter fingerprint"
 )
 
 // Bloom represents a bloom filter. It holds the filter itself, the hashing functions that must be
 // applied to values that are added to the filter and a mutex to handle concurrent accesses to the filter
 type Bloom struct {
-	filter   []byte
-	hashFunc []hashing.Hasher
-	mutex    sync.Mutex
+	filter            []byte
+	hashFunc          []hashing.Hasher
+	mutex             sync.Mutex
+	numPositives      uint64
+	numNegatives      uint64
+	numFalsePositives uint64
 }
 
 // NewFilter returns a new Bloom object with the given size and
@@ -89,17 +94,79 @@ func (b *Bloom) MayContain(data []byte) bool {
 		pos, bitMask := getBytePositionAndBitMask(res[i])
 
 		if b.filter[pos]&bitMask == 0 {
+			atomic.AddUint64(&b.numNegatives, 1)
 			return false
 		}
 	}
+
+	atomic.AddUint64(&b.numPositives, 1)
 	return true
 }
 
 // Clear resets the bits of the bloom filter
 func (b *Bloom) Clear() {
+	b.mutex.Lock()
 	for i := 0; i < len(b.filter); i++ {
 		b.filter[i] = 0
 	}
+	b.mutex.Unlock()
+}
+
+// MarkFalsePositive records that a positive answer of MayContain was not confirmed by the DB
+func (b *Bloom) MarkFalsePositive() {
+	atomic.AddUint64(&b.numFalsePositives, 1)
+}
+
+// Statistics returns the counters used for computing the false positive rate
+func (b *Bloom) Statistics() storage.BloomFilterStatistics {
+	return storage.BloomFilterStatistics{
+		NumPositives:      atomic.LoadUint64(&b.numPositives),
+		NumNegatives:      atomic.LoadUint64(&b.numNegatives),
+		NumFalsePositives: atomic.LoadUint64(&b.numFalsePositives),
+	}
+}
+
+// Close does nothing as the in-memory bloom filter does not hold any resources
+func (b *Bloom) Close() error {
+	return nil
+}
+
+// bits returns a copy of the filter's bits
+func (b *Bloom) bits() []byte {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	bits := make([]byte, len(b.filter))
+	copy(bits, b.filter)
+
+	return bits
+}
+
+// mergeBits sets all the bits that are set in the provided slice
+func (b *Bloom) mergeBits(bits []byte) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if len(bits) != len(b.filter) {
+		return ErrFilterSizeMismatch
+	}
+
+	for i := range bits {
+		b.filter[i] |= bits[i]
+	}
+
+	return nil
+}
+
+// fingerprint identifies the hashing functions set, so bits saved with other hashers will not be loaded
+func (b *Bloom) fingerprint() []byte {
+	fingerprint := make([]byte, 0, len(b.hashFunc)*fingerprintBytesPerHasher)
+	for _, h := range b.hashFunc {
+		hash := h.Compute(fingerprintSeed)
+		fingerprint = append(fingerprint, hash[:fingerprintBytesPerHasher]...)
+	}
+
+	return fingerprint
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -0,0 +1,15 @@
+package bloom
+
+import "errors"
+
+// ErrFilterSizeMismatch signals that the provided bits do not match the size of the filter
+var ErrFilterSizeMismatch = errors.New("bloom filter size mismatch")
+
+// ErrInvalidFilterFile signals that the saved bloom filter file is invalid
+var ErrInvalidFilterFile = errors.New("invalid bloom filter file")
+
+// ErrFingerprintMismatch signals that the saved bloom filter was built with other hashing functions
+var ErrFingerprintMismatch = errors.New("bloom filter fingerprint mismatch")
+
+// ErrNilBloomFilter signals that a nil bloom filter has been provided
+var ErrNilBloomFilter = errors.New("nil bloom filter")
@@ -0,0 +1,266 @@
+package bloom
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.BloomFilter = (*persistentFilter)(nil)
+
+var log = logger.GetOrCreate("storage/bloom")
+
+// FilterFileName is the name of the file in which the bloom filter bits are saved, inside the persister's directory
+const FilterFileName = "BloomFilter.bin"
+
+const filterFileVersion = byte(1)
+const filterFileTempSuffix = ".tmp"
+const filterFileRwOwner = 0600
+
+// ArgsPersistentFilter is the DTO used to create a new persistent bloom filter
+type ArgsPersistentFilter struct {
+	Filter    *Bloom
+	Persister storage.Persister
+	// DirPath is the persister's directory. An empty value disables saving the filter on disk
+	DirPath string
+}
+
+// persistentFilter is a bloom filter whose bits are saved on close next to the persister it guards.
+// The saved copy is deleted as soon as it is loaded, so a crash can never leave behind a stale filter.
+// If there is no saved copy, the filter is rebuilt in background from the persister's keys and,
+// until it is ready, every lookup will be forwarded to the persister.
+type persistentFilter struct {
+	filter    *Bloom
+	persister storage.Persister
+	filePath  string
+	isReady   atomic.Flag
+	cancel    context.CancelFunc
+	mutClose  sync.Mutex
+	isClosed  bool
+}
+
+// NewPersistentFilter creates a bloom filter that survives restarts
+func NewPersistentFilter(args ArgsPersistentFilter) (*persistentFilter, error) {
+	if args.Filter == nil {
+		return nil, ErrNilBloomFilter
+	}
+	if check.IfNil(args.Persister) {
+		return nil, storage.ErrNilPersister
+	}
+
+	pf := &persistentFilter{
+		filter:    args.Filter,
+		persister: args.Persister,
+	}
+	if len(args.DirPath) > 0 {
+		pf.filePath = filepath.Join(args.DirPath, FilterFileName)
+	}
+
+	err := pf.loadFromFile()
+	if err == nil {
+		pf.isReady.Set()
+		return pf, nil
+	}
+
+	log.Debug("bloom filter will be rebuilt from persister", "path", args.DirPath, "reason", err.Error())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pf.cancel = cancel
+	go pf.rebuild(ctx)
+
+	return pf, nil
+}
+
+func (pf *persistentFilter) loadFromFile() error {
+	if len(pf.filePath) == 0 {
+		return ErrInvalidFilterFile
+	}
+
+	buff, err := ioutil.ReadFile(pf.filePath)
+	if err != nil {
+		return err
+	}
+
+	// the saved copy is valid only until the next write, so it will be re-created on the next clean close
+	errRemove := os.Remove(pf.filePath)
+	if errRemove != nil {
+		return errRemove
+	}
+
+	bits, err := pf.decode(buff)
+	if err != nil {
+		return err
+	}
+
+	return pf.filter.mergeBits(bits)
+}
+
+func (pf *persistentFilter) rebuild(ctx context.Context) {
+	numKeys := 0
+	wasCancelled := false
+	pf.persister.RangeKeys(func(key []byte, _ []byte) bool {
+		select {
+		case <-ctx.Done():
+			wasCancelled = true
+			return false
+		default:
+		}
+
+		pf.filter.Add(key)
+		numKeys++
+
+		return true
+	})
+
+	if wasCancelled {
+		log.Debug("bloom filter rebuild cancelled", "path", pf.filePath, "num keys", numKeys)
+		return
+	}
+
+	pf.isReady.Set()
+	log.Debug("bloom filter rebuilt", "path", pf.filePath, "num keys", numKeys)
+}
+
+// Add sets the bits that correspond to the hashes of the data
+func (pf *persistentFilter) Add(data []byte) {
+	pf.filter.Add(data)
+}
+
+// MayContain checks if the bits that correspond to the hashes of the data are set.
+// It always returns true while the filter is being rebuilt
+func (pf *persistentFilter) MayContain(data []byte) bool {
+	if !pf.isReady.IsSet() {
+		return true
+	}
+
+	return pf.filter.MayContain(data)
+}
+
+// Clear resets the bits of the bloom filter
+func (pf *persistentFilter) Clear() {
+	pf.filter.Clear()
+}
+
+// MarkFalsePositive records that a positive answer of MayContain was not confirmed by the DB
+func (pf *persistentFilter) MarkFalsePositive() {
+	if !pf.isReady.IsSet() {
+		return
+	}
+
+	pf.filter.MarkFalsePositive()
+}
+
+// Statistics returns the counters used for computing the false positive rate
+func (pf *persistentFilter) Statistics() storage.BloomFilterStatistics {
+	return pf.filter.Statistics()
+}
+
+// IsReady returns true if the filter was either loaded or completely rebuilt
+func (pf *persistentFilter) IsReady() bool {
+	return pf.isReady.IsSet()
+}
+
+// Close stops the rebuilding process, if any, and saves the filter's bits if the filter is ready.
+// It should be called before closing the persister
+func (pf *persistentFilter) Close() error {
+	pf.mutClose.Lock()
+	defer pf.mutClose.Unlock()
+
+	if pf.isClosed {
+		return nil
+	}
+	pf.isClosed = true
+
+	if pf.cancel != nil {
+		pf.cancel()
+	}
+	if !pf.isReady.IsSet() || len(pf.filePath) == 0 {
+		return nil
+	}
+
+	return pf.saveToFile()
+}
+
+func (pf *persistentFilter) saveToFile() error {
+	_, err := os.Stat(filepath.Dir(pf.filePath))
+	if err != nil {
+		// the persister's directory was removed, nothing to save
+		return nil
+	}
+
+	tempFilePath := pf.filePath + filterFileTempSuffix
+	err = ioutil.WriteFile(tempFilePath, pf.encode(), filterFileRwOwner)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilePath, pf.filePath)
+}
+
+// encode serializes the filter as: version | fingerprint length | fingerprint | bits length | bits
+func (pf *persistentFilter) encode() []byte {
+	fingerprint := pf.filter.fingerprint()
+	bits := pf.filter.bits()
+
+	buff := bytes.NewBuffer(make([]byte, 0, len(fingerprint)+len(bits)+9))
+	buff.WriteByte(filterFileVersion)
+	_ = binary.Write(buff, binary.BigEndian, uint32(len(fingerprint)))
+	buff.Write(fingerprint)
+	_ = binary.Write(buff, binary.BigEndian, uint32(len(bits)))
+	buff.Write(bits)
+
+	return buff.Bytes()
+}
+
+func (pf *persistentFilter) decode(data []byte) ([]byte, error) {
+	buff := bytes.NewBuffer(data)
+	version, err := buff.ReadByte()
+	if err != nil || version != filterFileVersion {
+		return nil, ErrInvalidFilterFile
+	}
+
+	fingerprint, err := readLengthPrefixed(buff)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(fingerprint, pf.filter.fingerprint()) {
+		return nil, ErrFingerprintMismatch
+	}
+
+	bits, err := readLengthPrefixed(buff)
+	if err != nil {
+		return nil, err
+	}
+	if buff.Len() != 0 {
+		return nil, ErrInvalidFilterFile
+	}
+
+	return bits, nil
+}
+
+func readLengthPrefixed(buff *bytes.Buffer) ([]byte, error) {
+	var length uint32
+	err := binary.Read(buff, binary.BigEndian, &length)
+	if err != nil {
+		return nil, ErrInvalidFilterFile
+	}
+	if uint32(buff.Len()) < length {
+		return nil, ErrInvalidFilterFile
+	}
+
+	return buff.Next(int(length)), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pf *persistentFilter) IsInterfaceNil() bool {
+	return pf == nil
+}
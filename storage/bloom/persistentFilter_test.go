@@ -0,0 +1,165 @@
+package bloom_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/hashing/fnv"
+	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/bloom"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestFilter(t *testing.T) *bloom.Bloom {
+	b, err := bloom.NewFilter(2048, []hashing.Hasher{keccak.Keccak{}, &blake2b.Blake2b{}, fnv.Fnv{}})
+	require.Nil(t, err)
+
+	return b
+}
+
+func waitUntilReady(t *testing.T, pf interface{ IsReady() bool }) {
+	for i := 0; i < 100; i++ {
+		if pf.IsReady() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	require.Fail(t, "bloom filter was not rebuilt in time")
+}
+
+func TestNewPersistentFilter_NilFilterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pf, err := bloom.NewPersistentFilter(bloom.ArgsPersistentFilter{
+		Persister: memorydb.New(),
+	})
+
+	assert.Nil(t, pf)
+	assert.Equal(t, bloom.ErrNilBloomFilter, err)
+}
+
+func TestNewPersistentFilter_NilPersisterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pf, err := bloom.NewPersistentFilter(bloom.ArgsPersistentFilter{
+		Filter: createTestFilter(t),
+	})
+
+	assert.Nil(t, pf)
+	assert.Equal(t, storage.ErrNilPersister, err)
+}
+
+func TestPersistentFilter_MissingFileShouldRebuildFromPersister(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	_ = db.Put([]byte("key1"), []byte("val1"))
+	_ = db.Put([]byte("key2"), []byte("val2"))
+
+	pf, err := bloom.NewPersistentFilter(bloom.ArgsPersistentFilter{
+		Filter:    createTestFilter(t),
+		Persister: db,
+	})
+	require.Nil(t, err)
+	waitUntilReady(t, pf)
+
+	assert.True(t, pf.MayContain([]byte("key1")))
+	assert.True(t, pf.MayContain([]byte("key2")))
+	assert.False(t, pf.MayContain([]byte("missing key")))
+}
+
+func TestPersistentFilter_CloseAndReopenShouldLoadTheSavedBits(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "bloom")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	pf, err := bloom.NewPersistentFilter(bloom.ArgsPersistentFilter{
+		Filter:    createTestFilter(t),
+		Persister: memorydb.New(),
+		DirPath:   dir,
+	})
+	require.Nil(t, err)
+	waitUntilReady(t, pf)
+
+	pf.Add([]byte("key"))
+	err = pf.Close()
+	assert.Nil(t, err)
+
+	_, err = os.Stat(filepath.Join(dir, bloom.FilterFileName))
+	assert.Nil(t, err)
+
+	// the key is not in the persister, so it can only be found if the bits were loaded
+	reopened, err := bloom.NewPersistentFilter(bloom.ArgsPersistentFilter{
+		Filter:    createTestFilter(t),
+		Persister: memorydb.New(),
+		DirPath:   dir,
+	})
+	require.Nil(t, err)
+	assert.True(t, reopened.IsReady())
+	assert.True(t, reopened.MayContain([]byte("key")))
+
+	_, err = os.Stat(filepath.Join(dir, bloom.FilterFileName))
+	assert.True(t, os.IsNotExist(err), "the saved copy should be removed after loading")
+}
+
+func TestPersistentFilter_FingerprintMismatchShouldRebuild(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "bloom")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	pf, _ := bloom.NewPersistentFilter(bloom.ArgsPersistentFilter{
+		Filter:    createTestFilter(t),
+		Persister: memorydb.New(),
+		DirPath:   dir,
+	})
+	waitUntilReady(t, pf)
+	pf.Add([]byte("key"))
+	_ = pf.Close()
+
+	otherHashers, _ := bloom.NewFilter(2048, []hashing.Hasher{fnv.Fnv{}, keccak.Keccak{}})
+	reopened, err := bloom.NewPersistentFilter(bloom.ArgsPersistentFilter{
+		Filter:    otherHashers,
+		Persister: memorydb.New(),
+		DirPath:   dir,
+	})
+	require.Nil(t, err)
+	waitUntilReady(t, reopened)
+
+	assert.False(t, reopened.MayContain([]byte("key")))
+}
+
+func TestPersistentFilter_StatisticsShouldCountFalsePositives(t *testing.T) {
+	t.Parallel()
+
+	pf, _ := bloom.NewPersistentFilter(bloom.ArgsPersistentFilter{
+		Filter:    createTestFilter(t),
+		Persister: memorydb.New(),
+	})
+	waitUntilReady(t, pf)
+
+	pf.Add([]byte("key"))
+	_ = pf.MayContain([]byte("key"))
+	_ = pf.MayContain([]byte("missing key"))
+	pf.MarkFalsePositive()
+
+	statistics := pf.Statistics()
+	assert.Equal(t, uint64(1), statistics.NumPositives)
+	assert.Equal(t, uint64(1), statistics.NumNegatives)
+	assert.Equal(t, uint64(1), statistics.NumFalsePositives)
+	assert.Equal(t, 0.5, statistics.FalsePositiveRate())
+}
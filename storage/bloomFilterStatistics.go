@@ -0,0 +1,27 @@
+package storage
+
+// BloomFilterStatistics holds the counters used for computing the false positive rate of a bloom filter
+type BloomFilterStatistics struct {
+	NumPositives      uint64
+	NumNegatives      uint64
+	NumFalsePositives uint64
+}
+
+// FalsePositiveRate returns the ratio between the false positives and all the lookups for absent keys
+func (bfs BloomFilterStatistics) FalsePositiveRate() float64 {
+	numAbsentKeysLookups := bfs.NumFalsePositives + bfs.NumNegatives
+	if numAbsentKeysLookups == 0 {
+		return 0
+	}
+
+	return float64(bfs.NumFalsePositives) / float64(numAbsentKeysLookups)
+}
+
+// Add returns the sum of the two statistics
+func (bfs BloomFilterStatistics) Add(other BloomFilterStatistics) BloomFilterStatistics {
+	return BloomFilterStatistics{
+		NumPositives:      bfs.NumPositives + other.NumPositives,
+		NumNegatives:      bfs.NumNegatives + other.NumNegatives,
+		NumFalsePositives: bfs.NumFalsePositives + other.NumFalsePositives,
+	}
+}
@@ -70,6 +70,9 @@ var ErrEmptyStaticPathTemplate = errors.New("empty path template for static stor
 // ErrInvalidPruningPathTemplate signals that an invalid path template for pruning storers has been provided
 var ErrInvalidPruningPathTemplate = errors.New("invalid path template for pruning storers")
 
+// ErrInvalidColdPruningPathTemplate signals that an invalid path template for the cold storage of pruning storers has been provided
+var ErrInvalidColdPruningPathTemplate = errors.New("invalid path template for cold storage of pruning storers")
+
 // ErrInvalidStaticPathTemplate signals that an invalid path template for static storers has been provided
 var ErrInvalidStaticPathTemplate = errors.New("invalid path template for static storers")
 
@@ -112,8 +115,14 @@ var ErrLRUCacheWithProvidedSize = errors.New("LRU cache does not support size in
 // ErrLRUCacheInvalidSize signals that the provided size in bytes value for LRU cache is invalid
 var ErrLRUCacheInvalidSize = errors.New("wrong size in bytes value for LRU cache")
 
+// ErrAdaptiveCacheInvalidSize signals that the provided size in bytes value for the adaptive cache is invalid
+var ErrAdaptiveCacheInvalidSize = errors.New("wrong size in bytes value for adaptive cache")
+
 // ErrNegativeSizeInBytes signals that the provided size in bytes value is negative
 var ErrNegativeSizeInBytes = errors.New("negative size in bytes")
 
 // ErrNilTimeCache signals that a nil time cache has been provided
 var ErrNilTimeCache = errors.New("nil time cache")
+
+// ErrReadOnlyPersister signals that a write operation was called on a read-only persister
+var ErrReadOnlyPersister = errors.New("persister was opened in read-only mode")
@@ -46,6 +46,22 @@ func (pf *PersisterFactory) Create(path string) (storage.Persister, error) {
 	}
 }
 
+// CreateReadOnly will open an existing DB from the given path without allowing any modification
+func (pf *PersisterFactory) CreateReadOnly(path string) (storage.Persister, error) {
+	if len(path) == 0 {
+		return nil, errors.New("invalid file path")
+	}
+
+	switch storageUnit.DBType(pf.dbType) {
+	case storageUnit.LvlDB, storageUnit.LvlDBSerial:
+		return leveldb.NewReadOnlyDB(path, pf.maxOpenFiles)
+	case storageUnit.MemoryDB:
+		return memorydb.New(), nil
+	default:
+		return nil, storage.ErrNotSupportedDBType
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (pf *PersisterFactory) IsInterfaceNil() bool {
 	return pf == nil
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/adaptivecache"
 	"github.com/ElrondNetwork/elrond-go/storage/pruning"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
@@ -27,6 +28,7 @@ type StorageServiceFactory struct {
 	pathManager        storage.PathManagerHandler
 	epochStartNotifier storage.EpochStartNotifier
 	currentEpoch       uint32
+	memoryBudget       *adaptivecache.MemoryBudget
 }
 
 // NewStorageServiceFactory will return a new instance of StorageServiceFactory
@@ -36,6 +38,7 @@ func NewStorageServiceFactory(
 	pathManager storage.PathManagerHandler,
 	epochStartNotifier storage.EpochStartNotifier,
 	currentEpoch uint32,
+	memoryBudget *adaptivecache.MemoryBudget,
 ) (*StorageServiceFactory, error) {
 	if config == nil {
 		return nil, storage.ErrNilConfig
@@ -62,6 +65,7 @@ func NewStorageServiceFactory(
 		pathManager:        pathManager,
 		epochStartNotifier: epochStartNotifier,
 		currentEpoch:       currentEpoch,
+		memoryBudget:       memoryBudget,
 	}, nil
 }
 
@@ -78,6 +82,8 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	var shardHdrHashNonceUnit *pruning.PruningStorer
 	var bootstrapUnit *pruning.PruningStorer
 	var txLogsUnit *pruning.PruningStorer
+	var accountsChangeSetUnit *pruning.PruningStorer
+	var receiptsUnit *pruning.PruningStorer
 	var err error
 
 	successfullyCreatedStorers := make([]storage.Storer, 0)
@@ -158,7 +164,7 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	dbPath := psf.pathManager.PathForStatic(shardId, psf.generalConfig.Heartbeat.HeartbeatStorage.DB.FilePath)
 	heartbeatDbConfig.FilePath = dbPath
 	heartbeatStorageUnit, err := storageUnit.NewStorageUnitFromConf(
-		GetCacherFromConfig(psf.generalConfig.Heartbeat.HeartbeatStorage.Cache),
+		psf.getCacherFromConfig(psf.generalConfig.Heartbeat.HeartbeatStorage.Cache),
 		heartbeatDbConfig,
 		GetBloomFromConfig(psf.generalConfig.Heartbeat.HeartbeatStorage.Bloom))
 	if err != nil {
@@ -171,7 +177,7 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	dbPath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.StatusMetricsStorage.DB.FilePath)
 	statusMetricsDbConfig.FilePath = dbPath
 	statusMetricsStorageUnit, err := storageUnit.NewStorageUnitFromConf(
-		GetCacherFromConfig(psf.generalConfig.StatusMetricsStorage.Cache),
+		psf.getCacherFromConfig(psf.generalConfig.StatusMetricsStorage.Cache),
 		statusMetricsDbConfig,
 		GetBloomFromConfig(psf.generalConfig.StatusMetricsStorage.Bloom))
 	if err != nil {
@@ -193,6 +199,20 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, txLogsUnit)
 
+	accountsChangeSetUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.AccountsChangeSetStorage)
+	accountsChangeSetUnit, err = pruning.NewPruningStorer(accountsChangeSetUnitArgs)
+	if err != nil {
+		return nil, err
+	}
+	successfullyCreatedStorers = append(successfullyCreatedStorers, accountsChangeSetUnit)
+
+	receiptsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.ReceiptsStorage)
+	receiptsUnit, err = pruning.NewPruningStorer(receiptsUnitArgs)
+	if err != nil {
+		return nil, err
+	}
+	successfullyCreatedStorers = append(successfullyCreatedStorers, receiptsUnit)
+
 	store := dataRetriever.NewChainStorer()
 	store.AddStorer(dataRetriever.TransactionUnit, txUnit)
 	store.AddStorer(dataRetriever.MiniBlockUnit, miniBlockUnit)
@@ -208,6 +228,8 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
 	store.AddStorer(dataRetriever.TxLogsUnit, txLogsUnit)
+	store.AddStorer(dataRetriever.AccountsChangeSetUnit, accountsChangeSetUnit)
+	store.AddStorer(dataRetriever.ReceiptsUnit, receiptsUnit)
 
 	return store, err
 }
@@ -224,6 +246,8 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	var shardHdrHashNonceUnits []*pruning.PruningStorer
 	var bootstrapUnit *pruning.PruningStorer
 	var txLogsUnit *pruning.PruningStorer
+	var accountsChangeSetUnit *pruning.PruningStorer
+	var receiptsUnit *pruning.PruningStorer
 	var err error
 
 	successfullyCreatedStorers := make([]storage.Storer, 0)
@@ -274,7 +298,7 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	dbPath := psf.pathManager.PathForStatic(shardId, psf.generalConfig.Heartbeat.HeartbeatStorage.DB.FilePath)
 	heartbeatDbConfig.FilePath = dbPath
 	heartbeatStorageUnit, err := storageUnit.NewStorageUnitFromConf(
-		GetCacherFromConfig(psf.generalConfig.Heartbeat.HeartbeatStorage.Cache),
+		psf.getCacherFromConfig(psf.generalConfig.Heartbeat.HeartbeatStorage.Cache),
 		heartbeatDbConfig,
 		GetBloomFromConfig(psf.generalConfig.Heartbeat.HeartbeatStorage.Bloom))
 	if err != nil {
@@ -287,7 +311,7 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	dbPath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.StatusMetricsStorage.DB.FilePath)
 	statusMetricsDbConfig.FilePath = dbPath
 	statusMetricsStorageUnit, err := storageUnit.NewStorageUnitFromConf(
-		GetCacherFromConfig(psf.generalConfig.StatusMetricsStorage.Cache),
+		psf.getCacherFromConfig(psf.generalConfig.StatusMetricsStorage.Cache),
 		statusMetricsDbConfig,
 		GetBloomFromConfig(psf.generalConfig.StatusMetricsStorage.Bloom))
 	if err != nil {
@@ -337,6 +361,20 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, txLogsUnit)
 
+	accountsChangeSetUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.AccountsChangeSetStorage)
+	accountsChangeSetUnit, err = pruning.NewPruningStorer(accountsChangeSetUnitArgs)
+	if err != nil {
+		return nil, err
+	}
+	successfullyCreatedStorers = append(successfullyCreatedStorers, accountsChangeSetUnit)
+
+	receiptsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.ReceiptsStorage)
+	receiptsUnit, err = pruning.NewPruningStorer(receiptsUnitArgs)
+	if err != nil {
+		return nil, err
+	}
+	successfullyCreatedStorers = append(successfullyCreatedStorers, receiptsUnit)
+
 	store := dataRetriever.NewChainStorer()
 	store.AddStorer(dataRetriever.MetaBlockUnit, metaBlockUnit)
 	store.AddStorer(dataRetriever.BlockHeaderUnit, headerUnit)
@@ -353,10 +391,20 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
 	store.AddStorer(dataRetriever.TxLogsUnit, txLogsUnit)
+	store.AddStorer(dataRetriever.AccountsChangeSetUnit, accountsChangeSetUnit)
+	store.AddStorer(dataRetriever.ReceiptsUnit, receiptsUnit)
 
 	return store, err
 }
 
+// getCacherFromConfig returns the cache config of a storer, holding the memory budget shared by the adaptive caches
+func (psf *StorageServiceFactory) getCacherFromConfig(cfg config.CacheConfig) storageUnit.CacheConfig {
+	cacheConfig := GetCacherFromConfig(cfg)
+	cacheConfig.MemoryBudget = psf.memoryBudget
+
+	return cacheConfig
+}
+
 func (psf *StorageServiceFactory) createPruningStorerArgs(storageConfig config.StorageConfig) *pruning.StorerArgs {
 	fullArchiveMode := psf.generalConfig.StoragePruning.FullArchive
 	numOfEpochsToKeep := uint32(psf.generalConfig.StoragePruning.NumEpochsToKeep)
@@ -370,7 +418,7 @@ func (psf *StorageServiceFactory) createPruningStorerArgs(storageConfig config.S
 		StartingEpoch:         psf.currentEpoch,
 		FullArchive:           fullArchiveMode,
 		ShardCoordinator:      psf.shardCoordinator,
-		CacheConf:             GetCacherFromConfig(storageConfig.Cache),
+		CacheConf:             psf.getCacherFromConfig(storageConfig.Cache),
 		PathManager:           psf.pathManager,
 		DbPath:                dbPath,
 		PersisterFactory:      NewPersisterFactory(storageConfig.DB),
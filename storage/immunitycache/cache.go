@@ -239,6 +239,17 @@ func (ic *ImmunityCache) UnRegisterHandler(_ string) {
 	log.Error("ImmunityCache.UnRegisterHandler is not implemented")
 }
 
+// RegisterEvictionHandler registers the handler notified about the items evicted by the cache. The removals requested
+// on the cache are not notified
+func (ic *ImmunityCache) RegisterEvictionHandler(handler func(key []byte)) {
+	ic.mutex.RLock()
+	defer ic.mutex.RUnlock()
+
+	for _, chunk := range ic.chunks {
+		chunk.setEvictionHandler(handler)
+	}
+}
+
 // ForEachItem iterates over the items in the cache
 func (ic *ImmunityCache) ForEachItem(function storage.ForEachItem) {
 	for _, chunk := range ic.getChunksWithLock() {
@@ -16,6 +16,7 @@ type immunityChunk struct {
 	itemsAsList *list.List
 	immuneKeys  map[string]struct{}
 	numBytes    int
+	onEvicted   func(key []byte)
 	mutex       sync.RWMutex
 }
 
@@ -141,6 +142,10 @@ func (chunk *immunityChunk) removeOldestNoLock(numToRemove int) int {
 
 		chunk.removeNoLock(elementToRemove)
 		numRemoved++
+
+		if chunk.onEvicted != nil {
+			chunk.onEvicted(item.GetKey())
+		}
 	}
 
 	return numRemoved
@@ -153,6 +158,12 @@ func (chunk *immunityChunk) removeNoLock(element *list.Element) {
 	chunk.trackNumBytesOnRemoveNoLock(item)
 }
 
+func (chunk *immunityChunk) setEvictionHandler(handler func(key []byte)) {
+	chunk.mutex.Lock()
+	chunk.onEvicted = handler
+	chunk.mutex.Unlock()
+}
+
 func (chunk *immunityChunk) monitorEvictionNoLock(numRemoved int, err error) {
 	cacheName := chunk.config.cacheName
 
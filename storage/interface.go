@@ -25,6 +25,8 @@ type Persister interface {
 	Destroy() error
 	// DestroyClosed removes the already closed persistence medium stored data
 	DestroyClosed() error
+	// RangeKeys iterates over all the stored (key, value) pairs until the handler returns false
+	RangeKeys(handler func(key []byte, val []byte) bool)
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -86,6 +88,12 @@ type BloomFilter interface {
 	MayContain([]byte) bool
 	//Clear sets all the bits from the filter to 0
 	Clear()
+	// MarkFalsePositive records that a positive answer of MayContain was not confirmed by the DB
+	MarkFalsePositive()
+	// Statistics returns the counters used for computing the false positive rate
+	Statistics() BloomFilterStatistics
+	// Close releases the resources held by the bloom filter, saving its bits if it is a persistent one
+	Close() error
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -112,6 +120,12 @@ type StorerWithPutInEpoch interface {
 	SetEpochForPutOperation(epoch uint32)
 }
 
+// StorerWrapper defines a component able to decorate the storers, e.g. for collecting usage statistics
+type StorerWrapper interface {
+	Wrap(identifier string, storer Storer) Storer
+	IsInterfaceNil() bool
+}
+
 // EpochStartNotifier defines which actions should be done for handling new epoch's events
 type EpochStartNotifier interface {
 	RegisterHandler(handler epochStart.ActionHandler)
@@ -123,6 +137,7 @@ type EpochStartNotifier interface {
 // PathManagerHandler defines which actions should be done for generating paths for databases directories
 type PathManagerHandler interface {
 	PathForEpoch(shardId string, epoch uint32, identifier string) string
+	PathForEpochInColdStorage(shardId string, epoch uint32, identifier string) string
 	PathForStatic(shardId string, identifier string) string
 	IsInterfaceNil() bool
 }
@@ -67,3 +67,25 @@ func openOneTime(path string, options *opt.Options) (*leveldb.DB, error) {
 
 	return nil, errOpen
 }
+
+func rangeKeys(db *leveldb.DB, handler func(key []byte, val []byte) bool) {
+	iterator := db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		key := make([]byte, len(iterator.Key()))
+		copy(key, iterator.Key())
+		val := make([]byte, len(iterator.Value()))
+		copy(val, iterator.Value())
+
+		shouldContinue := handler(key, val)
+		if !shouldContinue {
+			break
+		}
+	}
+
+	err := iterator.Error()
+	if err != nil {
+		log.Debug("leveldb range keys", "error", err.Error())
+	}
+}
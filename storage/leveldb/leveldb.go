@@ -244,6 +244,27 @@ func (s *DB) DestroyClosed() error {
 	return os.RemoveAll(s.path)
 }
 
+// RangeKeys will iterate over all contained (key, value) pairs calling the handler for each pair.
+// The pending batch is written first so the iteration also covers the latest writes
+func (s *DB) RangeKeys(handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	s.mutBatch.Lock()
+	err := s.putBatch(s.batch)
+	if err == nil {
+		s.batch.Reset()
+		s.sizeBatch = 0
+	}
+	s.mutBatch.Unlock()
+	if err != nil {
+		log.Warn("leveldb putBatch before range keys", "error", err.Error())
+	}
+
+	rangeKeys(s.db, handler)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (s *DB) IsInterfaceNil() bool {
 	return s == nil
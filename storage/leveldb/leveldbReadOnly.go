@@ -0,0 +1,121 @@
+package leveldb
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+var _ storage.Persister = (*ReadOnlyDB)(nil)
+
+// ReadOnlyDB holds a pointer to a leveldb database opened in read-only mode. It is used for accessing
+// the databases of old epochs, without altering their files
+type ReadOnlyDB struct {
+	db   *leveldb.DB
+	path string
+}
+
+// NewReadOnlyDB is a constructor for the read-only leveldb persister
+// The database has to already exist in the location given as parameter
+func NewReadOnlyDB(path string, maxOpenFiles int) (*ReadOnlyDB, error) {
+	if maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+
+	_, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &opt.Options{
+		// disable internal cache
+		BlockCacheCapacity:     -1,
+		OpenFilesCacheCapacity: maxOpenFiles,
+		ReadOnly:               true,
+	}
+
+	db, err := openLevelDB(path, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	return &ReadOnlyDB{
+		db:   db,
+		path: path,
+	}, nil
+}
+
+// Put returns error as the database is opened in read-only mode
+func (s *ReadOnlyDB) Put(_, _ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Get returns the value associated to the key
+func (s *ReadOnlyDB) Get(key []byte) ([]byte, error) {
+	data, err := s.db.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *ReadOnlyDB) Has(key []byte) error {
+	has, err := s.db.Has(key, nil)
+	if err != nil {
+		return err
+	}
+
+	if has {
+		return nil
+	}
+
+	return storage.ErrKeyNotFound
+}
+
+// Init initializes the storage medium and prepares it for usage
+func (s *ReadOnlyDB) Init() error {
+	// no special initialization needed
+	return nil
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *ReadOnlyDB) Close() error {
+	return s.db.Close()
+}
+
+// Remove returns error as the database is opened in read-only mode
+func (s *ReadOnlyDB) Remove(_ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Destroy returns error as the database is opened in read-only mode
+func (s *ReadOnlyDB) Destroy() error {
+	return storage.ErrReadOnlyPersister
+}
+
+// DestroyClosed returns error as the database is opened in read-only mode
+func (s *ReadOnlyDB) DestroyClosed() error {
+	return storage.ErrReadOnlyPersister
+}
+
+// RangeKeys will iterate over all contained (key, value) pairs calling the handler for each pair
+func (s *ReadOnlyDB) RangeKeys(handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	rangeKeys(s.db, handler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *ReadOnlyDB) IsInterfaceNil() bool {
+	return s == nil
+}
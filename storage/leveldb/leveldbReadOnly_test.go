@@ -0,0 +1,71 @@
+package leveldb_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReadOnlyDB_InvalidNumOpenFilesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	db, err := leveldb.NewReadOnlyDB("path", 0)
+	assert.Nil(t, db)
+	assert.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+}
+
+func TestNewReadOnlyDB_MissingDirectoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "leveldb_temp")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	missingPath := filepath.Join(dir, "missing")
+	db, err := leveldb.NewReadOnlyDB(missingPath, 10)
+	assert.Nil(t, db)
+	assert.NotNil(t, err)
+
+	_, err = os.Stat(missingPath)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestReadOnlyDB_ShouldReadButNotAlterTheDatabase(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "leveldb_temp")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	key, val := []byte("key"), []byte("val")
+	db, err := leveldb.NewSerialDB(dir, 10, 1, 10)
+	require.Nil(t, err)
+	require.Nil(t, db.Put(key, val))
+	require.Nil(t, db.Close())
+
+	roDb, err := leveldb.NewReadOnlyDB(dir, 10)
+	require.Nil(t, err)
+
+	recovered, err := roDb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+	assert.Nil(t, roDb.Has(key))
+	assert.Equal(t, storage.ErrKeyNotFound, roDb.Has([]byte("missing")))
+
+	assert.Equal(t, storage.ErrReadOnlyPersister, roDb.Put([]byte("key2"), val))
+	assert.Equal(t, storage.ErrReadOnlyPersister, roDb.Remove(key))
+	assert.Equal(t, storage.ErrReadOnlyPersister, roDb.Destroy())
+	assert.Nil(t, roDb.Close())
+	assert.Equal(t, storage.ErrReadOnlyPersister, roDb.DestroyClosed())
+
+	_, err = os.Stat(dir)
+	assert.Nil(t, err)
+}
@@ -292,6 +292,21 @@ func (s *SerialDB) DestroyClosed() error {
 	return err
 }
 
+// RangeKeys will iterate over all contained (key, value) pairs calling the handler for each pair.
+// The pending batch is written first so the iteration also covers the latest writes
+func (s *SerialDB) RangeKeys(handler func(key []byte, val []byte) bool) {
+	if handler == nil || s.isClosed() {
+		return
+	}
+
+	err := s.putBatch()
+	if err != nil {
+		log.Warn("leveldb serial putBatch before range keys", "error", err.Error())
+	}
+
+	rangeKeys(s.db, handler)
+}
+
 func (s *SerialDB) processLoop(ctx context.Context) {
 	for {
 		select {
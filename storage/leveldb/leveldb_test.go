@@ -260,3 +260,46 @@ func TestDB_Destroy(t *testing.T) {
 
 	assert.Nil(t, err, "no error expected but got %s", err)
 }
+
+func TestDB_RangeKeysShouldIterateAllKeysIncludingTheBatchedOnes(t *testing.T) {
+	ldb := createLevelDb(t, 10, 100, 10)
+	defer func() {
+		_ = ldb.Destroy()
+	}()
+
+	keys := map[string][]byte{
+		"key1": []byte("val1"),
+		"key2": []byte("val2"),
+		"key3": []byte("val3"),
+	}
+	for key, val := range keys {
+		err := ldb.Put([]byte(key), val)
+		require.Nil(t, err)
+	}
+
+	recovered := make(map[string][]byte)
+	ldb.RangeKeys(func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	})
+
+	assert.Equal(t, keys, recovered)
+}
+
+func TestDB_RangeKeysShouldStopWhenHandlerReturnsFalse(t *testing.T) {
+	ldb := createLevelDb(t, 10, 1, 10)
+	defer func() {
+		_ = ldb.Destroy()
+	}()
+
+	_ = ldb.Put([]byte("key1"), []byte("val1"))
+	_ = ldb.Put([]byte("key2"), []byte("val2"))
+
+	numCalls := 0
+	ldb.RangeKeys(func(key []byte, val []byte) bool {
+		numCalls++
+		return false
+	})
+
+	assert.Equal(t, 1, numCalls)
+}
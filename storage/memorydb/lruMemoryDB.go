@@ -81,6 +81,30 @@ func (l *lruDB) DestroyClosed() error {
 	return l.Destroy()
 }
 
+// RangeKeys will iterate over all contained (key, value) pairs calling the handler for each pair
+func (l *lruDB) RangeKeys(handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	for _, key := range l.cacher.Keys() {
+		val, ok := l.cacher.Peek(key)
+		if !ok {
+			continue
+		}
+
+		buff, ok := val.([]byte)
+		if !ok {
+			continue
+		}
+
+		shouldContinue := handler(key, buff)
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (l *lruDB) IsInterfaceNil() bool {
 	return l == nil
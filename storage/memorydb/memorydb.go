@@ -100,6 +100,23 @@ func (s *DB) DestroyClosed() error {
 	return s.Destroy()
 }
 
+// RangeKeys will iterate over all contained (key, value) pairs calling the handler for each pair
+func (s *DB) RangeKeys(handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	s.mutx.RLock()
+	defer s.mutx.RUnlock()
+
+	for k, v := range s.db {
+		shouldContinue := handler([]byte(k), v)
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (s *DB) IsInterfaceNil() bool {
 	return s == nil
@@ -12,8 +12,9 @@ var _ storage.PathManagerHandler = (*PathManager)(nil)
 
 // PathManager will handle creation of paths for storers
 type PathManager struct {
-	pruningPathTemplate string
-	staticPathTemplate  string
+	pruningPathTemplate     string
+	staticPathTemplate      string
+	coldPruningPathTemplate string
 }
 
 // NewPathManager will return a new instance of PathManager if the provided arguments are fine
@@ -21,9 +22,7 @@ func NewPathManager(pruningPathTemplate string, staticPathTemplate string) (*Pat
 	if len(pruningPathTemplate) == 0 {
 		return nil, storage.ErrEmptyPruningPathTemplate
 	}
-	if !strings.Contains(pruningPathTemplate, core.PathEpochPlaceholder) ||
-		!strings.Contains(pruningPathTemplate, core.PathShardPlaceholder) ||
-		!strings.Contains(pruningPathTemplate, core.PathIdentifierPlaceholder) {
+	if !isValidPruningPathTemplate(pruningPathTemplate) {
 		return nil, storage.ErrInvalidPruningPathTemplate
 	}
 
@@ -41,9 +40,53 @@ func NewPathManager(pruningPathTemplate string, staticPathTemplate string) (*Pat
 	}, nil
 }
 
+// NewPathManagerWithColdStorage will return a new instance of PathManager which will also generate the paths
+// where the closed epochs' persisters are moved to
+func NewPathManagerWithColdStorage(
+	pruningPathTemplate string,
+	staticPathTemplate string,
+	coldPruningPathTemplate string,
+) (*PathManager, error) {
+	pm, err := NewPathManager(pruningPathTemplate, staticPathTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isValidPruningPathTemplate(coldPruningPathTemplate) {
+		return nil, storage.ErrInvalidColdPruningPathTemplate
+	}
+	if coldPruningPathTemplate == pruningPathTemplate {
+		return nil, storage.ErrInvalidColdPruningPathTemplate
+	}
+
+	pm.coldPruningPathTemplate = coldPruningPathTemplate
+
+	return pm, nil
+}
+
+func isValidPruningPathTemplate(pathTemplate string) bool {
+	return strings.Contains(pathTemplate, core.PathEpochPlaceholder) &&
+		strings.Contains(pathTemplate, core.PathShardPlaceholder) &&
+		strings.Contains(pathTemplate, core.PathIdentifierPlaceholder)
+}
+
 // PathForEpoch will return the new path for a pruning storer
 func (pm *PathManager) PathForEpoch(shardId string, epoch uint32, identifier string) string {
-	path := pm.pruningPathTemplate
+	return replacePruningPlaceholders(pm.pruningPathTemplate, shardId, epoch, identifier)
+}
+
+// PathForEpochInColdStorage will return the path of a closed epoch's persister from the cold storage.
+// It returns an empty string if the cold storage is not configured
+func (pm *PathManager) PathForEpochInColdStorage(shardId string, epoch uint32, identifier string) string {
+	if len(pm.coldPruningPathTemplate) == 0 {
+		return ""
+	}
+
+	return replacePruningPlaceholders(pm.coldPruningPathTemplate, shardId, epoch, identifier)
+}
+
+func replacePruningPlaceholders(pathTemplate string, shardId string, epoch uint32, identifier string) string {
+	path := pathTemplate
 	path = strings.Replace(path, core.PathEpochPlaceholder, fmt.Sprintf("%d", epoch), 1)
 	path = strings.Replace(path, core.PathShardPlaceholder, shardId, 1)
 	path = strings.Replace(path, core.PathIdentifierPlaceholder, identifier, 1)
@@ -152,3 +152,31 @@ func TestPathManager_PathForStatic(t *testing.T) {
 		})
 	}
 }
+
+func TestNewPathManagerWithColdStorage_InvalidColdPathTemplateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pm, err := pathmanager.NewPathManagerWithColdStorage("epoch_[E]/shard_[S]/[I]", "shard_[S]/[I]", "cold/shard_[S]/[I]")
+	assert.Nil(t, pm)
+	assert.Equal(t, storage.ErrInvalidColdPruningPathTemplate, err)
+}
+
+func TestNewPathManagerWithColdStorage_SameColdPathTemplateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pm, err := pathmanager.NewPathManagerWithColdStorage("epoch_[E]/shard_[S]/[I]", "shard_[S]/[I]", "epoch_[E]/shard_[S]/[I]")
+	assert.Nil(t, pm)
+	assert.Equal(t, storage.ErrInvalidColdPruningPathTemplate, err)
+}
+
+func TestPathManager_PathForEpochInColdStorage(t *testing.T) {
+	t.Parallel()
+
+	pm, _ := pathmanager.NewPathManager("Epoch_[E]/Shard_[S]/[I]", "Shard_[S]/[I]")
+	assert.Equal(t, "", pm.PathForEpochInColdStorage("0", 2, "table"))
+
+	pm, err := pathmanager.NewPathManagerWithColdStorage("Epoch_[E]/Shard_[S]/[I]", "Shard_[S]/[I]", "Cold/Epoch_[E]/Shard_[S]/[I]")
+	assert.Nil(t, err)
+	assert.Equal(t, "Epoch_2/Shard_0/table", pm.PathForEpoch("0", 2, "table"))
+	assert.Equal(t, "Cold/Epoch_2/Shard_0/table", pm.PathForEpochInColdStorage("0", 2, "table"))
+}
@@ -36,3 +36,21 @@ func (ps *PruningStorer) GetActivePersistersEpochs() []uint32 {
 func RemoveDirectoryIfEmpty(path string) {
 	removeDirectoryIfEmpty(path)
 }
+
+func (ps *PruningStorer) AreBloomFiltersReady() bool {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	for _, pd := range ps.activePersisters {
+		readyHandler, ok := pd.bloomFilter.(interface{ IsReady() bool })
+		if ok && !readyHandler.IsReady() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func CopyDirectory(src string, dst string) error {
+	return copyDirectory(src, dst)
+}
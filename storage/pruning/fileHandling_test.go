@@ -0,0 +1,41 @@
+package pruning_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage/pruning"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyDirectory_ShouldCopyRecursivelyAndReplaceTheDestination(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "pruning_copy")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	src := filepath.Join(dir, "src")
+	dst := filepath.Join(dir, "dst")
+	require.Nil(t, os.MkdirAll(filepath.Join(src, "sub"), 0700))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(src, "a"), []byte("a"), 0600))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(src, "sub", "b"), []byte("b"), 0600))
+	require.Nil(t, os.MkdirAll(dst, 0700))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dst, "stale"), []byte("stale"), 0600))
+
+	err := pruning.CopyDirectory(src, dst)
+	assert.Nil(t, err)
+
+	content, err := ioutil.ReadFile(filepath.Join(dst, "a"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("a"), content)
+	content, err = ioutil.ReadFile(filepath.Join(dst, "sub", "b"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("b"), content)
+	_, err = os.Stat(filepath.Join(dst, "stale"))
+	assert.True(t, os.IsNotExist(err))
+}
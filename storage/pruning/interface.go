@@ -15,5 +15,6 @@ type EpochStartNotifier interface {
 // DbFactoryHandler defines what a db factory implementation should do
 type DbFactoryHandler interface {
 	Create(filePath string) (storage.Persister, error)
+	CreateReadOnly(filePath string) (storage.Persister, error)
 	IsInterfaceNil() bool
 }
@@ -4,7 +4,11 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
+	"os"
+	"path/filepath"
+	"sort"
 	"sync"
+	"sync/atomic"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -24,16 +28,68 @@ var log = logger.GetOrCreate("storage/pruning")
 // and requires data from older epochs
 const maxNumEpochsToKeepIfAShardIsStuck = 5
 
+const rwxOwner = 0700
+
 // epochForDefaultEpochPrepareHdr represents the default epoch number for the meta block which is saved on EpochPrepareAction
 // it is useful for checking if any metablock of this kind is received
 const epochForDefaultEpochPrepareHdr = math.MaxUint32 - 7
 
+// maxNumOpenedClosedPersisters is the number of closed persisters kept open after being read, so the consecutive
+// reads from the old epochs do not reopen their databases each time
+const maxNumOpenedClosedPersisters = 5
+
 // persisterData structure is used so the persister and its path can be kept in the same place
 type persisterData struct {
-	persister storage.Persister
-	path      string
-	epoch     uint32
-	isClosed  bool
+	persister       storage.Persister
+	openedPersister storage.Persister
+	bloomFilter     storage.BloomFilter
+	path            string
+	coldPath        string
+	epoch           uint32
+	isClosed        bool
+	isInColdStorage bool
+	isRelocating    bool
+}
+
+// mayContain returns false only if the persister's bloom filter confirms that the key is not stored
+func (pd *persisterData) mayContain(key []byte) bool {
+	return pd.bloomFilter == nil || pd.bloomFilter.MayContain(key)
+}
+
+func (pd *persisterData) markBloomFilterFalsePositive() {
+	if pd.bloomFilter != nil {
+		pd.bloomFilter.MarkFalsePositive()
+	}
+}
+
+func (pd *persisterData) bloomFilterStatistics() storage.BloomFilterStatistics {
+	if pd.bloomFilter == nil {
+		return storage.BloomFilterStatistics{}
+	}
+
+	return pd.bloomFilter.Statistics()
+}
+
+// closeBloomFilter saves the bloom filter next to the persister, so it will be reloaded when the persister is reopened
+func (pd *persisterData) closeBloomFilter() {
+	if pd.bloomFilter == nil {
+		return
+	}
+
+	err := pd.bloomFilter.Close()
+	if err != nil {
+		log.Debug("cannot save bloom filter", "path", pd.path, "error", err.Error())
+	}
+	pd.bloomFilter = nil
+}
+
+// destroyClosed removes a closed persister. The persisters from the cold storage are not opened for this
+func (pd *persisterData) destroyClosed() error {
+	if pd.isInColdStorage {
+		return os.RemoveAll(pd.path)
+	}
+
+	return pd.persister.DestroyClosed()
 }
 
 // PruningStorer represents a storer which creates a new persister for each epoch and removes older activePersisters
@@ -42,14 +98,19 @@ type PruningStorer struct {
 	shardCoordinator      storage.ShardCoordinator
 	activePersisters      []*persisterData
 	persistersMapByEpoch  map[uint32]*persisterData
+	openedPersisters      []*persisterData
 	cacher                storage.Cacher
-	bloomFilter           storage.BloomFilter
+	bloomFilterConf       storageUnit.BloomConfig
+	closedBloomStatistics storage.BloomFilterStatistics
+	numCacheHits          uint64
 	pathManager           storage.PathManagerHandler
 	dbPath                string
 	persisterFactory      DbFactoryHandler
 	mutEpochPrepareHdr    sync.RWMutex
 	epochPrepareHdr       *block.MetaBlock
 	identifier            string
+	baseIdentifier        string
+	shardIdStr            string
 	numOfEpochsToKeep     uint32
 	numOfActivePersisters uint32
 	epochForPutOperation  uint32
@@ -78,7 +139,6 @@ func initPruningStorer(
 ) (*PruningStorer, error) {
 	var cache storage.Cacher
 	var db storage.Persister
-	var bf storage.BloomFilter
 	var err error
 
 	defer func() {
@@ -106,10 +166,17 @@ func initPruningStorer(
 		return nil, storage.ErrCacheSizeIsLowerThanBatchSize
 	}
 
-	cache, err = storageUnit.NewCache(args.CacheConf.Type, args.CacheConf.Capacity, args.CacheConf.Shards, args.CacheConf.SizeInBytes)
+	cache, err = storageUnit.NewCacheWithMemoryBudget(
+		args.CacheConf.Type,
+		args.CacheConf.Capacity,
+		args.CacheConf.Shards,
+		args.CacheConf.SizeInBytes,
+		args.CacheConf.MemoryBudget,
+	)
 	if err != nil {
 		return nil, err
 	}
+	storageUnit.SetCacheName(cache, args.Identifier+shardIdStr)
 
 	persisters, persistersMapByEpoch, err := initPersistersInEpoch(args, shardIdStr)
 	if err != nil {
@@ -124,6 +191,8 @@ func initPruningStorer(
 	pdb := &PruningStorer{
 		pruningEnabled:        args.PruningEnabled,
 		identifier:            identifier,
+		baseIdentifier:        args.Identifier,
+		shardIdStr:            shardIdStr,
 		fullArchive:           args.FullArchive,
 		activePersisters:      persisters,
 		persisterFactory:      args.PersisterFactory,
@@ -131,7 +200,7 @@ func initPruningStorer(
 		persistersMapByEpoch:  persistersMapByEpoch,
 		cacher:                cache,
 		epochPrepareHdr:       &block.MetaBlock{Epoch: epochForDefaultEpochPrepareHdr},
-		bloomFilter:           nil,
+		bloomFilterConf:       args.BloomFilterConf,
 		epochForPutOperation:  args.StartingEpoch,
 		pathManager:           args.PathManager,
 		dbPath:                args.DbPath,
@@ -139,14 +208,13 @@ func initPruningStorer(
 		numOfActivePersisters: args.NumOfActivePersisters,
 	}
 
-	if args.BloomFilterConf.Size != 0 { // if size is 0, that means an empty config was used so bloom filter will be nil
-		bf, err = storageUnit.NewBloomFilter(args.BloomFilterConf)
-		if err != nil {
-			return nil, err
+	pdb.lock.Lock()
+	for _, pd := range pdb.persistersMapByEpoch {
+		if pd.isClosed {
+			pdb.relocateToColdStorage(pd)
 		}
-
-		pdb.bloomFilter = bf
 	}
+	pdb.lock.Unlock()
 
 	pdb.registerHandler(args.Notifier)
 
@@ -161,7 +229,7 @@ func initPersistersInEpoch(
 	persistersMapByEpoch := make(map[uint32]*persisterData)
 
 	if !args.PruningEnabled {
-		p, err := createPersisterDataForEpoch(args, 0, shardIdStr)
+		p, err := createActivePersisterDataForEpoch(args, 0, shardIdStr)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -183,22 +251,24 @@ func initPersistersInEpoch(
 	}
 
 	for epoch := int64(args.StartingEpoch); epoch >= oldestEpochKeep; epoch-- {
-		p, err := createPersisterDataForEpoch(args, uint32(epoch), shardIdStr)
+		if epoch < oldestEpochActive {
+			p, err := createClosedPersisterDataForEpoch(args, uint32(epoch), shardIdStr)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			persistersMapByEpoch[uint32(epoch)] = p
+			continue
+		}
+
+		p, err := createActivePersisterDataForEpoch(args, uint32(epoch), shardIdStr)
 		if err != nil {
 			return nil, nil, err
 		}
 
 		persistersMapByEpoch[uint32(epoch)] = p
-
-		if epoch < oldestEpochActive {
-			err = p.persister.Close()
-			if err != nil {
-				log.Debug("persister.Close()", "error", err.Error())
-			}
-		} else {
-			persisters = append(persisters, p)
-			log.Debug("appended a pruning active persister", "epoch", epoch, "identifier", args.Identifier)
-		}
+		persisters = append(persisters, p)
+		log.Debug("appended a pruning active persister", "epoch", epoch, "identifier", args.Identifier)
 	}
 
 	return persisters, persistersMapByEpoch, nil
@@ -229,8 +299,8 @@ func (ps *PruningStorer) Put(key, data []byte) error {
 		return err
 	}
 
-	if ps.bloomFilter != nil {
-		ps.bloomFilter.Add(key)
+	if persisterToUse.bloomFilter != nil {
+		persisterToUse.bloomFilter.Add(key)
 	}
 
 	return nil
@@ -245,14 +315,18 @@ func (ps *PruningStorer) Get(key []byte) ([]byte, error) {
 	v, ok := ps.cacher.Get(key)
 	var err error
 
-	if !ok {
+	if ok {
+		atomic.AddUint64(&ps.numCacheHits, 1)
+	} else {
 		// not found in cache
 		// search it in active persisters
 		found := false
 		for idx := uint32(0); (idx < ps.numOfActivePersisters) && (idx < uint32(len(ps.activePersisters))); idx++ {
-			if ps.bloomFilter == nil || ps.bloomFilter.MayContain(key) {
-				v, err = ps.activePersisters[idx].persister.Get(key)
+			pd := ps.activePersisters[idx]
+			if pd.mayContain(key) {
+				v, err = pd.persister.Get(key)
 				if err != nil {
+					pd.markBloomFilterFalsePositive()
 					continue
 				}
 
@@ -278,8 +352,15 @@ func (ps *PruningStorer) Get(key []byte) ([]byte, error) {
 
 // Close will close PruningStorer
 func (ps *PruningStorer) Close() error {
+	storageUnit.CloseCache(ps.cacher)
+
+	ps.lock.Lock()
+	ps.releaseAllOpenedClosedPersisters()
+	ps.lock.Unlock()
+
 	closedSuccessfully := true
 	for _, persister := range ps.activePersisters {
+		persister.closeBloomFilter()
 		err := persister.persister.Close()
 
 		if err != nil {
@@ -303,6 +384,7 @@ func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error)
 
 	v, ok := ps.cacher.Get(key)
 	if ok {
+		atomic.AddUint64(&ps.numCacheHits, 1)
 		return v.([]byte), nil
 	}
 
@@ -313,29 +395,20 @@ func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error)
 	}
 
 	if !pd.isClosed {
-		return pd.persister.Get(key)
-	}
-
-	persister, err := ps.persisterFactory.Create(pd.path)
-	if err != nil {
-		log.Debug("open old persister", "error", err.Error())
-		return nil, err
-	}
+		if !pd.mayContain(key) {
+			return nil, fmt.Errorf("key %s not found in %s",
+				hex.EncodeToString(key), ps.identifier)
+		}
 
-	defer func() {
-		err = persister.Close()
+		res, err := pd.persister.Get(key)
 		if err != nil {
-			log.Debug("persister.Close()", "error", err.Error())
+			pd.markBloomFilterFalsePositive()
 		}
-	}()
 
-	err = persister.Init()
-	if err != nil {
-		log.Debug("init old persister", "error", err.Error())
-		return nil, err
+		return res, err
 	}
 
-	res, err := persister.Get(key)
+	res, err := ps.getFromClosedPersister(pd, key)
 	if err == nil {
 		return res, nil
 	}
@@ -351,23 +424,35 @@ func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error)
 
 }
 
-// SearchFirst will search a given key in all the active persisters, from the newest to the oldest
+// SearchFirst will search a given key in all the active persisters, from the newest to the oldest, and then in the
+// closed persisters moved to the cold storage
 func (ps *PruningStorer) SearchFirst(key []byte) ([]byte, error) {
 	ps.lock.Lock()
 	defer ps.lock.Unlock()
 
 	v, ok := ps.cacher.Get(key)
 	if ok {
+		atomic.AddUint64(&ps.numCacheHits, 1)
 		return v.([]byte), nil
 	}
 
 	var res []byte
 	var err error
 	for _, pd := range ps.activePersisters {
+		if !pd.mayContain(key) {
+			continue
+		}
+
 		res, err = pd.persister.Get(key)
 		if err == nil {
 			return res, nil
 		}
+		pd.markBloomFilterFalsePositive()
+	}
+
+	res, err = ps.searchFirstInColdStorage(key)
+	if err == nil {
+		return res, nil
 	}
 
 	return nil, fmt.Errorf("%w - SearchFirst, unit = %s, key = %s, num active persisters = %d",
@@ -387,17 +472,20 @@ func (ps *PruningStorer) Has(key []byte) error {
 
 	has := ps.cacher.Has(key)
 	if has {
+		atomic.AddUint64(&ps.numCacheHits, 1)
 		return nil
 	}
 
-	if ps.bloomFilter == nil || ps.bloomFilter.MayContain(key) {
-		for _, persister := range ps.activePersisters {
-			if persister.persister.Has(key) != nil {
-				continue
-			}
-
-			return nil
+	for _, persister := range ps.activePersisters {
+		if !persister.mayContain(key) {
+			continue
+		}
+		if persister.persister.Has(key) != nil {
+			persister.markBloomFilterFalsePositive()
+			continue
 		}
+
+		return nil
 	}
 
 	return storage.ErrKeyNotFound
@@ -408,44 +496,33 @@ func (ps *PruningStorer) Has(key []byte) error {
 // and if present it checks the db
 func (ps *PruningStorer) HasInEpoch(key []byte, epoch uint32) error {
 	// TODO: this will be used when requesting from resolvers
-	ps.lock.RLock()
-	defer ps.lock.RUnlock()
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
 
 	has := ps.cacher.Has(key)
 	if has {
+		atomic.AddUint64(&ps.numCacheHits, 1)
 		return nil
 	}
 
-	if ps.bloomFilter == nil || ps.bloomFilter.MayContain(key) {
-		pd, ok := ps.persistersMapByEpoch[epoch]
-		if !ok {
-			return storage.ErrKeyNotFound
-		}
+	pd, ok := ps.persistersMapByEpoch[epoch]
+	if !ok {
+		return storage.ErrKeyNotFound
+	}
 
+	if pd.mayContain(key) {
 		if !pd.isClosed {
-			return pd.persister.Has(key)
-		}
-
-		persister, err := ps.persisterFactory.Create(pd.path)
-		if err != nil {
-			log.Debug("open old persister", "error", err.Error())
-			return err
-		}
-
-		defer func() {
-			err = persister.Close()
+			err := pd.persister.Has(key)
 			if err != nil {
-				log.Debug("persister.Close()", "error", err.Error())
+				pd.markBloomFilterFalsePositive()
 			}
-		}()
 
-		err = persister.Init()
-		if err != nil {
-			log.Debug("init old persister", "error", err.Error())
 			return err
 		}
 
-		return persister.Has(key)
+		_, err := ps.getFromClosedPersister(pd, key)
+
+		return err
 	}
 
 	return storage.ErrKeyNotFound
@@ -485,18 +562,20 @@ func (ps *PruningStorer) DestroyUnit() error {
 	ps.lock.Lock()
 	defer ps.lock.Unlock()
 
-	if ps.bloomFilter != nil {
-		ps.bloomFilter.Clear()
-	}
-
 	ps.cacher.Clear()
+	storageUnit.CloseCache(ps.cacher)
+	ps.releaseAllOpenedClosedPersisters()
 
 	var err error
 	numOfPersistersRemoved := 0
 	totalNumOfPersisters := len(ps.persistersMapByEpoch)
 	for _, pd := range ps.persistersMapByEpoch {
+		if pd.bloomFilter != nil {
+			pd.bloomFilter.Clear()
+		}
+
 		if pd.isClosed {
-			err = pd.persister.DestroyClosed()
+			err = pd.destroyClosed()
 		} else {
 			err = pd.persister.Destroy()
 		}
@@ -577,30 +656,38 @@ func (ps *PruningStorer) changeEpoch(header data.HeaderHandler) error {
 		return nil
 	}
 
-	shardId := core.GetShardIdString(ps.shardCoordinator.SelfId())
-	filePath := ps.pathManager.PathForEpoch(shardId, epoch, ps.identifier)
+	filePath, coldPath := persisterPathsForEpoch(ps.pathManager, ps.shardCoordinator, ps.baseIdentifier, epoch, ps.shardIdStr)
 	db, err := ps.persisterFactory.Create(filePath)
 	if err != nil {
 		log.Warn("change epoch", "persister", ps.identifier, "error", err.Error())
 		return err
 	}
 
+	err = db.Init()
+	if err != nil {
+		closeNewPersister(db, ps.identifier)
+		return err
+	}
+
+	bloomFilter, err := createBloomFilterIfNeeded(ps.bloomFilterConf, db, filePath)
+	if err != nil {
+		closeNewPersister(db, ps.identifier)
+		return err
+	}
+
 	newPersister := &persisterData{
-		persister: db,
-		epoch:     epoch,
-		path:      filePath,
-		isClosed:  false,
+		persister:   db,
+		epoch:       epoch,
+		path:        filePath,
+		coldPath:    coldPath,
+		isClosed:    false,
+		bloomFilter: bloomFilter,
 	}
 
 	singleItemPersisters := []*persisterData{newPersister}
 	ps.activePersisters = append(singleItemPersisters, ps.activePersisters...)
 	ps.persistersMapByEpoch[epoch] = newPersister
 
-	err = ps.activePersisters[0].persister.Init()
-	if err != nil {
-		return err
-	}
-
 	wasExtended := ps.extendSavedEpochsIfNeeded(header)
 	if wasExtended {
 		return nil
@@ -614,6 +701,13 @@ func (ps *PruningStorer) changeEpoch(header data.HeaderHandler) error {
 	return nil
 }
 
+func closeNewPersister(db storage.Persister, identifier string) {
+	errClose := db.Close()
+	if errClose != nil {
+		log.Warn("change epoch - closing the new persister", "persister", identifier, "error", errClose.Error())
+	}
+}
+
 func (ps *PruningStorer) extendSavedEpochsIfNeeded(header data.HeaderHandler) bool {
 	epoch := header.GetEpoch()
 	metaBlock, mbOk := header.(*block.MetaBlock)
@@ -663,6 +757,7 @@ func (ps *PruningStorer) changeEpochWithExisting(epoch uint32) error {
 		}
 
 		if p.isClosed {
+			ps.releaseOpenedClosedPersister(p)
 			_, err = ps.persisterFactory.Create(p.path)
 			if err != nil {
 				return err
@@ -687,6 +782,7 @@ func (ps *PruningStorer) extendActivePersisters(from uint32, to uint32) error {
 		}
 
 		if p.isClosed {
+			ps.releaseOpenedClosedPersister(p)
 			_, err := ps.persisterFactory.Create(p.path)
 			if err != nil {
 				return err
@@ -709,6 +805,8 @@ func (ps *PruningStorer) closeAndDestroyPersisters(epoch uint32) error {
 	if ps.numOfActivePersisters < uint32(len(ps.activePersisters)) {
 		for idx := int(ps.numOfActivePersisters); idx < len(ps.activePersisters); idx++ {
 			persisterToClose := ps.activePersisters[idx]
+			ps.closedBloomStatistics = ps.closedBloomStatistics.Add(persisterToClose.bloomFilterStatistics())
+			persisterToClose.closeBloomFilter()
 			err := persisterToClose.persister.Close()
 			if err != nil {
 				log.Error("error closing persister", "error", err.Error(), "id", ps.identifier)
@@ -719,6 +817,7 @@ func (ps *PruningStorer) closeAndDestroyPersisters(epoch uint32) error {
 			persisterToClose.isClosed = true
 			epochToClose := epoch - ps.numOfActivePersisters
 			ps.persistersMapByEpoch[epochToClose] = persisterToClose
+			ps.relocateToColdStorage(persisterToClose)
 		}
 	}
 
@@ -731,8 +830,9 @@ func (ps *PruningStorer) closeAndDestroyPersisters(epoch uint32) error {
 				break
 			}
 			delete(ps.persistersMapByEpoch, idxToRemove)
+			ps.releaseOpenedClosedPersister(persisterToDestroy)
 
-			err := persisterToDestroy.persister.DestroyClosed()
+			err := persisterToDestroy.destroyClosed()
 			if err != nil {
 				return err
 			}
@@ -744,17 +844,274 @@ func (ps *PruningStorer) closeAndDestroyPersisters(epoch uint32) error {
 	return nil
 }
 
+// relocateToColdStorage moves the directory of a closed persister in the cold storage, if one is configured.
+// If the directory can not be simply renamed (e.g. the cold storage is on another device), it will be copied
+// in background while the persister remains usable from its current location. Must be called under lock
+func (ps *PruningStorer) relocateToColdStorage(pd *persisterData) {
+	if len(pd.coldPath) == 0 || pd.isInColdStorage || pd.isRelocating {
+		return
+	}
+
+	ps.releaseOpenedClosedPersister(pd)
+	hotPath := pd.path
+	err := os.MkdirAll(filepath.Dir(pd.coldPath), rwxOwner)
+	if err != nil {
+		log.Warn("cannot create cold storage directory", "path", pd.coldPath, "error", err.Error())
+		return
+	}
+
+	err = os.Rename(hotPath, pd.coldPath)
+	if err == nil {
+		pd.path = pd.coldPath
+		pd.isInColdStorage = true
+		removeDirectoryIfEmpty(hotPath)
+		log.Debug("persister moved to cold storage", "epoch", pd.epoch, "identifier", ps.identifier)
+		return
+	}
+
+	pd.isRelocating = true
+	go ps.copyToColdStorage(pd, hotPath)
+}
+
+func (ps *PruningStorer) copyToColdStorage(pd *persisterData, hotPath string) {
+	err := copyDirectory(hotPath, pd.coldPath)
+
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+
+	pd.isRelocating = false
+	if err != nil {
+		log.Warn("cannot copy persister to cold storage", "epoch", pd.epoch, "identifier", ps.identifier,
+			"error", err.Error())
+		_ = os.RemoveAll(pd.coldPath)
+		removeDirectoryIfEmpty(pd.coldPath)
+		return
+	}
+
+	isStillClosed := ps.persistersMapByEpoch[pd.epoch] == pd && pd.isClosed
+	if !isStillClosed {
+		// the persister was either destroyed or reopened in the meantime, so the copy is not needed anymore
+		_ = os.RemoveAll(pd.coldPath)
+		removeDirectoryIfEmpty(pd.coldPath)
+		return
+	}
+
+	ps.releaseOpenedClosedPersister(pd)
+	pd.path = pd.coldPath
+	pd.isInColdStorage = true
+
+	err = os.RemoveAll(hotPath)
+	if err != nil {
+		log.Debug("cannot remove relocated persister", "path", hotPath, "error", err.Error())
+	}
+	removeDirectoryIfEmpty(hotPath)
+	log.Debug("persister copied to cold storage", "epoch", pd.epoch, "identifier", ps.identifier)
+}
+
+// getFromClosedPersister fetches the value for the given key from a closed persister, which is opened if needed and
+// kept open for the next reads. Persisters from the cold storage are opened in read-only mode. The bloom filter of
+// the opened persister is checked before reading from it. Must be called under lock
+func (ps *PruningStorer) getFromClosedPersister(pd *persisterData, key []byte) ([]byte, error) {
+	persister, err := ps.getOpenedClosedPersister(pd)
+	if err != nil {
+		log.Debug("open old persister", "error", err.Error())
+		return nil, err
+	}
+
+	if !pd.mayContain(key) {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	res, err := persister.Get(key)
+	if err != nil {
+		pd.markBloomFilterFalsePositive()
+	}
+
+	return res, err
+}
+
+func (ps *PruningStorer) getOpenedClosedPersister(pd *persisterData) (storage.Persister, error) {
+	if pd.openedPersister != nil {
+		ps.removeFromOpenedClosedPersisters(pd)
+		ps.openedPersisters = append(ps.openedPersisters, pd)
+		return pd.openedPersister, nil
+	}
+
+	persister, err := ps.openClosedPersister(pd)
+	if err != nil {
+		return nil, err
+	}
+
+	err = persister.Init()
+	if err != nil {
+		closeNewPersister(persister, ps.identifier)
+		return nil, err
+	}
+
+	bloomFilter, err := createBloomFilterIfNeeded(ps.bloomFilterConf, persister, pd.path)
+	if err != nil {
+		closeNewPersister(persister, ps.identifier)
+		return nil, err
+	}
+
+	pd.bloomFilter = bloomFilter
+	pd.openedPersister = persister
+	ps.openedPersisters = append(ps.openedPersisters, pd)
+	if len(ps.openedPersisters) > maxNumOpenedClosedPersisters {
+		ps.releaseOpenedClosedPersister(ps.openedPersisters[0])
+	}
+
+	return persister, nil
+}
+
+func (ps *PruningStorer) openClosedPersister(pd *persisterData) (storage.Persister, error) {
+	if pd.isInColdStorage || pd.isRelocating {
+		return ps.persisterFactory.CreateReadOnly(pd.path)
+	}
+
+	return ps.persisterFactory.Create(pd.path)
+}
+
+// releaseOpenedClosedPersister closes the persister opened for reading from a closed epoch, if any, together with its
+// bloom filter. Must be called under lock, before the persister is reopened, moved or destroyed
+func (ps *PruningStorer) releaseOpenedClosedPersister(pd *persisterData) {
+	if pd.openedPersister == nil {
+		return
+	}
+
+	ps.closedBloomStatistics = ps.closedBloomStatistics.Add(pd.bloomFilterStatistics())
+	pd.closeBloomFilter()
+	err := pd.openedPersister.Close()
+	if err != nil {
+		log.Debug("persister.Close()", "error", err.Error())
+	}
+	pd.openedPersister = nil
+	ps.removeFromOpenedClosedPersisters(pd)
+}
+
+// searchFirstInColdStorage searches the key in the closed persisters from the cold storage, starting with the
+// newest epoch. The persisters are opened through the bounded set of opened closed persisters. Must be called under lock
+func (ps *PruningStorer) searchFirstInColdStorage(key []byte) ([]byte, error) {
+	coldPersisters := make([]*persisterData, 0)
+	for _, pd := range ps.persistersMapByEpoch {
+		if pd.isClosed && pd.isInColdStorage {
+			coldPersisters = append(coldPersisters, pd)
+		}
+	}
+
+	sort.Slice(coldPersisters, func(i, j int) bool {
+		return coldPersisters[i].epoch > coldPersisters[j].epoch
+	})
+
+	for _, pd := range coldPersisters {
+		res, err := ps.getFromClosedPersister(pd, key)
+		if err == nil {
+			return res, nil
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+func (ps *PruningStorer) releaseAllOpenedClosedPersisters() {
+	for len(ps.openedPersisters) > 0 {
+		ps.releaseOpenedClosedPersister(ps.openedPersisters[0])
+	}
+}
+
+func (ps *PruningStorer) removeFromOpenedClosedPersisters(pd *persisterData) {
+	for idx, openedPd := range ps.openedPersisters {
+		if openedPd == pd {
+			ps.openedPersisters = append(ps.openedPersisters[:idx], ps.openedPersisters[idx+1:]...)
+			return
+		}
+	}
+}
+
+// BloomFilterStatistics returns the summed counters of the bloom filters of all the persisters
+func (ps *PruningStorer) BloomFilterStatistics() storage.BloomFilterStatistics {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	statistics := ps.closedBloomStatistics
+	for _, pd := range ps.activePersisters {
+		statistics = statistics.Add(pd.bloomFilterStatistics())
+	}
+
+	return statistics
+}
+
+// NumCacheHits returns the number of the get and has operations that were served from the cache
+func (ps *PruningStorer) NumCacheHits() uint64 {
+	return atomic.LoadUint64(&ps.numCacheHits)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ps *PruningStorer) IsInterfaceNil() bool {
 	return ps == nil
 }
 
+func createActivePersisterDataForEpoch(args *StorerArgs, epoch uint32, shardIdStr string) (*persisterData, error) {
+	p, err := createPersisterDataForEpoch(args, epoch, shardIdStr)
+	if err != nil {
+		return nil, err
+	}
+
+	p.bloomFilter, err = createBloomFilterIfNeeded(args.BloomFilterConf, p.persister, p.path)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// createClosedPersisterDataForEpoch will check the persister of an old epoch, which can be found either in the
+// hot or in the cold storage, and leave it closed
+func createClosedPersisterDataForEpoch(args *StorerArgs, epoch uint32, shardIdStr string) (*persisterData, error) {
+	filePath, coldPath := persisterPathsForEpoch(args.PathManager, args.ShardCoordinator, args.Identifier, epoch, shardIdStr)
+	if len(coldPath) > 0 && !directoryExists(filePath) && directoryExists(coldPath) {
+		db, err := args.PersisterFactory.CreateReadOnly(coldPath)
+		if err != nil {
+			log.Warn("cold storage persister open error", "path", coldPath, "error", err.Error())
+			return nil, err
+		}
+
+		err = db.Close()
+		if err != nil {
+			log.Debug("persister.Close()", "error", err.Error())
+		}
+
+		return &persisterData{
+			persister:       db,
+			epoch:           epoch,
+			path:            coldPath,
+			coldPath:        coldPath,
+			isClosed:        true,
+			isInColdStorage: true,
+		}, nil
+	}
+
+	p, err := createPersisterDataForEpoch(args, epoch, shardIdStr)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.persister.Close()
+	if err != nil {
+		log.Debug("persister.Close()", "error", err.Error())
+	}
+	p.isClosed = true
+
+	return p, nil
+}
+
 func createPersisterDataForEpoch(args *StorerArgs, epoch uint32, shardIdStr string) (*persisterData, error) {
-	// TODO: if booting from storage in an epoch > 0, shardId needs to be taken from somewhere else
-	// e.g. determined from directories in persister path or taken from boot storer
-	filePath := args.PathManager.PathForEpoch(core.GetShardIdString(args.ShardCoordinator.SelfId()), epoch, args.Identifier)
-	if len(shardIdStr) > 0 {
-		filePath += shardIdStr
+	filePath, coldPath := persisterPathsForEpoch(args.PathManager, args.ShardCoordinator, args.Identifier, epoch, shardIdStr)
+	isInColdStorage := false
+	if len(coldPath) > 0 && !directoryExists(filePath) && directoryExists(coldPath) {
+		// an active epoch which was already moved, so it will be used from the cold storage
+		filePath = coldPath
+		isInColdStorage = true
 	}
 
 	db, err := args.PersisterFactory.Create(filePath)
@@ -764,10 +1121,12 @@ func createPersisterDataForEpoch(args *StorerArgs, epoch uint32, shardIdStr stri
 	}
 
 	p := &persisterData{
-		persister: db,
-		epoch:     epoch,
-		path:      filePath,
-		isClosed:  false,
+		persister:       db,
+		epoch:           epoch,
+		path:            filePath,
+		coldPath:        coldPath,
+		isClosed:        false,
+		isInColdStorage: isInColdStorage,
 	}
 
 	err = p.persister.Init()
@@ -779,6 +1138,43 @@ func createPersisterDataForEpoch(args *StorerArgs, epoch uint32, shardIdStr stri
 	return p, nil
 }
 
+// persisterPathsForEpoch returns the persister's path and its path in the cold storage, if configured
+func persisterPathsForEpoch(
+	pathManager storage.PathManagerHandler,
+	shardCoordinator storage.ShardCoordinator,
+	identifier string,
+	epoch uint32,
+	shardIdStr string,
+) (string, string) {
+	// TODO: if booting from storage in an epoch > 0, shardId needs to be taken from somewhere else
+	// e.g. determined from directories in persister path or taken from boot storer
+	shardId := core.GetShardIdString(shardCoordinator.SelfId())
+	filePath := pathManager.PathForEpoch(shardId, epoch, identifier)
+	coldPath := pathManager.PathForEpochInColdStorage(shardId, epoch, identifier)
+	if len(shardIdStr) > 0 {
+		filePath += shardIdStr
+		if len(coldPath) > 0 {
+			coldPath += shardIdStr
+		}
+	}
+
+	return filePath, coldPath
+}
+
+// createBloomFilterIfNeeded creates a persistent bloom filter saved next to the persister. If the size is 0,
+// that means an empty config was used so the bloom filter will be nil
+func createBloomFilterIfNeeded(
+	conf storageUnit.BloomConfig,
+	persister storage.Persister,
+	path string,
+) (storage.BloomFilter, error) {
+	if conf.Size == 0 {
+		return nil, nil
+	}
+
+	return storageUnit.NewPersistentBloomFilter(conf, persister, path)
+}
+
 func computeOldestEpoch(metaBlock *block.MetaBlock) uint32 {
 	oldestEpoch := metaBlock.Epoch
 	for _, lastHdr := range metaBlock.EpochStart.LastFinalizedHeaders {
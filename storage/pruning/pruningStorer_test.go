@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
@@ -17,6 +19,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/bloom"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/mock"
 	"github.com/ElrondNetwork/elrond-go/storage/pruning"
@@ -386,6 +389,215 @@ func TestNewPruningStorer_Has_MultiplePersistersShouldWork(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func waitUntilBloomFiltersAreReady(t *testing.T, ps *pruning.PruningStorer) {
+	for i := 0; i < 100; i++ {
+		if ps.AreBloomFiltersReady() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	require.Fail(t, "bloom filters were not rebuilt in time")
+}
+
+func TestPruningStorer_BloomFilterShouldBeSavedAndReloadedWithThePersister(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "pruning_bloom")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := getDefaultArgsSerialDB()
+	args.PathManager = &mock.PathManagerStub{
+		PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+			return filepath.Join(dir, fmt.Sprintf("Epoch_%d", epoch), identifier)
+		},
+	}
+	args.BloomFilterConf = storageUnit.BloomConfig{
+		Size:     2048,
+		HashFunc: []storageUnit.HasherType{storageUnit.Keccak, storageUnit.Blake2b, storageUnit.Fnv},
+	}
+	args.NumOfActivePersisters = 1
+	args.NumOfEpochsToKeep = 1
+	ps, err := pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+	waitUntilBloomFiltersAreReady(t, ps)
+
+	testKey, testVal := []byte("key"), []byte("value")
+	err = ps.Put(testKey, testVal)
+	require.Nil(t, err)
+	err = ps.Close()
+	require.Nil(t, err)
+
+	bloomFilePath := filepath.Join(args.PathManager.PathForEpoch("0", 0, args.Identifier), bloom.FilterFileName)
+	_, err = os.Stat(bloomFilePath)
+	assert.Nil(t, err)
+
+	ps, err = pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = ps.Close()
+	}()
+
+	_, err = os.Stat(bloomFilePath)
+	assert.True(t, os.IsNotExist(err), "the saved bloom filter should be consumed when reopening")
+	assert.True(t, ps.AreBloomFiltersReady())
+
+	res, err := ps.Get(testKey)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+
+	_, err = ps.Get([]byte("missing key"))
+	assert.NotNil(t, err)
+	statistics := ps.BloomFilterStatistics()
+	assert.Equal(t, uint64(1), statistics.NumPositives)
+	assert.Equal(t, uint64(1), statistics.NumNegatives)
+}
+
+func TestPruningStorer_ClosedPersistersShouldBeMovedAndReadFromColdStorage(t *testing.T) {
+	t.Parallel()
+
+	hotDir, _ := ioutil.TempDir("", "pruning_hot")
+	coldDir, _ := ioutil.TempDir("", "pruning_cold")
+	defer func() {
+		_ = os.RemoveAll(hotDir)
+		_ = os.RemoveAll(coldDir)
+	}()
+
+	numReadOnlyOpens := 0
+	args := getDefaultArgsSerialDB()
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			return leveldb.NewSerialDB(path, 1, 20, 10)
+		},
+		CreateReadOnlyCalled: func(path string) (storage.Persister, error) {
+			numReadOnlyOpens++
+			return leveldb.NewReadOnlyDB(path, 10)
+		},
+	}
+	args.PathManager = &mock.PathManagerStub{
+		PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+			return filepath.Join(hotDir, fmt.Sprintf("Epoch_%d", epoch), identifier)
+		},
+		PathForEpochInColdStorageCalled: func(shardId string, epoch uint32, identifier string) string {
+			return filepath.Join(coldDir, fmt.Sprintf("Epoch_%d", epoch), identifier)
+		},
+	}
+	args.NumOfActivePersisters = 1
+	args.NumOfEpochsToKeep = 3
+	ps, err := pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+
+	testKey, testVal := []byte("key"), []byte("value")
+	err = ps.Put(testKey, testVal)
+	require.Nil(t, err)
+
+	err = ps.ChangeEpochSimple(1)
+	require.Nil(t, err)
+	ps.ClearCache()
+
+	hotPath := args.PathManager.PathForEpoch("0", 0, args.Identifier)
+	coldPath := args.PathManager.PathForEpochInColdStorage("0", 0, args.Identifier)
+	_, err = os.Stat(hotPath)
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Dir(hotPath))
+	assert.True(t, os.IsNotExist(err), "the empty epoch directory should be removed")
+	_, err = os.Stat(coldPath)
+	assert.Nil(t, err)
+
+	res, err := ps.GetFromEpoch(testKey, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+	ps.ClearCache()
+
+	res, err = ps.GetFromEpoch(testKey, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+	assert.Equal(t, 1, numReadOnlyOpens, "the cold persister should be kept open between reads")
+
+	ps.ClearCache()
+	res, err = ps.SearchFirst(testKey)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+	assert.Equal(t, 1, numReadOnlyOpens, "the cold persister should be reused by SearchFirst")
+
+	_, err = ps.SearchFirst([]byte("missing key"))
+	assert.True(t, errors.Is(err, storage.ErrKeyNotFound))
+
+	err = ps.Close()
+	require.Nil(t, err)
+
+	args.StartingEpoch = 1
+	ps, err = pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+
+	res, err = ps.GetFromEpoch(testKey, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+
+	_ = ps.ChangeEpochSimple(2)
+	_ = ps.ChangeEpochSimple(3)
+	_ = ps.Close()
+
+	_, err = os.Stat(coldPath)
+	assert.True(t, os.IsNotExist(err), "the cold storage persister should be removed when the epoch is pruned")
+}
+
+func TestPruningStorer_ShardedClosedPersistersShouldBeMovedAndReadFromColdStorage(t *testing.T) {
+	t.Parallel()
+
+	hotDir, _ := ioutil.TempDir("", "pruning_hot")
+	coldDir, _ := ioutil.TempDir("", "pruning_cold")
+	defer func() {
+		_ = os.RemoveAll(hotDir)
+		_ = os.RemoveAll(coldDir)
+	}()
+
+	args := getDefaultArgsSerialDB()
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			return leveldb.NewSerialDB(path, 1, 20, 10)
+		},
+		CreateReadOnlyCalled: func(path string) (storage.Persister, error) {
+			return leveldb.NewReadOnlyDB(path, 10)
+		},
+	}
+	args.PathManager = &mock.PathManagerStub{
+		PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+			return filepath.Join(hotDir, fmt.Sprintf("Epoch_%d", epoch), identifier)
+		},
+		PathForEpochInColdStorageCalled: func(shardId string, epoch uint32, identifier string) string {
+			return filepath.Join(coldDir, fmt.Sprintf("Epoch_%d", epoch), identifier)
+		},
+	}
+	args.NumOfActivePersisters = 1
+	args.NumOfEpochsToKeep = 4
+	ps, err := pruning.NewShardedPruningStorer(args, 2)
+	require.Nil(t, err)
+	defer func() {
+		_ = ps.Close()
+	}()
+
+	err = ps.ChangeEpochSimple(1)
+	require.Nil(t, err)
+	testKey, testVal := []byte("key"), []byte("value")
+	ps.SetEpochForPutOperation(1)
+	err = ps.Put(testKey, testVal)
+	require.Nil(t, err)
+
+	err = ps.ChangeEpochSimple(2)
+	require.Nil(t, err)
+	ps.ClearCache()
+
+	_, err = os.Stat(filepath.Join(coldDir, "Epoch_1", args.Identifier+"2"))
+	assert.Nil(t, err, "the persister created on epoch change should be moved in the shard's cold storage directory")
+
+	res, err := ps.GetFromEpoch(testKey, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+}
+
 func TestNewPruningStorer_OldDataHasToBeRemoved(t *testing.T) {
 	t.Parallel()
 
@@ -3,8 +3,10 @@ package storageUnit
 import (
 	"encoding/base64"
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -15,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing/fnv"
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/adaptivecache"
 	"github.com/ElrondNetwork/elrond-go/storage/bloom"
 	"github.com/ElrondNetwork/elrond-go/storage/fifocache"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
@@ -38,6 +41,7 @@ const (
 	LRUCache         CacheType = "LRU"
 	SizeLRUCache     CacheType = "SizeLRU"
 	FIFOShardedCache CacheType = "FIFOSharded"
+	AdaptiveCache    CacheType = "Adaptive"
 )
 
 var log = logger.GetOrCreate("storage/storageUnit")
@@ -70,12 +74,17 @@ type UnitConfig struct {
 
 // CacheConfig holds the configurable elements of a cache
 type CacheConfig struct {
-	Type                 CacheType
-	SizeInBytes          uint64
-	SizeInBytesPerSender uint32
-	Capacity             uint32
-	SizePerSender        uint32
-	Shards               uint32
+	Type                                 CacheType
+	SizeInBytes                          uint64
+	SizeInBytesPerSender                 uint32
+	Capacity                             uint32
+	SizePerSender                        uint32
+	Shards                               uint32
+	ReplacementMinGasPriceBumpPercentage uint32
+	MaxTxAgeInSeconds                    uint32
+	SelectionStrategy                    string
+	// MemoryBudget is the budget shared by the adaptive caches, needed only by the caches of Type Adaptive
+	MemoryBudget *adaptivecache.MemoryBudget
 }
 
 // DBConfig holds the configurable elements of a database
@@ -96,10 +105,11 @@ type BloomConfig struct {
 // Unit represents a storer's data bank
 // holding the cache, persistence unit and bloom filter
 type Unit struct {
-	lock        sync.RWMutex
-	persister   storage.Persister
-	cacher      storage.Cacher
-	bloomFilter storage.BloomFilter
+	lock         sync.RWMutex
+	persister    storage.Persister
+	cacher       storage.Cacher
+	bloomFilter  storage.BloomFilter
+	numCacheHits uint64
 }
 
 // Put adds data to both cache and persistence medium and updates the bloom filter
@@ -124,6 +134,15 @@ func (u *Unit) Put(key, data []byte) error {
 
 // Close will close unit
 func (u *Unit) Close() error {
+	CloseCache(u.cacher)
+
+	if u.bloomFilter != nil {
+		err := u.bloomFilter.Close()
+		if err != nil {
+			log.Warn("cannot save storage unit bloom filter", "error", err)
+		}
+	}
+
 	err := u.persister.Close()
 	if err != nil {
 		log.Error("cannot close storage unit persister", err)
@@ -144,12 +163,15 @@ func (u *Unit) Get(key []byte) ([]byte, error) {
 	v, ok := u.cacher.Get(key)
 	var err error
 
-	if !ok {
+	if ok {
+		atomic.AddUint64(&u.numCacheHits, 1)
+	} else {
 		// not found in cache
 		// search it in second persistence medium
 		if u.bloomFilter == nil || u.bloomFilter.MayContain(key) {
 			v, err = u.persister.Get(key)
 			if err != nil {
+				u.markBloomFilterFalsePositive()
 				return nil, err
 			}
 
@@ -182,16 +204,37 @@ func (u *Unit) Has(key []byte) error {
 
 	has := u.cacher.Has(key)
 	if has {
+		atomic.AddUint64(&u.numCacheHits, 1)
 		return nil
 	}
 
 	if u.bloomFilter == nil || u.bloomFilter.MayContain(key) {
-		return u.persister.Has(key)
+		err := u.persister.Has(key)
+		if err != nil {
+			u.markBloomFilterFalsePositive()
+		}
+
+		return err
 	}
 
 	return storage.ErrKeyNotFound
 }
 
+func (u *Unit) markBloomFilterFalsePositive() {
+	if u.bloomFilter != nil {
+		u.bloomFilter.MarkFalsePositive()
+	}
+}
+
+// BloomFilterStatistics returns the counters of the bloom filter, if one is used
+func (u *Unit) BloomFilterStatistics() storage.BloomFilterStatistics {
+	if u.bloomFilter == nil {
+		return storage.BloomFilterStatistics{}
+	}
+
+	return u.bloomFilter.Statistics()
+}
+
 // SearchFirst will call the Get method as this storer doesn't handle epochs
 func (u *Unit) SearchFirst(key []byte) ([]byte, error) {
 	return u.Get(key)
@@ -228,9 +271,15 @@ func (u *Unit) DestroyUnit() error {
 	}
 
 	u.cacher.Clear()
+	CloseCache(u.cacher)
 	return u.persister.Destroy()
 }
 
+// NumCacheHits returns the number of the get and has operations that were served from the cache
+func (u *Unit) NumCacheHits() uint64 {
+	return atomic.LoadUint64(&u.numCacheHits)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (u *Unit) IsInterfaceNil() bool {
 	return u == nil
@@ -304,10 +353,11 @@ func NewStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig, bloomFilterC
 		return nil, storage.ErrCacheSizeIsLowerThanBatchSize
 	}
 
-	cache, err = NewCache(cacheConf.Type, cacheConf.Capacity, cacheConf.Shards, cacheConf.SizeInBytes)
+	cache, err = NewCacheWithMemoryBudget(cacheConf.Type, cacheConf.Capacity, cacheConf.Shards, cacheConf.SizeInBytes, cacheConf.MemoryBudget)
 	if err != nil {
 		return nil, err
 	}
+	SetCacheName(cache, filepath.Base(dbConf.FilePath))
 
 	argDB := ArgDB{
 		DBType:            dbConf.Type,
@@ -325,7 +375,7 @@ func NewStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig, bloomFilterC
 		return NewStorageUnit(cache, db)
 	}
 
-	bf, err = NewBloomFilter(bloomFilterConf)
+	bf, err = NewPersistentBloomFilter(bloomFilterConf, db, getBloomFilterDirPath(dbConf))
 	if err != nil {
 		return nil, err
 	}
@@ -333,9 +383,29 @@ func NewStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig, bloomFilterC
 	return NewStorageUnitWithBloomFilter(cache, db, bf)
 }
 
+func getBloomFilterDirPath(dbConf DBConfig) string {
+	if dbConf.Type == MemoryDB {
+		return ""
+	}
+
+	return dbConf.FilePath
+}
+
 //NewCache creates a new cache from a cache config
 //TODO: add a cacher factory or a cacheConfig param instead
 func NewCache(cacheType CacheType, capacity uint32, shards uint32, sizeInBytes uint64) (storage.Cacher, error) {
+	return NewCacheWithMemoryBudget(cacheType, capacity, shards, sizeInBytes, nil)
+}
+
+// NewCacheWithMemoryBudget creates a new cache from a cache config. The memory budget is needed only by the
+// adaptive caches, which share it
+func NewCacheWithMemoryBudget(
+	cacheType CacheType,
+	capacity uint32,
+	shards uint32,
+	sizeInBytes uint64,
+	memoryBudget *adaptivecache.MemoryBudget,
+) (storage.Cacher, error) {
 	var cacher storage.Cacher
 	var err error
 
@@ -361,6 +431,20 @@ func NewCache(cacheType CacheType, capacity uint32, shards uint32, sizeInBytes u
 		if err != nil {
 			return nil, err
 		}
+	case AdaptiveCache:
+		if sizeInBytes < minimumSizeForLRUCache {
+			return nil, fmt.Errorf("%w, provided %d, minimum %d",
+				storage.ErrAdaptiveCacheInvalidSize,
+				sizeInBytes,
+				minimumSizeForLRUCache,
+			)
+		}
+
+		cacher, err = adaptivecache.NewAdaptiveCache(adaptivecache.ArgAdaptiveCache{
+			MaxNumItems:    int(capacity),
+			MaxSizeInBytes: int64(sizeInBytes),
+			Budget:         memoryBudget,
+		})
 		// add other implementations if required
 	default:
 		return nil, storage.ErrNotSupportedCacheType
@@ -373,6 +457,27 @@ func NewCache(cacheType CacheType, capacity uint32, shards uint32, sizeInBytes u
 	return cacher, nil
 }
 
+// SetCacheName sets the name used for reporting the cache's statistics, if the cache supports it
+func SetCacheName(cache storage.Cacher, name string) {
+	namedCache, ok := cache.(interface{ SetName(name string) })
+	if ok {
+		namedCache.SetName(name)
+	}
+}
+
+// CloseCache releases the resources held by the cache, if the cache supports it
+func CloseCache(cache storage.Cacher) {
+	closableCache, ok := cache.(interface{ Close() error })
+	if !ok {
+		return
+	}
+
+	err := closableCache.Close()
+	if err != nil {
+		log.Warn("cannot close cache", "error", err)
+	}
+}
+
 // ArgDB is a structure that is used to create a new storage.Persister implementation
 type ArgDB struct {
 	DBType            DBType
@@ -436,6 +541,42 @@ func NewBloomFilter(conf BloomConfig) (storage.BloomFilter, error) {
 	return bf, nil
 }
 
+// NewPersistentBloomFilter creates a new bloom filter from bloom filter config whose bits are saved in the
+// provided directory on close. If the saved bits are missing, the filter is rebuilt from the persister's keys
+func NewPersistentBloomFilter(conf BloomConfig, persister storage.Persister, dirPath string) (storage.BloomFilter, error) {
+	hashers, err := createHashers(conf.HashFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	bf, err := bloom.NewFilter(conf.Size, hashers)
+	if err != nil {
+		return nil, err
+	}
+
+	argsPersistentFilter := bloom.ArgsPersistentFilter{
+		Filter:    bf,
+		Persister: persister,
+		DirPath:   dirPath,
+	}
+
+	return bloom.NewPersistentFilter(argsPersistentFilter)
+}
+
+func createHashers(hashTypes []HasherType) ([]hashing.Hasher, error) {
+	hashers := make([]hashing.Hasher, 0, len(hashTypes))
+	for _, hashString := range hashTypes {
+		hasher, err := hashString.NewHasher()
+		if err != nil {
+			return nil, err
+		}
+
+		hashers = append(hashers, hasher)
+	}
+
+	return hashers, nil
+}
+
 // NewHasher will return a hasher implementation form the string HasherType
 func (h HasherType) NewHasher() (hashing.Hasher, error) {
 	switch h {
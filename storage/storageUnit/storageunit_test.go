@@ -1,6 +1,7 @@
 package storageUnit_test
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
@@ -14,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing/fnv"
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/adaptivecache"
 	"github.com/ElrondNetwork/elrond-go/storage/bloom"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
@@ -458,6 +460,46 @@ func TestCreateCacheFromConfOK(t *testing.T) {
 	assert.NotNil(t, cacher, "valid cacher expected but got nil")
 }
 
+func TestCreateCacheFromConfAdaptiveCacheWithLowSizeShouldErr(t *testing.T) {
+
+	cacher, err := storageUnit.NewCache(storageUnit.AdaptiveCache, 10, 1, 10)
+
+	assert.True(t, errors.Is(err, storage.ErrAdaptiveCacheInvalidSize))
+	assert.Nil(t, cacher)
+}
+
+func TestCreateCacheFromConfAdaptiveCacheWithoutMemoryBudgetShouldErr(t *testing.T) {
+
+	cacher, err := storageUnit.NewCache(storageUnit.AdaptiveCache, 10, 1, 10240)
+
+	assert.Equal(t, adaptivecache.ErrNilMemoryBudget, err)
+	assert.Nil(t, cacher)
+}
+
+func TestCreateCacheFromConfAdaptiveCacheOK(t *testing.T) {
+
+	cacher, err := storageUnit.NewCacheWithMemoryBudget(storageUnit.AdaptiveCache, 10, 1, 10240, adaptivecache.NewMemoryBudget())
+
+	assert.Nil(t, err)
+	assert.NotNil(t, cacher)
+}
+
+func TestUnit_CloseShouldUnregisterTheAdaptiveCache(t *testing.T) {
+	t.Parallel()
+
+	budget := adaptivecache.NewMemoryBudget()
+	cacher, _ := storageUnit.NewCacheWithMemoryBudget(storageUnit.AdaptiveCache, 10, 1, 10240, budget)
+	unit, _ := storageUnit.NewStorageUnit(cacher, memorydb.New())
+	_ = unit.Put([]byte("key"), []byte("value"))
+	assert.Equal(t, 1, len(budget.CachesStatistics()))
+
+	err := unit.Close()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(budget.CachesStatistics()))
+	assert.Equal(t, uint64(0), budget.UsedSizeInBytes())
+}
+
 func TestCreateDBFromConfWrongType(t *testing.T) {
 	arg := storageUnit.ArgDB{
 		DBType:            "NotLvlDB",
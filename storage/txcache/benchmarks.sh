@@ -1,2 +1,3 @@
 #!/bin/bash
 go test -bench="BenchmarkSendersMap_GetSnapshotAscending$" -benchtime=1x
+go test -bench="BenchmarkTxCache_SelectTransactions" -benchtime=1x
@@ -3,6 +3,7 @@ package txcache
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -19,6 +20,17 @@ const maxNumBytesPerSenderLowerBound = maxNumItemsPerSenderLowerBound * 1
 const maxNumBytesPerSenderUpperBound = 33_554_432 // 32 MB
 const numTxsToPreemptivelyEvictLowerBound = 1
 const numSendersToPreemptivelyEvictLowerBound = 1
+const percentageDenominator = 100
+
+// SelectionStrategy defines the order in which the transactions are selected from the cache
+type SelectionStrategy string
+
+const (
+	// ScoreSelection selects the transactions sender by sender, in the descending order of the senders' score
+	ScoreSelection SelectionStrategy = "Score"
+	// FeeMarketSelection selects the transactions in the descending order of their fee per gas unit
+	FeeMarketSelection SelectionStrategy = "FeeMarket"
+)
 
 // ConfigSourceMe holds cache configuration
 type ConfigSourceMe struct {
@@ -31,11 +43,43 @@ type ConfigSourceMe struct {
 	CountPerSenderThreshold       uint32
 	NumSendersToPreemptivelyEvict uint32
 	MinGasPriceNanoErd            uint32
+	// ReplacementMinGasPriceBumpPercentage is the minimum percentage by which the gas price of an incoming transaction
+	// has to exceed the gas price of a pooled transaction with the same sender and nonce in order to replace it.
+	// Zero disables the replacement
+	ReplacementMinGasPriceBumpPercentage uint32
+	// MaxTxAgeInSeconds is the maximum duration a transaction is kept in the cache, after which it is swept as expired.
+	// Zero disables the expiry
+	MaxTxAgeInSeconds uint32
+	// SelectionStrategy is the order in which the transactions are selected. Empty defaults to ScoreSelection
+	SelectionStrategy SelectionStrategy
 }
 
 type senderConstraints struct {
-	maxNumTxs   uint32
-	maxNumBytes uint32
+	maxNumTxs                            uint32
+	maxNumBytes                          uint32
+	replacementMinGasPriceBumpPercentage uint32
+}
+
+func (constraints *senderConstraints) isReplacementEnabled() bool {
+	return constraints.replacementMinGasPriceBumpPercentage > 0
+}
+
+// canBeReplaced returns true if the gas price of the incoming transaction is higher than the gas price of the pooled
+// transaction by at least the configured percentage
+func (constraints *senderConstraints) canBeReplaced(pooledTx *WrappedTransaction, incomingTx *WrappedTransaction) bool {
+	if pooledTx.sameAs(incomingTx) {
+		return false
+	}
+	if incomingTx.Tx.GetGasPrice() <= pooledTx.Tx.GetGasPrice() {
+		return false
+	}
+
+	pooledGasPrice := big.NewInt(0).SetUint64(pooledTx.Tx.GetGasPrice())
+	minGasPrice := big.NewInt(0).Mul(pooledGasPrice, big.NewInt(int64(percentageDenominator+constraints.replacementMinGasPriceBumpPercentage)))
+	incomingGasPrice := big.NewInt(0).SetUint64(incomingTx.Tx.GetGasPrice())
+	incomingGasPrice.Mul(incomingGasPrice, big.NewInt(percentageDenominator))
+
+	return incomingGasPrice.Cmp(minGasPrice) >= 0
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -55,6 +99,9 @@ func (config *ConfigSourceMe) verify() error {
 	if config.MinGasPriceNanoErd < minGasPriceNanoErdLowerBound {
 		return fmt.Errorf("%w: config.MinGasPriceNanoErd is invalid", storage.ErrInvalidConfig)
 	}
+	if !config.SelectionStrategy.isValid() {
+		return fmt.Errorf("%w: config.SelectionStrategy is invalid", storage.ErrInvalidConfig)
+	}
 	if config.EvictionEnabled {
 		if config.NumBytesThreshold < maxNumBytesLowerBound || config.NumBytesThreshold > maxNumBytesUpperBound {
 			return fmt.Errorf("%w: config.NumBytesThreshold is invalid", storage.ErrInvalidConfig)
@@ -70,10 +117,20 @@ func (config *ConfigSourceMe) verify() error {
 	return nil
 }
 
+func (strategy SelectionStrategy) isValid() bool {
+	switch strategy {
+	case "", ScoreSelection, FeeMarketSelection:
+		return true
+	default:
+		return false
+	}
+}
+
 func (config *ConfigSourceMe) getSenderConstraints() senderConstraints {
 	return senderConstraints{
-		maxNumBytes: config.NumBytesPerSenderThreshold,
-		maxNumTxs:   config.CountPerSenderThreshold,
+		maxNumBytes:                          config.NumBytesPerSenderThreshold,
+		maxNumTxs:                            config.CountPerSenderThreshold,
+		replacementMinGasPriceBumpPercentage: config.ReplacementMinGasPriceBumpPercentage,
 	}
 }
 
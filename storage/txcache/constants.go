@@ -7,3 +7,7 @@ const senderGracePeriodLowerBound = 2
 const senderGracePeriodUpperBound = 2
 
 const numEvictedTxsToDisplay = 3
+
+const numExpiredTxHashesToRemember = 100000
+
+const numReplacedTxsToRemember = 10000
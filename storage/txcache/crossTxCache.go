@@ -109,6 +109,13 @@ func (cache *CrossTxCache) RemoveTxByHash(txHash []byte) bool {
 	return cache.RemoveWithResult(txHash)
 }
 
+// RegisterRemovalHandler registers the handler notified about the transactions evicted by the cache
+func (cache *CrossTxCache) RegisterRemovalHandler(handler func(txHashes [][]byte)) {
+	cache.RegisterEvictionHandler(func(key []byte) {
+		handler([][]byte{key})
+	})
+}
+
 // ForEachTransaction iterates over the transactions in the cache
 func (cache *CrossTxCache) ForEachTransaction(function ForEachTransaction) {
 	cache.ForEachItem(func(key []byte, item storage.CacheItem) {
@@ -95,6 +95,10 @@ func (cache *DisabledCache) RegisterHandler(func(key []byte, value interface{}),
 func (cache *DisabledCache) UnRegisterHandler(string) {
 }
 
+// RegisterRemovalHandler does nothing
+func (cache *DisabledCache) RegisterRemovalHandler(_ func(txHashes [][]byte)) {
+}
+
 // NotifyAccountNonce does nothing
 func (cache *DisabledCache) NotifyAccountNonce(_ []byte, _ uint64) {
 }
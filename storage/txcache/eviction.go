@@ -71,6 +71,7 @@ func (cache *TxCache) areThereTooManyTxs() bool {
 func (cache *TxCache) doEvictItems(txsToEvict [][]byte, sendersToEvict []string) (countTxs uint32, countSenders uint32) {
 	countTxs = cache.txByHash.RemoveTxsBulk(txsToEvict)
 	countSenders = cache.txListBySender.RemoveSendersBulk(sendersToEvict)
+	cache.notifyRemovedTxs(txsToEvict)
 	return
 }
 
@@ -0,0 +1,118 @@
+package txcache
+
+import (
+	"container/heap"
+)
+
+// senderCandidates holds the transactions of a sender, which were copied (in nonce order) during a selection pass
+type senderCandidates struct {
+	txs            []*WrappedTransaction
+	nextIndex      int
+	senderPosition int
+}
+
+func (candidates *senderCandidates) nextTx() *WrappedTransaction {
+	return candidates.txs[candidates.nextIndex]
+}
+
+// candidatesHeap is a max-heap of senders, by the fee per gas unit of their next transaction
+// Ties are broken by the position of the sender in the snapshot of senders (as ordered by their score)
+type candidatesHeap []*senderCandidates
+
+// Len returns the number of senders in the heap
+func (candidates candidatesHeap) Len() int {
+	return len(candidates)
+}
+
+// Less returns whether the next transaction of the i-th sender is more valuable than the one of the j-th sender
+func (candidates candidatesHeap) Less(i, j int) bool {
+	feeI := estimateTxFeePerGasUnit(candidates[i].nextTx())
+	feeJ := estimateTxFeePerGasUnit(candidates[j].nextTx())
+	if feeI != feeJ {
+		return feeI > feeJ
+	}
+
+	return candidates[i].senderPosition < candidates[j].senderPosition
+}
+
+// Swap swaps two senders in the heap
+func (candidates candidatesHeap) Swap(i, j int) {
+	candidates[i], candidates[j] = candidates[j], candidates[i]
+}
+
+// Push adds a sender in the heap
+func (candidates *candidatesHeap) Push(x interface{}) {
+	*candidates = append(*candidates, x.(*senderCandidates))
+}
+
+// Pop removes the last sender of the heap
+func (candidates *candidatesHeap) Pop() interface{} {
+	old := *candidates
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*candidates = old[:n-1]
+	return item
+}
+
+// doSelectTransactionsByFeeMarket selects the transactions in the descending order of their fee per gas unit
+// In each pass, every sender gives at most "batchSizePerSender" transactions (in nonce order, without gaps),
+// which are merged by their fee per gas unit. A transaction with a higher fee still waits for the lower nonces of the same sender.
+func (cache *TxCache) doSelectTransactionsByFeeMarket(numRequested int, batchSizePerSender int) []*WrappedTransaction {
+	stopWatch := cache.monitorSelectionStart()
+
+	result := make([]*WrappedTransaction, 0, numRequested)
+	snapshotOfSenders := cache.getSendersEligibleForSelection()
+
+	for pass := 0; len(result) < numRequested; pass++ {
+		// Reset happens on first pass only
+		isFirstBatch := pass == 0
+		candidates := cache.collectCandidatesOfSenders(snapshotOfSenders, isFirstBatch, batchSizePerSender)
+
+		// No more passes needed
+		if len(candidates) == 0 {
+			break
+		}
+
+		heap.Init(&candidates)
+		for len(candidates) > 0 && len(result) < numRequested {
+			mostValuable := candidates[0]
+			result = append(result, mostValuable.nextTx())
+			mostValuable.nextIndex++
+
+			if mostValuable.nextIndex == len(mostValuable.txs) {
+				heap.Pop(&candidates)
+				continue
+			}
+			heap.Fix(&candidates, 0)
+		}
+	}
+
+	cache.monitorSelectionEnd(result, stopWatch)
+	return result
+}
+
+func (cache *TxCache) collectCandidatesOfSenders(snapshotOfSenders []*txListForSender, isFirstBatch bool, batchSizePerSender int) candidatesHeap {
+	candidates := make(candidatesHeap, 0, len(snapshotOfSenders))
+
+	for position, txList := range snapshotOfSenders {
+		batch := make([]*WrappedTransaction, batchSizePerSender)
+		journal := txList.selectBatchTo(isFirstBatch, batch, batchSizePerSender)
+		cache.monitorBatchSelectionEnd(journal)
+
+		if isFirstBatch {
+			cache.collectSweepable(txList)
+		}
+
+		if journal.copied == 0 {
+			continue
+		}
+
+		candidates = append(candidates, &senderCandidates{
+			txs:            batch[:journal.copied],
+			senderPosition: position,
+		})
+	}
+
+	return candidates
+}
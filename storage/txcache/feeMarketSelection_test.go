@@ -0,0 +1,152 @@
+package txcache
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeMarketSelection_SelectsByFeePerGasUnit(t *testing.T) {
+	cache := newFeeMarketCacheToTest()
+
+	cache.AddTx(createTxWithParams([]byte("alice-1"), "alice", 1, 128, 50000, 100*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("alice-2"), "alice", 2, 128, 50000, 500*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("bob-1"), "bob", 1, 128, 50000, 300*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("carol-1"), "carol", 1, 128, 50000, 200*oneBillion))
+
+	selected := cache.SelectTransactions(10, 10)
+
+	// "alice-2" pays the most, but has to wait for "alice-1"
+	require.Equal(t, []string{"bob-1", "carol-1", "alice-1", "alice-2"}, hashesOf(selected))
+}
+
+func TestFeeMarketSelection_RespectsNumRequested(t *testing.T) {
+	cache := newFeeMarketCacheToTest()
+
+	cache.AddTx(createTxWithParams([]byte("alice-1"), "alice", 1, 128, 50000, 100*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("bob-1"), "bob", 1, 128, 50000, 300*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("carol-1"), "carol", 1, 128, 50000, 200*oneBillion))
+
+	selected := cache.SelectTransactions(2, 10)
+	require.Equal(t, []string{"bob-1", "carol-1"}, hashesOf(selected))
+}
+
+func TestFeeMarketSelection_RespectsBatchSizePerSenderInEachPass(t *testing.T) {
+	cache := newFeeMarketCacheToTest()
+
+	cache.AddTx(createTxWithParams([]byte("alice-1"), "alice", 1, 128, 50000, 500*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("alice-2"), "alice", 2, 128, 50000, 500*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("alice-3"), "alice", 3, 128, 50000, 500*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("bob-1"), "bob", 1, 128, 50000, 100*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("bob-2"), "bob", 2, 128, 50000, 100*oneBillion))
+
+	// First pass: "alice-1", "alice-2", "bob-1", "bob-2"; second pass: "alice-3"
+	selected := cache.SelectTransactions(10, 2)
+	require.Equal(t, []string{"alice-1", "alice-2", "bob-1", "bob-2", "alice-3"}, hashesOf(selected))
+}
+
+func TestFeeMarketSelection_BreaksAtNonceGaps(t *testing.T) {
+	cache := newFeeMarketCacheToTest()
+
+	cache.AddTx(createTxWithParams([]byte("alice-1"), "alice", 1, 128, 50000, 100*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("alice-2"), "alice", 2, 128, 50000, 100*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("alice-4"), "alice", 4, 128, 50000, 900*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("bob-1"), "bob", 1, 128, 50000, 200*oneBillion))
+
+	selected := cache.SelectTransactions(10, 10)
+	require.Equal(t, []string{"bob-1", "alice-1", "alice-2"}, hashesOf(selected))
+}
+
+func TestFeeMarketSelection_KeepsNonceOrderForManySenders(t *testing.T) {
+	cache := newFeeMarketCacheToTest()
+
+	nSenders := 100
+	nTransactionsPerSender := 50
+	for senderTag := 0; senderTag < nSenders; senderTag++ {
+		sender := fmt.Sprintf("sender:%d", senderTag)
+
+		for txNonce := nTransactionsPerSender; txNonce > 0; txNonce-- {
+			txHash := fmt.Sprintf("hash:%d:%d", senderTag, txNonce)
+			gasPrice := uint64(100+(senderTag*txNonce)%100) * oneBillion
+			cache.AddTx(createTxWithParams([]byte(txHash), sender, uint64(txNonce), 128, 50000, gasPrice))
+		}
+	}
+
+	selected := cache.SelectTransactions(math.MaxInt16, 2)
+	require.Len(t, selected, nSenders*nTransactionsPerSender)
+
+	nonces := make(map[string]uint64, nSenders)
+	for _, tx := range selected {
+		sender := string(tx.Tx.GetSndAddr())
+		require.Equal(t, nonces[sender]+1, tx.Tx.GetNonce())
+		nonces[sender] = tx.Tx.GetNonce()
+	}
+}
+
+func BenchmarkTxCache_SelectTransactions(b *testing.B) {
+	for _, strategy := range []SelectionStrategy{ScoreSelection, FeeMarketSelection} {
+		b.Run(string(strategy), func(b *testing.B) {
+			if b.N > 10 {
+				fmt.Println("impractical benchmark: b.N too high")
+				return
+			}
+
+			caches := make([]*TxCache, b.N)
+			for i := 0; i < b.N; i++ {
+				caches[i] = newCacheWithManySendersToBenchmark(strategy, 10000, 10)
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				var selected []*WrappedTransaction
+				measureWithStopWatch(b, func() {
+					selected = caches[i].doSelectTransactionsByStrategy(30000, 10)
+				})
+				b.ReportMetric(float64(sumOfFees(selected)), "fees")
+			}
+		})
+	}
+}
+
+func newFeeMarketCacheToTest() *TxCache {
+	cache := newUnconstrainedCacheToTest()
+	cache.config.SelectionStrategy = FeeMarketSelection
+	return cache
+}
+
+func newCacheWithManySendersToBenchmark(strategy SelectionStrategy, numSenders int, numTxsPerSender int) *TxCache {
+	cache := newUnconstrainedCacheToTest()
+	cache.config.SelectionStrategy = strategy
+
+	for senderTag := 0; senderTag < numSenders; senderTag++ {
+		sender := createFakeSenderAddress(senderTag)
+
+		for txNonce := 1; txNonce <= numTxsPerSender; txNonce++ {
+			txHash := createFakeTxHash(sender, txNonce)
+			gasPrice := uint64(100+(senderTag*31+txNonce*17)%400) * oneBillion
+			gasLimit := uint64(50000 + (senderTag%10)*10000)
+			cache.AddTx(createTxWithParams(txHash, string(sender), uint64(txNonce), 128, gasLimit, gasPrice))
+		}
+	}
+
+	return cache
+}
+
+func sumOfFees(txs []*WrappedTransaction) uint64 {
+	sum := uint64(0)
+	for _, tx := range txs {
+		sum += estimateTxFee(tx)
+	}
+	return sum
+}
+
+func hashesOf(txs []*WrappedTransaction) []string {
+	hashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		hashes = append(hashes, string(tx.TxHash))
+	}
+	return hashes
+}
@@ -7,11 +7,11 @@ import (
 
 var log = logger.GetOrCreate("txcache")
 
-func (cache *TxCache) monitorEvictionWrtSenderLimit(sender []byte, evicted [][]byte) {
-	log.Trace("TxCache.AddTx() evict transactions wrt. limit by sender", "name", cache.name, "sender", sender, "num", len(evicted))
+func (cache *TxCache) monitorRemovalWrtSender(sender []byte, removed [][]byte) {
+	log.Trace("TxCache.AddTx() remove transactions replaced or evicted wrt. limit by sender", "name", cache.name, "sender", sender, "num", len(removed))
 
-	for i := 0; i < core.MinInt(len(evicted), numEvictedTxsToDisplay); i++ {
-		log.Trace("TxCache.AddTx() evict transactions wrt. limit by sender", "name", cache.name, "sender", sender, "tx", evicted[i])
+	for i := 0; i < core.MinInt(len(removed), numEvictedTxsToDisplay); i++ {
+		log.Trace("TxCache.AddTx() remove transactions replaced or evicted wrt. limit by sender", "name", cache.name, "sender", sender, "tx", removed[i])
 	}
 }
 
@@ -98,6 +98,27 @@ func (cache *TxCache) monitorSweepingEnd(numTxs uint32, numSenders uint32, stopW
 	cache.displaySendersHistogram()
 }
 
+func (cache *TxCache) monitorExpirySweepingStart() *core.StopWatch {
+	sw := core.NewStopWatch()
+	sw.Start("expirySweeping")
+	return sw
+}
+
+func (cache *TxCache) monitorExpirySweepingEnd(expired [][]byte, stopWatch *core.StopWatch) {
+	stopWatch.Stop("expirySweeping")
+	duration := stopWatch.GetMeasurement("expirySweeping")
+
+	logFunc := log.Trace
+	if len(expired) > 0 {
+		logFunc = log.Debug
+	}
+	logFunc("TxCache: swept expired transactions:", "name", cache.name, "duration", duration, "txs", len(expired), "maxAgeInSeconds", cache.config.MaxTxAgeInSeconds)
+
+	for i := 0; i < core.MinInt(len(expired), numEvictedTxsToDisplay); i++ {
+		log.Trace("TxCache: swept expired transaction", "name", cache.name, "tx", expired[i])
+	}
+}
+
 func (cache *TxCache) displaySendersHistogram() {
 	txListBySenderMap := cache.txListBySender.backingMap
 	log.Debug("TxCache.sendersHistogram:", "chunks", txListBySenderMap.ChunksCounts(), "scoreChunks", txListBySenderMap.ScoreChunksCounts())
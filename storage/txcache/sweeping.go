@@ -1,5 +1,9 @@
 package txcache
 
+import (
+	"time"
+)
+
 func (cache *TxCache) initSweepable() {
 	cache.sweepingListOfSenders = make([]*txListForSender, 0, estimatedNumOfSweepableSendersPerSelection)
 }
@@ -27,3 +31,29 @@ func (cache *TxCache) sweepSweepable() {
 	cache.initSweepable()
 	cache.monitorSweepingEnd(numTxs, numSenders, stopWatch)
 }
+
+// SweepExpired removes the transactions which stayed in the cache for longer than the configured maximum age
+// The hashes of the expired transactions are remembered (with a bound), so that their status can be reported
+func (cache *TxCache) SweepExpired() {
+	if cache.config.MaxTxAgeInSeconds == 0 {
+		return
+	}
+
+	cache.sweepingMutex.Lock()
+	defer cache.sweepingMutex.Unlock()
+
+	stopWatch := cache.monitorExpirySweepingStart()
+	maxAge := time.Duration(cache.config.MaxTxAgeInSeconds) * time.Second
+	expiredTxs := cache.txByHash.popExpired(time.Now(), maxAge)
+
+	removedTxHashes := make([][]byte, 0, len(expiredTxs))
+	for _, tx := range expiredTxs {
+		if cache.RemoveTxByHash(tx.TxHash) {
+			cache.expiredTxHashes.Put(tx.TxHash, struct{}{}, len(tx.TxHash))
+			removedTxHashes = append(removedTxHashes, tx.TxHash)
+		}
+	}
+
+	cache.notifyRemovedTxs(removedTxHashes)
+	cache.monitorExpirySweepingEnd(removedTxHashes, stopWatch)
+}
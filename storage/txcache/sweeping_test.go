@@ -2,6 +2,7 @@ package txcache
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -115,3 +116,86 @@ func TestSweeping_SweepSweepable(t *testing.T) {
 	require.Equal(t, uint64(1), cache.CountTx())
 	require.Equal(t, uint64(1), cache.CountSenders())
 }
+
+func TestSweeping_SweepExpired(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+	cache.config.MaxTxAgeInSeconds = 60
+
+	// Fake "alice-42" and "bob-42" as old, as if they were moved from another cache
+	oldTimestamp := time.Now().Add(-2 * time.Minute).UnixNano()
+	aliceOld := createTx([]byte("alice-42"), "alice", 42)
+	aliceOld.insertionTimestamp = oldTimestamp
+	bobOld := createTx([]byte("bob-42"), "bob", 42)
+	bobOld.insertionTimestamp = oldTimestamp
+
+	cache.AddTx(aliceOld)
+	cache.AddTx(createTx([]byte("alice-43"), "alice", 43))
+	cache.AddTx(bobOld)
+
+	cache.SweepExpired()
+
+	require.Equal(t, uint64(1), cache.CountTx())
+	require.Equal(t, uint64(1), cache.CountSenders())
+	require.True(t, cache.Has([]byte("alice-43")))
+	require.True(t, cache.HasExpiredTx([]byte("alice-42")))
+	require.True(t, cache.HasExpiredTx([]byte("bob-42")))
+	require.False(t, cache.HasExpiredTx([]byte("alice-43")))
+	require.Len(t, cache.txByHash.byAge.elements, 1)
+}
+
+func TestSweeping_SelectionShouldNotSweepExpired(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+	cache.config.MaxTxAgeInSeconds = 60
+
+	tx := createTx([]byte("alice-42"), "alice", 42)
+	tx.insertionTimestamp = time.Now().Add(-2 * time.Minute).UnixNano()
+	cache.AddTx(tx)
+
+	_ = cache.SelectTransactions(10, 10)
+
+	require.True(t, cache.Has([]byte("alice-42")))
+}
+
+func TestTxsByAge_ShouldKeepTheInsertionTimestampOrder(t *testing.T) {
+	byAge := newTxsByAge()
+	now := time.Now()
+
+	txs := make([]*WrappedTransaction, 0)
+	for i, minutesAgo := range []int{5, 1, 3, 1, 4} {
+		tx := createTx([]byte{byte(i)}, "alice", uint64(i))
+		tx.insertionTimestamp = now.Add(-time.Duration(minutesAgo) * time.Minute).UnixNano()
+		byAge.add(tx)
+		txs = append(txs, tx)
+	}
+	byAge.remove(txs[4])
+
+	expired := byAge.popExpired(now, 2*time.Minute)
+	require.Equal(t, []*WrappedTransaction{txs[0], txs[2]}, expired)
+	require.Equal(t, 2, byAge.items.Len())
+	require.Len(t, byAge.elements, 2)
+}
+
+func TestSweeping_SweepExpiredWhenDisabled(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	cache.AddTx(createTx([]byte("alice-42"), "alice", 42))
+	tx, _ := cache.GetByTxHash([]byte("alice-42"))
+	tx.insertionTimestamp = time.Now().Add(-24 * time.Hour).UnixNano()
+
+	cache.SweepExpired()
+
+	require.Equal(t, uint64(1), cache.CountTx())
+	require.False(t, cache.HasExpiredTx([]byte("alice-42")))
+}
+
+func TestWrappedTransaction_OnInsertionShouldKeepTheInitialTimestamp(t *testing.T) {
+	tx := createTx([]byte("alice-42"), "alice", 42)
+	first := time.Now()
+
+	tx.onInsertion(first)
+	tx.onInsertion(first.Add(time.Hour))
+
+	require.Equal(t, first.UnixNano(), tx.insertionTimestamp)
+	require.False(t, tx.isExpired(first.Add(time.Minute), time.Minute))
+	require.True(t, tx.isExpired(first.Add(time.Minute+time.Second), time.Minute))
+}
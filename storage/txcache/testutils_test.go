@@ -133,6 +133,19 @@ func createTx(hash []byte, sender string, nonce uint64) *WrappedTransaction {
 	}
 }
 
+func createTxOnNonceLane(hash []byte, sender string, lane uint32, nonce uint64) *WrappedTransaction {
+	tx := &transaction.Transaction{
+		SndAddr:   []byte(sender),
+		Nonce:     nonce,
+		NonceLane: lane,
+	}
+
+	return &WrappedTransaction{
+		Tx:     tx,
+		TxHash: hash,
+	}
+}
+
 func createTxWithParams(hash []byte, sender string, nonce uint64, dataLength uint64, gasLimit uint64, gasPrice uint64) *WrappedTransaction {
 	payloadLength := int(dataLength) - int(estimatedSizeOfBoundedTxFields)
 	if payloadLength < 0 {
@@ -1,6 +1,8 @@
 package txcache
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache/maps"
 )
@@ -10,6 +12,7 @@ type txByHashMap struct {
 	backingMap *maps.ConcurrentMap
 	counter    atomic.Counter
 	numBytes   atomic.Counter
+	byAge      *txsByAge
 }
 
 // newTxByHashMap creates a new TxByHashMap instance
@@ -18,6 +21,7 @@ func newTxByHashMap(nChunksHint uint32) *txByHashMap {
 
 	return &txByHashMap{
 		backingMap: backingMap,
+		byAge:      newTxsByAge(),
 	}
 }
 
@@ -27,6 +31,7 @@ func (txMap *txByHashMap) addTx(tx *WrappedTransaction) bool {
 	if added {
 		txMap.counter.Increment()
 		txMap.numBytes.Add(int64(estimateTxSize(tx)))
+		txMap.byAge.add(tx)
 	}
 
 	return added
@@ -47,6 +52,7 @@ func (txMap *txByHashMap) removeTx(txHash string) (*WrappedTransaction, bool) {
 	if removed {
 		txMap.counter.Decrement()
 		txMap.numBytes.Subtract(int64(estimateTxSize(tx)))
+		txMap.byAge.remove(tx)
 	}
 
 	return tx, true
@@ -77,6 +83,11 @@ func (txMap *txByHashMap) RemoveTxsBulk(txHashes [][]byte) uint32 {
 	return numRemoved
 }
 
+// popExpired removes from the age index and returns the transactions older than the given maximum age
+func (txMap *txByHashMap) popExpired(now time.Time, maxAge time.Duration) []*WrappedTransaction {
+	return txMap.byAge.popExpired(now, maxAge)
+}
+
 // forEach iterates over the senders
 func (txMap *txByHashMap) forEach(function ForEachTransaction) {
 	txMap.backingMap.IterCb(func(key string, item interface{}) {
@@ -88,6 +99,7 @@ func (txMap *txByHashMap) forEach(function ForEachTransaction) {
 func (txMap *txByHashMap) clear() {
 	txMap.backingMap.Clear()
 	txMap.counter.Set(0)
+	txMap.byAge.clear()
 }
 
 func (txMap *txByHashMap) keys() [][]byte {
@@ -2,10 +2,12 @@ package txcache
 
 import (
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 )
 
 var _ storage.Cacher = (*TxCache)(nil)
@@ -26,6 +28,10 @@ type TxCache struct {
 	numSendersInGracePeriod   atomic.Counter
 	sweepingMutex             sync.Mutex
 	sweepingListOfSenders     []*txListForSender
+	expiredTxHashes           storage.Cacher
+	replacedTxs               storage.Cacher
+	mutexRemovalHandler       sync.RWMutex
+	onRemovedTxs              func(txHashes [][]byte)
 }
 
 // NewTxCache creates a new transaction cache
@@ -42,12 +48,23 @@ func NewTxCache(config ConfigSourceMe) (*TxCache, error) {
 	senderConstraints := config.getSenderConstraints()
 	scoreComputer := newDefaultScoreComputer(config.MinGasPriceNanoErd)
 
+	expiredTxHashes, err := lrucache.NewCache(numExpiredTxHashesToRemember)
+	if err != nil {
+		return nil, err
+	}
+	replacedTxs, err := lrucache.NewCache(numReplacedTxsToRemember)
+	if err != nil {
+		return nil, err
+	}
+
 	txCache := &TxCache{
 		name:            config.Name,
 		txListBySender:  newTxListBySenderMap(numChunks, senderConstraints, scoreComputer),
 		txByHash:        newTxByHashMap(numChunks),
 		config:          config,
 		evictionJournal: evictionJournal{},
+		expiredTxHashes: expiredTxHashes,
+		replacedTxs:     replacedTxs,
 	}
 
 	txCache.initSweepable()
@@ -56,6 +73,7 @@ func NewTxCache(config ConfigSourceMe) (*TxCache, error) {
 
 // AddTx adds a transaction in the cache
 // Eviction happens if maximum capacity is reached
+// A pooled transaction having the same sender and nonce is replaced if the gas price is bumped enough (see ConfigSourceMe)
 func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 	if tx == nil || check.IfNil(tx.Tx) {
 		return false, false
@@ -65,8 +83,9 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 		cache.doEviction()
 	}
 
+	tx.onInsertion(time.Now())
 	addedInByHash := cache.txByHash.addTx(tx)
-	addedInBySender, evicted := cache.txListBySender.addTx(tx)
+	addedInBySender, replaced, evicted := cache.txListBySender.addTx(tx)
 	if addedInByHash != addedInBySender {
 		// This can happen  when two go-routines concur to add the same transaction:
 		// - A adds to "txByHash"
@@ -76,9 +95,12 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 		log.Trace("TxCache.AddTx(): slight inconsistency detected:", "name", cache.name, "tx", tx.TxHash, "sender", tx.Tx.GetSndAddr(), "addedInByHash", addedInByHash, "addedInBySender", addedInBySender)
 	}
 
-	if len(evicted) > 0 {
-		cache.monitorEvictionWrtSenderLimit(tx.Tx.GetSndAddr(), evicted)
-		cache.txByHash.RemoveTxsBulk(evicted)
+	removed := append(replaced, evicted...)
+	if len(removed) > 0 {
+		cache.monitorRemovalWrtSender(tx.SenderKey(), removed)
+		cache.rememberReplacedTxs(replaced)
+		cache.txByHash.RemoveTxsBulk(removed)
+		cache.notifyRemovedTxs(removed)
 	}
 
 	// The return value "added" is true even if transaction added, but then removed due to limits be sender.
@@ -86,21 +108,51 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 	return true, addedInByHash || addedInBySender
 }
 
-// GetByTxHash gets the transaction by hash
+// rememberReplacedTxs keeps the replaced transactions searchable by hash (with a bound), since a block proposed by a
+// node which did not receive the replacement might still include them. They are forgotten once removed by hash
+func (cache *TxCache) rememberReplacedTxs(txHashes [][]byte) {
+	for _, txHash := range txHashes {
+		tx, ok := cache.txByHash.getTx(string(txHash))
+		if ok {
+			cache.replacedTxs.Put(txHash, tx, tx.Size())
+		}
+	}
+}
+
+// GetByTxHash gets the transaction by hash. The recently replaced transactions are also searched
 func (cache *TxCache) GetByTxHash(txHash []byte) (*WrappedTransaction, bool) {
 	tx, ok := cache.txByHash.getTx(string(txHash))
+	if ok {
+		return tx, true
+	}
+
+	item, ok := cache.replacedTxs.Get(txHash)
+	if !ok {
+		return nil, false
+	}
+
+	tx, ok = item.(*WrappedTransaction)
 	return tx, ok
 }
 
 // SelectTransactions selects a reasonably fair list of transactions to be included in the next miniblock
 // It returns at most "numRequested" transactions
 // Each sender gets the chance to give at least "batchSizePerSender" transactions, unless "numRequested" limit is reached before iterating over all senders
+// With the fee market strategy, the transactions are selected in the descending order of their fee per gas unit instead
 func (cache *TxCache) SelectTransactions(numRequested int, batchSizePerSender int) []*WrappedTransaction {
-	result := cache.doSelectTransactions(numRequested, batchSizePerSender)
+	result := cache.doSelectTransactionsByStrategy(numRequested, batchSizePerSender)
 	go cache.doAfterSelection()
 	return result
 }
 
+func (cache *TxCache) doSelectTransactionsByStrategy(numRequested int, batchSizePerSender int) []*WrappedTransaction {
+	if cache.config.SelectionStrategy == FeeMarketSelection {
+		return cache.doSelectTransactionsByFeeMarket(numRequested, batchSizePerSender)
+	}
+
+	return cache.doSelectTransactions(numRequested, batchSizePerSender)
+}
+
 func (cache *TxCache) doSelectTransactions(numRequested int, batchSizePerSender int) []*WrappedTransaction {
 	stopWatch := cache.monitorSelectionStart()
 
@@ -156,9 +208,14 @@ func (cache *TxCache) doAfterSelection() {
 
 // RemoveTxByHash removes tx by hash
 func (cache *TxCache) RemoveTxByHash(txHash []byte) bool {
+	foundInReplaced := cache.replacedTxs.Has(txHash)
+	if foundInReplaced {
+		cache.replacedTxs.Remove(txHash)
+	}
+
 	tx, foundInByHash := cache.txByHash.removeTx(string(txHash))
 	if !foundInByHash {
-		return false
+		return foundInReplaced
 	}
 
 	foundInBySender := cache.txListBySender.removeTx(tx)
@@ -206,6 +263,7 @@ func (cache *TxCache) ForEachTransaction(function ForEachTransaction) {
 func (cache *TxCache) Clear() {
 	cache.txListBySender.clear()
 	cache.txByHash.clear()
+	cache.replacedTxs.Clear()
 }
 
 // Put is not implemented
@@ -272,6 +330,30 @@ func (cache *TxCache) UnRegisterHandler(string) {
 	log.Error("TxCache.UnRegisterHandler is not implemented")
 }
 
+// RegisterRemovalHandler registers the handler notified about the transactions removed by the cache itself: the
+// transactions evicted, replaced by a transaction with a higher gas price or expired. The removals requested through
+// RemoveTxByHash are not notified
+func (cache *TxCache) RegisterRemovalHandler(handler func(txHashes [][]byte)) {
+	cache.mutexRemovalHandler.Lock()
+	cache.onRemovedTxs = handler
+	cache.mutexRemovalHandler.Unlock()
+}
+
+func (cache *TxCache) notifyRemovedTxs(txHashes [][]byte) {
+	cache.mutexRemovalHandler.RLock()
+	handler := cache.onRemovedTxs
+	cache.mutexRemovalHandler.RUnlock()
+
+	if handler != nil && len(txHashes) > 0 {
+		handler(txHashes)
+	}
+}
+
+// HasExpiredTx returns whether the transaction was recently removed from the cache because it was too old
+func (cache *TxCache) HasExpiredTx(txHash []byte) bool {
+	return cache.expiredTxHashes.Has(txHash)
+}
+
 // NotifyAccountNonce should be called by external components (such as interceptors and transactions processor)
 // in order to inform the cache about initial nonce gap phenomena
 func (cache *TxCache) NotifyAccountNonce(accountKey []byte, nonce uint64) {
@@ -56,6 +56,10 @@ func Test_NewTxCache(t *testing.T) {
 	badConfig.CountPerSenderThreshold = 0
 	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.CountPerSenderThreshold")
 
+	badConfig = config
+	badConfig.SelectionStrategy = "Unknown"
+	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.SelectionStrategy")
+
 	badConfig = config
 	badConfig.MinGasPriceNanoErd = 0
 	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.MinGasPriceNanoErd")
@@ -101,6 +105,64 @@ func Test_AddTx(t *testing.T) {
 	require.Equal(t, tx, foundTx)
 }
 
+func Test_AddTx_ReplacesTransactionWhenGasPriceBumpedEnough(t *testing.T) {
+	config := ConfigSourceMe{
+		Name:                                 "test",
+		NumChunks:                            16,
+		NumBytesPerSenderThreshold:           maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:              math.MaxUint32,
+		MinGasPriceNanoErd:                   100,
+		ReplacementMinGasPriceBumpPercentage: 25,
+	}
+	cache, err := NewTxCache(config)
+	require.Nil(t, err)
+
+	cache.AddTx(createTxWithParams([]byte("tx-alice-1"), "alice", 1, 128, 50000, oneBillion))
+	cache.AddTx(createTxWithParams([]byte("tx-alice-2"), "alice", 2, 128, 50000, oneBillion))
+
+	ok, added := cache.AddTx(createTxWithParams([]byte("tx-alice-2-bumped"), "alice", 2, 128, 50000, 2*oneBillion))
+	require.True(t, ok)
+	require.True(t, added)
+	require.Equal(t, []string{"tx-alice-1", "tx-alice-2-bumped"}, cache.getHashesForSender("alice"))
+	require.True(t, cache.Has([]byte("tx-alice-2-bumped")))
+	require.Equal(t, uint64(2), cache.CountTx())
+	require.True(t, cache.areInternalMapsConsistent())
+
+	// The replaced transaction is still searchable by hash, until removed
+	_, isInByHash := cache.txByHash.getTx("tx-alice-2")
+	require.False(t, isInByHash)
+	replacedTx, ok := cache.GetByTxHash([]byte("tx-alice-2"))
+	require.True(t, ok)
+	require.Equal(t, uint64(2), replacedTx.Tx.GetNonce())
+	require.True(t, cache.RemoveTxByHash([]byte("tx-alice-2")))
+	require.False(t, cache.Has([]byte("tx-alice-2")))
+	require.Equal(t, []string{"tx-alice-1", "tx-alice-2-bumped"}, cache.getHashesForSender("alice"))
+}
+
+func Test_AddTx_ShouldNotifyTheRemovalHandler(t *testing.T) {
+	config := ConfigSourceMe{
+		Name:                                 "test",
+		NumChunks:                            16,
+		NumBytesPerSenderThreshold:           maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:              2,
+		MinGasPriceNanoErd:                   100,
+		ReplacementMinGasPriceBumpPercentage: 25,
+	}
+	cache, _ := NewTxCache(config)
+	notified := make([]string, 0)
+	cache.RegisterRemovalHandler(func(txHashes [][]byte) {
+		notified = append(notified, hashesAsStrings(txHashes)...)
+	})
+
+	cache.AddTx(createTxWithParams([]byte("tx-alice-1"), "alice", 1, 128, 50000, oneBillion))
+	cache.AddTx(createTxWithParams([]byte("tx-alice-2"), "alice", 2, 128, 50000, oneBillion))
+	cache.AddTx(createTxWithParams([]byte("tx-alice-3"), "alice", 3, 128, 50000, oneBillion))
+	cache.AddTx(createTxWithParams([]byte("tx-alice-1-bumped"), "alice", 1, 128, 50000, 2*oneBillion))
+	cache.RemoveTxByHash([]byte("tx-alice-2"))
+
+	require.Equal(t, []string{"tx-alice-3", "tx-alice-1"}, notified)
+}
+
 func Test_AddNilTx_DoesNothing(t *testing.T) {
 	cache := newUnconstrainedCacheToTest()
 
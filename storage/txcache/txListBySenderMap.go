@@ -15,9 +15,36 @@ type txListBySenderMap struct {
 	senderConstraints senderConstraints
 	counter           atomic.Counter
 	scoreComputer     scoreComputer
+	usageByAddress    map[string]*senderUsage
 	mutex             sync.Mutex
 }
 
+// senderUsage holds the number of transactions and the number of bytes a sender address has in the cache, summed over
+// all its nonce lanes, so that the sender constraints apply per address
+type senderUsage struct {
+	address  string
+	numTxs   atomic.Counter
+	numBytes atomic.Counter
+	numLists int
+}
+
+func newSenderUsage(address string) *senderUsage {
+	return &senderUsage{
+		address:  address,
+		numLists: 1,
+	}
+}
+
+func (usage *senderUsage) onAddedTransaction(tx *WrappedTransaction) {
+	usage.numTxs.Increment()
+	usage.numBytes.Add(int64(estimateTxSize(tx)))
+}
+
+func (usage *senderUsage) onRemovedTransaction(tx *WrappedTransaction) {
+	usage.numTxs.Decrement()
+	usage.numBytes.Subtract(int64(estimateTxSize(tx)))
+}
+
 // newTxListBySenderMap creates a new instance of TxListBySenderMap
 func newTxListBySenderMap(nChunksHint uint32, senderConstraints senderConstraints, scoreComputer scoreComputer) *txListBySenderMap {
 	backingMap := maps.NewBucketSortedMap(nChunksHint, numberOfScoreChunks)
@@ -26,18 +53,20 @@ func newTxListBySenderMap(nChunksHint uint32, senderConstraints senderConstraint
 		backingMap:        backingMap,
 		senderConstraints: senderConstraints,
 		scoreComputer:     scoreComputer,
+		usageByAddress:    make(map[string]*senderUsage),
 	}
 }
 
-// addTx adds a transaction in the map, in the corresponding list (selected by its sender)
-func (txMap *txListBySenderMap) addTx(tx *WrappedTransaction) (bool, [][]byte) {
-	sender := string(tx.Tx.GetSndAddr())
-	listForSender := txMap.getOrAddListForSender(sender)
+// addTx adds a transaction in the map, in the corresponding list (selected by its sender and nonce lane)
+func (txMap *txListBySenderMap) addTx(tx *WrappedTransaction) (bool, [][]byte, [][]byte) {
+	sender := string(tx.SenderKey())
+	address := string(tx.Tx.GetSndAddr())
+	listForSender := txMap.getOrAddListForSender(sender, address)
 	return listForSender.AddTx(tx)
 }
 
 // getOrAddListForSender gets or lazily creates a list (using double-checked locking pattern)
-func (txMap *txListBySenderMap) getOrAddListForSender(sender string) *txListForSender {
+func (txMap *txListBySenderMap) getOrAddListForSender(sender string, address string) *txListForSender {
 	listForSender, ok := txMap.getListForSender(sender)
 	if ok {
 		return listForSender
@@ -51,7 +80,7 @@ func (txMap *txListBySenderMap) getOrAddListForSender(sender string) *txListForS
 		return listForSender
 	}
 
-	return txMap.addSender(sender)
+	return txMap.addSender(sender, address)
 }
 
 func (txMap *txListBySenderMap) getListForSender(sender string) (*txListForSender, bool) {
@@ -64,8 +93,17 @@ func (txMap *txListBySenderMap) getListForSender(sender string) (*txListForSende
 	return listForSender, true
 }
 
-func (txMap *txListBySenderMap) addSender(sender string) *txListForSender {
+// This function should only be called in a critical section managed by txMap.mutex
+func (txMap *txListBySenderMap) addSender(sender string, address string) *txListForSender {
 	listForSender := newTxListForSender(sender, &txMap.senderConstraints, txMap.notifyScoreChange)
+	usage, ok := txMap.usageByAddress[address]
+	if ok {
+		usage.numLists++
+		listForSender.usage = usage
+	} else {
+		listForSender.usage = newSenderUsage(address)
+		txMap.usageByAddress[address] = listForSender.usage
+	}
 
 	txMap.backingMap.Set(listForSender)
 	txMap.counter.Increment()
@@ -82,7 +120,7 @@ func (txMap *txListBySenderMap) notifyScoreChange(txList *txListForSender, score
 
 // removeTx removes a transaction from the map
 func (txMap *txListBySenderMap) removeTx(tx *WrappedTransaction) bool {
-	sender := string(tx.Tx.GetSndAddr())
+	sender := string(tx.SenderKey())
 
 	listForSender, ok := txMap.getListForSender(sender)
 	if !ok {
@@ -102,14 +140,33 @@ func (txMap *txListBySenderMap) removeTx(tx *WrappedTransaction) bool {
 }
 
 func (txMap *txListBySenderMap) removeSender(sender string) bool {
-	_, removed := txMap.backingMap.Remove(sender)
+	item, removed := txMap.backingMap.Remove(sender)
 	if removed {
 		txMap.counter.Decrement()
+		txMap.releaseUsage(item.(*txListForSender))
 	}
 
 	return removed
 }
 
+// releaseUsage detaches a removed list from the usage of its sender address
+func (txMap *txListBySenderMap) releaseUsage(listForSender *txListForSender) {
+	txMap.mutex.Lock()
+	defer txMap.mutex.Unlock()
+
+	usage := listForSender.usage
+	usage.numLists--
+	if usage.numLists <= 0 {
+		delete(txMap.usageByAddress, usage.address)
+		return
+	}
+
+	listForSender.mutex.RLock()
+	usage.numTxs.Subtract(int64(listForSender.countTx()))
+	usage.numBytes.Subtract(listForSender.totalBytes.Get())
+	listForSender.mutex.RUnlock()
+}
+
 // RemoveSendersBulk removes senders, in bulk
 func (txMap *txListBySenderMap) RemoveSendersBulk(senders []string) uint32 {
 	numRemoved := uint32(0)
@@ -156,6 +213,10 @@ func (txMap *txListBySenderMap) getSnapshotDescending() []*txListForSender {
 }
 
 func (txMap *txListBySenderMap) clear() {
+	txMap.mutex.Lock()
+	txMap.usageByAddress = make(map[string]*senderUsage)
+	txMap.mutex.Unlock()
+
 	txMap.backingMap.Clear()
 	txMap.counter.Set(0)
 }
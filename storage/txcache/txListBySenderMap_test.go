@@ -6,6 +6,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/stretchr/testify/require"
 )
 
@@ -113,6 +114,57 @@ func TestSendersMap_notifyAccountNonce(t *testing.T) {
 	require.True(t, alice.accountNonceKnown.IsSet())
 }
 
+func TestSendersMap_AddTx_SecondaryNonceLanesShouldHaveTheirOwnLists(t *testing.T) {
+	myMap := newSendersMapToTest()
+
+	txAlice := createTx([]byte("a"), "alice", uint64(1))
+	txAliceLane1 := createTxOnNonceLane([]byte("a-lane-1"), "alice", 1, uint64(1))
+	txAliceLane2 := createTxOnNonceLane([]byte("a-lane-2"), "alice", 2, uint64(5))
+
+	myMap.addTx(txAlice)
+	myMap.addTx(txAliceLane1)
+	myMap.addTx(txAliceLane2)
+	require.Equal(t, int64(3), myMap.counter.Get())
+	require.Equal(t, uint64(1), myMap.testGetListForSender("alice").countTx())
+	require.Equal(t, uint64(1), myMap.testGetListForSender(string(txAliceLane1.SenderKey())).countTx())
+
+	myMap.notifyAccountNonce(txAliceLane2.SenderKey(), 5)
+	aliceLane2, _ := myMap.getListForSender(string(txAliceLane2.SenderKey()))
+	require.Equal(t, uint64(5), aliceLane2.accountNonce.Get())
+	require.False(t, aliceLane2.hasInitialGap())
+
+	myMap.removeTx(txAliceLane1)
+	require.Equal(t, int64(2), myMap.counter.Get())
+}
+
+func TestSendersMap_AddTx_ConstraintsShouldApplyPerAddressOverAllNonceLanes(t *testing.T) {
+	myMap := newTxListBySenderMap(4, senderConstraints{
+		maxNumBytes: math.MaxUint32,
+		maxNumTxs:   3,
+	}, &disabledScoreComputer{})
+
+	myMap.addTx(createTx([]byte("a1"), "alice", uint64(1)))
+	myMap.addTx(createTx([]byte("a2"), "alice", uint64(2)))
+	myMap.addTx(createTxOnNonceLane([]byte("a-lane-1"), "alice", 1, uint64(1)))
+	_, _, evicted := myMap.addTx(createTxOnNonceLane([]byte("a-lane-2"), "alice", 2, uint64(1)))
+	require.Equal(t, [][]byte{[]byte("a-lane-2")}, evicted)
+	require.Equal(t, int64(3), myMap.usageByAddress["alice"].numTxs.Get())
+
+	txAlice3 := createTx([]byte("a3"), "alice", uint64(3))
+	myMap.addTx(txAlice3)
+	require.Equal(t, uint64(2), myMap.testGetListForSender("alice").countTx())
+
+	myMap.removeSender(string(core.ComputeNonceLaneSenderKey([]byte("alice"), 1)))
+	require.Equal(t, int64(2), myMap.usageByAddress["alice"].numTxs.Get())
+	_, _, evicted = myMap.addTx(txAlice3)
+	require.Empty(t, evicted)
+	require.Equal(t, int64(3), myMap.usageByAddress["alice"].numTxs.Get())
+
+	myMap.removeSender("alice")
+	myMap.removeSender(string(core.ComputeNonceLaneSenderKey([]byte("alice"), 2)))
+	require.Empty(t, myMap.usageByAddress)
+}
+
 func BenchmarkSendersMap_GetSnapshotAscending(b *testing.B) {
 	if b.N > 10 {
 		fmt.Println("impractical benchmark: b.N too high")
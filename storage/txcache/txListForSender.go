@@ -20,6 +20,7 @@ type txListForSender struct {
 	items               *list.List
 	copyBatchIndex      *list.Element
 	constraints         *senderConstraints
+	usage               *senderUsage
 	scoreChunk          *maps.MapChunk
 	accountNonceKnown   atomic.Flag
 	lastComputedScore   atomic.Uint32
@@ -43,22 +44,28 @@ func newTxListForSender(sender string, constraints *senderConstraints, onScoreCh
 		items:         list.New(),
 		sender:        sender,
 		constraints:   constraints,
+		usage:         newSenderUsage(sender),
 		onScoreChange: onScoreChange,
 	}
 }
 
 // AddTx adds a transaction in sender's list
 // This is a "sorted" insert
-func (listForSender *txListForSender) AddTx(tx *WrappedTransaction) (bool, [][]byte) {
+// It returns the hashes of the transactions removed from the list: the ones replaced by the incoming transaction and
+// the ones evicted due to the size constraints
+func (listForSender *txListForSender) AddTx(tx *WrappedTransaction) (bool, [][]byte, [][]byte) {
 	// We don't allow concurrent interceptor goroutines to mutate a given sender's list
 	listForSender.mutex.Lock()
 	defer listForSender.mutex.Unlock()
 
 	insertionPlace, err := listForSender.findInsertionPlace(tx)
 	if err != nil {
-		return false, nil
+		return false, nil, nil
 	}
 
+	// The replaced transactions have a lower gas price than the incoming one, thus none of them can be the insertion place
+	replaced := listForSender.removeTxsReplacedBy(tx)
+
 	if insertionPlace == nil {
 		listForSender.items.PushFront(tx)
 	} else {
@@ -68,7 +75,47 @@ func (listForSender *txListForSender) AddTx(tx *WrappedTransaction) (bool, [][]b
 	listForSender.onAddedTransaction(tx)
 	evicted := listForSender.applySizeConstraints()
 	listForSender.triggerScoreChange()
-	return true, evicted
+	return true, replaced, evicted
+}
+
+// removeTxsReplacedBy removes the transactions having the same nonce as the incoming one, but a gas price lower by
+// at least the configured percentage, so that a sender can bump the gas price of a transaction stuck in the pool.
+// The transactions with the same nonce which are not outbid are kept, sorted by gas price, as they might be required
+// by a block proposed by another node
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) removeTxsReplacedBy(incomingTx *WrappedTransaction) [][]byte {
+	replacedTxHashes := make([][]byte, 0)
+	if !listForSender.constraints.isReplacementEnabled() {
+		return replacedTxHashes
+	}
+
+	incomingNonce := incomingTx.Tx.GetNonce()
+	element := listForSender.items.Back()
+	for element != nil {
+		previous := element.Prev()
+		currentTx := element.Value.(*WrappedTransaction)
+		currentTxNonce := currentTx.Tx.GetNonce()
+		if currentTxNonce < incomingNonce {
+			break
+		}
+
+		if currentTxNonce == incomingNonce && listForSender.constraints.canBeReplaced(currentTx, incomingTx) {
+			listForSender.items.Remove(element)
+			listForSender.onRemovedListElement(element)
+			replacedTxHashes = append(replacedTxHashes, currentTx.TxHash)
+
+			log.Trace("txListForSender.AddTx(): transaction replaced",
+				"sender", []byte(listForSender.sender),
+				"nonce", incomingNonce,
+				"replaced", currentTx.TxHash,
+				"replacedBy", incomingTx.TxHash,
+			)
+		}
+
+		element = previous
+	}
+
+	return replacedTxHashes
 }
 
 // This function should only be used in critical section (listForSender.mutex)
@@ -92,16 +139,19 @@ func (listForSender *txListForSender) applySizeConstraints() [][]byte {
 	return evictedTxHashes
 }
 
+// isCapacityExceeded checks the constraints against the usage of the sender address, which is shared by all its
+// nonce lanes
 func (listForSender *txListForSender) isCapacityExceeded() bool {
 	maxBytes := int64(listForSender.constraints.maxNumBytes)
-	maxNumTxs := uint64(listForSender.constraints.maxNumTxs)
-	tooManyBytes := listForSender.totalBytes.Get() > maxBytes
-	tooManyTxs := listForSender.countTx() > maxNumTxs
+	maxNumTxs := int64(listForSender.constraints.maxNumTxs)
+	tooManyBytes := listForSender.usage.numBytes.Get() > maxBytes
+	tooManyTxs := listForSender.usage.numTxs.Get() > maxNumTxs
 
 	return tooManyBytes || tooManyTxs
 }
 
 func (listForSender *txListForSender) onAddedTransaction(tx *WrappedTransaction) {
+	listForSender.usage.onAddedTransaction(tx)
 	listForSender.totalBytes.Add(int64(estimateTxSize(tx)))
 	listForSender.totalGas.Add(int64(estimateTxGas(tx)))
 	listForSender.totalFee.Add(int64(estimateTxFee(tx)))
@@ -174,6 +224,7 @@ func (listForSender *txListForSender) RemoveTx(tx *WrappedTransaction) bool {
 func (listForSender *txListForSender) onRemovedListElement(element *list.Element) {
 	value := element.Value.(*WrappedTransaction)
 
+	listForSender.usage.onRemovedTransaction(value)
 	listForSender.totalBytes.Subtract(int64(estimateTxSize(value)))
 	listForSender.totalGas.Subtract(int64(estimateTxGas(value)))
 	listForSender.totalFee.Subtract(int64(estimateTxFee(value)))
@@ -45,16 +45,64 @@ func TestListForSender_AddTx_SortsCorrectlyWhenSameNonceSamePrice(t *testing.T)
 	require.Equal(t, []string{"a", "f", "e", "c", "b", "g", "d"}, list.getTxHashesAsStrings())
 }
 
+func TestListForSender_AddTx_ReplacesWhenGasPriceBumpedEnough(t *testing.T) {
+	list := newListWithReplacementToTest(10)
+
+	list.AddTx(createTxWithParams([]byte("a"), ".", 1, 128, 42, 100))
+	list.AddTx(createTxWithParams([]byte("b"), ".", 2, 128, 42, 100))
+	list.AddTx(createTxWithParams([]byte("c"), ".", 3, 128, 42, 100))
+
+	// Not bumped enough, kept after the pooled one
+	added, removed, _ := list.AddTx(createTxWithParams([]byte("b+"), ".", 2, 128, 42, 109))
+	require.True(t, added)
+	require.Len(t, removed, 0)
+	require.Equal(t, []string{"a", "b+", "b", "c"}, list.getTxHashesAsStrings())
+
+	// Outbids both "b+" and "b"
+	added, removed, _ = list.AddTx(createTxWithParams([]byte("b++"), ".", 2, 128, 42, 120))
+	require.True(t, added)
+	require.ElementsMatch(t, []string{"b", "b+"}, hashesAsStrings(removed))
+	require.Equal(t, []string{"a", "b++", "c"}, list.getTxHashesAsStrings())
+	require.Equal(t, uint64(3), list.countTx())
+
+	// Lower gas price, kept after the pooled one
+	added, removed, _ = list.AddTx(createTxWithParams([]byte("c-"), ".", 3, 128, 42, 90))
+	require.True(t, added)
+	require.Len(t, removed, 0)
+	require.Equal(t, []string{"a", "b++", "c", "c-"}, list.getTxHashesAsStrings())
+
+	// Outbids "c" by exactly the minimum percentage, thus "c-" as well
+	added, removed, _ = list.AddTx(createTxWithParams([]byte("c+"), ".", 3, 128, 42, 110))
+	require.True(t, added)
+	require.ElementsMatch(t, []string{"c", "c-"}, hashesAsStrings(removed))
+	require.Equal(t, []string{"a", "b++", "c+"}, list.getTxHashesAsStrings())
+	require.Equal(t, uint64(3), list.countTx())
+}
+
+func TestListForSender_AddTx_ReplacementDoesNotAffectDuplicates(t *testing.T) {
+	list := newListWithReplacementToTest(10)
+
+	tx := createTxWithParams([]byte("a"), ".", 1, 128, 42, 100)
+	added, removed, _ := list.AddTx(tx)
+	require.True(t, added)
+	require.Len(t, removed, 0)
+
+	added, removed, _ = list.AddTx(tx)
+	require.False(t, added)
+	require.Len(t, removed, 0)
+	require.Equal(t, []string{"a"}, list.getTxHashesAsStrings())
+}
+
 func TestListForSender_AddTx_IgnoresDuplicates(t *testing.T) {
 	list := newUnconstrainedListToTest()
 
-	added, _ := list.AddTx(createTx([]byte("tx1"), ".", 1))
+	added, _, _ := list.AddTx(createTx([]byte("tx1"), ".", 1))
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx2"), ".", 2))
+	added, _, _ = list.AddTx(createTx([]byte("tx2"), ".", 2))
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx3"), ".", 3))
+	added, _, _ = list.AddTx(createTx([]byte("tx3"), ".", 3))
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx2"), ".", 2))
+	added, _, _ = list.AddTx(createTx([]byte("tx2"), ".", 2))
 	require.False(t, added)
 }
 
@@ -67,17 +115,17 @@ func TestListForSender_AddTx_AppliesSizeConstraintsForNumTransactions(t *testing
 	list.AddTx(createTx([]byte("tx2"), ".", 2))
 	require.Equal(t, []string{"tx1", "tx2", "tx4"}, list.getTxHashesAsStrings())
 
-	_, evicted := list.AddTx(createTx([]byte("tx3"), ".", 3))
+	_, _, evicted := list.AddTx(createTx([]byte("tx3"), ".", 3))
 	require.Equal(t, []string{"tx1", "tx2", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx4"}, hashesAsStrings(evicted))
 
 	// Gives priority to higher gas - though undesirably to some extent, "tx3" is evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 42))
+	_, _, evicted = list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 42))
 	require.Equal(t, []string{"tx1", "tx2++", "tx2"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx3"}, hashesAsStrings(evicted))
 
 	// Though Undesirably to some extent, "tx3++"" is added, then evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 128, 42, 42))
+	_, _, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 128, 42, 42))
 	require.Equal(t, []string{"tx1", "tx2++", "tx2"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx3++"}, hashesAsStrings(evicted))
 }
@@ -88,20 +136,20 @@ func TestListForSender_AddTx_AppliesSizeConstraintsForNumBytes(t *testing.T) {
 	list.AddTx(createTxWithParams([]byte("tx1"), ".", 1, 128, 42, 42))
 	list.AddTx(createTxWithParams([]byte("tx2"), ".", 2, 512, 42, 42))
 	list.AddTx(createTxWithParams([]byte("tx3"), ".", 3, 256, 42, 42))
-	_, evicted := list.AddTx(createTxWithParams([]byte("tx5"), ".", 4, 256, 42, 42))
+	_, _, evicted := list.AddTx(createTxWithParams([]byte("tx5"), ".", 4, 256, 42, 42))
 	require.Equal(t, []string{"tx1", "tx2", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx5"}, hashesAsStrings(evicted))
 
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx5--"), ".", 4, 128, 42, 42))
+	_, _, evicted = list.AddTx(createTxWithParams([]byte("tx5--"), ".", 4, 128, 42, 42))
 	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx5--"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{}, hashesAsStrings(evicted))
 
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx4"), ".", 4, 128, 42, 42))
+	_, _, evicted = list.AddTx(createTxWithParams([]byte("tx4"), ".", 4, 128, 42, 42))
 	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx4"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx5--"}, hashesAsStrings(evicted))
 
 	// Gives priority to higher gas - though undesirably to some extent, "tx4" is evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 256, 42, 100))
+	_, _, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 256, 42, 100))
 	require.Equal(t, []string{"tx1", "tx2", "tx3++", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx4"}, hashesAsStrings(evicted))
 }
@@ -362,6 +410,14 @@ func newUnconstrainedListToTest() *txListForSender {
 	}, func(_ *txListForSender, _ senderScoreParams) {})
 }
 
+func newListWithReplacementToTest(replacementMinGasPriceBumpPercentage uint32) *txListForSender {
+	return newTxListForSender(".", &senderConstraints{
+		maxNumBytes:                          math.MaxUint32,
+		maxNumTxs:                            math.MaxUint32,
+		replacementMinGasPriceBumpPercentage: replacementMinGasPriceBumpPercentage,
+	}, func(_ *txListForSender, _ senderScoreParams) {})
+}
+
 func newListToTest(maxNumBytes uint32, maxNumTxs uint32) *txListForSender {
 	return newTxListForSender(".", &senderConstraints{
 		maxNumBytes: maxNumBytes,
@@ -0,0 +1,79 @@
+package txcache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// txsByAge holds the transactions of a cache ordered by their insertion timestamp, so that the expired ones are
+// found without iterating over the whole cache
+type txsByAge struct {
+	items    *list.List
+	elements map[*WrappedTransaction]*list.Element
+	mutex    sync.Mutex
+}
+
+func newTxsByAge() *txsByAge {
+	return &txsByAge{
+		items:    list.New(),
+		elements: make(map[*WrappedTransaction]*list.Element),
+	}
+}
+
+// add inserts the transaction by its insertion timestamp. The transactions moved between caches keep their initial
+// timestamp, so the insertion place is searched from the back of the list, where the most recent ones are
+func (byAge *txsByAge) add(tx *WrappedTransaction) {
+	byAge.mutex.Lock()
+	defer byAge.mutex.Unlock()
+
+	for element := byAge.items.Back(); element != nil; element = element.Prev() {
+		current := element.Value.(*WrappedTransaction)
+		if current.insertionTimestamp <= tx.insertionTimestamp {
+			byAge.elements[tx] = byAge.items.InsertAfter(tx, element)
+			return
+		}
+	}
+
+	byAge.elements[tx] = byAge.items.PushFront(tx)
+}
+
+func (byAge *txsByAge) remove(tx *WrappedTransaction) {
+	byAge.mutex.Lock()
+	defer byAge.mutex.Unlock()
+
+	element, ok := byAge.elements[tx]
+	if !ok {
+		return
+	}
+
+	byAge.items.Remove(element)
+	delete(byAge.elements, tx)
+}
+
+// popExpired removes and returns the transactions older than the given maximum age
+func (byAge *txsByAge) popExpired(now time.Time, maxAge time.Duration) []*WrappedTransaction {
+	byAge.mutex.Lock()
+	defer byAge.mutex.Unlock()
+
+	expired := make([]*WrappedTransaction, 0)
+	for element := byAge.items.Front(); element != nil; element = byAge.items.Front() {
+		tx := element.Value.(*WrappedTransaction)
+		if !tx.isExpired(now, maxAge) {
+			break
+		}
+
+		byAge.items.Remove(element)
+		delete(byAge.elements, tx)
+		expired = append(expired, tx)
+	}
+
+	return expired
+}
+
+func (byAge *txsByAge) clear() {
+	byAge.mutex.Lock()
+	byAge.items = list.New()
+	byAge.elements = make(map[*WrappedTransaction]*list.Element)
+	byAge.mutex.Unlock()
+}
@@ -2,13 +2,20 @@ package txcache
 
 import (
 	"bytes"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/data"
 )
 
 const estimatedSizeOfBoundedTxFields = uint64(128)
 
+// nonceLaneHandler defines the transactions which can be ordered on a secondary nonce lane of their sender
+type nonceLaneHandler interface {
+	GetNonceLane() uint32
+}
+
 // WrappedTransaction contains a transaction, its hash and extra information
 type WrappedTransaction struct {
 	Tx                     data.TransactionHandler
@@ -16,6 +23,7 @@ type WrappedTransaction struct {
 	SenderShardID          uint32
 	ReceiverShardID        uint32
 	isImmuneToEvictionFlag atomic.Flag
+	insertionTimestamp     int64
 }
 
 // GetKey gets the transaction hash
@@ -23,6 +31,17 @@ func (wrappedTx *WrappedTransaction) GetKey() []byte {
 	return wrappedTx.TxHash
 }
 
+// SenderKey gets the key of the transactions sequence the transaction belongs to: the sender address for the default
+// nonce lane, the sender address suffixed with the lane for the secondary ones
+func (wrappedTx *WrappedTransaction) SenderKey() []byte {
+	txWithLane, ok := wrappedTx.Tx.(nonceLaneHandler)
+	if !ok {
+		return wrappedTx.Tx.GetSndAddr()
+	}
+
+	return core.ComputeNonceLaneSenderKey(wrappedTx.Tx.GetSndAddr(), txWithLane.GetNonceLane())
+}
+
 // Size gets the size (in bytes) of the transaction
 func (wrappedTx *WrappedTransaction) Size() int {
 	return int(estimateTxSize(wrappedTx))
@@ -42,6 +61,26 @@ func (wrappedTx *WrappedTransaction) sameAs(another *WrappedTransaction) bool {
 	return bytes.Equal(wrappedTx.TxHash, another.TxHash)
 }
 
+// SetInsertionTimestamp sets the moment the transaction was first added in a cache (unix nanoseconds). It is used
+// for the transactions reloaded after a restart, which keep their age instead of being considered new
+func (wrappedTx *WrappedTransaction) SetInsertionTimestamp(timestamp int64) {
+	wrappedTx.insertionTimestamp = timestamp
+}
+
+// onInsertion records the moment the transaction was first added in a cache (unix nanoseconds)
+// The transactions moved between caches keep their initial timestamp
+func (wrappedTx *WrappedTransaction) onInsertion(now time.Time) {
+	if wrappedTx.insertionTimestamp == 0 {
+		wrappedTx.insertionTimestamp = now.UnixNano()
+	}
+}
+
+// isExpired returns whether the transaction stayed in the pool for longer than the given maximum age
+func (wrappedTx *WrappedTransaction) isExpired(now time.Time, maxAge time.Duration) bool {
+	age := now.UnixNano() - wrappedTx.insertionTimestamp
+	return age > maxAge.Nanoseconds()
+}
+
 // estimateTxSize returns an approximation
 func estimateTxSize(tx *WrappedTransaction) uint64 {
 	sizeOfData := uint64(len(tx.Tx.GetData()))
@@ -54,6 +93,11 @@ func estimateTxGas(tx *WrappedTransaction) uint64 {
 	return gasLimit
 }
 
+// estimateTxFeePerGasUnit returns the fee paid by the transaction for each unit of gas, in the smallest denomination
+func estimateTxFeePerGasUnit(tx *WrappedTransaction) uint64 {
+	return tx.Tx.GetGasPrice()
+}
+
 // estimateTxFee returns an approximation for the cost of a transaction, in nano ERD
 // TODO: switch to integer operations (as opposed to float operations).
 // TODO: do not assume the order of magnitude of minGasPrice.
@@ -214,3 +214,6 @@ var ErrNilChanStopNodeProcess = errors.New("nil channel to stop node")
 
 // ErrNilEpochConfirmedNotifier signals that nil epoch confirmed notifier was provided
 var ErrNilEpochConfirmedNotifier = errors.New("nil epoch confirmed notifier")
+
+// ErrNilBlockChain signals that a nil blockchain has been provided
+var ErrNilBlockChain = errors.New("nil blockchain")
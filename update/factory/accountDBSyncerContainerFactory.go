@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/syncer"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -19,6 +20,7 @@ import (
 )
 
 const numConcurrentTrieSyncers = 50
+const maxInFlightTrieNodesPerSyncer = 1000
 
 // ArgsNewAccountsDBSyncersContainerFactory defines the arguments needed to create accounts DB syncers container
 type ArgsNewAccountsDBSyncersContainerFactory struct {
@@ -42,6 +44,7 @@ type accountDBSyncersContainerFactory struct {
 	waitTime             time.Duration
 	trieStorageManager   data.StorageManager
 	maxTrieLevelinMemory uint
+	syncStatistics       data.SyncStatisticsHandler
 }
 
 const minWaitTime = time.Second
@@ -79,6 +82,7 @@ func NewAccountsDBSContainerFactory(args ArgsNewAccountsDBSyncersContainerFactor
 		trieStorageManager:   args.TrieStorageManager,
 		waitTime:             args.WaitTime,
 		maxTrieLevelinMemory: args.MaxTrieLevelInMemory,
+		syncStatistics:       trie.NewTrieSyncStatistics(),
 	}
 
 	return t, nil
@@ -123,6 +127,8 @@ func (a *accountDBSyncersContainerFactory) createUserAccountsSyncer(shardId uint
 			WaitTime:             a.waitTime,
 			Cacher:               a.trieCacher,
 			MaxTrieLevelInMemory: a.maxTrieLevelinMemory,
+			TrieSyncStatistics:   a.syncStatistics,
+			MaxInFlightNodes:     maxInFlightTrieNodesPerSyncer,
 		},
 		ShardId:   shardId,
 		Throttler: thr,
@@ -146,6 +152,8 @@ func (a *accountDBSyncersContainerFactory) createValidatorAccountsSyncer(shardId
 			WaitTime:             a.waitTime,
 			Cacher:               a.trieCacher,
 			MaxTrieLevelInMemory: a.maxTrieLevelinMemory,
+			TrieSyncStatistics:   a.syncStatistics,
+			MaxInFlightNodes:     maxInFlightTrieNodesPerSyncer,
 		},
 	}
 	accountSyncer, err := syncer.NewValidatorAccountsSyncer(args)
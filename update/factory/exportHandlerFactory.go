@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -40,6 +41,7 @@ type ArgsExporter struct {
 	Uint64Converter          typeConverters.Uint64ByteSliceConverter
 	DataPool                 dataRetriever.PoolsHolder
 	StorageService           dataRetriever.StorageService
+	BlockChain               data.ChainHandler
 	RequestHandler           process.RequestHandler
 	ShardCoordinator         sharding.Coordinator
 	Messenger                p2p.Messenger
@@ -64,6 +66,7 @@ type ArgsExporter struct {
 	ValidityAttester         process.ValidityAttester
 	InputAntifloodHandler    process.P2PAntifloodHandler
 	OutputAntifloodHandler   process.P2PAntifloodHandler
+	TxVersionEnableEpoch     uint32
 }
 
 type exportHandlerFactory struct {
@@ -74,6 +77,7 @@ type exportHandlerFactory struct {
 	uint64Converter          typeConverters.Uint64ByteSliceConverter
 	dataPool                 dataRetriever.PoolsHolder
 	storageService           dataRetriever.StorageService
+	blockChain               data.ChainHandler
 	requestHandler           process.RequestHandler
 	shardCoordinator         sharding.Coordinator
 	messenger                p2p.Messenger
@@ -101,6 +105,7 @@ type exportHandlerFactory struct {
 	resolverContainer        dataRetriever.ResolversContainer
 	inputAntifloodHandler    process.P2PAntifloodHandler
 	outputAntifloodHandler   process.P2PAntifloodHandler
+	txVersionEnableEpoch     uint32
 }
 
 // NewExportHandlerFactory creates an exporter factory
@@ -126,6 +131,9 @@ func NewExportHandlerFactory(args ArgsExporter) (*exportHandlerFactory, error) {
 	if check.IfNil(args.StorageService) {
 		return nil, update.ErrNilStorage
 	}
+	if check.IfNil(args.BlockChain) {
+		return nil, update.ErrNilBlockChain
+	}
 	if check.IfNil(args.RequestHandler) {
 		return nil, update.ErrNilRequestHandler
 	}
@@ -195,6 +203,7 @@ func NewExportHandlerFactory(args ArgsExporter) (*exportHandlerFactory, error) {
 		uint64Converter:          args.Uint64Converter,
 		dataPool:                 args.DataPool,
 		storageService:           args.StorageService,
+		blockChain:               args.BlockChain,
 		requestHandler:           args.RequestHandler,
 		shardCoordinator:         args.ShardCoordinator,
 		messenger:                args.Messenger,
@@ -219,6 +228,7 @@ func NewExportHandlerFactory(args ArgsExporter) (*exportHandlerFactory, error) {
 		validityAttester:         args.ValidityAttester,
 		inputAntifloodHandler:    args.InputAntifloodHandler,
 		outputAntifloodHandler:   args.OutputAntifloodHandler,
+		txVersionEnableEpoch:     args.TxVersionEnableEpoch,
 		maxTrieLevelInMemory:     args.MaxTrieLevelInMemory,
 	}
 
@@ -379,11 +389,12 @@ func (e *exportHandlerFactory) Create() (update.ExportHandler, error) {
 	}
 
 	argsExporter := genesis.ArgsNewStateExporter{
-		ShardCoordinator: e.shardCoordinator,
-		StateSyncer:      stateSyncer,
-		Marshalizer:      e.marshalizer,
-		Writer:           writer,
-		Hasher:           e.hasher,
+		ShardCoordinator:  e.shardCoordinator,
+		StateSyncer:       stateSyncer,
+		Marshalizer:       e.marshalizer,
+		Writer:            writer,
+		Hasher:            e.hasher,
+		ActiveAccountsDBs: e.activeAccountsDBs,
 	}
 	exportHandler, err := genesis.NewStateExporter(argsExporter)
 	if err != nil {
@@ -402,6 +413,7 @@ func (e *exportHandlerFactory) Create() (update.ExportHandler, error) {
 func (e *exportHandlerFactory) createInterceptors() error {
 	argsInterceptors := ArgsNewFullSyncInterceptorsContainerFactory{
 		Accounts:                e.accounts,
+		BlockChain:              e.blockChain,
 		ShardCoordinator:        e.shardCoordinator,
 		NodesCoordinator:        e.nodesCoordinator,
 		Messenger:               e.messenger,
@@ -429,6 +441,7 @@ func (e *exportHandlerFactory) createInterceptors() error {
 		InterceptorsContainer:   e.interceptorsContainer,
 		AntifloodHandler:        e.inputAntifloodHandler,
 		NonceConverter:          e.uint64Converter,
+		TxVersionEnableEpoch:    e.txVersionEnableEpoch,
 	}
 	fullSyncInterceptors, err := NewFullSyncInterceptorsContainerFactory(argsInterceptors)
 	if err != nil {
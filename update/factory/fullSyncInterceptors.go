@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -17,6 +18,7 @@ import (
 	interceptorFactory "github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/update"
 )
@@ -52,6 +54,7 @@ type fullSyncInterceptorsContainerFactory struct {
 // ArgsNewFullSyncInterceptorsContainerFactory holds the arguments needed for fullSyncInterceptorsContainerFactory
 type ArgsNewFullSyncInterceptorsContainerFactory struct {
 	Accounts                state.AccountsAdapter
+	BlockChain              data.ChainHandler
 	ShardCoordinator        sharding.Coordinator
 	NodesCoordinator        sharding.NodesCoordinator
 	Messenger               process.TopicHandler
@@ -79,6 +82,7 @@ type ArgsNewFullSyncInterceptorsContainerFactory struct {
 	InterceptorsContainer   process.InterceptorsContainer
 	AntifloodHandler        process.P2PAntifloodHandler
 	NonceConverter          typeConverters.Uint64ByteSliceConverter
+	TxVersionEnableEpoch    uint32
 }
 
 // NewFullSyncInterceptorsContainerFactory is responsible for creating a new interceptors factory object
@@ -146,6 +150,11 @@ func NewFullSyncInterceptorsContainerFactory(
 		return nil, process.ErrNilAntifloodHandler
 	}
 
+	multisigAccounts, err := builtInFunctions.NewMultisigAccountsReader(args.Accounts, args.BlockChain, args.Marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
 	argInterceptorFactory := &interceptorFactory.ArgInterceptedDataFactory{
 		Hasher:                  args.Hasher,
 		ProtoMarshalizer:        args.Marshalizer,
@@ -165,6 +174,9 @@ func NewFullSyncInterceptorsContainerFactory(
 		EpochStartTrigger:       args.EpochStartTrigger,
 		NonceConverter:          args.NonceConverter,
 		WhiteListerVerifiedTxs:  args.WhiteListerVerifiedTxs,
+		TxVersionEnableEpoch:    args.TxVersionEnableEpoch,
+		MultisigAccounts:        multisigAccounts,
+		BlockChain:              args.BlockChain,
 	}
 
 	icf := &fullSyncInterceptorsContainerFactory{
@@ -3,6 +3,7 @@ package factory
 import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/trie"
 	factoryTrie "github.com/ElrondNetwork/elrond-go/process/factory"
@@ -27,6 +28,7 @@ type trieSyncersContainerFactory struct {
 	trieCacher       storage.Cacher
 	trieContainer    state.TriesHolder
 	requestHandler   update.RequestHandler
+	syncStatistics   data.SyncStatisticsHandler
 }
 
 // NewTrieSyncersContainerFactory creates a factory for trie syncers container
@@ -52,6 +54,7 @@ func NewTrieSyncersContainerFactory(args ArgsNewTrieSyncersContainerFactory) (*t
 		trieCacher:       args.TrieCacher,
 		requestHandler:   args.RequestHandler,
 		trieContainer:    args.DataTrieContainer,
+		syncStatistics:   trie.NewTrieSyncStatistics(),
 	}
 
 	return t, nil
@@ -93,7 +96,16 @@ func (t *trieSyncersContainerFactory) createOneTrieSyncer(
 		return update.ErrNilDataTrieContainer
 	}
 
-	trieSyncer, err := trie.NewTrieSyncer(t.requestHandler, t.trieCacher, dataTrie, shId, trieTopicFromAccountType(accType))
+	arg := trie.ArgTrieSyncer{
+		RequestHandler:     t.requestHandler,
+		InterceptedNodes:   t.trieCacher,
+		Trie:               dataTrie,
+		ShardId:            shId,
+		Topic:              trieTopicFromAccountType(accType),
+		TrieSyncStatistics: t.syncStatistics,
+		MaxInFlightNodes:   maxInFlightTrieNodesPerSyncer,
+	}
+	trieSyncer, err := trie.NewTrieSyncer(arg)
 	if err != nil {
 		return err
 	}
@@ -27,6 +27,10 @@ const MiniBlocksFileName = "miniBlocks"
 // TrieFileName is the constant which defines the export/import filename for tries
 const TrieFileName = "trie"
 
+// StateDiffFileName is the constant which defines the export filename for the differences between the exported
+// tries and the live state. These files are not imported
+const StateDiffFileName = "stateDiff"
+
 // Type identifies the type of the export / import
 type Type uint8
 
@@ -60,6 +64,12 @@ const atSep = "@"
 const accTypeIDX = 3
 const shardIDIDX = 2
 
+// accountsDbIdentifiers maps the exported accounts types to the identifiers of the live accounts DBs
+var accountsDbIdentifiers = map[Type]state.AccountsDbIdentifier{
+	UserAccount:      state.UserAccountsState,
+	ValidatorAccount: state.PeerAccountsState,
+}
+
 // NewObject creates an object according to the given type
 func NewObject(objType Type) (interface{}, error) {
 	switch objType {
@@ -217,6 +227,11 @@ func AddRootHashToIdentifier(identifier string, hash string) string {
 	return identifier + atSep + hex.EncodeToString([]byte(hash))
 }
 
+// CreateStateDiffKey creates the key of a leaf that differs between an exported trie and the live state
+func CreateStateDiffKey(trieIdentifier string, leafKey []byte) string {
+	return "df" + atSep + trieIdentifier + atSep + hex.EncodeToString(leafKey)
+}
+
 // CreateMiniBlockKey returns a miniblock key
 func CreateMiniBlockKey(key string) string {
 	return "mb" + atSep + hex.EncodeToString([]byte(key))
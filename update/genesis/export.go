@@ -1,6 +1,8 @@
 package genesis
 
 import (
+	"context"
+	"encoding/hex"
 	"encoding/json"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -8,6 +10,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
@@ -17,21 +21,24 @@ import (
 
 var _ update.ExportHandler = (*stateExport)(nil)
 
-// ArgsNewStateExporter defines the arguments needed to create new state exporter
+// ArgsNewStateExporter defines the arguments needed to create new state exporter. The active accounts DBs are
+// optional, if provided the differences between the exported and the live state of the self shard are exported as well
 type ArgsNewStateExporter struct {
-	ShardCoordinator sharding.Coordinator
-	StateSyncer      update.StateSyncer
-	Marshalizer      marshal.Marshalizer
-	Hasher           hashing.Hasher
-	Writer           update.MultiFileWriter
+	ShardCoordinator  sharding.Coordinator
+	StateSyncer       update.StateSyncer
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+	Writer            update.MultiFileWriter
+	ActiveAccountsDBs map[state.AccountsDbIdentifier]state.AccountsAdapter
 }
 
 type stateExport struct {
-	writer           update.MultiFileWriter
-	stateSyncer      update.StateSyncer
-	shardCoordinator sharding.Coordinator
-	marshalizer      marshal.Marshalizer
-	hasher           hashing.Hasher
+	writer            update.MultiFileWriter
+	stateSyncer       update.StateSyncer
+	shardCoordinator  sharding.Coordinator
+	marshalizer       marshal.Marshalizer
+	hasher            hashing.Hasher
+	activeAccountsDBs map[state.AccountsDbIdentifier]state.AccountsAdapter
 }
 
 var log = logger.GetOrCreate("update/genesis")
@@ -55,11 +62,12 @@ func NewStateExporter(args ArgsNewStateExporter) (*stateExport, error) {
 	}
 
 	se := &stateExport{
-		writer:           args.Writer,
-		stateSyncer:      args.StateSyncer,
-		shardCoordinator: args.ShardCoordinator,
-		marshalizer:      args.Marshalizer,
-		hasher:           args.Hasher,
+		writer:            args.Writer,
+		stateSyncer:       args.StateSyncer,
+		shardCoordinator:  args.ShardCoordinator,
+		marshalizer:       args.Marshalizer,
+		hasher:            args.Hasher,
+		activeAccountsDBs: args.ActiveAccountsDBs,
 	}
 
 	return se, nil
@@ -84,6 +92,11 @@ func (se *stateExport) ExportAll(epoch uint32) error {
 		return err
 	}
 
+	err = se.exportAllLiveStateDiffs()
+	if err != nil {
+		return err
+	}
+
 	err = se.exportAllMiniBlocks()
 	if err != nil {
 		return err
@@ -147,6 +160,82 @@ func (se *stateExport) exportAllTries() error {
 	return nil
 }
 
+func (se *stateExport) exportAllLiveStateDiffs() error {
+	if len(se.activeAccountsDBs) == 0 {
+		return nil
+	}
+
+	toExportTries, err := se.stateSyncer.GetAllTries()
+	if err != nil {
+		return err
+	}
+
+	for key, exportedTrie := range toExportTries {
+		accType, shId, err := GetTrieTypeAndShId(TrieFileName + atSep + key)
+		if err != nil {
+			return err
+		}
+		if shId != se.shardCoordinator.SelfId() {
+			continue
+		}
+
+		accountsDbIdentifier, ok := accountsDbIdentifiers[accType]
+		if !ok {
+			continue
+		}
+		activeAccounts, ok := se.activeAccountsDBs[accountsDbIdentifier]
+		if !ok || check.IfNil(activeAccounts) {
+			continue
+		}
+
+		err = se.exportLiveStateDiff(key, exportedTrie, activeAccounts)
+		if err != nil {
+			log.Warn("could not export the diff against the live state", "trie", key, "error", err)
+		}
+	}
+
+	return nil
+}
+
+// exportLiveStateDiff writes the leaves that differ between the exported trie and the current trie of the live state
+func (se *stateExport) exportLiveStateDiff(key string, exportedTrie data.Trie, activeAccounts state.AccountsAdapter) error {
+	liveRootHash, err := activeAccounts.RootHash()
+	if err != nil {
+		return err
+	}
+
+	liveTrie, err := activeAccounts.GetTrie(liveRootHash)
+	if err != nil {
+		return err
+	}
+
+	fileName := StateDiffFileName + atSep + key
+	defer se.writer.CloseFile(fileName)
+
+	numDiffs := 0
+	err = trie.GetTrieDiff(exportedTrie, liveTrie, func(diff trie.LeafDiff) error {
+		jsonData, errMarshal := json.Marshal(state.LeafDiffApiResponse{
+			Type:     diff.Type.String(),
+			Key:      hex.EncodeToString(diff.Key),
+			OldValue: hex.EncodeToString(diff.OldValue),
+			NewValue: hex.EncodeToString(diff.NewValue),
+		})
+		if errMarshal != nil {
+			return errMarshal
+		}
+
+		numDiffs++
+		return se.writer.Write(fileName, CreateStateDiffKey(key, diff.Key), jsonData)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Debug("Exported diff against the live state", "trie", key, "live root hash", liveRootHash, "num diffs", numDiffs)
+
+	return nil
+}
+
 func (se *stateExport) exportMeta() error {
 	metaBlock, err := se.stateSyncer.GetEpochStartMetaBlock()
 	if err != nil {
@@ -174,11 +263,6 @@ func (se *stateExport) exportMeta() error {
 func (se *stateExport) exportTrie(key string, trie data.Trie) error {
 	fileName := TrieFileName + atSep + key
 
-	leaves, err := trie.GetAllLeaves()
-	if err != nil {
-		return err
-	}
-
 	accType, shId, err := GetTrieTypeAndShId(fileName)
 	if err != nil {
 		return err
@@ -199,58 +283,51 @@ func (se *stateExport) exportTrie(key string, trie data.Trie) error {
 		return err
 	}
 
+	exportLeaf := se.exportAccountLeaf
 	if accType == DataTrie {
-		return se.exportDataTries(leaves, accType, shId, fileName)
+		exportLeaf = se.exportDataTrieLeaf
 	}
 
-	return se.exportAccountLeafs(leaves, accType, shId, fileName)
-}
-
-func (se *stateExport) exportDataTries(leafs map[string][]byte, accType Type, shId uint32, fileName string) error {
-	for address, buff := range leafs {
-		keyToExport := CreateAccountKey(accType, shId, address)
-		err := se.writer.Write(fileName, keyToExport, buff)
-		if err != nil {
-			return err
-		}
+	err = trie.IterateAllLeaves(context.Background(), func(key []byte, value []byte) error {
+		return exportLeaf(string(key), value, accType, shId, fileName)
+	})
+	if err != nil {
+		return err
 	}
 
 	se.writer.CloseFile(fileName)
 	return nil
 }
 
-func (se *stateExport) exportAccountLeafs(leafs map[string][]byte, accType Type, shId uint32, fileName string) error {
-	for address, buff := range leafs {
-		keyToExport := CreateAccountKey(accType, shId, address)
-		account, err := NewEmptyAccount(accType, []byte(address))
-		if err != nil {
-			log.Warn("error creating new account account", "address", address, "error", err)
-			continue
-		}
-		err = se.marshalizer.Unmarshal(account, buff)
-		if err != nil {
-			err = se.writer.Write(fileName, keyToExport, buff)
-			if err != nil {
-				return err
-			}
-			log.Trace("error unmarshaling account this is maybe a code", "address", address, "error", err)
-			continue
-		}
+func (se *stateExport) exportDataTrieLeaf(address string, buff []byte, accType Type, shId uint32, fileName string) error {
+	keyToExport := CreateAccountKey(accType, shId, address)
+	return se.writer.Write(fileName, keyToExport, buff)
+}
 
-		jsonData, err := json.Marshal(account)
-		if err != nil {
-			log.Warn("error marshaling account", "address", address, "error", err)
-			continue
+func (se *stateExport) exportAccountLeaf(address string, buff []byte, accType Type, shId uint32, fileName string) error {
+	keyToExport := CreateAccountKey(accType, shId, address)
+	account, err := NewEmptyAccount(accType, []byte(address))
+	if err != nil {
+		log.Warn("error creating new account account", "address", address, "error", err)
+		return nil
+	}
+	err = se.marshalizer.Unmarshal(account, buff)
+	if err != nil {
+		errWrite := se.writer.Write(fileName, keyToExport, buff)
+		if errWrite != nil {
+			return errWrite
 		}
+		log.Trace("error unmarshaling account this is maybe a code", "address", address, "error", err)
+		return nil
+	}
 
-		err = se.writer.Write(fileName, keyToExport, jsonData)
-		if err != nil {
-			return err
-		}
+	jsonData, err := json.Marshal(account)
+	if err != nil {
+		log.Warn("error marshaling account", "address", address, "error", err)
+		return nil
 	}
 
-	se.writer.CloseFile(fileName)
-	return nil
+	return se.writer.Write(fileName, keyToExport, jsonData)
 }
 
 func (se *stateExport) exportMBs(key string, mb *block.MiniBlock) error {
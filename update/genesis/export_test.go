@@ -1,6 +1,8 @@
 package genesis
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"math/big"
 	"os"
 	"testing"
@@ -8,11 +10,16 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/update"
 	"github.com/ElrondNetwork/elrond-go/update/files"
 	"github.com/ElrondNetwork/elrond-go/update/mock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -104,3 +111,86 @@ func TestExportAll(t *testing.T) {
 		require.Fail(t, "file wasn't created"+TransactionsFileName)
 	}
 }
+
+func createCommittedTrie(t *testing.T, db data.DBWriteCacher, keysValues map[string]string) data.Trie {
+	trieStorage, _ := trie.NewTrieStorageManagerWithoutPruning(db)
+	tr, err := trie.NewTrie(trieStorage, &marshal.GogoProtoMarshalizer{}, &mock.HasherMock{}, 5)
+	require.Nil(t, err)
+
+	for key, value := range keysValues {
+		require.Nil(t, tr.Update([]byte(key), []byte(value)))
+	}
+	require.Nil(t, tr.Commit())
+
+	return tr
+}
+
+func TestExportAllLiveStateDiffs(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	exportedTrie := createCommittedTrie(t, db, map[string]string{"addr1": "acc1", "addr2": "acc2"})
+	liveTrie := createCommittedTrie(t, db, map[string]string{"addr1": "acc1", "addr2": "changed acc2", "addr3": "acc3"})
+	liveRootHash, _ := liveTrie.Root()
+
+	selfShardTrieKey := CreateTrieIdentifier(0, UserAccount)
+	otherShardTrieKey := CreateTrieIdentifier(1, UserAccount)
+	stateSyncer := &mock.SyncStateStub{
+		GetAllTriesCalled: func() (map[string]data.Trie, error) {
+			return map[string]data.Trie{
+				selfShardTrieKey:  exportedTrie,
+				otherShardTrieKey: exportedTrie,
+			}, nil
+		},
+	}
+	activeAccounts := &mock.AccountsStub{
+		RootHashCalled: func() ([]byte, error) {
+			return liveRootHash, nil
+		},
+		GetTrieCalled: func(rootHash []byte) (data.Trie, error) {
+			return exportedTrie.Recreate(rootHash)
+		},
+	}
+
+	writtenDiffs := make(map[string]state.LeafDiffApiResponse)
+	closedFiles := make(map[string]struct{})
+	expectedFileName := StateDiffFileName + atSep + selfShardTrieKey
+	writer := &mock.MultiFileWriterStub{
+		WriteCalled: func(fileName string, key string, value []byte) error {
+			assert.Equal(t, expectedFileName, fileName)
+
+			diff := state.LeafDiffApiResponse{}
+			require.Nil(t, json.Unmarshal(value, &diff))
+			writtenDiffs[key] = diff
+			return nil
+		},
+		CloseFileCalled: func(fileName string) {
+			closedFiles[fileName] = struct{}{}
+		},
+	}
+
+	args := ArgsNewStateExporter{
+		ShardCoordinator:  mock.NewOneShardCoordinatorMock(),
+		Marshalizer:       &mock.MarshalizerMock{},
+		StateSyncer:       stateSyncer,
+		Writer:            writer,
+		Hasher:            &mock.HasherMock{},
+		ActiveAccountsDBs: map[state.AccountsDbIdentifier]state.AccountsAdapter{state.UserAccountsState: activeAccounts},
+	}
+	stateExporter, _ := NewStateExporter(args)
+
+	err := stateExporter.exportAllLiveStateDiffs()
+	require.Nil(t, err)
+
+	require.Equal(t, 2, len(writtenDiffs))
+	modifiedDiff := writtenDiffs[CreateStateDiffKey(selfShardTrieKey, []byte("addr2"))]
+	assert.Equal(t, trie.LeafModified.String(), modifiedDiff.Type)
+	assert.Equal(t, hex.EncodeToString([]byte("acc2")), modifiedDiff.OldValue)
+	assert.Equal(t, hex.EncodeToString([]byte("changed acc2")), modifiedDiff.NewValue)
+	addedDiff := writtenDiffs[CreateStateDiffKey(selfShardTrieKey, []byte("addr3"))]
+	assert.Equal(t, trie.LeafAdded.String(), addedDiff.Type)
+	assert.Equal(t, hex.EncodeToString([]byte("acc3")), addedDiff.NewValue)
+
+	_, isClosed := closedFiles[expectedFileName]
+	assert.True(t, isClosed)
+}
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"github.com/ElrondNetwork/elrond-go/data"
 )
 
@@ -42,6 +43,16 @@ func (ts *TrieStub) GetAllLeaves() (map[string][]byte, error) {
 	return nil, nil
 }
 
+// IterateAllLeaves -
+func (ts *TrieStub) IterateAllLeaves(_ context.Context, _ func(key []byte, value []byte) error) error {
+	return nil
+}
+
+// GetProof -
+func (ts *TrieStub) GetProof(_ []byte) ([][]byte, error) {
+	return nil, nil
+}
+
 // IsPruningEnabled -
 func (ts *TrieStub) IsPruningEnabled() bool {
 	return false
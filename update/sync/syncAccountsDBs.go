@@ -111,17 +111,22 @@ func (st *syncAccountsDBs) SyncTriesFrom(meta *block.MetaBlock, waitTime time.Du
 }
 
 func (st *syncAccountsDBs) syncMeta(meta *block.MetaBlock) error {
-	err := st.syncAccountsOfType(genesis.UserAccount, state.UserAccountsState, core.MetachainShardId, meta.RootHash)
-	if err != nil {
-		return err
-	}
+	var errValidatorAccounts error
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		errValidatorAccounts = st.syncAccountsOfType(genesis.ValidatorAccount, state.PeerAccountsState, core.MetachainShardId, meta.ValidatorStatsRootHash)
+		wg.Done()
+	}()
 
-	err = st.syncAccountsOfType(genesis.ValidatorAccount, state.PeerAccountsState, core.MetachainShardId, meta.ValidatorStatsRootHash)
-	if err != nil {
-		return err
+	errUserAccounts := st.syncAccountsOfType(genesis.UserAccount, state.UserAccountsState, core.MetachainShardId, meta.RootHash)
+	wg.Wait()
+
+	if errUserAccounts != nil {
+		return errUserAccounts
 	}
 
-	return nil
+	return errValidatorAccounts
 }
 
 func (st *syncAccountsDBs) syncShard(shardData block.EpochStartShardData) error {
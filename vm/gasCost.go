@@ -30,6 +30,8 @@ type BuiltInCost struct {
 	SaveUserName          uint64
 	SaveKeyValue          uint64
 	ESDTTransfer          uint64
+	SetMultisigAccount    uint64
+	ChangeMultisigSigners uint64
 }
 
 // GasCost holds all the needed gas costs for system smart contracts